@@ -0,0 +1,40 @@
+package benchmark
+
+import (
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+)
+
+// AggregateBenchmark is implemented by the adapters able to run reporting queries, whose rows are projections
+// rather than entities.
+type AggregateBenchmark interface {
+	// SummarizeGenres groups the books by genre and scans the count, the total quantity and the first and last
+	// publication of every genre into a model.GenreSummary.
+	SummarizeGenres(b *testing.B)
+}
+
+// genreSummaryColumns are the columns of model.GenreSummary, for the libraries taking the select list as an expression.
+const genreSummaryColumns = "genre, count(*) AS books, sum(quantity) AS quantity, " +
+	"min(publicized_at) AS first_publicized_at, max(publicized_at) AS last_publicized_at"
+
+// benchmarkSummarizeGenres measures fn summarizing the genres of utils.CatalogBooksNumber books, besides the books
+// left by the other operations.
+func benchmarkSummarizeGenres(b *testing.B, fn func() error) {
+	if err := utils.SeedCatalog(utils.CatalogBooksNumber); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err := fn()
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
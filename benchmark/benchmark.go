@@ -10,6 +10,8 @@ import (
 type Benchmark interface {
 	Init() error
 	Close() error
+	// Capabilities declares the operations the adapter cannot run natively, a nil map means all of them are native.
+	Capabilities() Capabilities
 	Insert(b *testing.B)
 	InsertBulk(b *testing.B)
 	Update(b *testing.B)
@@ -18,6 +20,48 @@ type Benchmark interface {
 	FindPage(b *testing.B)
 }
 
+// Support tells how an adapter runs an operation.
+type Support int
+
+const (
+	// Native means the library has an API for the operation.
+	Native Support = iota
+	// Emulated means the operation is built from other calls, e.g. a bulk insert done row by row.
+	Emulated
+	// Unsupported means the operation is not run and is reported as n/a.
+	Unsupported
+)
+
+// Capabilities maps operation names to their support. Operations missing from the map are Native.
+type Capabilities map[string]Support
+
+// Of returns the support of the named operation.
+func (c Capabilities) Of(operation string) Support {
+	return c[operation]
+}
+
+// StatementsMetric is the unit of the metric reporting how many statements an operation issues.
+const StatementsMetric = "stmts/op"
+
+// countStatements starts counting the statements issued to the database. The returned function stops the
+// timer and reports the count per operation, it reports nothing when pg_stat_statements is not available.
+func countStatements(b *testing.B) func() {
+	start, err := utils.CountStatements()
+	if err != nil {
+		b.Logf("statements are not counted: %v", err)
+		return func() {}
+	}
+	return func() {
+		b.StopTimer()
+		end, err := utils.CountStatements()
+		if err != nil {
+			b.Error(err)
+			return
+		}
+		b.ReportMetric(float64(end-start)/float64(b.N), StatementsMetric)
+	}
+}
+
 func BeforeBenchmark() {
 	utils.RecreateDatabase()
 }
@@ -25,5 +69,6 @@ func BeforeBenchmark() {
 type ResultWrapper struct {
 	Orm        string
 	Benchmarks map[string]testing.BenchmarkResult
+	Support    Capabilities
 	Err        error
 }
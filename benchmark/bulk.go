@@ -0,0 +1,135 @@
+package benchmark
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// BulkMutationBenchmark is implemented by the adapters able to update or delete many books in one statement.
+// Every operation touches utils.BulkInsertNumber books.
+type BulkMutationBenchmark interface {
+	// UpdateBulk sets the same quantity on books selected by id with WHERE id = ANY.
+	UpdateBulk(b *testing.B)
+	// UpdateBulkValues sets a different quantity on each book, joining the books with the new values
+	// or switching on the id with CASE.
+	UpdateBulkValues(b *testing.B)
+	// DeleteBulk deletes books selected by id.
+	DeleteBulk(b *testing.B)
+	// DeleteBulkWhere deletes the books of a genre.
+	DeleteBulkWhere(b *testing.B)
+}
+
+// seedBookIDs persists utils.BulkInsertNumber books with the given genre and returns their ids.
+func seedBookIDs(genre string) ([]int64, error) {
+	books := model.NewBooks(utils.BulkInsertNumber)
+	for _, book := range books {
+		book.Genre = genre
+	}
+	if err := utils.SeedBooks(books...); err != nil {
+		return nil, err
+	}
+	ids := make([]int64, len(books))
+	for i, book := range books {
+		ids[i] = book.ID
+	}
+	return ids, nil
+}
+
+// benchmarkUpdateBulk measures fn setting the quantity of the i-th iteration on the seeded books.
+func benchmarkUpdateBulk(b *testing.B, fn func(ids []int64, quantity int) error) {
+	ids, err := seedBookIDs(model.NewBook().Genre)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err = fn(ids, i)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// benchmarkUpdateBulkValues measures fn setting a different quantity on each seeded book.
+func benchmarkUpdateBulkValues(b *testing.B, fn func(ids []int64, quantities []int) error) {
+	ids, err := seedBookIDs(model.NewBook().Genre)
+	if err != nil {
+		b.Error(err)
+	}
+	quantities := make([]int, len(ids))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for j := range quantities {
+			quantities[j] = i + j
+		}
+		b.StartTimer()
+
+		err = fn(ids, quantities)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// benchmarkDeleteBulk measures fn deleting books seeded before every iteration.
+func benchmarkDeleteBulk(b *testing.B, fn func(ids []int64) error) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		ids, err := seedBookIDs(model.NewBook().Genre)
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+
+		err = fn(ids)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// benchmarkDeleteBulkWhere measures fn deleting the books of a genre seeded before every iteration.
+func benchmarkDeleteBulkWhere(b *testing.B, fn func(genre string) error) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		genre := fmt.Sprintf("Bulk delete %d", i)
+		_, err := seedBookIDs(genre)
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+
+		err = fn(genre)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
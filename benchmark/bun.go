@@ -3,11 +3,14 @@ package benchmark
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
 	"github.com/lauro-santana/golang-orm-benchmarks/model"
 
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/driver/pgdriver"
@@ -18,6 +21,10 @@ type BunBenchmark struct {
 	ctx context.Context
 }
 
+func init() {
+	Register(Adapter{Name: "bun", Category: CategoryORM, New: NewBunBenchmark})
+}
+
 func NewBunBenchmark() Benchmark {
 	return &BunBenchmark{ctx: context.Background()}
 }
@@ -32,6 +39,10 @@ func (o *BunBenchmark) Close() error {
 	return o.db.Close()
 }
 
+func (o *BunBenchmark) Capabilities() Capabilities {
+	return nil
+}
+
 func (o *BunBenchmark) Insert(b *testing.B) {
 	book := model.NewBook()
 
@@ -41,6 +52,7 @@ func (o *BunBenchmark) Insert(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
 		book.ID = 0
+		book.ISBN = model.NewISBN()
 		b.StartTimer()
 
 		_, err := o.db.NewInsert().Model(book).Exec(o.ctx)
@@ -63,6 +75,7 @@ func (o *BunBenchmark) InsertBulk(b *testing.B) {
 		b.StopTimer()
 		for _, book := range books {
 			book.ID = 0
+			book.ISBN = model.NewISBN()
 		}
 		b.StartTimer()
 
@@ -176,3 +189,522 @@ func (o *BunBenchmark) FindPage(b *testing.B) {
 		}
 	}
 }
+
+func (o *BunBenchmark) FindWithPricePolicies(b *testing.B) {
+	book, err := seedBookWithPricePolicies()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		foundBook := new(model.Book)
+		err = o.db.NewSelect().Model(foundBook).Relation("PricePolicies").Where("book.id = ?", book.ID).Scan(o.ctx)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+
+	report()
+}
+
+func (o *BunBenchmark) FindPageWithPricePoliciesNaive(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			var booksPage []model.Book
+			err = o.db.NewSelect().Model(&booksPage).Where("id > ?", pageCursor(books, s)).Limit(utils.PageSize).Scan(o.ctx)
+			for j := 0; err == nil && j < len(booksPage); j++ {
+				err = o.db.NewSelect().
+					Model(&booksPage[j].PricePolicies).
+					Where("book_id = ?", booksPage[j].ID).
+					Scan(o.ctx)
+			}
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *BunBenchmark) FindPageWithPricePoliciesEager(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			var booksPage []model.Book
+			err = o.db.NewSelect().
+				Model(&booksPage).
+				Relation("PricePolicies").
+				Where("book.id > ?", pageCursor(books, s)).
+				Limit(utils.PageSize).
+				Scan(o.ctx)
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *BunBenchmark) TransactionCommit(b *testing.B) {
+	benchmarkTransaction(b, true, o.doTransaction)
+}
+
+func (o *BunBenchmark) TransactionRollback(b *testing.B) {
+	benchmarkTransaction(b, false, o.doTransaction)
+}
+
+func (o *BunBenchmark) doTransaction(book *model.Book, commit bool) error {
+	return o.db.RunInTx(o.ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewInsert().Model(book).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		policies := model.NewPricePolicies(book.ID, utils.TransactionPricePoliciesNumber)
+		_, err = tx.NewInsert().Model(&policies).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.NewUpdate().
+			Model(book).
+			Set("quantity = ?", updatedQuantity(book)).
+			WherePK().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !commit {
+			return errRollback
+		}
+		return nil
+	})
+}
+
+func (o *BunBenchmark) Upsert(b *testing.B) {
+	benchmarkUpsert(b, func(book *model.Book) error {
+		_, err := o.db.NewInsert().
+			Model(book).
+			On("CONFLICT (isbn) DO UPDATE").
+			Set(upsertSet).
+			Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) UpsertBulk(b *testing.B) {
+	benchmarkUpsertBulk(b, func(books []*model.Book) error {
+		_, err := o.db.NewInsert().
+			Model(&books).
+			On("CONFLICT (isbn) DO UPDATE").
+			Set(upsertSet).
+			Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) UpdateBulk(b *testing.B) {
+	benchmarkUpdateBulk(b, func(ids []int64, quantity int) error {
+		_, err := o.db.NewUpdate().
+			Model((*model.Book)(nil)).
+			Set("quantity = ?", quantity).
+			Where("id = ANY(?)", pgdialect.Array(ids)).
+			Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) UpdateBulkValues(b *testing.B) {
+	benchmarkUpdateBulkValues(b, func(ids []int64, quantities []int) error {
+		books := make([]model.Book, len(ids))
+		for i := range ids {
+			books[i].ID = ids[i]
+			books[i].Quantity = quantities[i]
+		}
+
+		_, err := o.db.NewUpdate().Model(&books).Column("quantity").Bulk().Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) DeleteBulk(b *testing.B) {
+	benchmarkDeleteBulk(b, func(ids []int64) error {
+		_, err := o.db.NewDelete().
+			Model((*model.Book)(nil)).
+			Where("id = ANY(?)", pgdialect.Array(ids)).
+			Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) DeleteBulkWhere(b *testing.B) {
+	benchmarkDeleteBulkWhere(b, func(genre string) error {
+		_, err := o.db.NewDelete().
+			Model((*model.Book)(nil)).
+			Where("genre = ?", genre).
+			Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) FindPageOffset(b *testing.B) {
+	benchmarkPageDepths(b, func(offset int, _ int64) error {
+		var booksPage []model.Book
+		_, err := o.db.NewSelect().
+			Model(&booksPage).
+			Order("id").
+			Limit(utils.PageSize).
+			Offset(offset).
+			ScanAndCount(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) FindPageKeyset(b *testing.B) {
+	benchmarkPageDepths(b, func(_ int, cursor int64) error {
+		var booksPage []model.Book
+		return o.db.NewSelect().
+			Model(&booksPage).
+			Where("id > ?", cursor).
+			Order("id").
+			Limit(utils.PageSize).
+			Scan(o.ctx)
+	})
+}
+
+func (o *BunBenchmark) SummarizeGenres(b *testing.B) {
+	benchmarkSummarizeGenres(b, func() error {
+		var summaries []model.GenreSummary
+		return o.db.NewSelect().
+			Model((*model.Book)(nil)).
+			ColumnExpr(genreSummaryColumns).
+			Group("genre").
+			Order("genre").
+			Scan(o.ctx, &summaries)
+	})
+}
+
+func (o *BunBenchmark) Search(b *testing.B) {
+	benchmarkSearch(b, func(search bookSearch) error {
+		var books []model.Book
+		query := o.db.NewSelect().Model(&books)
+		if search.author != "" {
+			query = query.Where("author = ?", search.author)
+		}
+		if search.genres != nil {
+			query = query.Where("genre IN (?)", bun.In(search.genres))
+		}
+		if search.minQuantity != nil {
+			query = query.Where("quantity >= ?", *search.minQuantity)
+		}
+		if search.maxQuantity != nil {
+			query = query.Where("quantity <= ?", *search.maxQuantity)
+		}
+		if !search.publishedFrom.IsZero() {
+			query = query.Where("publicized_at >= ?", search.publishedFrom)
+		}
+		if !search.publishedTo.IsZero() {
+			query = query.Where("publicized_at < ?", search.publishedTo)
+		}
+		if search.title != "" {
+			query = query.Where("title ILIKE ?", search.titlePattern())
+		}
+
+		return query.Order("publicized_at DESC").Limit(utils.PageSize).Scan(o.ctx)
+	})
+}
+
+func (o *BunBenchmark) FindTitleByID(b *testing.B) {
+	benchmarkFindTitleByID(b, func(id int64) error {
+		var title model.BookTitle
+		return o.db.NewSelect().
+			Model((*model.Book)(nil)).
+			Column("id", "title").
+			Where("id = ?", id).
+			Scan(o.ctx, &title)
+	})
+}
+
+func (o *BunBenchmark) FindTitlePage(b *testing.B) {
+	benchmarkFindTitlePage(b, func(cursor int64) error {
+		var titles []model.BookTitle
+		return o.db.NewSelect().
+			Model((*model.Book)(nil)).
+			Column("id", "title").
+			Where("id > ?", cursor).
+			Limit(utils.PageSize).
+			Scan(o.ctx, &titles)
+	})
+}
+
+func (o *BunBenchmark) Stream(b *testing.B) {
+	benchmarkStream(b, func(limit int) (int, error) {
+		rows, err := o.db.NewSelect().Model((*model.Book)(nil)).Order("id").Limit(limit).Rows(o.ctx)
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+
+		read := 0
+		for rows.Next() {
+			var book model.Book
+			if err = o.db.ScanRow(o.ctx, rows, &book); err != nil {
+				return read, err
+			}
+			read++
+		}
+		return read, rows.Err()
+	})
+}
+
+func (o *BunBenchmark) UpdatePartial(b *testing.B) {
+	benchmarkUpdatePartial(b, func(book *model.Book) error {
+		_, err := o.db.NewUpdate().Model(book).Column("quantity").WherePK().Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) Decrement(b *testing.B) {
+	benchmarkDecrement(b, o.decrement)
+}
+
+func (o *BunBenchmark) DecrementConcurrent(b *testing.B) {
+	benchmarkDecrementConcurrent(b, o.decrement)
+}
+
+func (o *BunBenchmark) decrement(id int64) (int, error) {
+	book := model.Book{ID: id}
+	err := o.db.NewUpdate().
+		Model(&book).
+		SetColumn("quantity", "quantity - 1").
+		WherePK().
+		Where("quantity > 0").
+		Returning("quantity").
+		Scan(o.ctx)
+	return book.Quantity, err
+}
+
+func (o *BunBenchmark) UpdateOptimistic(b *testing.B) {
+	benchmarkUpdateOptimistic(b, o.findBook, o.saveVersioned)
+}
+
+func (o *BunBenchmark) findBook(id int64) (*model.Book, error) {
+	book := new(model.Book)
+	err := o.db.NewSelect().Model(book).Where("id = ?", id).Scan(o.ctx)
+	return book, err
+}
+
+func (o *BunBenchmark) saveVersioned(book *model.Book) error {
+	result, err := o.db.NewUpdate().
+		Model(book).
+		Set("quantity = ?", book.Quantity).
+		Set("version = version + 1").
+		WherePK().
+		Where("version = ?", book.Version).
+		Exec(o.ctx)
+	if err != nil {
+		return err
+	}
+	updated, err := result.RowsAffected()
+	if err == nil && updated == 0 {
+		return errVersionConflict
+	}
+	return err
+}
+
+// bunSoftDeletableBook maps the books table with the soft_delete option on deleted_at, which turns the deletes into
+// updates of the column and leaves the deleted books out of the queries. It is kept apart from model.Book, so the
+// other operations are not scoped.
+type bunSoftDeletableBook struct {
+	bun.BaseModel `bun:"table:books"`
+
+	ID           int64 `bun:"id,pk,autoincrement"`
+	ISBN         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int
+	PublicizedAt time.Time
+	Version      int
+	DeletedAt    time.Time `bun:",soft_delete,nullzero"`
+}
+
+func (o *BunBenchmark) SoftDelete(b *testing.B) {
+	benchmarkSoftDelete(b, func(id int64) error {
+		_, err := o.db.NewDelete().
+			Model((*bunSoftDeletableBook)(nil)).
+			Where("id = ?", id).
+			Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) RestoreSoftDeleted(b *testing.B) {
+	benchmarkRestoreSoftDeleted(b, func(id int64) error {
+		_, err := o.db.NewUpdate().
+			Model((*bunSoftDeletableBook)(nil)).
+			Set("deleted_at = NULL").
+			Where("id = ?", id).
+			WhereAllWithDeleted().
+			Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) FindNotDeletedByID(b *testing.B) {
+	benchmarkFindNotDeletedByID(b, o.findNotDeleted)
+}
+
+func (o *BunBenchmark) findNotDeleted(id int64) (bool, error) {
+	book := new(bunSoftDeletableBook)
+	err := o.db.NewSelect().Model(book).Where("id = ?", id).Scan(o.ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (o *BunBenchmark) Exists(b *testing.B) {
+	benchmarkExists(b, func(isbn string) (bool, error) {
+		return o.db.NewSelect().Model((*model.Book)(nil)).Where("isbn = ?", isbn).Exists(o.ctx)
+	})
+}
+
+func (o *BunBenchmark) Count(b *testing.B) {
+	benchmarkCount(b, func(genre string) (int, error) {
+		return o.db.NewSelect().Model((*model.Book)(nil)).Where("genre = ?", genre).Count(o.ctx)
+	})
+}
+
+func (o *BunBenchmark) FindByIDs(b *testing.B) {
+	benchmarkFindByIDs(b, func(ids []int64) (int, error) {
+		var books []model.Book
+		err := o.db.NewSelect().Model(&books).Where("id IN (?)", bun.In(ids)).Scan(o.ctx)
+		return len(books), err
+	})
+}
+
+func (o *BunBenchmark) InsertListing(b *testing.B) {
+	benchmarkInsertListing(b, func(listing *model.Listing) error {
+		_, err := o.db.NewInsert().Model(listing).Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) FindListingByID(b *testing.B) {
+	benchmarkFindListingByID(b, func(id int64) error {
+		listing := new(model.Listing)
+		return o.db.NewSelect().Model(listing).Where("id = ?", id).Scan(o.ctx)
+	})
+}
+
+func (o *BunBenchmark) UpdateListing(b *testing.B) {
+	benchmarkUpdateListing(b, func(listing *model.Listing) error {
+		_, err := o.db.NewUpdate().Model(listing).WherePK().Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) InsertDraft(b *testing.B) {
+	benchmarkInsertDraft(b, func(draft *model.Draft) error {
+		_, err := o.db.NewInsert().Model(draft).Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) FindDraftByID(b *testing.B) {
+	benchmarkFindDraftByID(b, func(id int64) error {
+		draft := new(model.Draft)
+		return o.db.NewSelect().Model(draft).Where("id = ?", id).Scan(o.ctx)
+	})
+}
+
+func (o *BunBenchmark) FindDrafts(b *testing.B) {
+	benchmarkFindDrafts(b, func(limit int) (int, error) {
+		var drafts []model.Draft
+		err := o.db.NewSelect().Model(&drafts).Order("id").Limit(limit).Scan(o.ctx)
+		return len(drafts), err
+	})
+}
+
+func (o *BunBenchmark) InsertWide(b *testing.B) {
+	benchmarkInsertWide(b, func(wide *model.Wide) error {
+		_, err := o.db.NewInsert().Model(wide).Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) FindWideByID(b *testing.B) {
+	benchmarkFindWideByID(b, func(id int64) error {
+		wide := new(model.Wide)
+		return o.db.NewSelect().Model(wide).Where("id = ?", id).Scan(o.ctx)
+	})
+}
+
+func (o *BunBenchmark) FindWides(b *testing.B) {
+	benchmarkFindWides(b, func(limit int) (int, error) {
+		var wides []model.Wide
+		err := o.db.NewSelect().Model(&wides).Order("id").Limit(limit).Scan(o.ctx)
+		return len(wides), err
+	})
+}
+
+func (o *BunBenchmark) InsertUUIDClient(b *testing.B) {
+	benchmarkInsertUUIDClient(b, func(book *model.UUIDBook) error {
+		_, err := o.db.NewInsert().Model(book).Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) InsertUUIDServer(b *testing.B) {
+	benchmarkInsertUUIDServer(b, func(book *model.UUIDBook) error {
+		// The key is zero, so bun leaves it to the default of the column and reads it back.
+		_, err := o.db.NewInsert().Model(book).Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark) FindUUIDByID(b *testing.B) {
+	benchmarkFindUUIDByID(b, func(id uuid.UUID) error {
+		book := new(model.UUIDBook)
+		return o.db.NewSelect().Model(book).Where("id = ?", id).Scan(o.ctx)
+	})
+}
+
+func (o *BunBenchmark) DeleteUUID(b *testing.B) {
+	benchmarkDeleteUUID(b, func(id uuid.UUID) error {
+		_, err := o.db.NewDelete().Model(&model.UUIDBook{ID: id}).WherePK().Exec(o.ctx)
+		return err
+	})
+}
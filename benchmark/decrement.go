@@ -0,0 +1,82 @@
+package benchmark
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// DecrementBenchmark is implemented by the adapters able to update a column from its own value. The quantity of a
+// book is decremented as an inventory would, guarded against going below zero, in a single statement returning
+// the new quantity.
+type DecrementBenchmark interface {
+	// Decrement takes one book out of stock.
+	Decrement(b *testing.B)
+	// DecrementConcurrent takes the same book out of stock from parallel goroutines, contending for its row.
+	DecrementConcurrent(b *testing.B)
+}
+
+// decrementFunc decrements the quantity of the book with the given id when it is in stock and returns the new one.
+type decrementFunc func(id int64) (int, error)
+
+// errOutOfStock is returned by the adapters reporting the updated rows rather than the row itself, when the
+// guard matched no book.
+var errOutOfStock = errors.New("the book is out of stock")
+
+// stockQuantity is the quantity of the decremented book, high enough to never run out during a benchmark.
+const stockQuantity = 1 << 30
+
+// seedStockedBook persists a book with stockQuantity copies.
+func seedStockedBook() (*model.Book, error) {
+	book := model.NewBook()
+	book.Quantity = stockQuantity
+	return book, utils.SeedBooks(book)
+}
+
+// benchmarkDecrement measures fn decrementing the quantity of a book and checks the value it returns.
+func benchmarkDecrement(b *testing.B, fn decrementFunc) {
+	book, err := seedStockedBook()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		quantity, err := fn(book.ID)
+
+		b.StopTimer()
+		if err == nil && quantity != book.Quantity-1 {
+			err = fmt.Errorf("decremented quantity is %d, want %d", quantity, book.Quantity-1)
+		}
+		if err != nil {
+			b.Error(err)
+		}
+		book.Quantity = quantity
+		b.StartTimer()
+	}
+}
+
+// benchmarkDecrementConcurrent measures fn decrementing the quantity of the same book from b.RunParallel
+// goroutines, so the row lock is contended.
+func benchmarkDecrementConcurrent(b *testing.B, fn decrementFunc) {
+	book, err := seedStockedBook()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := fn(book.ID); err != nil {
+				b.Error(err)
+			}
+		}
+	})
+}
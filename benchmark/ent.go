@@ -4,14 +4,19 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent"
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/book"
+	entdraft "github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/draft"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+	entwide "github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/wide"
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
 	"github.com/lauro-santana/golang-orm-benchmarks/model"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 
 	// Postgres driver.
 	_ "github.com/jackc/pgx/v5/stdlib"
@@ -22,6 +27,10 @@ type EntBenchmark struct {
 	ctx context.Context
 }
 
+func init() {
+	Register(Adapter{Name: "ent", Category: CategoryCodegen, New: NewEntBenchmark})
+}
+
 func NewEntBenchmark() Benchmark {
 	return &EntBenchmark{ctx: context.Background()}
 }
@@ -40,6 +49,13 @@ func (o *EntBenchmark) Close() error {
 	return o.db.Close()
 }
 
+func (o *EntBenchmark) Capabilities() Capabilities {
+	return Capabilities{
+		UpdateBulkValuesOp: Emulated,
+		SelectStreamOp:     Emulated,
+	}
+}
+
 func (o *EntBenchmark) Insert(b *testing.B) {
 	newBook := model.NewBook()
 
@@ -49,6 +65,7 @@ func (o *EntBenchmark) Insert(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
 		newBook.ID = 0
+		newBook.ISBN = model.NewISBN()
 		b.StartTimer()
 
 		_, err := o.db.Book.
@@ -87,6 +104,12 @@ func (o *EntBenchmark) InsertBulk(b *testing.B) {
 	}
 
 	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for _, create := range batch {
+			create.SetIsbn(model.NewISBN())
+		}
+		b.StartTimer()
+
 		_, err := o.db.Book.CreateBulk(batch...).Save(o.ctx)
 
 		b.StopTimer()
@@ -243,3 +266,652 @@ func (o *EntBenchmark) FindPage(b *testing.B) {
 		}
 	}
 }
+
+func (o *EntBenchmark) FindWithPricePolicies(b *testing.B) {
+	newBook, err := seedBookWithPricePolicies()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = o.db.Book.
+			Query().
+			Where(book.ID(int(newBook.ID))).
+			WithPricePolicies().
+			Only(o.ctx)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+
+	report()
+}
+
+func (o *EntBenchmark) FindPageWithPricePoliciesNaive(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			var booksPage []*ent.Book
+			booksPage, err = o.db.Book.
+				Query().
+				Where(book.IDGT(int(pageCursor(books, s)))).
+				Limit(utils.PageSize).
+				All(o.ctx)
+			for j := 0; err == nil && j < len(booksPage); j++ {
+				booksPage[j].Edges.PricePolicies, err = booksPage[j].QueryPricePolicies().All(o.ctx)
+			}
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *EntBenchmark) FindPageWithPricePoliciesEager(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			_, err = o.db.Book.
+				Query().
+				Where(book.IDGT(int(pageCursor(books, s)))).
+				Limit(utils.PageSize).
+				WithPricePolicies().
+				All(o.ctx)
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *EntBenchmark) TransactionCommit(b *testing.B) {
+	benchmarkTransaction(b, true, o.doTransaction)
+}
+
+func (o *EntBenchmark) TransactionRollback(b *testing.B) {
+	benchmarkTransaction(b, false, o.doTransaction)
+}
+
+func (o *EntBenchmark) doTransaction(newBook *model.Book, commit bool) error {
+	tx, err := o.db.Tx(o.ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// it is a no-op once the transaction is committed
+		_ = tx.Rollback()
+	}()
+
+	created, err := tx.Book.
+		Create().
+		SetIsbn(newBook.ISBN).
+		SetTitle(newBook.Title).
+		SetAuthor(newBook.Author).
+		SetGenre(newBook.Genre).
+		SetQuantity(newBook.Quantity).
+		SetPublicizedAt(newBook.PublicizedAt).
+		Save(o.ctx)
+	if err != nil {
+		return err
+	}
+
+	policies := model.NewPricePolicies(int64(created.ID), utils.TransactionPricePoliciesNumber)
+	batch := make([]*ent.PricePolicyCreate, len(policies))
+	for i, policy := range policies {
+		batch[i] = tx.PricePolicy.Create().
+			SetBookID(created.ID).
+			SetPrice(policy.Price).
+			SetStartDate(policy.StartDate).
+			SetEndDate(policy.EndDate)
+	}
+	_, err = tx.PricePolicy.CreateBulk(batch...).Save(o.ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Book.UpdateOneID(created.ID).SetQuantity(updatedQuantity(newBook)).Exec(o.ctx)
+	if err != nil {
+		return err
+	}
+
+	if !commit {
+		return tx.Rollback()
+	}
+	return tx.Commit()
+}
+
+func (o *EntBenchmark) Upsert(b *testing.B) {
+	benchmarkUpsert(b, func(newBook *model.Book) error {
+		return o.db.Book.
+			Create().
+			SetIsbn(newBook.ISBN).
+			SetTitle(newBook.Title).
+			SetAuthor(newBook.Author).
+			SetGenre(newBook.Genre).
+			SetQuantity(newBook.Quantity).
+			SetPublicizedAt(newBook.PublicizedAt).
+			OnConflictColumns(book.FieldIsbn).
+			UpdateNewValues().
+			Exec(o.ctx)
+	})
+}
+
+func (o *EntBenchmark) UpsertBulk(b *testing.B) {
+	benchmarkUpsertBulk(b, func(books []*model.Book) error {
+		batch := make([]*ent.BookCreate, len(books))
+		for i, newBook := range books {
+			batch[i] = o.db.Book.Create().
+				SetIsbn(newBook.ISBN).
+				SetTitle(newBook.Title).
+				SetAuthor(newBook.Author).
+				SetGenre(newBook.Genre).
+				SetQuantity(newBook.Quantity).
+				SetPublicizedAt(newBook.PublicizedAt)
+		}
+
+		return o.db.Book.
+			CreateBulk(batch...).
+			OnConflictColumns(book.FieldIsbn).
+			UpdateNewValues().
+			Exec(o.ctx)
+	})
+}
+
+func (o *EntBenchmark) UpdateBulk(b *testing.B) {
+	benchmarkUpdateBulk(b, func(ids []int64, quantity int) error {
+		_, err := o.db.Book.
+			Update().
+			Where(book.IDIn(toInts(ids)...)).
+			SetQuantity(quantity).
+			Save(o.ctx)
+		return err
+	})
+}
+
+func (o *EntBenchmark) UpdateBulkValues(b *testing.B) {
+	benchmarkUpdateBulkValues(b, func(ids []int64, quantities []int) error {
+		// ent has no bulk update of different values, the books are updated one by one in a transaction.
+		tx, err := o.db.Tx(o.ctx)
+		if err != nil {
+			return err
+		}
+		for i := range ids {
+			err = tx.Book.UpdateOneID(int(ids[i])).SetQuantity(quantities[i]).Exec(o.ctx)
+			if err != nil {
+				_ = tx.Rollback()
+				return err
+			}
+		}
+		return tx.Commit()
+	})
+}
+
+func (o *EntBenchmark) DeleteBulk(b *testing.B) {
+	benchmarkDeleteBulk(b, func(ids []int64) error {
+		_, err := o.db.Book.Delete().Where(book.IDIn(toInts(ids)...)).Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *EntBenchmark) DeleteBulkWhere(b *testing.B) {
+	benchmarkDeleteBulkWhere(b, func(genre string) error {
+		_, err := o.db.Book.Delete().Where(book.Genre(genre)).Exec(o.ctx)
+		return err
+	})
+}
+
+// toInts converts the ids to the type ent generates for the id field.
+func toInts(ids []int64) []int {
+	converted := make([]int, len(ids))
+	for i, id := range ids {
+		converted[i] = int(id)
+	}
+	return converted
+}
+
+func (o *EntBenchmark) FindPageOffset(b *testing.B) {
+	benchmarkPageDepths(b, func(offset int, _ int64) error {
+		_, err := o.db.Book.
+			Query().
+			Order(book.ByID()).
+			Limit(utils.PageSize).
+			Offset(offset).
+			All(o.ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = o.db.Book.Query().Count(o.ctx)
+		return err
+	})
+}
+
+func (o *EntBenchmark) FindPageKeyset(b *testing.B) {
+	benchmarkPageDepths(b, func(_ int, cursor int64) error {
+		_, err := o.db.Book.
+			Query().
+			Where(book.IDGT(int(cursor))).
+			Order(book.ByID()).
+			Limit(utils.PageSize).
+			All(o.ctx)
+		return err
+	})
+}
+
+func (o *EntBenchmark) SummarizeGenres(b *testing.B) {
+	benchmarkSummarizeGenres(b, func() error {
+		var summaries []model.GenreSummary
+		return o.db.Book.
+			Query().
+			Order(book.ByGenre()).
+			GroupBy(book.FieldGenre).
+			Aggregate(
+				ent.As(ent.Count(), "books"),
+				ent.As(ent.Sum(book.FieldQuantity), "quantity"),
+				ent.As(ent.Min(book.FieldPublicizedAt), "first_publicized_at"),
+				ent.As(ent.Max(book.FieldPublicizedAt), "last_publicized_at"),
+			).
+			Scan(o.ctx, &summaries)
+	})
+}
+
+func (o *EntBenchmark) Search(b *testing.B) {
+	benchmarkSearch(b, func(search bookSearch) error {
+		var predicates []predicate.Book
+		if search.author != "" {
+			predicates = append(predicates, book.AuthorEQ(search.author))
+		}
+		if search.genres != nil {
+			predicates = append(predicates, book.GenreIn(search.genres...))
+		}
+		if search.minQuantity != nil {
+			predicates = append(predicates, book.QuantityGTE(*search.minQuantity))
+		}
+		if search.maxQuantity != nil {
+			predicates = append(predicates, book.QuantityLTE(*search.maxQuantity))
+		}
+		if !search.publishedFrom.IsZero() {
+			predicates = append(predicates, book.PublicizedAtGTE(search.publishedFrom))
+		}
+		if !search.publishedTo.IsZero() {
+			predicates = append(predicates, book.PublicizedAtLT(search.publishedTo))
+		}
+		if search.title != "" {
+			predicates = append(predicates, book.TitleContainsFold(search.title))
+		}
+
+		_, err := o.db.Book.
+			Query().
+			Where(predicates...).
+			Order(book.ByPublicizedAt(entsql.OrderDesc())).
+			Limit(utils.PageSize).
+			All(o.ctx)
+		return err
+	})
+}
+
+func (o *EntBenchmark) FindTitleByID(b *testing.B) {
+	benchmarkFindTitleByID(b, func(id int64) error {
+		// ent scans projections into slices only.
+		var titles []model.BookTitle
+		return o.db.Book.
+			Query().
+			Where(book.ID(int(id))).
+			Select(book.FieldID, book.FieldTitle).
+			Scan(o.ctx, &titles)
+	})
+}
+
+func (o *EntBenchmark) FindTitlePage(b *testing.B) {
+	benchmarkFindTitlePage(b, func(cursor int64) error {
+		var titles []model.BookTitle
+		return o.db.Book.
+			Query().
+			Where(book.IDGT(int(cursor))).
+			Limit(utils.PageSize).
+			Select(book.FieldID, book.FieldTitle).
+			Scan(o.ctx, &titles)
+	})
+}
+
+func (o *EntBenchmark) Stream(b *testing.B) {
+	benchmarkStream(b, func(limit int) (int, error) {
+		// ent has no iterator, the books are read in batches of keyset pages.
+		read, cursor := 0, 0
+		for read < limit {
+			books, err := o.db.Book.
+				Query().
+				Where(book.IDGT(cursor)).
+				Order(book.ByID()).
+				Limit(min(utils.StreamBatchSize, limit-read)).
+				All(o.ctx)
+			if err != nil || len(books) == 0 {
+				return read, err
+			}
+			read += len(books)
+			cursor = books[len(books)-1].ID
+		}
+		return read, nil
+	})
+}
+
+func (o *EntBenchmark) UpdatePartial(b *testing.B) {
+	benchmarkUpdatePartial(b, func(book *model.Book) error {
+		return o.db.Book.UpdateOneID(int(book.ID)).SetQuantity(book.Quantity).Exec(o.ctx)
+	})
+}
+
+func (o *EntBenchmark) Decrement(b *testing.B) {
+	benchmarkDecrement(b, o.decrement)
+}
+
+func (o *EntBenchmark) DecrementConcurrent(b *testing.B) {
+	benchmarkDecrementConcurrent(b, o.decrement)
+}
+
+func (o *EntBenchmark) decrement(id int64) (int, error) {
+	updated, err := o.db.Book.
+		UpdateOneID(int(id)).
+		Where(book.QuantityGT(0)).
+		AddQuantity(-1).
+		Save(o.ctx)
+	if err != nil {
+		return 0, err
+	}
+	return updated.Quantity, nil
+}
+
+func (o *EntBenchmark) UpdateOptimistic(b *testing.B) {
+	benchmarkUpdateOptimistic(b, o.findBook, o.saveVersioned)
+}
+
+func (o *EntBenchmark) findBook(id int64) (*model.Book, error) {
+	found, err := o.db.Book.Get(o.ctx, int(id))
+	if err != nil {
+		return nil, err
+	}
+	return &model.Book{
+		ID:           int64(found.ID),
+		ISBN:         found.Isbn,
+		Title:        found.Title,
+		Author:       found.Author,
+		Genre:        found.Genre,
+		Quantity:     found.Quantity,
+		PublicizedAt: found.PublicizedAt,
+		Version:      found.Version,
+	}, nil
+}
+
+func (o *EntBenchmark) saveVersioned(saved *model.Book) error {
+	updated, err := o.db.Book.
+		Update().
+		Where(book.ID(int(saved.ID)), book.Version(saved.Version)).
+		SetQuantity(saved.Quantity).
+		AddVersion(1).
+		Save(o.ctx)
+	if err == nil && updated == 0 {
+		return errVersionConflict
+	}
+	return err
+}
+
+func (o *EntBenchmark) SoftDelete(b *testing.B) {
+	benchmarkSoftDelete(b, func(id int64) error {
+		// ent has no built-in soft delete, the mixin of its documentation needs interceptors, which would scope
+		// every other operation too. The deletion and its filter are explicit instead.
+		return o.db.Book.
+			Update().
+			Where(book.ID(int(id)), book.DeletedAtIsNil()).
+			SetDeletedAt(time.Now()).
+			Exec(o.ctx)
+	})
+}
+
+func (o *EntBenchmark) RestoreSoftDeleted(b *testing.B) {
+	benchmarkRestoreSoftDeleted(b, func(id int64) error {
+		return o.db.Book.UpdateOneID(int(id)).ClearDeletedAt().Exec(o.ctx)
+	})
+}
+
+func (o *EntBenchmark) FindNotDeletedByID(b *testing.B) {
+	benchmarkFindNotDeletedByID(b, o.findNotDeleted)
+}
+
+func (o *EntBenchmark) findNotDeleted(id int64) (bool, error) {
+	_, err := o.db.Book.
+		Query().
+		Where(book.ID(int(id)), book.DeletedAtIsNil()).
+		Only(o.ctx)
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (o *EntBenchmark) Exists(b *testing.B) {
+	benchmarkExists(b, func(isbn string) (bool, error) {
+		return o.db.Book.Query().Where(book.Isbn(isbn)).Exist(o.ctx)
+	})
+}
+
+func (o *EntBenchmark) Count(b *testing.B) {
+	benchmarkCount(b, func(genre string) (int, error) {
+		return o.db.Book.Query().Where(book.Genre(genre)).Count(o.ctx)
+	})
+}
+
+func (o *EntBenchmark) FindByIDs(b *testing.B) {
+	benchmarkFindByIDs(b, func(ids []int64) (int, error) {
+		books, err := o.db.Book.Query().Where(book.IDIn(toInts(ids)...)).All(o.ctx)
+		return len(books), err
+	})
+}
+
+func (o *EntBenchmark) InsertListing(b *testing.B) {
+	benchmarkInsertListing(b, func(listing *model.Listing) error {
+		created, err := o.db.Listing.
+			Create().
+			SetReference(listing.Reference).
+			SetAttributes(listing.Attributes).
+			SetTags(listing.Tags).
+			SetPrice(listing.Price).
+			SetCover(listing.Cover).
+			SetNillableSubtitle(listing.Subtitle).
+			SetNillableDiscontinuedAt(listing.DiscontinuedAt).
+			SetListedAt(listing.ListedAt).
+			Save(o.ctx)
+		if err != nil {
+			return err
+		}
+		listing.ID = int64(created.ID)
+		return nil
+	})
+}
+
+func (o *EntBenchmark) FindListingByID(b *testing.B) {
+	benchmarkFindListingByID(b, func(id int64) error {
+		_, err := o.db.Listing.Get(o.ctx, int(id))
+		return err
+	})
+}
+
+func (o *EntBenchmark) UpdateListing(b *testing.B) {
+	benchmarkUpdateListing(b, func(listing *model.Listing) error {
+		update := o.db.Listing.
+			UpdateOneID(int(listing.ID)).
+			SetReference(listing.Reference).
+			SetAttributes(listing.Attributes).
+			SetTags(listing.Tags).
+			SetPrice(listing.Price).
+			SetCover(listing.Cover).
+			SetListedAt(listing.ListedAt)
+		// The setters of nillable fields ignore nil, the columns are cleared instead.
+		if listing.Subtitle != nil {
+			update.SetSubtitle(*listing.Subtitle)
+		} else {
+			update.ClearSubtitle()
+		}
+		if listing.DiscontinuedAt != nil {
+			update.SetDiscontinuedAt(*listing.DiscontinuedAt)
+		} else {
+			update.ClearDiscontinuedAt()
+		}
+		return update.Exec(o.ctx)
+	})
+}
+
+func (o *EntBenchmark) InsertDraft(b *testing.B) {
+	benchmarkInsertDraft(b, func(draft *model.Draft) error {
+		created, err := o.db.Draft.
+			Create().
+			SetNillableTitle(draft.Title).
+			SetNillableSubtitle(draft.Subtitle).
+			SetNillableAuthor(draft.Author).
+			SetNillableTranslator(draft.Translator).
+			SetNillablePages(draft.Pages).
+			SetNillableRating(draft.Rating).
+			SetNillableIllustrated(draft.Illustrated).
+			SetNillableSubmittedAt(draft.SubmittedAt).
+			Save(o.ctx)
+		if err != nil {
+			return err
+		}
+		draft.ID = int64(created.ID)
+		return nil
+	})
+}
+
+func (o *EntBenchmark) FindDraftByID(b *testing.B) {
+	benchmarkFindDraftByID(b, func(id int64) error {
+		_, err := o.db.Draft.Get(o.ctx, int(id))
+		return err
+	})
+}
+
+func (o *EntBenchmark) FindDrafts(b *testing.B) {
+	benchmarkFindDrafts(b, func(limit int) (int, error) {
+		drafts, err := o.db.Draft.
+			Query().
+			Order(entdraft.ByID()).
+			Limit(limit).
+			All(o.ctx)
+		return len(drafts), err
+	})
+}
+
+func (o *EntBenchmark) InsertWide(b *testing.B) {
+	benchmarkInsertWide(b, func(wide *model.Wide) error {
+		// The setters depend on the width the table is generated with, so the fields are set by name.
+		create := o.db.Wide.Create()
+		for i, value := range wide.Values() {
+			if err := create.Mutation().SetField(model.WideColumns[i], value); err != nil {
+				return err
+			}
+		}
+		created, err := create.Save(o.ctx)
+		if err != nil {
+			return err
+		}
+		wide.ID = int64(created.ID)
+		return nil
+	})
+}
+
+func (o *EntBenchmark) FindWideByID(b *testing.B) {
+	benchmarkFindWideByID(b, func(id int64) error {
+		_, err := o.db.Wide.Get(o.ctx, int(id))
+		return err
+	})
+}
+
+func (o *EntBenchmark) FindWides(b *testing.B) {
+	benchmarkFindWides(b, func(limit int) (int, error) {
+		wides, err := o.db.Wide.
+			Query().
+			Order(entwide.ByID()).
+			Limit(limit).
+			All(o.ctx)
+		return len(wides), err
+	})
+}
+
+func (o *EntBenchmark) InsertUUIDClient(b *testing.B) {
+	benchmarkInsertUUIDClient(b, func(book *model.UUIDBook) error {
+		_, err := o.db.UUIDBook.
+			Create().
+			SetID(book.ID).
+			SetIsbn(book.ISBN).
+			SetTitle(book.Title).
+			SetAuthor(book.Author).
+			SetGenre(book.Genre).
+			SetQuantity(book.Quantity).
+			SetPublicizedAt(book.PublicizedAt).
+			Save(o.ctx)
+		return err
+	})
+}
+
+func (o *EntBenchmark) InsertUUIDServer(b *testing.B) {
+	benchmarkInsertUUIDServer(b, func(book *model.UUIDBook) error {
+		created, err := o.db.UUIDBook.
+			Create().
+			SetIsbn(book.ISBN).
+			SetTitle(book.Title).
+			SetAuthor(book.Author).
+			SetGenre(book.Genre).
+			SetQuantity(book.Quantity).
+			SetPublicizedAt(book.PublicizedAt).
+			Save(o.ctx)
+		if err != nil {
+			return err
+		}
+		book.ID = created.ID
+		return nil
+	})
+}
+
+func (o *EntBenchmark) FindUUIDByID(b *testing.B) {
+	benchmarkFindUUIDByID(b, func(id uuid.UUID) error {
+		_, err := o.db.UUIDBook.Get(o.ctx, id)
+		return err
+	})
+}
+
+func (o *EntBenchmark) DeleteUUID(b *testing.B) {
+	benchmarkDeleteUUID(b, func(id uuid.UUID) error {
+		return o.db.UUIDBook.DeleteOneID(id).Exec(o.ctx)
+	})
+}
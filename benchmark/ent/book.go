@@ -29,19 +29,44 @@ type Book struct {
 	Quantity int `json:"quantity,omitempty"`
 	// PublicizedAt holds the value of the "publicized_at" field.
 	PublicizedAt time.Time `json:"publicized_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BookQuery when eager-loading is set.
+	Edges        BookEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// BookEdges holds the relations/edges for other nodes in the graph.
+type BookEdges struct {
+	// PricePolicies holds the value of the price_policies edge.
+	PricePolicies []*PricePolicy `json:"price_policies,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PricePoliciesOrErr returns the PricePolicies value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookEdges) PricePoliciesOrErr() ([]*PricePolicy, error) {
+	if e.loadedTypes[0] {
+		return e.PricePolicies, nil
+	}
+	return nil, &NotLoadedError{edge: "price_policies"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Book) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case book.FieldID, book.FieldQuantity:
+		case book.FieldID, book.FieldQuantity, book.FieldVersion:
 			values[i] = new(sql.NullInt64)
 		case book.FieldIsbn, book.FieldTitle, book.FieldAuthor, book.FieldGenre:
 			values[i] = new(sql.NullString)
-		case book.FieldPublicizedAt:
+		case book.FieldPublicizedAt, book.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -100,6 +125,19 @@ func (b *Book) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				b.PublicizedAt = value.Time
 			}
+		case book.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				b.Version = int(value.Int64)
+			}
+		case book.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				b.DeletedAt = new(time.Time)
+				*b.DeletedAt = value.Time
+			}
 		default:
 			b.selectValues.Set(columns[i], values[i])
 		}
@@ -113,6 +151,11 @@ func (b *Book) Value(name string) (ent.Value, error) {
 	return b.selectValues.Get(name)
 }
 
+// QueryPricePolicies queries the "price_policies" edge of the Book entity.
+func (b *Book) QueryPricePolicies() *PricePolicyQuery {
+	return NewBookClient(b.config).QueryPricePolicies(b)
+}
+
 // Update returns a builder for updating this Book.
 // Note that you need to call Book.Unwrap() before calling this method if this Book
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -153,6 +196,14 @@ func (b *Book) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("publicized_at=")
 	builder.WriteString(b.PublicizedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", b.Version))
+	builder.WriteString(", ")
+	if v := b.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
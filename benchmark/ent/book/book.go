@@ -4,6 +4,7 @@ package book
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -23,8 +24,21 @@ const (
 	FieldQuantity = "quantity"
 	// FieldPublicizedAt holds the string denoting the publicized_at field in the database.
 	FieldPublicizedAt = "publicized_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// EdgePricePolicies holds the string denoting the price_policies edge name in mutations.
+	EdgePricePolicies = "price_policies"
 	// Table holds the table name of the book in the database.
 	Table = "books"
+	// PricePoliciesTable is the table that holds the price_policies relation/edge.
+	PricePoliciesTable = "price_policies"
+	// PricePoliciesInverseTable is the table name for the PricePolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "pricepolicy" package.
+	PricePoliciesInverseTable = "price_policies"
+	// PricePoliciesColumn is the table column denoting the price_policies relation/edge.
+	PricePoliciesColumn = "book_id"
 )
 
 // Columns holds all SQL columns for book fields.
@@ -36,6 +50,8 @@ var Columns = []string{
 	FieldGenre,
 	FieldQuantity,
 	FieldPublicizedAt,
+	FieldVersion,
+	FieldDeletedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -48,6 +64,11 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+)
+
 // OrderOption defines the ordering options for the Book queries.
 type OrderOption func(*sql.Selector)
 
@@ -85,3 +106,34 @@ func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
 func ByPublicizedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublicizedAt, opts...).ToFunc()
 }
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByPricePoliciesCount orders the results by price_policies count.
+func ByPricePoliciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPricePoliciesStep(), opts...)
+	}
+}
+
+// ByPricePolicies orders the results by price_policies terms.
+func ByPricePolicies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPricePoliciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPricePoliciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PricePoliciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PricePoliciesTable, PricePoliciesColumn),
+	)
+}
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/book"
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/pricepolicy"
 )
 
 // BookUpdate is the builder for updating Book entities.
@@ -119,11 +120,88 @@ func (bu *BookUpdate) SetNillablePublicizedAt(t *time.Time) *BookUpdate {
 	return bu
 }
 
+// SetVersion sets the "version" field.
+func (bu *BookUpdate) SetVersion(i int) *BookUpdate {
+	bu.mutation.ResetVersion()
+	bu.mutation.SetVersion(i)
+	return bu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (bu *BookUpdate) SetNillableVersion(i *int) *BookUpdate {
+	if i != nil {
+		bu.SetVersion(*i)
+	}
+	return bu
+}
+
+// AddVersion adds i to the "version" field.
+func (bu *BookUpdate) AddVersion(i int) *BookUpdate {
+	bu.mutation.AddVersion(i)
+	return bu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (bu *BookUpdate) SetDeletedAt(t time.Time) *BookUpdate {
+	bu.mutation.SetDeletedAt(t)
+	return bu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (bu *BookUpdate) SetNillableDeletedAt(t *time.Time) *BookUpdate {
+	if t != nil {
+		bu.SetDeletedAt(*t)
+	}
+	return bu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (bu *BookUpdate) ClearDeletedAt() *BookUpdate {
+	bu.mutation.ClearDeletedAt()
+	return bu
+}
+
+// AddPricePolicyIDs adds the "price_policies" edge to the PricePolicy entity by IDs.
+func (bu *BookUpdate) AddPricePolicyIDs(ids ...int) *BookUpdate {
+	bu.mutation.AddPricePolicyIDs(ids...)
+	return bu
+}
+
+// AddPricePolicies adds the "price_policies" edges to the PricePolicy entity.
+func (bu *BookUpdate) AddPricePolicies(p ...*PricePolicy) *BookUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bu.AddPricePolicyIDs(ids...)
+}
+
 // Mutation returns the BookMutation object of the builder.
 func (bu *BookUpdate) Mutation() *BookMutation {
 	return bu.mutation
 }
 
+// ClearPricePolicies clears all "price_policies" edges to the PricePolicy entity.
+func (bu *BookUpdate) ClearPricePolicies() *BookUpdate {
+	bu.mutation.ClearPricePolicies()
+	return bu
+}
+
+// RemovePricePolicyIDs removes the "price_policies" edge to PricePolicy entities by IDs.
+func (bu *BookUpdate) RemovePricePolicyIDs(ids ...int) *BookUpdate {
+	bu.mutation.RemovePricePolicyIDs(ids...)
+	return bu
+}
+
+// RemovePricePolicies removes "price_policies" edges to PricePolicy entities.
+func (bu *BookUpdate) RemovePricePolicies(p ...*PricePolicy) *BookUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bu.RemovePricePolicyIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (bu *BookUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
@@ -181,6 +259,63 @@ func (bu *BookUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := bu.mutation.PublicizedAt(); ok {
 		_spec.SetField(book.FieldPublicizedAt, field.TypeTime, value)
 	}
+	if value, ok := bu.mutation.Version(); ok {
+		_spec.SetField(book.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.AddedVersion(); ok {
+		_spec.AddField(book.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.DeletedAt(); ok {
+		_spec.SetField(book.FieldDeletedAt, field.TypeTime, value)
+	}
+	if bu.mutation.DeletedAtCleared() {
+		_spec.ClearField(book.FieldDeletedAt, field.TypeTime)
+	}
+	if bu.mutation.PricePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PricePoliciesTable,
+			Columns: []string{book.PricePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedPricePoliciesIDs(); len(nodes) > 0 && !bu.mutation.PricePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PricePoliciesTable,
+			Columns: []string{book.PricePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.PricePoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PricePoliciesTable,
+			Columns: []string{book.PricePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{book.Label}
@@ -292,11 +427,88 @@ func (buo *BookUpdateOne) SetNillablePublicizedAt(t *time.Time) *BookUpdateOne {
 	return buo
 }
 
+// SetVersion sets the "version" field.
+func (buo *BookUpdateOne) SetVersion(i int) *BookUpdateOne {
+	buo.mutation.ResetVersion()
+	buo.mutation.SetVersion(i)
+	return buo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (buo *BookUpdateOne) SetNillableVersion(i *int) *BookUpdateOne {
+	if i != nil {
+		buo.SetVersion(*i)
+	}
+	return buo
+}
+
+// AddVersion adds i to the "version" field.
+func (buo *BookUpdateOne) AddVersion(i int) *BookUpdateOne {
+	buo.mutation.AddVersion(i)
+	return buo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (buo *BookUpdateOne) SetDeletedAt(t time.Time) *BookUpdateOne {
+	buo.mutation.SetDeletedAt(t)
+	return buo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (buo *BookUpdateOne) SetNillableDeletedAt(t *time.Time) *BookUpdateOne {
+	if t != nil {
+		buo.SetDeletedAt(*t)
+	}
+	return buo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (buo *BookUpdateOne) ClearDeletedAt() *BookUpdateOne {
+	buo.mutation.ClearDeletedAt()
+	return buo
+}
+
+// AddPricePolicyIDs adds the "price_policies" edge to the PricePolicy entity by IDs.
+func (buo *BookUpdateOne) AddPricePolicyIDs(ids ...int) *BookUpdateOne {
+	buo.mutation.AddPricePolicyIDs(ids...)
+	return buo
+}
+
+// AddPricePolicies adds the "price_policies" edges to the PricePolicy entity.
+func (buo *BookUpdateOne) AddPricePolicies(p ...*PricePolicy) *BookUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return buo.AddPricePolicyIDs(ids...)
+}
+
 // Mutation returns the BookMutation object of the builder.
 func (buo *BookUpdateOne) Mutation() *BookMutation {
 	return buo.mutation
 }
 
+// ClearPricePolicies clears all "price_policies" edges to the PricePolicy entity.
+func (buo *BookUpdateOne) ClearPricePolicies() *BookUpdateOne {
+	buo.mutation.ClearPricePolicies()
+	return buo
+}
+
+// RemovePricePolicyIDs removes the "price_policies" edge to PricePolicy entities by IDs.
+func (buo *BookUpdateOne) RemovePricePolicyIDs(ids ...int) *BookUpdateOne {
+	buo.mutation.RemovePricePolicyIDs(ids...)
+	return buo
+}
+
+// RemovePricePolicies removes "price_policies" edges to PricePolicy entities.
+func (buo *BookUpdateOne) RemovePricePolicies(p ...*PricePolicy) *BookUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return buo.RemovePricePolicyIDs(ids...)
+}
+
 // Where appends a list predicates to the BookUpdate builder.
 func (buo *BookUpdateOne) Where(ps ...predicate.Book) *BookUpdateOne {
 	buo.mutation.Where(ps...)
@@ -384,6 +596,63 @@ func (buo *BookUpdateOne) sqlSave(ctx context.Context) (_node *Book, err error)
 	if value, ok := buo.mutation.PublicizedAt(); ok {
 		_spec.SetField(book.FieldPublicizedAt, field.TypeTime, value)
 	}
+	if value, ok := buo.mutation.Version(); ok {
+		_spec.SetField(book.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.AddedVersion(); ok {
+		_spec.AddField(book.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.DeletedAt(); ok {
+		_spec.SetField(book.FieldDeletedAt, field.TypeTime, value)
+	}
+	if buo.mutation.DeletedAtCleared() {
+		_spec.ClearField(book.FieldDeletedAt, field.TypeTime)
+	}
+	if buo.mutation.PricePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PricePoliciesTable,
+			Columns: []string{book.PricePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedPricePoliciesIDs(); len(nodes) > 0 && !buo.mutation.PricePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PricePoliciesTable,
+			Columns: []string{book.PricePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.PricePoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PricePoliciesTable,
+			Columns: []string{book.PricePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Book{config: buo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -9,12 +9,19 @@ import (
 	"log"
 	"reflect"
 
+	"github.com/google/uuid"
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/migrate"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/book"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/draft"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/listing"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/pricepolicy"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/uuidbook"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/wide"
 )
 
 // Client is the client that holds all ent builders.
@@ -24,6 +31,16 @@ type Client struct {
 	Schema *migrate.Schema
 	// Book is the client for interacting with the Book builders.
 	Book *BookClient
+	// Draft is the client for interacting with the Draft builders.
+	Draft *DraftClient
+	// Listing is the client for interacting with the Listing builders.
+	Listing *ListingClient
+	// PricePolicy is the client for interacting with the PricePolicy builders.
+	PricePolicy *PricePolicyClient
+	// UUIDBook is the client for interacting with the UUIDBook builders.
+	UUIDBook *UUIDBookClient
+	// Wide is the client for interacting with the Wide builders.
+	Wide *WideClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -36,6 +53,11 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Book = NewBookClient(c.config)
+	c.Draft = NewDraftClient(c.config)
+	c.Listing = NewListingClient(c.config)
+	c.PricePolicy = NewPricePolicyClient(c.config)
+	c.UUIDBook = NewUUIDBookClient(c.config)
+	c.Wide = NewWideClient(c.config)
 }
 
 type (
@@ -126,9 +148,14 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Book:   NewBookClient(cfg),
+		ctx:         ctx,
+		config:      cfg,
+		Book:        NewBookClient(cfg),
+		Draft:       NewDraftClient(cfg),
+		Listing:     NewListingClient(cfg),
+		PricePolicy: NewPricePolicyClient(cfg),
+		UUIDBook:    NewUUIDBookClient(cfg),
+		Wide:        NewWideClient(cfg),
 	}, nil
 }
 
@@ -146,9 +173,14 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Book:   NewBookClient(cfg),
+		ctx:         ctx,
+		config:      cfg,
+		Book:        NewBookClient(cfg),
+		Draft:       NewDraftClient(cfg),
+		Listing:     NewListingClient(cfg),
+		PricePolicy: NewPricePolicyClient(cfg),
+		UUIDBook:    NewUUIDBookClient(cfg),
+		Wide:        NewWideClient(cfg),
 	}, nil
 }
 
@@ -177,13 +209,21 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Book.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Book, c.Draft, c.Listing, c.PricePolicy, c.UUIDBook, c.Wide,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Book.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Book, c.Draft, c.Listing, c.PricePolicy, c.UUIDBook, c.Wide,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -191,6 +231,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *BookMutation:
 		return c.Book.mutate(ctx, m)
+	case *DraftMutation:
+		return c.Draft.mutate(ctx, m)
+	case *ListingMutation:
+		return c.Listing.mutate(ctx, m)
+	case *PricePolicyMutation:
+		return c.PricePolicy.mutate(ctx, m)
+	case *UUIDBookMutation:
+		return c.UUIDBook.mutate(ctx, m)
+	case *WideMutation:
+		return c.Wide.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -304,6 +354,22 @@ func (c *BookClient) GetX(ctx context.Context, id int) *Book {
 	return obj
 }
 
+// QueryPricePolicies queries the price_policies edge of a Book.
+func (c *BookClient) QueryPricePolicies(b *Book) *PricePolicyQuery {
+	query := (&PricePolicyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, id),
+			sqlgraph.To(pricepolicy.Table, pricepolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.PricePoliciesTable, book.PricePoliciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *BookClient) Hooks() []Hook {
 	return c.hooks.Book
@@ -329,12 +395,693 @@ func (c *BookClient) mutate(ctx context.Context, m *BookMutation) (Value, error)
 	}
 }
 
+// DraftClient is a client for the Draft schema.
+type DraftClient struct {
+	config
+}
+
+// NewDraftClient returns a client for the Draft from the given config.
+func NewDraftClient(c config) *DraftClient {
+	return &DraftClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `draft.Hooks(f(g(h())))`.
+func (c *DraftClient) Use(hooks ...Hook) {
+	c.hooks.Draft = append(c.hooks.Draft, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `draft.Intercept(f(g(h())))`.
+func (c *DraftClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Draft = append(c.inters.Draft, interceptors...)
+}
+
+// Create returns a builder for creating a Draft entity.
+func (c *DraftClient) Create() *DraftCreate {
+	mutation := newDraftMutation(c.config, OpCreate)
+	return &DraftCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Draft entities.
+func (c *DraftClient) CreateBulk(builders ...*DraftCreate) *DraftCreateBulk {
+	return &DraftCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DraftClient) MapCreateBulk(slice any, setFunc func(*DraftCreate, int)) *DraftCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DraftCreateBulk{err: fmt.Errorf("calling to DraftClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DraftCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DraftCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Draft.
+func (c *DraftClient) Update() *DraftUpdate {
+	mutation := newDraftMutation(c.config, OpUpdate)
+	return &DraftUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DraftClient) UpdateOne(d *Draft) *DraftUpdateOne {
+	mutation := newDraftMutation(c.config, OpUpdateOne, withDraft(d))
+	return &DraftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DraftClient) UpdateOneID(id int) *DraftUpdateOne {
+	mutation := newDraftMutation(c.config, OpUpdateOne, withDraftID(id))
+	return &DraftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Draft.
+func (c *DraftClient) Delete() *DraftDelete {
+	mutation := newDraftMutation(c.config, OpDelete)
+	return &DraftDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DraftClient) DeleteOne(d *Draft) *DraftDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DraftClient) DeleteOneID(id int) *DraftDeleteOne {
+	builder := c.Delete().Where(draft.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DraftDeleteOne{builder}
+}
+
+// Query returns a query builder for Draft.
+func (c *DraftClient) Query() *DraftQuery {
+	return &DraftQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDraft},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Draft entity by its id.
+func (c *DraftClient) Get(ctx context.Context, id int) (*Draft, error) {
+	return c.Query().Where(draft.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DraftClient) GetX(ctx context.Context, id int) *Draft {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DraftClient) Hooks() []Hook {
+	return c.hooks.Draft
+}
+
+// Interceptors returns the client interceptors.
+func (c *DraftClient) Interceptors() []Interceptor {
+	return c.inters.Draft
+}
+
+func (c *DraftClient) mutate(ctx context.Context, m *DraftMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DraftCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DraftUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DraftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DraftDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Draft mutation op: %q", m.Op())
+	}
+}
+
+// ListingClient is a client for the Listing schema.
+type ListingClient struct {
+	config
+}
+
+// NewListingClient returns a client for the Listing from the given config.
+func NewListingClient(c config) *ListingClient {
+	return &ListingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `listing.Hooks(f(g(h())))`.
+func (c *ListingClient) Use(hooks ...Hook) {
+	c.hooks.Listing = append(c.hooks.Listing, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `listing.Intercept(f(g(h())))`.
+func (c *ListingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Listing = append(c.inters.Listing, interceptors...)
+}
+
+// Create returns a builder for creating a Listing entity.
+func (c *ListingClient) Create() *ListingCreate {
+	mutation := newListingMutation(c.config, OpCreate)
+	return &ListingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Listing entities.
+func (c *ListingClient) CreateBulk(builders ...*ListingCreate) *ListingCreateBulk {
+	return &ListingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ListingClient) MapCreateBulk(slice any, setFunc func(*ListingCreate, int)) *ListingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ListingCreateBulk{err: fmt.Errorf("calling to ListingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ListingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ListingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Listing.
+func (c *ListingClient) Update() *ListingUpdate {
+	mutation := newListingMutation(c.config, OpUpdate)
+	return &ListingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ListingClient) UpdateOne(l *Listing) *ListingUpdateOne {
+	mutation := newListingMutation(c.config, OpUpdateOne, withListing(l))
+	return &ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ListingClient) UpdateOneID(id int) *ListingUpdateOne {
+	mutation := newListingMutation(c.config, OpUpdateOne, withListingID(id))
+	return &ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Listing.
+func (c *ListingClient) Delete() *ListingDelete {
+	mutation := newListingMutation(c.config, OpDelete)
+	return &ListingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ListingClient) DeleteOne(l *Listing) *ListingDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ListingClient) DeleteOneID(id int) *ListingDeleteOne {
+	builder := c.Delete().Where(listing.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ListingDeleteOne{builder}
+}
+
+// Query returns a query builder for Listing.
+func (c *ListingClient) Query() *ListingQuery {
+	return &ListingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeListing},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Listing entity by its id.
+func (c *ListingClient) Get(ctx context.Context, id int) (*Listing, error) {
+	return c.Query().Where(listing.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ListingClient) GetX(ctx context.Context, id int) *Listing {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ListingClient) Hooks() []Hook {
+	return c.hooks.Listing
+}
+
+// Interceptors returns the client interceptors.
+func (c *ListingClient) Interceptors() []Interceptor {
+	return c.inters.Listing
+}
+
+func (c *ListingClient) mutate(ctx context.Context, m *ListingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ListingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ListingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ListingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Listing mutation op: %q", m.Op())
+	}
+}
+
+// PricePolicyClient is a client for the PricePolicy schema.
+type PricePolicyClient struct {
+	config
+}
+
+// NewPricePolicyClient returns a client for the PricePolicy from the given config.
+func NewPricePolicyClient(c config) *PricePolicyClient {
+	return &PricePolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pricepolicy.Hooks(f(g(h())))`.
+func (c *PricePolicyClient) Use(hooks ...Hook) {
+	c.hooks.PricePolicy = append(c.hooks.PricePolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pricepolicy.Intercept(f(g(h())))`.
+func (c *PricePolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PricePolicy = append(c.inters.PricePolicy, interceptors...)
+}
+
+// Create returns a builder for creating a PricePolicy entity.
+func (c *PricePolicyClient) Create() *PricePolicyCreate {
+	mutation := newPricePolicyMutation(c.config, OpCreate)
+	return &PricePolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PricePolicy entities.
+func (c *PricePolicyClient) CreateBulk(builders ...*PricePolicyCreate) *PricePolicyCreateBulk {
+	return &PricePolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PricePolicyClient) MapCreateBulk(slice any, setFunc func(*PricePolicyCreate, int)) *PricePolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PricePolicyCreateBulk{err: fmt.Errorf("calling to PricePolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PricePolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PricePolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PricePolicy.
+func (c *PricePolicyClient) Update() *PricePolicyUpdate {
+	mutation := newPricePolicyMutation(c.config, OpUpdate)
+	return &PricePolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PricePolicyClient) UpdateOne(pp *PricePolicy) *PricePolicyUpdateOne {
+	mutation := newPricePolicyMutation(c.config, OpUpdateOne, withPricePolicy(pp))
+	return &PricePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PricePolicyClient) UpdateOneID(id int) *PricePolicyUpdateOne {
+	mutation := newPricePolicyMutation(c.config, OpUpdateOne, withPricePolicyID(id))
+	return &PricePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PricePolicy.
+func (c *PricePolicyClient) Delete() *PricePolicyDelete {
+	mutation := newPricePolicyMutation(c.config, OpDelete)
+	return &PricePolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PricePolicyClient) DeleteOne(pp *PricePolicy) *PricePolicyDeleteOne {
+	return c.DeleteOneID(pp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PricePolicyClient) DeleteOneID(id int) *PricePolicyDeleteOne {
+	builder := c.Delete().Where(pricepolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PricePolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for PricePolicy.
+func (c *PricePolicyClient) Query() *PricePolicyQuery {
+	return &PricePolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePricePolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PricePolicy entity by its id.
+func (c *PricePolicyClient) Get(ctx context.Context, id int) (*PricePolicy, error) {
+	return c.Query().Where(pricepolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PricePolicyClient) GetX(ctx context.Context, id int) *PricePolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBook queries the book edge of a PricePolicy.
+func (c *PricePolicyClient) QueryBook(pp *PricePolicy) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pricepolicy.Table, pricepolicy.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pricepolicy.BookTable, pricepolicy.BookColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PricePolicyClient) Hooks() []Hook {
+	return c.hooks.PricePolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *PricePolicyClient) Interceptors() []Interceptor {
+	return c.inters.PricePolicy
+}
+
+func (c *PricePolicyClient) mutate(ctx context.Context, m *PricePolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PricePolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PricePolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PricePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PricePolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PricePolicy mutation op: %q", m.Op())
+	}
+}
+
+// UUIDBookClient is a client for the UUIDBook schema.
+type UUIDBookClient struct {
+	config
+}
+
+// NewUUIDBookClient returns a client for the UUIDBook from the given config.
+func NewUUIDBookClient(c config) *UUIDBookClient {
+	return &UUIDBookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `uuidbook.Hooks(f(g(h())))`.
+func (c *UUIDBookClient) Use(hooks ...Hook) {
+	c.hooks.UUIDBook = append(c.hooks.UUIDBook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `uuidbook.Intercept(f(g(h())))`.
+func (c *UUIDBookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UUIDBook = append(c.inters.UUIDBook, interceptors...)
+}
+
+// Create returns a builder for creating a UUIDBook entity.
+func (c *UUIDBookClient) Create() *UUIDBookCreate {
+	mutation := newUUIDBookMutation(c.config, OpCreate)
+	return &UUIDBookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UUIDBook entities.
+func (c *UUIDBookClient) CreateBulk(builders ...*UUIDBookCreate) *UUIDBookCreateBulk {
+	return &UUIDBookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UUIDBookClient) MapCreateBulk(slice any, setFunc func(*UUIDBookCreate, int)) *UUIDBookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UUIDBookCreateBulk{err: fmt.Errorf("calling to UUIDBookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UUIDBookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UUIDBookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UUIDBook.
+func (c *UUIDBookClient) Update() *UUIDBookUpdate {
+	mutation := newUUIDBookMutation(c.config, OpUpdate)
+	return &UUIDBookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UUIDBookClient) UpdateOne(ub *UUIDBook) *UUIDBookUpdateOne {
+	mutation := newUUIDBookMutation(c.config, OpUpdateOne, withUUIDBook(ub))
+	return &UUIDBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UUIDBookClient) UpdateOneID(id uuid.UUID) *UUIDBookUpdateOne {
+	mutation := newUUIDBookMutation(c.config, OpUpdateOne, withUUIDBookID(id))
+	return &UUIDBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UUIDBook.
+func (c *UUIDBookClient) Delete() *UUIDBookDelete {
+	mutation := newUUIDBookMutation(c.config, OpDelete)
+	return &UUIDBookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UUIDBookClient) DeleteOne(ub *UUIDBook) *UUIDBookDeleteOne {
+	return c.DeleteOneID(ub.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UUIDBookClient) DeleteOneID(id uuid.UUID) *UUIDBookDeleteOne {
+	builder := c.Delete().Where(uuidbook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UUIDBookDeleteOne{builder}
+}
+
+// Query returns a query builder for UUIDBook.
+func (c *UUIDBookClient) Query() *UUIDBookQuery {
+	return &UUIDBookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUUIDBook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UUIDBook entity by its id.
+func (c *UUIDBookClient) Get(ctx context.Context, id uuid.UUID) (*UUIDBook, error) {
+	return c.Query().Where(uuidbook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UUIDBookClient) GetX(ctx context.Context, id uuid.UUID) *UUIDBook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UUIDBookClient) Hooks() []Hook {
+	return c.hooks.UUIDBook
+}
+
+// Interceptors returns the client interceptors.
+func (c *UUIDBookClient) Interceptors() []Interceptor {
+	return c.inters.UUIDBook
+}
+
+func (c *UUIDBookClient) mutate(ctx context.Context, m *UUIDBookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UUIDBookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UUIDBookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UUIDBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UUIDBookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UUIDBook mutation op: %q", m.Op())
+	}
+}
+
+// WideClient is a client for the Wide schema.
+type WideClient struct {
+	config
+}
+
+// NewWideClient returns a client for the Wide from the given config.
+func NewWideClient(c config) *WideClient {
+	return &WideClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wide.Hooks(f(g(h())))`.
+func (c *WideClient) Use(hooks ...Hook) {
+	c.hooks.Wide = append(c.hooks.Wide, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wide.Intercept(f(g(h())))`.
+func (c *WideClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Wide = append(c.inters.Wide, interceptors...)
+}
+
+// Create returns a builder for creating a Wide entity.
+func (c *WideClient) Create() *WideCreate {
+	mutation := newWideMutation(c.config, OpCreate)
+	return &WideCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Wide entities.
+func (c *WideClient) CreateBulk(builders ...*WideCreate) *WideCreateBulk {
+	return &WideCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WideClient) MapCreateBulk(slice any, setFunc func(*WideCreate, int)) *WideCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WideCreateBulk{err: fmt.Errorf("calling to WideClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WideCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WideCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Wide.
+func (c *WideClient) Update() *WideUpdate {
+	mutation := newWideMutation(c.config, OpUpdate)
+	return &WideUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WideClient) UpdateOne(w *Wide) *WideUpdateOne {
+	mutation := newWideMutation(c.config, OpUpdateOne, withWide(w))
+	return &WideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WideClient) UpdateOneID(id int) *WideUpdateOne {
+	mutation := newWideMutation(c.config, OpUpdateOne, withWideID(id))
+	return &WideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Wide.
+func (c *WideClient) Delete() *WideDelete {
+	mutation := newWideMutation(c.config, OpDelete)
+	return &WideDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WideClient) DeleteOne(w *Wide) *WideDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WideClient) DeleteOneID(id int) *WideDeleteOne {
+	builder := c.Delete().Where(wide.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WideDeleteOne{builder}
+}
+
+// Query returns a query builder for Wide.
+func (c *WideClient) Query() *WideQuery {
+	return &WideQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWide},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Wide entity by its id.
+func (c *WideClient) Get(ctx context.Context, id int) (*Wide, error) {
+	return c.Query().Where(wide.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WideClient) GetX(ctx context.Context, id int) *Wide {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WideClient) Hooks() []Hook {
+	return c.hooks.Wide
+}
+
+// Interceptors returns the client interceptors.
+func (c *WideClient) Interceptors() []Interceptor {
+	return c.inters.Wide
+}
+
+func (c *WideClient) mutate(ctx context.Context, m *WideMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WideCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WideUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WideDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Wide mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Book []ent.Hook
+		Book, Draft, Listing, PricePolicy, UUIDBook, Wide []ent.Hook
 	}
 	inters struct {
-		Book []ent.Interceptor
+		Book, Draft, Listing, PricePolicy, UUIDBook, Wide []ent.Interceptor
 	}
 )
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/draft"
+)
+
+// Draft is the model entity for the Draft schema.
+type Draft struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title *string `json:"title,omitempty"`
+	// Subtitle holds the value of the "subtitle" field.
+	Subtitle *string `json:"subtitle,omitempty"`
+	// Author holds the value of the "author" field.
+	Author *string `json:"author,omitempty"`
+	// Translator holds the value of the "translator" field.
+	Translator *string `json:"translator,omitempty"`
+	// Pages holds the value of the "pages" field.
+	Pages *int `json:"pages,omitempty"`
+	// Rating holds the value of the "rating" field.
+	Rating *float64 `json:"rating,omitempty"`
+	// Illustrated holds the value of the "illustrated" field.
+	Illustrated *bool `json:"illustrated,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt  *time.Time `json:"submitted_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Draft) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case draft.FieldIllustrated:
+			values[i] = new(sql.NullBool)
+		case draft.FieldRating:
+			values[i] = new(sql.NullFloat64)
+		case draft.FieldID, draft.FieldPages:
+			values[i] = new(sql.NullInt64)
+		case draft.FieldTitle, draft.FieldSubtitle, draft.FieldAuthor, draft.FieldTranslator:
+			values[i] = new(sql.NullString)
+		case draft.FieldSubmittedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Draft fields.
+func (d *Draft) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case draft.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			d.ID = int(value.Int64)
+		case draft.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				d.Title = new(string)
+				*d.Title = value.String
+			}
+		case draft.FieldSubtitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subtitle", values[i])
+			} else if value.Valid {
+				d.Subtitle = new(string)
+				*d.Subtitle = value.String
+			}
+		case draft.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				d.Author = new(string)
+				*d.Author = value.String
+			}
+		case draft.FieldTranslator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field translator", values[i])
+			} else if value.Valid {
+				d.Translator = new(string)
+				*d.Translator = value.String
+			}
+		case draft.FieldPages:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pages", values[i])
+			} else if value.Valid {
+				d.Pages = new(int)
+				*d.Pages = int(value.Int64)
+			}
+		case draft.FieldRating:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				d.Rating = new(float64)
+				*d.Rating = value.Float64
+			}
+		case draft.FieldIllustrated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field illustrated", values[i])
+			} else if value.Valid {
+				d.Illustrated = new(bool)
+				*d.Illustrated = value.Bool
+			}
+		case draft.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				d.SubmittedAt = new(time.Time)
+				*d.SubmittedAt = value.Time
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Draft.
+// This includes values selected through modifiers, order, etc.
+func (d *Draft) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Draft.
+// Note that you need to call Draft.Unwrap() before calling this method if this Draft
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Draft) Update() *DraftUpdateOne {
+	return NewDraftClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Draft entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Draft) Unwrap() *Draft {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Draft is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Draft) String() string {
+	var builder strings.Builder
+	builder.WriteString("Draft(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	if v := d.Title; v != nil {
+		builder.WriteString("title=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := d.Subtitle; v != nil {
+		builder.WriteString("subtitle=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := d.Author; v != nil {
+		builder.WriteString("author=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := d.Translator; v != nil {
+		builder.WriteString("translator=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := d.Pages; v != nil {
+		builder.WriteString("pages=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := d.Rating; v != nil {
+		builder.WriteString("rating=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := d.Illustrated; v != nil {
+		builder.WriteString("illustrated=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := d.SubmittedAt; v != nil {
+		builder.WriteString("submitted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Drafts is a parsable slice of Draft.
+type Drafts []*Draft
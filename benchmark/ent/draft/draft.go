@@ -0,0 +1,103 @@
+// Code generated by ent, DO NOT EDIT.
+
+package draft
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the draft type in the database.
+	Label = "draft"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldSubtitle holds the string denoting the subtitle field in the database.
+	FieldSubtitle = "subtitle"
+	// FieldAuthor holds the string denoting the author field in the database.
+	FieldAuthor = "author"
+	// FieldTranslator holds the string denoting the translator field in the database.
+	FieldTranslator = "translator"
+	// FieldPages holds the string denoting the pages field in the database.
+	FieldPages = "pages"
+	// FieldRating holds the string denoting the rating field in the database.
+	FieldRating = "rating"
+	// FieldIllustrated holds the string denoting the illustrated field in the database.
+	FieldIllustrated = "illustrated"
+	// FieldSubmittedAt holds the string denoting the submitted_at field in the database.
+	FieldSubmittedAt = "submitted_at"
+	// Table holds the table name of the draft in the database.
+	Table = "drafts"
+)
+
+// Columns holds all SQL columns for draft fields.
+var Columns = []string{
+	FieldID,
+	FieldTitle,
+	FieldSubtitle,
+	FieldAuthor,
+	FieldTranslator,
+	FieldPages,
+	FieldRating,
+	FieldIllustrated,
+	FieldSubmittedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the Draft queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// BySubtitle orders the results by the subtitle field.
+func BySubtitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtitle, opts...).ToFunc()
+}
+
+// ByAuthor orders the results by the author field.
+func ByAuthor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthor, opts...).ToFunc()
+}
+
+// ByTranslator orders the results by the translator field.
+func ByTranslator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTranslator, opts...).ToFunc()
+}
+
+// ByPages orders the results by the pages field.
+func ByPages(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPages, opts...).ToFunc()
+}
+
+// ByRating orders the results by the rating field.
+func ByRating(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRating, opts...).ToFunc()
+}
+
+// ByIllustrated orders the results by the illustrated field.
+func ByIllustrated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIllustrated, opts...).ToFunc()
+}
+
+// BySubmittedAt orders the results by the submitted_at field.
+func BySubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedAt, opts...).ToFunc()
+}
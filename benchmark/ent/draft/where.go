@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package draft
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Draft {
+	return predicate.Draft(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Draft {
+	return predicate.Draft(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Draft {
+	return predicate.Draft(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Draft {
+	return predicate.Draft(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Draft {
+	return predicate.Draft(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Draft {
+	return predicate.Draft(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Draft {
+	return predicate.Draft(sql.FieldLTE(FieldID, id))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldTitle, v))
+}
+
+// Subtitle applies equality check predicate on the "subtitle" field. It's identical to SubtitleEQ.
+func Subtitle(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldSubtitle, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldAuthor, v))
+}
+
+// Translator applies equality check predicate on the "translator" field. It's identical to TranslatorEQ.
+func Translator(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldTranslator, v))
+}
+
+// Pages applies equality check predicate on the "pages" field. It's identical to PagesEQ.
+func Pages(v int) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldPages, v))
+}
+
+// Rating applies equality check predicate on the "rating" field. It's identical to RatingEQ.
+func Rating(v float64) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldRating, v))
+}
+
+// Illustrated applies equality check predicate on the "illustrated" field. It's identical to IllustratedEQ.
+func Illustrated(v bool) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldIllustrated, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Draft {
+	return predicate.Draft(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Draft {
+	return predicate.Draft(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.Draft {
+	return predicate.Draft(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.Draft {
+	return predicate.Draft(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// SubtitleEQ applies the EQ predicate on the "subtitle" field.
+func SubtitleEQ(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldSubtitle, v))
+}
+
+// SubtitleNEQ applies the NEQ predicate on the "subtitle" field.
+func SubtitleNEQ(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldNEQ(FieldSubtitle, v))
+}
+
+// SubtitleIn applies the In predicate on the "subtitle" field.
+func SubtitleIn(vs ...string) predicate.Draft {
+	return predicate.Draft(sql.FieldIn(FieldSubtitle, vs...))
+}
+
+// SubtitleNotIn applies the NotIn predicate on the "subtitle" field.
+func SubtitleNotIn(vs ...string) predicate.Draft {
+	return predicate.Draft(sql.FieldNotIn(FieldSubtitle, vs...))
+}
+
+// SubtitleGT applies the GT predicate on the "subtitle" field.
+func SubtitleGT(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldGT(FieldSubtitle, v))
+}
+
+// SubtitleGTE applies the GTE predicate on the "subtitle" field.
+func SubtitleGTE(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldGTE(FieldSubtitle, v))
+}
+
+// SubtitleLT applies the LT predicate on the "subtitle" field.
+func SubtitleLT(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldLT(FieldSubtitle, v))
+}
+
+// SubtitleLTE applies the LTE predicate on the "subtitle" field.
+func SubtitleLTE(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldLTE(FieldSubtitle, v))
+}
+
+// SubtitleContains applies the Contains predicate on the "subtitle" field.
+func SubtitleContains(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldContains(FieldSubtitle, v))
+}
+
+// SubtitleHasPrefix applies the HasPrefix predicate on the "subtitle" field.
+func SubtitleHasPrefix(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldHasPrefix(FieldSubtitle, v))
+}
+
+// SubtitleHasSuffix applies the HasSuffix predicate on the "subtitle" field.
+func SubtitleHasSuffix(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldHasSuffix(FieldSubtitle, v))
+}
+
+// SubtitleIsNil applies the IsNil predicate on the "subtitle" field.
+func SubtitleIsNil() predicate.Draft {
+	return predicate.Draft(sql.FieldIsNull(FieldSubtitle))
+}
+
+// SubtitleNotNil applies the NotNil predicate on the "subtitle" field.
+func SubtitleNotNil() predicate.Draft {
+	return predicate.Draft(sql.FieldNotNull(FieldSubtitle))
+}
+
+// SubtitleEqualFold applies the EqualFold predicate on the "subtitle" field.
+func SubtitleEqualFold(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldEqualFold(FieldSubtitle, v))
+}
+
+// SubtitleContainsFold applies the ContainsFold predicate on the "subtitle" field.
+func SubtitleContainsFold(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldContainsFold(FieldSubtitle, v))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.Draft {
+	return predicate.Draft(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.Draft {
+	return predicate.Draft(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorIsNil applies the IsNil predicate on the "author" field.
+func AuthorIsNil() predicate.Draft {
+	return predicate.Draft(sql.FieldIsNull(FieldAuthor))
+}
+
+// AuthorNotNil applies the NotNil predicate on the "author" field.
+func AuthorNotNil() predicate.Draft {
+	return predicate.Draft(sql.FieldNotNull(FieldAuthor))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// TranslatorEQ applies the EQ predicate on the "translator" field.
+func TranslatorEQ(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldTranslator, v))
+}
+
+// TranslatorNEQ applies the NEQ predicate on the "translator" field.
+func TranslatorNEQ(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldNEQ(FieldTranslator, v))
+}
+
+// TranslatorIn applies the In predicate on the "translator" field.
+func TranslatorIn(vs ...string) predicate.Draft {
+	return predicate.Draft(sql.FieldIn(FieldTranslator, vs...))
+}
+
+// TranslatorNotIn applies the NotIn predicate on the "translator" field.
+func TranslatorNotIn(vs ...string) predicate.Draft {
+	return predicate.Draft(sql.FieldNotIn(FieldTranslator, vs...))
+}
+
+// TranslatorGT applies the GT predicate on the "translator" field.
+func TranslatorGT(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldGT(FieldTranslator, v))
+}
+
+// TranslatorGTE applies the GTE predicate on the "translator" field.
+func TranslatorGTE(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldGTE(FieldTranslator, v))
+}
+
+// TranslatorLT applies the LT predicate on the "translator" field.
+func TranslatorLT(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldLT(FieldTranslator, v))
+}
+
+// TranslatorLTE applies the LTE predicate on the "translator" field.
+func TranslatorLTE(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldLTE(FieldTranslator, v))
+}
+
+// TranslatorContains applies the Contains predicate on the "translator" field.
+func TranslatorContains(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldContains(FieldTranslator, v))
+}
+
+// TranslatorHasPrefix applies the HasPrefix predicate on the "translator" field.
+func TranslatorHasPrefix(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldHasPrefix(FieldTranslator, v))
+}
+
+// TranslatorHasSuffix applies the HasSuffix predicate on the "translator" field.
+func TranslatorHasSuffix(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldHasSuffix(FieldTranslator, v))
+}
+
+// TranslatorIsNil applies the IsNil predicate on the "translator" field.
+func TranslatorIsNil() predicate.Draft {
+	return predicate.Draft(sql.FieldIsNull(FieldTranslator))
+}
+
+// TranslatorNotNil applies the NotNil predicate on the "translator" field.
+func TranslatorNotNil() predicate.Draft {
+	return predicate.Draft(sql.FieldNotNull(FieldTranslator))
+}
+
+// TranslatorEqualFold applies the EqualFold predicate on the "translator" field.
+func TranslatorEqualFold(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldEqualFold(FieldTranslator, v))
+}
+
+// TranslatorContainsFold applies the ContainsFold predicate on the "translator" field.
+func TranslatorContainsFold(v string) predicate.Draft {
+	return predicate.Draft(sql.FieldContainsFold(FieldTranslator, v))
+}
+
+// PagesEQ applies the EQ predicate on the "pages" field.
+func PagesEQ(v int) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldPages, v))
+}
+
+// PagesNEQ applies the NEQ predicate on the "pages" field.
+func PagesNEQ(v int) predicate.Draft {
+	return predicate.Draft(sql.FieldNEQ(FieldPages, v))
+}
+
+// PagesIn applies the In predicate on the "pages" field.
+func PagesIn(vs ...int) predicate.Draft {
+	return predicate.Draft(sql.FieldIn(FieldPages, vs...))
+}
+
+// PagesNotIn applies the NotIn predicate on the "pages" field.
+func PagesNotIn(vs ...int) predicate.Draft {
+	return predicate.Draft(sql.FieldNotIn(FieldPages, vs...))
+}
+
+// PagesGT applies the GT predicate on the "pages" field.
+func PagesGT(v int) predicate.Draft {
+	return predicate.Draft(sql.FieldGT(FieldPages, v))
+}
+
+// PagesGTE applies the GTE predicate on the "pages" field.
+func PagesGTE(v int) predicate.Draft {
+	return predicate.Draft(sql.FieldGTE(FieldPages, v))
+}
+
+// PagesLT applies the LT predicate on the "pages" field.
+func PagesLT(v int) predicate.Draft {
+	return predicate.Draft(sql.FieldLT(FieldPages, v))
+}
+
+// PagesLTE applies the LTE predicate on the "pages" field.
+func PagesLTE(v int) predicate.Draft {
+	return predicate.Draft(sql.FieldLTE(FieldPages, v))
+}
+
+// PagesIsNil applies the IsNil predicate on the "pages" field.
+func PagesIsNil() predicate.Draft {
+	return predicate.Draft(sql.FieldIsNull(FieldPages))
+}
+
+// PagesNotNil applies the NotNil predicate on the "pages" field.
+func PagesNotNil() predicate.Draft {
+	return predicate.Draft(sql.FieldNotNull(FieldPages))
+}
+
+// RatingEQ applies the EQ predicate on the "rating" field.
+func RatingEQ(v float64) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldRating, v))
+}
+
+// RatingNEQ applies the NEQ predicate on the "rating" field.
+func RatingNEQ(v float64) predicate.Draft {
+	return predicate.Draft(sql.FieldNEQ(FieldRating, v))
+}
+
+// RatingIn applies the In predicate on the "rating" field.
+func RatingIn(vs ...float64) predicate.Draft {
+	return predicate.Draft(sql.FieldIn(FieldRating, vs...))
+}
+
+// RatingNotIn applies the NotIn predicate on the "rating" field.
+func RatingNotIn(vs ...float64) predicate.Draft {
+	return predicate.Draft(sql.FieldNotIn(FieldRating, vs...))
+}
+
+// RatingGT applies the GT predicate on the "rating" field.
+func RatingGT(v float64) predicate.Draft {
+	return predicate.Draft(sql.FieldGT(FieldRating, v))
+}
+
+// RatingGTE applies the GTE predicate on the "rating" field.
+func RatingGTE(v float64) predicate.Draft {
+	return predicate.Draft(sql.FieldGTE(FieldRating, v))
+}
+
+// RatingLT applies the LT predicate on the "rating" field.
+func RatingLT(v float64) predicate.Draft {
+	return predicate.Draft(sql.FieldLT(FieldRating, v))
+}
+
+// RatingLTE applies the LTE predicate on the "rating" field.
+func RatingLTE(v float64) predicate.Draft {
+	return predicate.Draft(sql.FieldLTE(FieldRating, v))
+}
+
+// RatingIsNil applies the IsNil predicate on the "rating" field.
+func RatingIsNil() predicate.Draft {
+	return predicate.Draft(sql.FieldIsNull(FieldRating))
+}
+
+// RatingNotNil applies the NotNil predicate on the "rating" field.
+func RatingNotNil() predicate.Draft {
+	return predicate.Draft(sql.FieldNotNull(FieldRating))
+}
+
+// IllustratedEQ applies the EQ predicate on the "illustrated" field.
+func IllustratedEQ(v bool) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldIllustrated, v))
+}
+
+// IllustratedNEQ applies the NEQ predicate on the "illustrated" field.
+func IllustratedNEQ(v bool) predicate.Draft {
+	return predicate.Draft(sql.FieldNEQ(FieldIllustrated, v))
+}
+
+// IllustratedIsNil applies the IsNil predicate on the "illustrated" field.
+func IllustratedIsNil() predicate.Draft {
+	return predicate.Draft(sql.FieldIsNull(FieldIllustrated))
+}
+
+// IllustratedNotNil applies the NotNil predicate on the "illustrated" field.
+func IllustratedNotNil() predicate.Draft {
+	return predicate.Draft(sql.FieldNotNull(FieldIllustrated))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.Draft {
+	return predicate.Draft(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.Draft {
+	return predicate.Draft(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.Draft {
+	return predicate.Draft(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.Draft {
+	return predicate.Draft(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.Draft {
+	return predicate.Draft(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.Draft {
+	return predicate.Draft(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.Draft {
+	return predicate.Draft(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.Draft {
+	return predicate.Draft(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.Draft {
+	return predicate.Draft(sql.FieldIsNull(FieldSubmittedAt))
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.Draft {
+	return predicate.Draft(sql.FieldNotNull(FieldSubmittedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Draft) predicate.Draft {
+	return predicate.Draft(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Draft) predicate.Draft {
+	return predicate.Draft(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Draft) predicate.Draft {
+	return predicate.Draft(sql.NotPredicates(p))
+}
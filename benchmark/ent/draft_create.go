@@ -0,0 +1,1066 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/draft"
+)
+
+// DraftCreate is the builder for creating a Draft entity.
+type DraftCreate struct {
+	config
+	mutation *DraftMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTitle sets the "title" field.
+func (dc *DraftCreate) SetTitle(s string) *DraftCreate {
+	dc.mutation.SetTitle(s)
+	return dc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (dc *DraftCreate) SetNillableTitle(s *string) *DraftCreate {
+	if s != nil {
+		dc.SetTitle(*s)
+	}
+	return dc
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (dc *DraftCreate) SetSubtitle(s string) *DraftCreate {
+	dc.mutation.SetSubtitle(s)
+	return dc
+}
+
+// SetNillableSubtitle sets the "subtitle" field if the given value is not nil.
+func (dc *DraftCreate) SetNillableSubtitle(s *string) *DraftCreate {
+	if s != nil {
+		dc.SetSubtitle(*s)
+	}
+	return dc
+}
+
+// SetAuthor sets the "author" field.
+func (dc *DraftCreate) SetAuthor(s string) *DraftCreate {
+	dc.mutation.SetAuthor(s)
+	return dc
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (dc *DraftCreate) SetNillableAuthor(s *string) *DraftCreate {
+	if s != nil {
+		dc.SetAuthor(*s)
+	}
+	return dc
+}
+
+// SetTranslator sets the "translator" field.
+func (dc *DraftCreate) SetTranslator(s string) *DraftCreate {
+	dc.mutation.SetTranslator(s)
+	return dc
+}
+
+// SetNillableTranslator sets the "translator" field if the given value is not nil.
+func (dc *DraftCreate) SetNillableTranslator(s *string) *DraftCreate {
+	if s != nil {
+		dc.SetTranslator(*s)
+	}
+	return dc
+}
+
+// SetPages sets the "pages" field.
+func (dc *DraftCreate) SetPages(i int) *DraftCreate {
+	dc.mutation.SetPages(i)
+	return dc
+}
+
+// SetNillablePages sets the "pages" field if the given value is not nil.
+func (dc *DraftCreate) SetNillablePages(i *int) *DraftCreate {
+	if i != nil {
+		dc.SetPages(*i)
+	}
+	return dc
+}
+
+// SetRating sets the "rating" field.
+func (dc *DraftCreate) SetRating(f float64) *DraftCreate {
+	dc.mutation.SetRating(f)
+	return dc
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (dc *DraftCreate) SetNillableRating(f *float64) *DraftCreate {
+	if f != nil {
+		dc.SetRating(*f)
+	}
+	return dc
+}
+
+// SetIllustrated sets the "illustrated" field.
+func (dc *DraftCreate) SetIllustrated(b bool) *DraftCreate {
+	dc.mutation.SetIllustrated(b)
+	return dc
+}
+
+// SetNillableIllustrated sets the "illustrated" field if the given value is not nil.
+func (dc *DraftCreate) SetNillableIllustrated(b *bool) *DraftCreate {
+	if b != nil {
+		dc.SetIllustrated(*b)
+	}
+	return dc
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (dc *DraftCreate) SetSubmittedAt(t time.Time) *DraftCreate {
+	dc.mutation.SetSubmittedAt(t)
+	return dc
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (dc *DraftCreate) SetNillableSubmittedAt(t *time.Time) *DraftCreate {
+	if t != nil {
+		dc.SetSubmittedAt(*t)
+	}
+	return dc
+}
+
+// Mutation returns the DraftMutation object of the builder.
+func (dc *DraftCreate) Mutation() *DraftMutation {
+	return dc.mutation
+}
+
+// Save creates the Draft in the database.
+func (dc *DraftCreate) Save(ctx context.Context) (*Draft, error) {
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DraftCreate) SaveX(ctx context.Context) *Draft {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DraftCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DraftCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DraftCreate) check() error {
+	return nil
+}
+
+func (dc *DraftCreate) sqlSave(ctx context.Context) (*Draft, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DraftCreate) createSpec() (*Draft, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Draft{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(draft.Table, sqlgraph.NewFieldSpec(draft.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = dc.conflict
+	if value, ok := dc.mutation.Title(); ok {
+		_spec.SetField(draft.FieldTitle, field.TypeString, value)
+		_node.Title = &value
+	}
+	if value, ok := dc.mutation.Subtitle(); ok {
+		_spec.SetField(draft.FieldSubtitle, field.TypeString, value)
+		_node.Subtitle = &value
+	}
+	if value, ok := dc.mutation.Author(); ok {
+		_spec.SetField(draft.FieldAuthor, field.TypeString, value)
+		_node.Author = &value
+	}
+	if value, ok := dc.mutation.Translator(); ok {
+		_spec.SetField(draft.FieldTranslator, field.TypeString, value)
+		_node.Translator = &value
+	}
+	if value, ok := dc.mutation.Pages(); ok {
+		_spec.SetField(draft.FieldPages, field.TypeInt, value)
+		_node.Pages = &value
+	}
+	if value, ok := dc.mutation.Rating(); ok {
+		_spec.SetField(draft.FieldRating, field.TypeFloat64, value)
+		_node.Rating = &value
+	}
+	if value, ok := dc.mutation.Illustrated(); ok {
+		_spec.SetField(draft.FieldIllustrated, field.TypeBool, value)
+		_node.Illustrated = &value
+	}
+	if value, ok := dc.mutation.SubmittedAt(); ok {
+		_spec.SetField(draft.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Draft.Create().
+//		SetTitle(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DraftUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (dc *DraftCreate) OnConflict(opts ...sql.ConflictOption) *DraftUpsertOne {
+	dc.conflict = opts
+	return &DraftUpsertOne{
+		create: dc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Draft.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dc *DraftCreate) OnConflictColumns(columns ...string) *DraftUpsertOne {
+	dc.conflict = append(dc.conflict, sql.ConflictColumns(columns...))
+	return &DraftUpsertOne{
+		create: dc,
+	}
+}
+
+type (
+	// DraftUpsertOne is the builder for "upsert"-ing
+	//  one Draft node.
+	DraftUpsertOne struct {
+		create *DraftCreate
+	}
+
+	// DraftUpsert is the "OnConflict" setter.
+	DraftUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTitle sets the "title" field.
+func (u *DraftUpsert) SetTitle(v string) *DraftUpsert {
+	u.Set(draft.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *DraftUpsert) UpdateTitle() *DraftUpsert {
+	u.SetExcluded(draft.FieldTitle)
+	return u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *DraftUpsert) ClearTitle() *DraftUpsert {
+	u.SetNull(draft.FieldTitle)
+	return u
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *DraftUpsert) SetSubtitle(v string) *DraftUpsert {
+	u.Set(draft.FieldSubtitle, v)
+	return u
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *DraftUpsert) UpdateSubtitle() *DraftUpsert {
+	u.SetExcluded(draft.FieldSubtitle)
+	return u
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *DraftUpsert) ClearSubtitle() *DraftUpsert {
+	u.SetNull(draft.FieldSubtitle)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *DraftUpsert) SetAuthor(v string) *DraftUpsert {
+	u.Set(draft.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *DraftUpsert) UpdateAuthor() *DraftUpsert {
+	u.SetExcluded(draft.FieldAuthor)
+	return u
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (u *DraftUpsert) ClearAuthor() *DraftUpsert {
+	u.SetNull(draft.FieldAuthor)
+	return u
+}
+
+// SetTranslator sets the "translator" field.
+func (u *DraftUpsert) SetTranslator(v string) *DraftUpsert {
+	u.Set(draft.FieldTranslator, v)
+	return u
+}
+
+// UpdateTranslator sets the "translator" field to the value that was provided on create.
+func (u *DraftUpsert) UpdateTranslator() *DraftUpsert {
+	u.SetExcluded(draft.FieldTranslator)
+	return u
+}
+
+// ClearTranslator clears the value of the "translator" field.
+func (u *DraftUpsert) ClearTranslator() *DraftUpsert {
+	u.SetNull(draft.FieldTranslator)
+	return u
+}
+
+// SetPages sets the "pages" field.
+func (u *DraftUpsert) SetPages(v int) *DraftUpsert {
+	u.Set(draft.FieldPages, v)
+	return u
+}
+
+// UpdatePages sets the "pages" field to the value that was provided on create.
+func (u *DraftUpsert) UpdatePages() *DraftUpsert {
+	u.SetExcluded(draft.FieldPages)
+	return u
+}
+
+// AddPages adds v to the "pages" field.
+func (u *DraftUpsert) AddPages(v int) *DraftUpsert {
+	u.Add(draft.FieldPages, v)
+	return u
+}
+
+// ClearPages clears the value of the "pages" field.
+func (u *DraftUpsert) ClearPages() *DraftUpsert {
+	u.SetNull(draft.FieldPages)
+	return u
+}
+
+// SetRating sets the "rating" field.
+func (u *DraftUpsert) SetRating(v float64) *DraftUpsert {
+	u.Set(draft.FieldRating, v)
+	return u
+}
+
+// UpdateRating sets the "rating" field to the value that was provided on create.
+func (u *DraftUpsert) UpdateRating() *DraftUpsert {
+	u.SetExcluded(draft.FieldRating)
+	return u
+}
+
+// AddRating adds v to the "rating" field.
+func (u *DraftUpsert) AddRating(v float64) *DraftUpsert {
+	u.Add(draft.FieldRating, v)
+	return u
+}
+
+// ClearRating clears the value of the "rating" field.
+func (u *DraftUpsert) ClearRating() *DraftUpsert {
+	u.SetNull(draft.FieldRating)
+	return u
+}
+
+// SetIllustrated sets the "illustrated" field.
+func (u *DraftUpsert) SetIllustrated(v bool) *DraftUpsert {
+	u.Set(draft.FieldIllustrated, v)
+	return u
+}
+
+// UpdateIllustrated sets the "illustrated" field to the value that was provided on create.
+func (u *DraftUpsert) UpdateIllustrated() *DraftUpsert {
+	u.SetExcluded(draft.FieldIllustrated)
+	return u
+}
+
+// ClearIllustrated clears the value of the "illustrated" field.
+func (u *DraftUpsert) ClearIllustrated() *DraftUpsert {
+	u.SetNull(draft.FieldIllustrated)
+	return u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *DraftUpsert) SetSubmittedAt(v time.Time) *DraftUpsert {
+	u.Set(draft.FieldSubmittedAt, v)
+	return u
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *DraftUpsert) UpdateSubmittedAt() *DraftUpsert {
+	u.SetExcluded(draft.FieldSubmittedAt)
+	return u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *DraftUpsert) ClearSubmittedAt() *DraftUpsert {
+	u.SetNull(draft.FieldSubmittedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Draft.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DraftUpsertOne) UpdateNewValues() *DraftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Draft.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DraftUpsertOne) Ignore() *DraftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DraftUpsertOne) DoNothing() *DraftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DraftCreate.OnConflict
+// documentation for more info.
+func (u *DraftUpsertOne) Update(set func(*DraftUpsert)) *DraftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DraftUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *DraftUpsertOne) SetTitle(v string) *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *DraftUpsertOne) UpdateTitle() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *DraftUpsertOne) ClearTitle() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *DraftUpsertOne) SetSubtitle(v string) *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetSubtitle(v)
+	})
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *DraftUpsertOne) UpdateSubtitle() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateSubtitle()
+	})
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *DraftUpsertOne) ClearSubtitle() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearSubtitle()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *DraftUpsertOne) SetAuthor(v string) *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *DraftUpsertOne) UpdateAuthor() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (u *DraftUpsertOne) ClearAuthor() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearAuthor()
+	})
+}
+
+// SetTranslator sets the "translator" field.
+func (u *DraftUpsertOne) SetTranslator(v string) *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetTranslator(v)
+	})
+}
+
+// UpdateTranslator sets the "translator" field to the value that was provided on create.
+func (u *DraftUpsertOne) UpdateTranslator() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateTranslator()
+	})
+}
+
+// ClearTranslator clears the value of the "translator" field.
+func (u *DraftUpsertOne) ClearTranslator() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearTranslator()
+	})
+}
+
+// SetPages sets the "pages" field.
+func (u *DraftUpsertOne) SetPages(v int) *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetPages(v)
+	})
+}
+
+// AddPages adds v to the "pages" field.
+func (u *DraftUpsertOne) AddPages(v int) *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.AddPages(v)
+	})
+}
+
+// UpdatePages sets the "pages" field to the value that was provided on create.
+func (u *DraftUpsertOne) UpdatePages() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdatePages()
+	})
+}
+
+// ClearPages clears the value of the "pages" field.
+func (u *DraftUpsertOne) ClearPages() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearPages()
+	})
+}
+
+// SetRating sets the "rating" field.
+func (u *DraftUpsertOne) SetRating(v float64) *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetRating(v)
+	})
+}
+
+// AddRating adds v to the "rating" field.
+func (u *DraftUpsertOne) AddRating(v float64) *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.AddRating(v)
+	})
+}
+
+// UpdateRating sets the "rating" field to the value that was provided on create.
+func (u *DraftUpsertOne) UpdateRating() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateRating()
+	})
+}
+
+// ClearRating clears the value of the "rating" field.
+func (u *DraftUpsertOne) ClearRating() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearRating()
+	})
+}
+
+// SetIllustrated sets the "illustrated" field.
+func (u *DraftUpsertOne) SetIllustrated(v bool) *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetIllustrated(v)
+	})
+}
+
+// UpdateIllustrated sets the "illustrated" field to the value that was provided on create.
+func (u *DraftUpsertOne) UpdateIllustrated() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateIllustrated()
+	})
+}
+
+// ClearIllustrated clears the value of the "illustrated" field.
+func (u *DraftUpsertOne) ClearIllustrated() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearIllustrated()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *DraftUpsertOne) SetSubmittedAt(v time.Time) *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *DraftUpsertOne) UpdateSubmittedAt() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *DraftUpsertOne) ClearSubmittedAt() *DraftUpsertOne {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearSubmittedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DraftUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DraftCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DraftUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DraftUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DraftUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DraftCreateBulk is the builder for creating many Draft entities in bulk.
+type DraftCreateBulk struct {
+	config
+	err      error
+	builders []*DraftCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Draft entities in the database.
+func (dcb *DraftCreateBulk) Save(ctx context.Context) ([]*Draft, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Draft, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DraftMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DraftCreateBulk) SaveX(ctx context.Context) []*Draft {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DraftCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DraftCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Draft.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DraftUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (dcb *DraftCreateBulk) OnConflict(opts ...sql.ConflictOption) *DraftUpsertBulk {
+	dcb.conflict = opts
+	return &DraftUpsertBulk{
+		create: dcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Draft.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dcb *DraftCreateBulk) OnConflictColumns(columns ...string) *DraftUpsertBulk {
+	dcb.conflict = append(dcb.conflict, sql.ConflictColumns(columns...))
+	return &DraftUpsertBulk{
+		create: dcb,
+	}
+}
+
+// DraftUpsertBulk is the builder for "upsert"-ing
+// a bulk of Draft nodes.
+type DraftUpsertBulk struct {
+	create *DraftCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Draft.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DraftUpsertBulk) UpdateNewValues() *DraftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Draft.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DraftUpsertBulk) Ignore() *DraftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DraftUpsertBulk) DoNothing() *DraftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DraftCreateBulk.OnConflict
+// documentation for more info.
+func (u *DraftUpsertBulk) Update(set func(*DraftUpsert)) *DraftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DraftUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *DraftUpsertBulk) SetTitle(v string) *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *DraftUpsertBulk) UpdateTitle() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *DraftUpsertBulk) ClearTitle() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *DraftUpsertBulk) SetSubtitle(v string) *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetSubtitle(v)
+	})
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *DraftUpsertBulk) UpdateSubtitle() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateSubtitle()
+	})
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *DraftUpsertBulk) ClearSubtitle() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearSubtitle()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *DraftUpsertBulk) SetAuthor(v string) *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *DraftUpsertBulk) UpdateAuthor() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (u *DraftUpsertBulk) ClearAuthor() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearAuthor()
+	})
+}
+
+// SetTranslator sets the "translator" field.
+func (u *DraftUpsertBulk) SetTranslator(v string) *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetTranslator(v)
+	})
+}
+
+// UpdateTranslator sets the "translator" field to the value that was provided on create.
+func (u *DraftUpsertBulk) UpdateTranslator() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateTranslator()
+	})
+}
+
+// ClearTranslator clears the value of the "translator" field.
+func (u *DraftUpsertBulk) ClearTranslator() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearTranslator()
+	})
+}
+
+// SetPages sets the "pages" field.
+func (u *DraftUpsertBulk) SetPages(v int) *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetPages(v)
+	})
+}
+
+// AddPages adds v to the "pages" field.
+func (u *DraftUpsertBulk) AddPages(v int) *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.AddPages(v)
+	})
+}
+
+// UpdatePages sets the "pages" field to the value that was provided on create.
+func (u *DraftUpsertBulk) UpdatePages() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdatePages()
+	})
+}
+
+// ClearPages clears the value of the "pages" field.
+func (u *DraftUpsertBulk) ClearPages() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearPages()
+	})
+}
+
+// SetRating sets the "rating" field.
+func (u *DraftUpsertBulk) SetRating(v float64) *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetRating(v)
+	})
+}
+
+// AddRating adds v to the "rating" field.
+func (u *DraftUpsertBulk) AddRating(v float64) *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.AddRating(v)
+	})
+}
+
+// UpdateRating sets the "rating" field to the value that was provided on create.
+func (u *DraftUpsertBulk) UpdateRating() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateRating()
+	})
+}
+
+// ClearRating clears the value of the "rating" field.
+func (u *DraftUpsertBulk) ClearRating() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearRating()
+	})
+}
+
+// SetIllustrated sets the "illustrated" field.
+func (u *DraftUpsertBulk) SetIllustrated(v bool) *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetIllustrated(v)
+	})
+}
+
+// UpdateIllustrated sets the "illustrated" field to the value that was provided on create.
+func (u *DraftUpsertBulk) UpdateIllustrated() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateIllustrated()
+	})
+}
+
+// ClearIllustrated clears the value of the "illustrated" field.
+func (u *DraftUpsertBulk) ClearIllustrated() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearIllustrated()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *DraftUpsertBulk) SetSubmittedAt(v time.Time) *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *DraftUpsertBulk) UpdateSubmittedAt() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *DraftUpsertBulk) ClearSubmittedAt() *DraftUpsertBulk {
+	return u.Update(func(s *DraftUpsert) {
+		s.ClearSubmittedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DraftUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DraftCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DraftCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DraftUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,632 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/draft"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+)
+
+// DraftUpdate is the builder for updating Draft entities.
+type DraftUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DraftMutation
+}
+
+// Where appends a list predicates to the DraftUpdate builder.
+func (du *DraftUpdate) Where(ps ...predicate.Draft) *DraftUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetTitle sets the "title" field.
+func (du *DraftUpdate) SetTitle(s string) *DraftUpdate {
+	du.mutation.SetTitle(s)
+	return du
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (du *DraftUpdate) SetNillableTitle(s *string) *DraftUpdate {
+	if s != nil {
+		du.SetTitle(*s)
+	}
+	return du
+}
+
+// ClearTitle clears the value of the "title" field.
+func (du *DraftUpdate) ClearTitle() *DraftUpdate {
+	du.mutation.ClearTitle()
+	return du
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (du *DraftUpdate) SetSubtitle(s string) *DraftUpdate {
+	du.mutation.SetSubtitle(s)
+	return du
+}
+
+// SetNillableSubtitle sets the "subtitle" field if the given value is not nil.
+func (du *DraftUpdate) SetNillableSubtitle(s *string) *DraftUpdate {
+	if s != nil {
+		du.SetSubtitle(*s)
+	}
+	return du
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (du *DraftUpdate) ClearSubtitle() *DraftUpdate {
+	du.mutation.ClearSubtitle()
+	return du
+}
+
+// SetAuthor sets the "author" field.
+func (du *DraftUpdate) SetAuthor(s string) *DraftUpdate {
+	du.mutation.SetAuthor(s)
+	return du
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (du *DraftUpdate) SetNillableAuthor(s *string) *DraftUpdate {
+	if s != nil {
+		du.SetAuthor(*s)
+	}
+	return du
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (du *DraftUpdate) ClearAuthor() *DraftUpdate {
+	du.mutation.ClearAuthor()
+	return du
+}
+
+// SetTranslator sets the "translator" field.
+func (du *DraftUpdate) SetTranslator(s string) *DraftUpdate {
+	du.mutation.SetTranslator(s)
+	return du
+}
+
+// SetNillableTranslator sets the "translator" field if the given value is not nil.
+func (du *DraftUpdate) SetNillableTranslator(s *string) *DraftUpdate {
+	if s != nil {
+		du.SetTranslator(*s)
+	}
+	return du
+}
+
+// ClearTranslator clears the value of the "translator" field.
+func (du *DraftUpdate) ClearTranslator() *DraftUpdate {
+	du.mutation.ClearTranslator()
+	return du
+}
+
+// SetPages sets the "pages" field.
+func (du *DraftUpdate) SetPages(i int) *DraftUpdate {
+	du.mutation.ResetPages()
+	du.mutation.SetPages(i)
+	return du
+}
+
+// SetNillablePages sets the "pages" field if the given value is not nil.
+func (du *DraftUpdate) SetNillablePages(i *int) *DraftUpdate {
+	if i != nil {
+		du.SetPages(*i)
+	}
+	return du
+}
+
+// AddPages adds i to the "pages" field.
+func (du *DraftUpdate) AddPages(i int) *DraftUpdate {
+	du.mutation.AddPages(i)
+	return du
+}
+
+// ClearPages clears the value of the "pages" field.
+func (du *DraftUpdate) ClearPages() *DraftUpdate {
+	du.mutation.ClearPages()
+	return du
+}
+
+// SetRating sets the "rating" field.
+func (du *DraftUpdate) SetRating(f float64) *DraftUpdate {
+	du.mutation.ResetRating()
+	du.mutation.SetRating(f)
+	return du
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (du *DraftUpdate) SetNillableRating(f *float64) *DraftUpdate {
+	if f != nil {
+		du.SetRating(*f)
+	}
+	return du
+}
+
+// AddRating adds f to the "rating" field.
+func (du *DraftUpdate) AddRating(f float64) *DraftUpdate {
+	du.mutation.AddRating(f)
+	return du
+}
+
+// ClearRating clears the value of the "rating" field.
+func (du *DraftUpdate) ClearRating() *DraftUpdate {
+	du.mutation.ClearRating()
+	return du
+}
+
+// SetIllustrated sets the "illustrated" field.
+func (du *DraftUpdate) SetIllustrated(b bool) *DraftUpdate {
+	du.mutation.SetIllustrated(b)
+	return du
+}
+
+// SetNillableIllustrated sets the "illustrated" field if the given value is not nil.
+func (du *DraftUpdate) SetNillableIllustrated(b *bool) *DraftUpdate {
+	if b != nil {
+		du.SetIllustrated(*b)
+	}
+	return du
+}
+
+// ClearIllustrated clears the value of the "illustrated" field.
+func (du *DraftUpdate) ClearIllustrated() *DraftUpdate {
+	du.mutation.ClearIllustrated()
+	return du
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (du *DraftUpdate) SetSubmittedAt(t time.Time) *DraftUpdate {
+	du.mutation.SetSubmittedAt(t)
+	return du
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (du *DraftUpdate) SetNillableSubmittedAt(t *time.Time) *DraftUpdate {
+	if t != nil {
+		du.SetSubmittedAt(*t)
+	}
+	return du
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (du *DraftUpdate) ClearSubmittedAt() *DraftUpdate {
+	du.mutation.ClearSubmittedAt()
+	return du
+}
+
+// Mutation returns the DraftMutation object of the builder.
+func (du *DraftUpdate) Mutation() *DraftMutation {
+	return du.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DraftUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DraftUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DraftUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DraftUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (du *DraftUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(draft.Table, draft.Columns, sqlgraph.NewFieldSpec(draft.FieldID, field.TypeInt))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Title(); ok {
+		_spec.SetField(draft.FieldTitle, field.TypeString, value)
+	}
+	if du.mutation.TitleCleared() {
+		_spec.ClearField(draft.FieldTitle, field.TypeString)
+	}
+	if value, ok := du.mutation.Subtitle(); ok {
+		_spec.SetField(draft.FieldSubtitle, field.TypeString, value)
+	}
+	if du.mutation.SubtitleCleared() {
+		_spec.ClearField(draft.FieldSubtitle, field.TypeString)
+	}
+	if value, ok := du.mutation.Author(); ok {
+		_spec.SetField(draft.FieldAuthor, field.TypeString, value)
+	}
+	if du.mutation.AuthorCleared() {
+		_spec.ClearField(draft.FieldAuthor, field.TypeString)
+	}
+	if value, ok := du.mutation.Translator(); ok {
+		_spec.SetField(draft.FieldTranslator, field.TypeString, value)
+	}
+	if du.mutation.TranslatorCleared() {
+		_spec.ClearField(draft.FieldTranslator, field.TypeString)
+	}
+	if value, ok := du.mutation.Pages(); ok {
+		_spec.SetField(draft.FieldPages, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedPages(); ok {
+		_spec.AddField(draft.FieldPages, field.TypeInt, value)
+	}
+	if du.mutation.PagesCleared() {
+		_spec.ClearField(draft.FieldPages, field.TypeInt)
+	}
+	if value, ok := du.mutation.Rating(); ok {
+		_spec.SetField(draft.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := du.mutation.AddedRating(); ok {
+		_spec.AddField(draft.FieldRating, field.TypeFloat64, value)
+	}
+	if du.mutation.RatingCleared() {
+		_spec.ClearField(draft.FieldRating, field.TypeFloat64)
+	}
+	if value, ok := du.mutation.Illustrated(); ok {
+		_spec.SetField(draft.FieldIllustrated, field.TypeBool, value)
+	}
+	if du.mutation.IllustratedCleared() {
+		_spec.ClearField(draft.FieldIllustrated, field.TypeBool)
+	}
+	if value, ok := du.mutation.SubmittedAt(); ok {
+		_spec.SetField(draft.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if du.mutation.SubmittedAtCleared() {
+		_spec.ClearField(draft.FieldSubmittedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{draft.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DraftUpdateOne is the builder for updating a single Draft entity.
+type DraftUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DraftMutation
+}
+
+// SetTitle sets the "title" field.
+func (duo *DraftUpdateOne) SetTitle(s string) *DraftUpdateOne {
+	duo.mutation.SetTitle(s)
+	return duo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillableTitle(s *string) *DraftUpdateOne {
+	if s != nil {
+		duo.SetTitle(*s)
+	}
+	return duo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (duo *DraftUpdateOne) ClearTitle() *DraftUpdateOne {
+	duo.mutation.ClearTitle()
+	return duo
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (duo *DraftUpdateOne) SetSubtitle(s string) *DraftUpdateOne {
+	duo.mutation.SetSubtitle(s)
+	return duo
+}
+
+// SetNillableSubtitle sets the "subtitle" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillableSubtitle(s *string) *DraftUpdateOne {
+	if s != nil {
+		duo.SetSubtitle(*s)
+	}
+	return duo
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (duo *DraftUpdateOne) ClearSubtitle() *DraftUpdateOne {
+	duo.mutation.ClearSubtitle()
+	return duo
+}
+
+// SetAuthor sets the "author" field.
+func (duo *DraftUpdateOne) SetAuthor(s string) *DraftUpdateOne {
+	duo.mutation.SetAuthor(s)
+	return duo
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillableAuthor(s *string) *DraftUpdateOne {
+	if s != nil {
+		duo.SetAuthor(*s)
+	}
+	return duo
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (duo *DraftUpdateOne) ClearAuthor() *DraftUpdateOne {
+	duo.mutation.ClearAuthor()
+	return duo
+}
+
+// SetTranslator sets the "translator" field.
+func (duo *DraftUpdateOne) SetTranslator(s string) *DraftUpdateOne {
+	duo.mutation.SetTranslator(s)
+	return duo
+}
+
+// SetNillableTranslator sets the "translator" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillableTranslator(s *string) *DraftUpdateOne {
+	if s != nil {
+		duo.SetTranslator(*s)
+	}
+	return duo
+}
+
+// ClearTranslator clears the value of the "translator" field.
+func (duo *DraftUpdateOne) ClearTranslator() *DraftUpdateOne {
+	duo.mutation.ClearTranslator()
+	return duo
+}
+
+// SetPages sets the "pages" field.
+func (duo *DraftUpdateOne) SetPages(i int) *DraftUpdateOne {
+	duo.mutation.ResetPages()
+	duo.mutation.SetPages(i)
+	return duo
+}
+
+// SetNillablePages sets the "pages" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillablePages(i *int) *DraftUpdateOne {
+	if i != nil {
+		duo.SetPages(*i)
+	}
+	return duo
+}
+
+// AddPages adds i to the "pages" field.
+func (duo *DraftUpdateOne) AddPages(i int) *DraftUpdateOne {
+	duo.mutation.AddPages(i)
+	return duo
+}
+
+// ClearPages clears the value of the "pages" field.
+func (duo *DraftUpdateOne) ClearPages() *DraftUpdateOne {
+	duo.mutation.ClearPages()
+	return duo
+}
+
+// SetRating sets the "rating" field.
+func (duo *DraftUpdateOne) SetRating(f float64) *DraftUpdateOne {
+	duo.mutation.ResetRating()
+	duo.mutation.SetRating(f)
+	return duo
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillableRating(f *float64) *DraftUpdateOne {
+	if f != nil {
+		duo.SetRating(*f)
+	}
+	return duo
+}
+
+// AddRating adds f to the "rating" field.
+func (duo *DraftUpdateOne) AddRating(f float64) *DraftUpdateOne {
+	duo.mutation.AddRating(f)
+	return duo
+}
+
+// ClearRating clears the value of the "rating" field.
+func (duo *DraftUpdateOne) ClearRating() *DraftUpdateOne {
+	duo.mutation.ClearRating()
+	return duo
+}
+
+// SetIllustrated sets the "illustrated" field.
+func (duo *DraftUpdateOne) SetIllustrated(b bool) *DraftUpdateOne {
+	duo.mutation.SetIllustrated(b)
+	return duo
+}
+
+// SetNillableIllustrated sets the "illustrated" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillableIllustrated(b *bool) *DraftUpdateOne {
+	if b != nil {
+		duo.SetIllustrated(*b)
+	}
+	return duo
+}
+
+// ClearIllustrated clears the value of the "illustrated" field.
+func (duo *DraftUpdateOne) ClearIllustrated() *DraftUpdateOne {
+	duo.mutation.ClearIllustrated()
+	return duo
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (duo *DraftUpdateOne) SetSubmittedAt(t time.Time) *DraftUpdateOne {
+	duo.mutation.SetSubmittedAt(t)
+	return duo
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillableSubmittedAt(t *time.Time) *DraftUpdateOne {
+	if t != nil {
+		duo.SetSubmittedAt(*t)
+	}
+	return duo
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (duo *DraftUpdateOne) ClearSubmittedAt() *DraftUpdateOne {
+	duo.mutation.ClearSubmittedAt()
+	return duo
+}
+
+// Mutation returns the DraftMutation object of the builder.
+func (duo *DraftUpdateOne) Mutation() *DraftMutation {
+	return duo.mutation
+}
+
+// Where appends a list predicates to the DraftUpdate builder.
+func (duo *DraftUpdateOne) Where(ps ...predicate.Draft) *DraftUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DraftUpdateOne) Select(field string, fields ...string) *DraftUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Draft entity.
+func (duo *DraftUpdateOne) Save(ctx context.Context) (*Draft, error) {
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DraftUpdateOne) SaveX(ctx context.Context) *Draft {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DraftUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DraftUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (duo *DraftUpdateOne) sqlSave(ctx context.Context) (_node *Draft, err error) {
+	_spec := sqlgraph.NewUpdateSpec(draft.Table, draft.Columns, sqlgraph.NewFieldSpec(draft.FieldID, field.TypeInt))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Draft.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, draft.FieldID)
+		for _, f := range fields {
+			if !draft.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != draft.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Title(); ok {
+		_spec.SetField(draft.FieldTitle, field.TypeString, value)
+	}
+	if duo.mutation.TitleCleared() {
+		_spec.ClearField(draft.FieldTitle, field.TypeString)
+	}
+	if value, ok := duo.mutation.Subtitle(); ok {
+		_spec.SetField(draft.FieldSubtitle, field.TypeString, value)
+	}
+	if duo.mutation.SubtitleCleared() {
+		_spec.ClearField(draft.FieldSubtitle, field.TypeString)
+	}
+	if value, ok := duo.mutation.Author(); ok {
+		_spec.SetField(draft.FieldAuthor, field.TypeString, value)
+	}
+	if duo.mutation.AuthorCleared() {
+		_spec.ClearField(draft.FieldAuthor, field.TypeString)
+	}
+	if value, ok := duo.mutation.Translator(); ok {
+		_spec.SetField(draft.FieldTranslator, field.TypeString, value)
+	}
+	if duo.mutation.TranslatorCleared() {
+		_spec.ClearField(draft.FieldTranslator, field.TypeString)
+	}
+	if value, ok := duo.mutation.Pages(); ok {
+		_spec.SetField(draft.FieldPages, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedPages(); ok {
+		_spec.AddField(draft.FieldPages, field.TypeInt, value)
+	}
+	if duo.mutation.PagesCleared() {
+		_spec.ClearField(draft.FieldPages, field.TypeInt)
+	}
+	if value, ok := duo.mutation.Rating(); ok {
+		_spec.SetField(draft.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := duo.mutation.AddedRating(); ok {
+		_spec.AddField(draft.FieldRating, field.TypeFloat64, value)
+	}
+	if duo.mutation.RatingCleared() {
+		_spec.ClearField(draft.FieldRating, field.TypeFloat64)
+	}
+	if value, ok := duo.mutation.Illustrated(); ok {
+		_spec.SetField(draft.FieldIllustrated, field.TypeBool, value)
+	}
+	if duo.mutation.IllustratedCleared() {
+		_spec.ClearField(draft.FieldIllustrated, field.TypeBool)
+	}
+	if value, ok := duo.mutation.SubmittedAt(); ok {
+		_spec.SetField(draft.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if duo.mutation.SubmittedAtCleared() {
+		_spec.ClearField(draft.FieldSubmittedAt, field.TypeTime)
+	}
+	_node = &Draft{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{draft.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
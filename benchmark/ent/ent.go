@@ -13,6 +13,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/book"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/draft"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/listing"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/pricepolicy"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/uuidbook"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/wide"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +78,12 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			book.Table: book.ValidColumn,
+			book.Table:        book.ValidColumn,
+			draft.Table:       draft.ValidColumn,
+			listing.Table:     listing.ValidColumn,
+			pricepolicy.Table: pricepolicy.ValidColumn,
+			uuidbook.Table:    uuidbook.ValidColumn,
+			wide.Table:        wide.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/listing"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// Listing is the model entity for the Listing schema.
+type Listing struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference uuid.UUID `json:"reference,omitempty"`
+	// Attributes holds the value of the "attributes" field.
+	Attributes model.ListingAttributes `json:"attributes,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags model.TextArray `json:"tags,omitempty"`
+	// Price holds the value of the "price" field.
+	Price float64 `json:"price,omitempty"`
+	// Cover holds the value of the "cover" field.
+	Cover []byte `json:"cover,omitempty"`
+	// Subtitle holds the value of the "subtitle" field.
+	Subtitle *string `json:"subtitle,omitempty"`
+	// DiscontinuedAt holds the value of the "discontinued_at" field.
+	DiscontinuedAt *time.Time `json:"discontinued_at,omitempty"`
+	// ListedAt holds the value of the "listed_at" field.
+	ListedAt     time.Time `json:"listed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Listing) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case listing.FieldAttributes, listing.FieldCover:
+			values[i] = new([]byte)
+		case listing.FieldTags:
+			values[i] = new(model.TextArray)
+		case listing.FieldPrice:
+			values[i] = new(sql.NullFloat64)
+		case listing.FieldID:
+			values[i] = new(sql.NullInt64)
+		case listing.FieldSubtitle:
+			values[i] = new(sql.NullString)
+		case listing.FieldDiscontinuedAt, listing.FieldListedAt:
+			values[i] = new(sql.NullTime)
+		case listing.FieldReference:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Listing fields.
+func (l *Listing) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case listing.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			l.ID = int(value.Int64)
+		case listing.FieldReference:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value != nil {
+				l.Reference = *value
+			}
+		case listing.FieldAttributes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attributes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &l.Attributes); err != nil {
+					return fmt.Errorf("unmarshal field attributes: %w", err)
+				}
+			}
+		case listing.FieldTags:
+			if value, ok := values[i].(*model.TextArray); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil {
+				l.Tags = *value
+			}
+		case listing.FieldPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				l.Price = value.Float64
+			}
+		case listing.FieldCover:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field cover", values[i])
+			} else if value != nil {
+				l.Cover = *value
+			}
+		case listing.FieldSubtitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subtitle", values[i])
+			} else if value.Valid {
+				l.Subtitle = new(string)
+				*l.Subtitle = value.String
+			}
+		case listing.FieldDiscontinuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field discontinued_at", values[i])
+			} else if value.Valid {
+				l.DiscontinuedAt = new(time.Time)
+				*l.DiscontinuedAt = value.Time
+			}
+		case listing.FieldListedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field listed_at", values[i])
+			} else if value.Valid {
+				l.ListedAt = value.Time
+			}
+		default:
+			l.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Listing.
+// This includes values selected through modifiers, order, etc.
+func (l *Listing) Value(name string) (ent.Value, error) {
+	return l.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Listing.
+// Note that you need to call Listing.Unwrap() before calling this method if this Listing
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *Listing) Update() *ListingUpdateOne {
+	return NewListingClient(l.config).UpdateOne(l)
+}
+
+// Unwrap unwraps the Listing entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (l *Listing) Unwrap() *Listing {
+	_tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Listing is not a transactional entity")
+	}
+	l.config.driver = _tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *Listing) String() string {
+	var builder strings.Builder
+	builder.WriteString("Listing(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	builder.WriteString("reference=")
+	builder.WriteString(fmt.Sprintf("%v", l.Reference))
+	builder.WriteString(", ")
+	builder.WriteString("attributes=")
+	builder.WriteString(fmt.Sprintf("%v", l.Attributes))
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", l.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", l.Price))
+	builder.WriteString(", ")
+	builder.WriteString("cover=")
+	builder.WriteString(fmt.Sprintf("%v", l.Cover))
+	builder.WriteString(", ")
+	if v := l.Subtitle; v != nil {
+		builder.WriteString("subtitle=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := l.DiscontinuedAt; v != nil {
+		builder.WriteString("discontinued_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("listed_at=")
+	builder.WriteString(l.ListedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Listings is a parsable slice of Listing.
+type Listings []*Listing
@@ -0,0 +1,93 @@
+// Code generated by ent, DO NOT EDIT.
+
+package listing
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the listing type in the database.
+	Label = "listing"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldAttributes holds the string denoting the attributes field in the database.
+	FieldAttributes = "attributes"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldCover holds the string denoting the cover field in the database.
+	FieldCover = "cover"
+	// FieldSubtitle holds the string denoting the subtitle field in the database.
+	FieldSubtitle = "subtitle"
+	// FieldDiscontinuedAt holds the string denoting the discontinued_at field in the database.
+	FieldDiscontinuedAt = "discontinued_at"
+	// FieldListedAt holds the string denoting the listed_at field in the database.
+	FieldListedAt = "listed_at"
+	// Table holds the table name of the listing in the database.
+	Table = "listings"
+)
+
+// Columns holds all SQL columns for listing fields.
+var Columns = []string{
+	FieldID,
+	FieldReference,
+	FieldAttributes,
+	FieldTags,
+	FieldPrice,
+	FieldCover,
+	FieldSubtitle,
+	FieldDiscontinuedAt,
+	FieldListedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the Listing queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByTags orders the results by the tags field.
+func ByTags(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTags, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// BySubtitle orders the results by the subtitle field.
+func BySubtitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtitle, opts...).ToFunc()
+}
+
+// ByDiscontinuedAt orders the results by the discontinued_at field.
+func ByDiscontinuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscontinuedAt, opts...).ToFunc()
+}
+
+// ByListedAt orders the results by the listed_at field.
+func ByListedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldListedAt, opts...).ToFunc()
+}
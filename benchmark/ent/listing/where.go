@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package listing
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldID, id))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldReference, v))
+}
+
+// Tags applies equality check predicate on the "tags" field. It's identical to TagsEQ.
+func Tags(v model.TextArray) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldTags, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldPrice, v))
+}
+
+// Cover applies equality check predicate on the "cover" field. It's identical to CoverEQ.
+func Cover(v []byte) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldCover, v))
+}
+
+// Subtitle applies equality check predicate on the "subtitle" field. It's identical to SubtitleEQ.
+func Subtitle(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldSubtitle, v))
+}
+
+// DiscontinuedAt applies equality check predicate on the "discontinued_at" field. It's identical to DiscontinuedAtEQ.
+func DiscontinuedAt(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldDiscontinuedAt, v))
+}
+
+// ListedAt applies equality check predicate on the "listed_at" field. It's identical to ListedAtEQ.
+func ListedAt(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldListedAt, v))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldReference, v))
+}
+
+// TagsEQ applies the EQ predicate on the "tags" field.
+func TagsEQ(v model.TextArray) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldTags, v))
+}
+
+// TagsNEQ applies the NEQ predicate on the "tags" field.
+func TagsNEQ(v model.TextArray) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldTags, v))
+}
+
+// TagsIn applies the In predicate on the "tags" field.
+func TagsIn(vs ...model.TextArray) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldTags, vs...))
+}
+
+// TagsNotIn applies the NotIn predicate on the "tags" field.
+func TagsNotIn(vs ...model.TextArray) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldTags, vs...))
+}
+
+// TagsGT applies the GT predicate on the "tags" field.
+func TagsGT(v model.TextArray) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldTags, v))
+}
+
+// TagsGTE applies the GTE predicate on the "tags" field.
+func TagsGTE(v model.TextArray) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldTags, v))
+}
+
+// TagsLT applies the LT predicate on the "tags" field.
+func TagsLT(v model.TextArray) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldTags, v))
+}
+
+// TagsLTE applies the LTE predicate on the "tags" field.
+func TagsLTE(v model.TextArray) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldTags, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...float64) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...float64) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldPrice, v))
+}
+
+// CoverEQ applies the EQ predicate on the "cover" field.
+func CoverEQ(v []byte) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldCover, v))
+}
+
+// CoverNEQ applies the NEQ predicate on the "cover" field.
+func CoverNEQ(v []byte) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldCover, v))
+}
+
+// CoverIn applies the In predicate on the "cover" field.
+func CoverIn(vs ...[]byte) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldCover, vs...))
+}
+
+// CoverNotIn applies the NotIn predicate on the "cover" field.
+func CoverNotIn(vs ...[]byte) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldCover, vs...))
+}
+
+// CoverGT applies the GT predicate on the "cover" field.
+func CoverGT(v []byte) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldCover, v))
+}
+
+// CoverGTE applies the GTE predicate on the "cover" field.
+func CoverGTE(v []byte) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldCover, v))
+}
+
+// CoverLT applies the LT predicate on the "cover" field.
+func CoverLT(v []byte) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldCover, v))
+}
+
+// CoverLTE applies the LTE predicate on the "cover" field.
+func CoverLTE(v []byte) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldCover, v))
+}
+
+// SubtitleEQ applies the EQ predicate on the "subtitle" field.
+func SubtitleEQ(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldSubtitle, v))
+}
+
+// SubtitleNEQ applies the NEQ predicate on the "subtitle" field.
+func SubtitleNEQ(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldSubtitle, v))
+}
+
+// SubtitleIn applies the In predicate on the "subtitle" field.
+func SubtitleIn(vs ...string) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldSubtitle, vs...))
+}
+
+// SubtitleNotIn applies the NotIn predicate on the "subtitle" field.
+func SubtitleNotIn(vs ...string) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldSubtitle, vs...))
+}
+
+// SubtitleGT applies the GT predicate on the "subtitle" field.
+func SubtitleGT(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldSubtitle, v))
+}
+
+// SubtitleGTE applies the GTE predicate on the "subtitle" field.
+func SubtitleGTE(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldSubtitle, v))
+}
+
+// SubtitleLT applies the LT predicate on the "subtitle" field.
+func SubtitleLT(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldSubtitle, v))
+}
+
+// SubtitleLTE applies the LTE predicate on the "subtitle" field.
+func SubtitleLTE(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldSubtitle, v))
+}
+
+// SubtitleContains applies the Contains predicate on the "subtitle" field.
+func SubtitleContains(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldContains(FieldSubtitle, v))
+}
+
+// SubtitleHasPrefix applies the HasPrefix predicate on the "subtitle" field.
+func SubtitleHasPrefix(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldHasPrefix(FieldSubtitle, v))
+}
+
+// SubtitleHasSuffix applies the HasSuffix predicate on the "subtitle" field.
+func SubtitleHasSuffix(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldHasSuffix(FieldSubtitle, v))
+}
+
+// SubtitleIsNil applies the IsNil predicate on the "subtitle" field.
+func SubtitleIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldSubtitle))
+}
+
+// SubtitleNotNil applies the NotNil predicate on the "subtitle" field.
+func SubtitleNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldSubtitle))
+}
+
+// SubtitleEqualFold applies the EqualFold predicate on the "subtitle" field.
+func SubtitleEqualFold(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEqualFold(FieldSubtitle, v))
+}
+
+// SubtitleContainsFold applies the ContainsFold predicate on the "subtitle" field.
+func SubtitleContainsFold(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldContainsFold(FieldSubtitle, v))
+}
+
+// DiscontinuedAtEQ applies the EQ predicate on the "discontinued_at" field.
+func DiscontinuedAtEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldDiscontinuedAt, v))
+}
+
+// DiscontinuedAtNEQ applies the NEQ predicate on the "discontinued_at" field.
+func DiscontinuedAtNEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldDiscontinuedAt, v))
+}
+
+// DiscontinuedAtIn applies the In predicate on the "discontinued_at" field.
+func DiscontinuedAtIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldDiscontinuedAt, vs...))
+}
+
+// DiscontinuedAtNotIn applies the NotIn predicate on the "discontinued_at" field.
+func DiscontinuedAtNotIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldDiscontinuedAt, vs...))
+}
+
+// DiscontinuedAtGT applies the GT predicate on the "discontinued_at" field.
+func DiscontinuedAtGT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldDiscontinuedAt, v))
+}
+
+// DiscontinuedAtGTE applies the GTE predicate on the "discontinued_at" field.
+func DiscontinuedAtGTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldDiscontinuedAt, v))
+}
+
+// DiscontinuedAtLT applies the LT predicate on the "discontinued_at" field.
+func DiscontinuedAtLT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldDiscontinuedAt, v))
+}
+
+// DiscontinuedAtLTE applies the LTE predicate on the "discontinued_at" field.
+func DiscontinuedAtLTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldDiscontinuedAt, v))
+}
+
+// DiscontinuedAtIsNil applies the IsNil predicate on the "discontinued_at" field.
+func DiscontinuedAtIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldDiscontinuedAt))
+}
+
+// DiscontinuedAtNotNil applies the NotNil predicate on the "discontinued_at" field.
+func DiscontinuedAtNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldDiscontinuedAt))
+}
+
+// ListedAtEQ applies the EQ predicate on the "listed_at" field.
+func ListedAtEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldListedAt, v))
+}
+
+// ListedAtNEQ applies the NEQ predicate on the "listed_at" field.
+func ListedAtNEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldListedAt, v))
+}
+
+// ListedAtIn applies the In predicate on the "listed_at" field.
+func ListedAtIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldListedAt, vs...))
+}
+
+// ListedAtNotIn applies the NotIn predicate on the "listed_at" field.
+func ListedAtNotIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldListedAt, vs...))
+}
+
+// ListedAtGT applies the GT predicate on the "listed_at" field.
+func ListedAtGT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldListedAt, v))
+}
+
+// ListedAtGTE applies the GTE predicate on the "listed_at" field.
+func ListedAtGTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldListedAt, v))
+}
+
+// ListedAtLT applies the LT predicate on the "listed_at" field.
+func ListedAtLT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldListedAt, v))
+}
+
+// ListedAtLTE applies the LTE predicate on the "listed_at" field.
+func ListedAtLTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldListedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Listing) predicate.Listing {
+	return predicate.Listing(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Listing) predicate.Listing {
+	return predicate.Listing(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Listing) predicate.Listing {
+	return predicate.Listing(sql.NotPredicates(p))
+}
@@ -0,0 +1,898 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/listing"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// ListingCreate is the builder for creating a Listing entity.
+type ListingCreate struct {
+	config
+	mutation *ListingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetReference sets the "reference" field.
+func (lc *ListingCreate) SetReference(u uuid.UUID) *ListingCreate {
+	lc.mutation.SetReference(u)
+	return lc
+}
+
+// SetAttributes sets the "attributes" field.
+func (lc *ListingCreate) SetAttributes(ma model.ListingAttributes) *ListingCreate {
+	lc.mutation.SetAttributes(ma)
+	return lc
+}
+
+// SetTags sets the "tags" field.
+func (lc *ListingCreate) SetTags(ma model.TextArray) *ListingCreate {
+	lc.mutation.SetTags(ma)
+	return lc
+}
+
+// SetPrice sets the "price" field.
+func (lc *ListingCreate) SetPrice(f float64) *ListingCreate {
+	lc.mutation.SetPrice(f)
+	return lc
+}
+
+// SetCover sets the "cover" field.
+func (lc *ListingCreate) SetCover(b []byte) *ListingCreate {
+	lc.mutation.SetCover(b)
+	return lc
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (lc *ListingCreate) SetSubtitle(s string) *ListingCreate {
+	lc.mutation.SetSubtitle(s)
+	return lc
+}
+
+// SetNillableSubtitle sets the "subtitle" field if the given value is not nil.
+func (lc *ListingCreate) SetNillableSubtitle(s *string) *ListingCreate {
+	if s != nil {
+		lc.SetSubtitle(*s)
+	}
+	return lc
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (lc *ListingCreate) SetDiscontinuedAt(t time.Time) *ListingCreate {
+	lc.mutation.SetDiscontinuedAt(t)
+	return lc
+}
+
+// SetNillableDiscontinuedAt sets the "discontinued_at" field if the given value is not nil.
+func (lc *ListingCreate) SetNillableDiscontinuedAt(t *time.Time) *ListingCreate {
+	if t != nil {
+		lc.SetDiscontinuedAt(*t)
+	}
+	return lc
+}
+
+// SetListedAt sets the "listed_at" field.
+func (lc *ListingCreate) SetListedAt(t time.Time) *ListingCreate {
+	lc.mutation.SetListedAt(t)
+	return lc
+}
+
+// Mutation returns the ListingMutation object of the builder.
+func (lc *ListingCreate) Mutation() *ListingMutation {
+	return lc.mutation
+}
+
+// Save creates the Listing in the database.
+func (lc *ListingCreate) Save(ctx context.Context) (*Listing, error) {
+	return withHooks(ctx, lc.sqlSave, lc.mutation, lc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lc *ListingCreate) SaveX(ctx context.Context) *Listing {
+	v, err := lc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lc *ListingCreate) Exec(ctx context.Context) error {
+	_, err := lc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lc *ListingCreate) ExecX(ctx context.Context) {
+	if err := lc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lc *ListingCreate) check() error {
+	if _, ok := lc.mutation.Reference(); !ok {
+		return &ValidationError{Name: "reference", err: errors.New(`ent: missing required field "Listing.reference"`)}
+	}
+	if _, ok := lc.mutation.Attributes(); !ok {
+		return &ValidationError{Name: "attributes", err: errors.New(`ent: missing required field "Listing.attributes"`)}
+	}
+	if _, ok := lc.mutation.Tags(); !ok {
+		return &ValidationError{Name: "tags", err: errors.New(`ent: missing required field "Listing.tags"`)}
+	}
+	if _, ok := lc.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Listing.price"`)}
+	}
+	if _, ok := lc.mutation.Cover(); !ok {
+		return &ValidationError{Name: "cover", err: errors.New(`ent: missing required field "Listing.cover"`)}
+	}
+	if _, ok := lc.mutation.ListedAt(); !ok {
+		return &ValidationError{Name: "listed_at", err: errors.New(`ent: missing required field "Listing.listed_at"`)}
+	}
+	return nil
+}
+
+func (lc *ListingCreate) sqlSave(ctx context.Context) (*Listing, error) {
+	if err := lc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lc.mutation.id = &_node.ID
+	lc.mutation.done = true
+	return _node, nil
+}
+
+func (lc *ListingCreate) createSpec() (*Listing, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Listing{config: lc.config}
+		_spec = sqlgraph.NewCreateSpec(listing.Table, sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = lc.conflict
+	if value, ok := lc.mutation.Reference(); ok {
+		_spec.SetField(listing.FieldReference, field.TypeUUID, value)
+		_node.Reference = value
+	}
+	if value, ok := lc.mutation.Attributes(); ok {
+		_spec.SetField(listing.FieldAttributes, field.TypeJSON, value)
+		_node.Attributes = value
+	}
+	if value, ok := lc.mutation.Tags(); ok {
+		_spec.SetField(listing.FieldTags, field.TypeOther, value)
+		_node.Tags = value
+	}
+	if value, ok := lc.mutation.Price(); ok {
+		_spec.SetField(listing.FieldPrice, field.TypeFloat64, value)
+		_node.Price = value
+	}
+	if value, ok := lc.mutation.Cover(); ok {
+		_spec.SetField(listing.FieldCover, field.TypeBytes, value)
+		_node.Cover = value
+	}
+	if value, ok := lc.mutation.Subtitle(); ok {
+		_spec.SetField(listing.FieldSubtitle, field.TypeString, value)
+		_node.Subtitle = &value
+	}
+	if value, ok := lc.mutation.DiscontinuedAt(); ok {
+		_spec.SetField(listing.FieldDiscontinuedAt, field.TypeTime, value)
+		_node.DiscontinuedAt = &value
+	}
+	if value, ok := lc.mutation.ListedAt(); ok {
+		_spec.SetField(listing.FieldListedAt, field.TypeTime, value)
+		_node.ListedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Listing.Create().
+//		SetReference(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingUpsert) {
+//			SetReference(v+v).
+//		}).
+//		Exec(ctx)
+func (lc *ListingCreate) OnConflict(opts ...sql.ConflictOption) *ListingUpsertOne {
+	lc.conflict = opts
+	return &ListingUpsertOne{
+		create: lc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lc *ListingCreate) OnConflictColumns(columns ...string) *ListingUpsertOne {
+	lc.conflict = append(lc.conflict, sql.ConflictColumns(columns...))
+	return &ListingUpsertOne{
+		create: lc,
+	}
+}
+
+type (
+	// ListingUpsertOne is the builder for "upsert"-ing
+	//  one Listing node.
+	ListingUpsertOne struct {
+		create *ListingCreate
+	}
+
+	// ListingUpsert is the "OnConflict" setter.
+	ListingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetReference sets the "reference" field.
+func (u *ListingUpsert) SetReference(v uuid.UUID) *ListingUpsert {
+	u.Set(listing.FieldReference, v)
+	return u
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateReference() *ListingUpsert {
+	u.SetExcluded(listing.FieldReference)
+	return u
+}
+
+// SetAttributes sets the "attributes" field.
+func (u *ListingUpsert) SetAttributes(v model.ListingAttributes) *ListingUpsert {
+	u.Set(listing.FieldAttributes, v)
+	return u
+}
+
+// UpdateAttributes sets the "attributes" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateAttributes() *ListingUpsert {
+	u.SetExcluded(listing.FieldAttributes)
+	return u
+}
+
+// SetTags sets the "tags" field.
+func (u *ListingUpsert) SetTags(v model.TextArray) *ListingUpsert {
+	u.Set(listing.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateTags() *ListingUpsert {
+	u.SetExcluded(listing.FieldTags)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *ListingUpsert) SetPrice(v float64) *ListingUpsert {
+	u.Set(listing.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ListingUpsert) UpdatePrice() *ListingUpsert {
+	u.SetExcluded(listing.FieldPrice)
+	return u
+}
+
+// AddPrice adds v to the "price" field.
+func (u *ListingUpsert) AddPrice(v float64) *ListingUpsert {
+	u.Add(listing.FieldPrice, v)
+	return u
+}
+
+// SetCover sets the "cover" field.
+func (u *ListingUpsert) SetCover(v []byte) *ListingUpsert {
+	u.Set(listing.FieldCover, v)
+	return u
+}
+
+// UpdateCover sets the "cover" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateCover() *ListingUpsert {
+	u.SetExcluded(listing.FieldCover)
+	return u
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *ListingUpsert) SetSubtitle(v string) *ListingUpsert {
+	u.Set(listing.FieldSubtitle, v)
+	return u
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateSubtitle() *ListingUpsert {
+	u.SetExcluded(listing.FieldSubtitle)
+	return u
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *ListingUpsert) ClearSubtitle() *ListingUpsert {
+	u.SetNull(listing.FieldSubtitle)
+	return u
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (u *ListingUpsert) SetDiscontinuedAt(v time.Time) *ListingUpsert {
+	u.Set(listing.FieldDiscontinuedAt, v)
+	return u
+}
+
+// UpdateDiscontinuedAt sets the "discontinued_at" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateDiscontinuedAt() *ListingUpsert {
+	u.SetExcluded(listing.FieldDiscontinuedAt)
+	return u
+}
+
+// ClearDiscontinuedAt clears the value of the "discontinued_at" field.
+func (u *ListingUpsert) ClearDiscontinuedAt() *ListingUpsert {
+	u.SetNull(listing.FieldDiscontinuedAt)
+	return u
+}
+
+// SetListedAt sets the "listed_at" field.
+func (u *ListingUpsert) SetListedAt(v time.Time) *ListingUpsert {
+	u.Set(listing.FieldListedAt, v)
+	return u
+}
+
+// UpdateListedAt sets the "listed_at" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateListedAt() *ListingUpsert {
+	u.SetExcluded(listing.FieldListedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ListingUpsertOne) UpdateNewValues() *ListingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ListingUpsertOne) Ignore() *ListingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingUpsertOne) DoNothing() *ListingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingCreate.OnConflict
+// documentation for more info.
+func (u *ListingUpsertOne) Update(set func(*ListingUpsert)) *ListingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReference sets the "reference" field.
+func (u *ListingUpsertOne) SetReference(v uuid.UUID) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetReference(v)
+	})
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateReference() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateReference()
+	})
+}
+
+// SetAttributes sets the "attributes" field.
+func (u *ListingUpsertOne) SetAttributes(v model.ListingAttributes) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetAttributes(v)
+	})
+}
+
+// UpdateAttributes sets the "attributes" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateAttributes() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateAttributes()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *ListingUpsertOne) SetTags(v model.TextArray) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateTags() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *ListingUpsertOne) SetPrice(v float64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *ListingUpsertOne) AddPrice(v float64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdatePrice() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetCover sets the "cover" field.
+func (u *ListingUpsertOne) SetCover(v []byte) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetCover(v)
+	})
+}
+
+// UpdateCover sets the "cover" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateCover() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateCover()
+	})
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *ListingUpsertOne) SetSubtitle(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetSubtitle(v)
+	})
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateSubtitle() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateSubtitle()
+	})
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *ListingUpsertOne) ClearSubtitle() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearSubtitle()
+	})
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (u *ListingUpsertOne) SetDiscontinuedAt(v time.Time) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetDiscontinuedAt(v)
+	})
+}
+
+// UpdateDiscontinuedAt sets the "discontinued_at" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateDiscontinuedAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateDiscontinuedAt()
+	})
+}
+
+// ClearDiscontinuedAt clears the value of the "discontinued_at" field.
+func (u *ListingUpsertOne) ClearDiscontinuedAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearDiscontinuedAt()
+	})
+}
+
+// SetListedAt sets the "listed_at" field.
+func (u *ListingUpsertOne) SetListedAt(v time.Time) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetListedAt(v)
+	})
+}
+
+// UpdateListedAt sets the "listed_at" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateListedAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateListedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ListingUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ListingUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ListingCreateBulk is the builder for creating many Listing entities in bulk.
+type ListingCreateBulk struct {
+	config
+	err      error
+	builders []*ListingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Listing entities in the database.
+func (lcb *ListingCreateBulk) Save(ctx context.Context) ([]*Listing, error) {
+	if lcb.err != nil {
+		return nil, lcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
+	nodes := make([]*Listing, len(lcb.builders))
+	mutators := make([]Mutator, len(lcb.builders))
+	for i := range lcb.builders {
+		func(i int, root context.Context) {
+			builder := lcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ListingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcb *ListingCreateBulk) SaveX(ctx context.Context) []*Listing {
+	v, err := lcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lcb *ListingCreateBulk) Exec(ctx context.Context) error {
+	_, err := lcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcb *ListingCreateBulk) ExecX(ctx context.Context) {
+	if err := lcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Listing.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingUpsert) {
+//			SetReference(v+v).
+//		}).
+//		Exec(ctx)
+func (lcb *ListingCreateBulk) OnConflict(opts ...sql.ConflictOption) *ListingUpsertBulk {
+	lcb.conflict = opts
+	return &ListingUpsertBulk{
+		create: lcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lcb *ListingCreateBulk) OnConflictColumns(columns ...string) *ListingUpsertBulk {
+	lcb.conflict = append(lcb.conflict, sql.ConflictColumns(columns...))
+	return &ListingUpsertBulk{
+		create: lcb,
+	}
+}
+
+// ListingUpsertBulk is the builder for "upsert"-ing
+// a bulk of Listing nodes.
+type ListingUpsertBulk struct {
+	create *ListingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ListingUpsertBulk) UpdateNewValues() *ListingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ListingUpsertBulk) Ignore() *ListingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingUpsertBulk) DoNothing() *ListingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingCreateBulk.OnConflict
+// documentation for more info.
+func (u *ListingUpsertBulk) Update(set func(*ListingUpsert)) *ListingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReference sets the "reference" field.
+func (u *ListingUpsertBulk) SetReference(v uuid.UUID) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetReference(v)
+	})
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateReference() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateReference()
+	})
+}
+
+// SetAttributes sets the "attributes" field.
+func (u *ListingUpsertBulk) SetAttributes(v model.ListingAttributes) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetAttributes(v)
+	})
+}
+
+// UpdateAttributes sets the "attributes" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateAttributes() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateAttributes()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *ListingUpsertBulk) SetTags(v model.TextArray) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateTags() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *ListingUpsertBulk) SetPrice(v float64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *ListingUpsertBulk) AddPrice(v float64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdatePrice() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetCover sets the "cover" field.
+func (u *ListingUpsertBulk) SetCover(v []byte) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetCover(v)
+	})
+}
+
+// UpdateCover sets the "cover" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateCover() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateCover()
+	})
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *ListingUpsertBulk) SetSubtitle(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetSubtitle(v)
+	})
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateSubtitle() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateSubtitle()
+	})
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *ListingUpsertBulk) ClearSubtitle() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearSubtitle()
+	})
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (u *ListingUpsertBulk) SetDiscontinuedAt(v time.Time) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetDiscontinuedAt(v)
+	})
+}
+
+// UpdateDiscontinuedAt sets the "discontinued_at" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateDiscontinuedAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateDiscontinuedAt()
+	})
+}
+
+// ClearDiscontinuedAt clears the value of the "discontinued_at" field.
+func (u *ListingUpsertBulk) ClearDiscontinuedAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearDiscontinuedAt()
+	})
+}
+
+// SetListedAt sets the "listed_at" field.
+func (u *ListingUpsertBulk) SetListedAt(v time.Time) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetListedAt(v)
+	})
+}
+
+// UpdateListedAt sets the "listed_at" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateListedAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateListedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ListingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
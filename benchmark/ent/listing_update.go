@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/listing"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// ListingUpdate is the builder for updating Listing entities.
+type ListingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ListingMutation
+}
+
+// Where appends a list predicates to the ListingUpdate builder.
+func (lu *ListingUpdate) Where(ps ...predicate.Listing) *ListingUpdate {
+	lu.mutation.Where(ps...)
+	return lu
+}
+
+// SetReference sets the "reference" field.
+func (lu *ListingUpdate) SetReference(u uuid.UUID) *ListingUpdate {
+	lu.mutation.SetReference(u)
+	return lu
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableReference(u *uuid.UUID) *ListingUpdate {
+	if u != nil {
+		lu.SetReference(*u)
+	}
+	return lu
+}
+
+// SetAttributes sets the "attributes" field.
+func (lu *ListingUpdate) SetAttributes(ma model.ListingAttributes) *ListingUpdate {
+	lu.mutation.SetAttributes(ma)
+	return lu
+}
+
+// SetNillableAttributes sets the "attributes" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableAttributes(ma *model.ListingAttributes) *ListingUpdate {
+	if ma != nil {
+		lu.SetAttributes(*ma)
+	}
+	return lu
+}
+
+// SetTags sets the "tags" field.
+func (lu *ListingUpdate) SetTags(ma model.TextArray) *ListingUpdate {
+	lu.mutation.SetTags(ma)
+	return lu
+}
+
+// SetPrice sets the "price" field.
+func (lu *ListingUpdate) SetPrice(f float64) *ListingUpdate {
+	lu.mutation.ResetPrice()
+	lu.mutation.SetPrice(f)
+	return lu
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillablePrice(f *float64) *ListingUpdate {
+	if f != nil {
+		lu.SetPrice(*f)
+	}
+	return lu
+}
+
+// AddPrice adds f to the "price" field.
+func (lu *ListingUpdate) AddPrice(f float64) *ListingUpdate {
+	lu.mutation.AddPrice(f)
+	return lu
+}
+
+// SetCover sets the "cover" field.
+func (lu *ListingUpdate) SetCover(b []byte) *ListingUpdate {
+	lu.mutation.SetCover(b)
+	return lu
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (lu *ListingUpdate) SetSubtitle(s string) *ListingUpdate {
+	lu.mutation.SetSubtitle(s)
+	return lu
+}
+
+// SetNillableSubtitle sets the "subtitle" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableSubtitle(s *string) *ListingUpdate {
+	if s != nil {
+		lu.SetSubtitle(*s)
+	}
+	return lu
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (lu *ListingUpdate) ClearSubtitle() *ListingUpdate {
+	lu.mutation.ClearSubtitle()
+	return lu
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (lu *ListingUpdate) SetDiscontinuedAt(t time.Time) *ListingUpdate {
+	lu.mutation.SetDiscontinuedAt(t)
+	return lu
+}
+
+// SetNillableDiscontinuedAt sets the "discontinued_at" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableDiscontinuedAt(t *time.Time) *ListingUpdate {
+	if t != nil {
+		lu.SetDiscontinuedAt(*t)
+	}
+	return lu
+}
+
+// ClearDiscontinuedAt clears the value of the "discontinued_at" field.
+func (lu *ListingUpdate) ClearDiscontinuedAt() *ListingUpdate {
+	lu.mutation.ClearDiscontinuedAt()
+	return lu
+}
+
+// SetListedAt sets the "listed_at" field.
+func (lu *ListingUpdate) SetListedAt(t time.Time) *ListingUpdate {
+	lu.mutation.SetListedAt(t)
+	return lu
+}
+
+// SetNillableListedAt sets the "listed_at" field if the given value is not nil.
+func (lu *ListingUpdate) SetNillableListedAt(t *time.Time) *ListingUpdate {
+	if t != nil {
+		lu.SetListedAt(*t)
+	}
+	return lu
+}
+
+// Mutation returns the ListingMutation object of the builder.
+func (lu *ListingUpdate) Mutation() *ListingMutation {
+	return lu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *ListingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *ListingUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *ListingUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *ListingUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lu *ListingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(listing.Table, listing.Columns, sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt))
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.Reference(); ok {
+		_spec.SetField(listing.FieldReference, field.TypeUUID, value)
+	}
+	if value, ok := lu.mutation.Attributes(); ok {
+		_spec.SetField(listing.FieldAttributes, field.TypeJSON, value)
+	}
+	if value, ok := lu.mutation.Tags(); ok {
+		_spec.SetField(listing.FieldTags, field.TypeOther, value)
+	}
+	if value, ok := lu.mutation.Price(); ok {
+		_spec.SetField(listing.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := lu.mutation.AddedPrice(); ok {
+		_spec.AddField(listing.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := lu.mutation.Cover(); ok {
+		_spec.SetField(listing.FieldCover, field.TypeBytes, value)
+	}
+	if value, ok := lu.mutation.Subtitle(); ok {
+		_spec.SetField(listing.FieldSubtitle, field.TypeString, value)
+	}
+	if lu.mutation.SubtitleCleared() {
+		_spec.ClearField(listing.FieldSubtitle, field.TypeString)
+	}
+	if value, ok := lu.mutation.DiscontinuedAt(); ok {
+		_spec.SetField(listing.FieldDiscontinuedAt, field.TypeTime, value)
+	}
+	if lu.mutation.DiscontinuedAtCleared() {
+		_spec.ClearField(listing.FieldDiscontinuedAt, field.TypeTime)
+	}
+	if value, ok := lu.mutation.ListedAt(); ok {
+		_spec.SetField(listing.FieldListedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listing.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lu.mutation.done = true
+	return n, nil
+}
+
+// ListingUpdateOne is the builder for updating a single Listing entity.
+type ListingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ListingMutation
+}
+
+// SetReference sets the "reference" field.
+func (luo *ListingUpdateOne) SetReference(u uuid.UUID) *ListingUpdateOne {
+	luo.mutation.SetReference(u)
+	return luo
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableReference(u *uuid.UUID) *ListingUpdateOne {
+	if u != nil {
+		luo.SetReference(*u)
+	}
+	return luo
+}
+
+// SetAttributes sets the "attributes" field.
+func (luo *ListingUpdateOne) SetAttributes(ma model.ListingAttributes) *ListingUpdateOne {
+	luo.mutation.SetAttributes(ma)
+	return luo
+}
+
+// SetNillableAttributes sets the "attributes" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableAttributes(ma *model.ListingAttributes) *ListingUpdateOne {
+	if ma != nil {
+		luo.SetAttributes(*ma)
+	}
+	return luo
+}
+
+// SetTags sets the "tags" field.
+func (luo *ListingUpdateOne) SetTags(ma model.TextArray) *ListingUpdateOne {
+	luo.mutation.SetTags(ma)
+	return luo
+}
+
+// SetPrice sets the "price" field.
+func (luo *ListingUpdateOne) SetPrice(f float64) *ListingUpdateOne {
+	luo.mutation.ResetPrice()
+	luo.mutation.SetPrice(f)
+	return luo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillablePrice(f *float64) *ListingUpdateOne {
+	if f != nil {
+		luo.SetPrice(*f)
+	}
+	return luo
+}
+
+// AddPrice adds f to the "price" field.
+func (luo *ListingUpdateOne) AddPrice(f float64) *ListingUpdateOne {
+	luo.mutation.AddPrice(f)
+	return luo
+}
+
+// SetCover sets the "cover" field.
+func (luo *ListingUpdateOne) SetCover(b []byte) *ListingUpdateOne {
+	luo.mutation.SetCover(b)
+	return luo
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (luo *ListingUpdateOne) SetSubtitle(s string) *ListingUpdateOne {
+	luo.mutation.SetSubtitle(s)
+	return luo
+}
+
+// SetNillableSubtitle sets the "subtitle" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableSubtitle(s *string) *ListingUpdateOne {
+	if s != nil {
+		luo.SetSubtitle(*s)
+	}
+	return luo
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (luo *ListingUpdateOne) ClearSubtitle() *ListingUpdateOne {
+	luo.mutation.ClearSubtitle()
+	return luo
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (luo *ListingUpdateOne) SetDiscontinuedAt(t time.Time) *ListingUpdateOne {
+	luo.mutation.SetDiscontinuedAt(t)
+	return luo
+}
+
+// SetNillableDiscontinuedAt sets the "discontinued_at" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableDiscontinuedAt(t *time.Time) *ListingUpdateOne {
+	if t != nil {
+		luo.SetDiscontinuedAt(*t)
+	}
+	return luo
+}
+
+// ClearDiscontinuedAt clears the value of the "discontinued_at" field.
+func (luo *ListingUpdateOne) ClearDiscontinuedAt() *ListingUpdateOne {
+	luo.mutation.ClearDiscontinuedAt()
+	return luo
+}
+
+// SetListedAt sets the "listed_at" field.
+func (luo *ListingUpdateOne) SetListedAt(t time.Time) *ListingUpdateOne {
+	luo.mutation.SetListedAt(t)
+	return luo
+}
+
+// SetNillableListedAt sets the "listed_at" field if the given value is not nil.
+func (luo *ListingUpdateOne) SetNillableListedAt(t *time.Time) *ListingUpdateOne {
+	if t != nil {
+		luo.SetListedAt(*t)
+	}
+	return luo
+}
+
+// Mutation returns the ListingMutation object of the builder.
+func (luo *ListingUpdateOne) Mutation() *ListingMutation {
+	return luo.mutation
+}
+
+// Where appends a list predicates to the ListingUpdate builder.
+func (luo *ListingUpdateOne) Where(ps ...predicate.Listing) *ListingUpdateOne {
+	luo.mutation.Where(ps...)
+	return luo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (luo *ListingUpdateOne) Select(field string, fields ...string) *ListingUpdateOne {
+	luo.fields = append([]string{field}, fields...)
+	return luo
+}
+
+// Save executes the query and returns the updated Listing entity.
+func (luo *ListingUpdateOne) Save(ctx context.Context) (*Listing, error) {
+	return withHooks(ctx, luo.sqlSave, luo.mutation, luo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *ListingUpdateOne) SaveX(ctx context.Context) *Listing {
+	node, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *ListingUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *ListingUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (luo *ListingUpdateOne) sqlSave(ctx context.Context) (_node *Listing, err error) {
+	_spec := sqlgraph.NewUpdateSpec(listing.Table, listing.Columns, sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt))
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Listing.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := luo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, listing.FieldID)
+		for _, f := range fields {
+			if !listing.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != listing.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.Reference(); ok {
+		_spec.SetField(listing.FieldReference, field.TypeUUID, value)
+	}
+	if value, ok := luo.mutation.Attributes(); ok {
+		_spec.SetField(listing.FieldAttributes, field.TypeJSON, value)
+	}
+	if value, ok := luo.mutation.Tags(); ok {
+		_spec.SetField(listing.FieldTags, field.TypeOther, value)
+	}
+	if value, ok := luo.mutation.Price(); ok {
+		_spec.SetField(listing.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := luo.mutation.AddedPrice(); ok {
+		_spec.AddField(listing.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := luo.mutation.Cover(); ok {
+		_spec.SetField(listing.FieldCover, field.TypeBytes, value)
+	}
+	if value, ok := luo.mutation.Subtitle(); ok {
+		_spec.SetField(listing.FieldSubtitle, field.TypeString, value)
+	}
+	if luo.mutation.SubtitleCleared() {
+		_spec.ClearField(listing.FieldSubtitle, field.TypeString)
+	}
+	if value, ok := luo.mutation.DiscontinuedAt(); ok {
+		_spec.SetField(listing.FieldDiscontinuedAt, field.TypeTime, value)
+	}
+	if luo.mutation.DiscontinuedAtCleared() {
+		_spec.ClearField(listing.FieldDiscontinuedAt, field.TypeTime)
+	}
+	if value, ok := luo.mutation.ListedAt(); ok {
+		_spec.SetField(listing.FieldListedAt, field.TypeTime, value)
+	}
+	_node = &Listing{config: luo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listing.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	luo.mutation.done = true
+	return _node, nil
+}
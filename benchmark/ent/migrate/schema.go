@@ -11,12 +11,14 @@ var (
 	// BooksColumns holds the columns for the "books" table.
 	BooksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "isbn", Type: field.TypeString},
+		{Name: "isbn", Type: field.TypeString, Unique: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "author", Type: field.TypeString},
 		{Name: "genre", Type: field.TypeString},
 		{Name: "quantity", Type: field.TypeInt},
 		{Name: "publicized_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// BooksTable holds the schema information for the "books" table.
 	BooksTable = &schema.Table{
@@ -24,11 +26,165 @@ var (
 		Columns:    BooksColumns,
 		PrimaryKey: []*schema.Column{BooksColumns[0]},
 	}
+	// DraftsColumns holds the columns for the "drafts" table.
+	DraftsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "subtitle", Type: field.TypeString, Nullable: true},
+		{Name: "author", Type: field.TypeString, Nullable: true},
+		{Name: "translator", Type: field.TypeString, Nullable: true},
+		{Name: "pages", Type: field.TypeInt, Nullable: true},
+		{Name: "rating", Type: field.TypeFloat64, Nullable: true},
+		{Name: "illustrated", Type: field.TypeBool, Nullable: true},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+	}
+	// DraftsTable holds the schema information for the "drafts" table.
+	DraftsTable = &schema.Table{
+		Name:       "drafts",
+		Columns:    DraftsColumns,
+		PrimaryKey: []*schema.Column{DraftsColumns[0]},
+	}
+	// ListingsColumns holds the columns for the "listings" table.
+	ListingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "reference", Type: field.TypeUUID, Unique: true},
+		{Name: "attributes", Type: field.TypeJSON},
+		{Name: "tags", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "text[]"}},
+		{Name: "price", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(10,2)"}},
+		{Name: "cover", Type: field.TypeBytes},
+		{Name: "subtitle", Type: field.TypeString, Nullable: true},
+		{Name: "discontinued_at", Type: field.TypeTime, Nullable: true},
+		{Name: "listed_at", Type: field.TypeTime},
+	}
+	// ListingsTable holds the schema information for the "listings" table.
+	ListingsTable = &schema.Table{
+		Name:       "listings",
+		Columns:    ListingsColumns,
+		PrimaryKey: []*schema.Column{ListingsColumns[0]},
+	}
+	// PricePoliciesColumns holds the columns for the "price_policies" table.
+	PricePoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "price", Type: field.TypeFloat64},
+		{Name: "start_date", Type: field.TypeTime},
+		{Name: "end_date", Type: field.TypeTime},
+		{Name: "book_id", Type: field.TypeInt},
+	}
+	// PricePoliciesTable holds the schema information for the "price_policies" table.
+	PricePoliciesTable = &schema.Table{
+		Name:       "price_policies",
+		Columns:    PricePoliciesColumns,
+		PrimaryKey: []*schema.Column{PricePoliciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "price_policies_books_price_policies",
+				Columns:    []*schema.Column{PricePoliciesColumns[4]},
+				RefColumns: []*schema.Column{BooksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// UUIDBooksColumns holds the columns for the "uuid_books" table.
+	UUIDBooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Default: "gen_random_uuid()"},
+		{Name: "isbn", Type: field.TypeString, Unique: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString},
+		{Name: "genre", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "publicized_at", Type: field.TypeTime},
+	}
+	// UUIDBooksTable holds the schema information for the "uuid_books" table.
+	UUIDBooksTable = &schema.Table{
+		Name:       "uuid_books",
+		Columns:    UUIDBooksColumns,
+		PrimaryKey: []*schema.Column{UUIDBooksColumns[0]},
+	}
+	// WidesColumns holds the columns for the "wides" table.
+	WidesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "column001", Type: field.TypeString},
+		{Name: "column002", Type: field.TypeInt},
+		{Name: "column003", Type: field.TypeFloat64},
+		{Name: "column004", Type: field.TypeTime},
+		{Name: "column005", Type: field.TypeString},
+		{Name: "column006", Type: field.TypeInt},
+		{Name: "column007", Type: field.TypeFloat64},
+		{Name: "column008", Type: field.TypeTime},
+		{Name: "column009", Type: field.TypeString},
+		{Name: "column010", Type: field.TypeInt},
+		{Name: "column011", Type: field.TypeFloat64},
+		{Name: "column012", Type: field.TypeTime},
+		{Name: "column013", Type: field.TypeString},
+		{Name: "column014", Type: field.TypeInt},
+		{Name: "column015", Type: field.TypeFloat64},
+		{Name: "column016", Type: field.TypeTime},
+		{Name: "column017", Type: field.TypeString},
+		{Name: "column018", Type: field.TypeInt},
+		{Name: "column019", Type: field.TypeFloat64},
+		{Name: "column020", Type: field.TypeTime},
+		{Name: "column021", Type: field.TypeString},
+		{Name: "column022", Type: field.TypeInt},
+		{Name: "column023", Type: field.TypeFloat64},
+		{Name: "column024", Type: field.TypeTime},
+		{Name: "column025", Type: field.TypeString},
+		{Name: "column026", Type: field.TypeInt},
+		{Name: "column027", Type: field.TypeFloat64},
+		{Name: "column028", Type: field.TypeTime},
+		{Name: "column029", Type: field.TypeString},
+		{Name: "column030", Type: field.TypeInt},
+		{Name: "column031", Type: field.TypeFloat64},
+		{Name: "column032", Type: field.TypeTime},
+		{Name: "column033", Type: field.TypeString},
+		{Name: "column034", Type: field.TypeInt},
+		{Name: "column035", Type: field.TypeFloat64},
+		{Name: "column036", Type: field.TypeTime},
+		{Name: "column037", Type: field.TypeString},
+		{Name: "column038", Type: field.TypeInt},
+		{Name: "column039", Type: field.TypeFloat64},
+		{Name: "column040", Type: field.TypeTime},
+		{Name: "column041", Type: field.TypeString},
+		{Name: "column042", Type: field.TypeInt},
+		{Name: "column043", Type: field.TypeFloat64},
+		{Name: "column044", Type: field.TypeTime},
+		{Name: "column045", Type: field.TypeString},
+		{Name: "column046", Type: field.TypeInt},
+		{Name: "column047", Type: field.TypeFloat64},
+		{Name: "column048", Type: field.TypeTime},
+		{Name: "column049", Type: field.TypeString},
+		{Name: "column050", Type: field.TypeInt},
+		{Name: "column051", Type: field.TypeFloat64},
+		{Name: "column052", Type: field.TypeTime},
+		{Name: "column053", Type: field.TypeString},
+		{Name: "column054", Type: field.TypeInt},
+		{Name: "column055", Type: field.TypeFloat64},
+		{Name: "column056", Type: field.TypeTime},
+		{Name: "column057", Type: field.TypeString},
+		{Name: "column058", Type: field.TypeInt},
+		{Name: "column059", Type: field.TypeFloat64},
+		{Name: "column060", Type: field.TypeTime},
+		{Name: "column061", Type: field.TypeString},
+		{Name: "column062", Type: field.TypeInt},
+		{Name: "column063", Type: field.TypeFloat64},
+		{Name: "column064", Type: field.TypeTime},
+	}
+	// WidesTable holds the schema information for the "wides" table.
+	WidesTable = &schema.Table{
+		Name:       "wides",
+		Columns:    WidesColumns,
+		PrimaryKey: []*schema.Column{WidesColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		BooksTable,
+		DraftsTable,
+		ListingsTable,
+		PricePoliciesTable,
+		UUIDBooksTable,
+		WidesTable,
 	}
 )
 
 func init() {
+	PricePoliciesTable.ForeignKeys[0].RefTable = BooksTable
 }
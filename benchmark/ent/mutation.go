@@ -11,8 +11,15 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/book"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/draft"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/listing"
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/pricepolicy"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/uuidbook"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/wide"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
 )
 
 const (
@@ -24,26 +31,37 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBook = "Book"
+	TypeBook        = "Book"
+	TypeDraft       = "Draft"
+	TypeListing     = "Listing"
+	TypePricePolicy = "PricePolicy"
+	TypeUUIDBook    = "UUIDBook"
+	TypeWide        = "Wide"
 )
 
 // BookMutation represents an operation that mutates the Book nodes in the graph.
 type BookMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	isbn          *string
-	title         *string
-	author        *string
-	genre         *string
-	quantity      *int
-	addquantity   *int
-	publicized_at *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Book, error)
-	predicates    []predicate.Book
+	op                    Op
+	typ                   string
+	id                    *int
+	isbn                  *string
+	title                 *string
+	author                *string
+	genre                 *string
+	quantity              *int
+	addquantity           *int
+	publicized_at         *time.Time
+	version               *int
+	addversion            *int
+	deleted_at            *time.Time
+	clearedFields         map[string]struct{}
+	price_policies        map[int]struct{}
+	removedprice_policies map[int]struct{}
+	clearedprice_policies bool
+	done                  bool
+	oldValue              func(context.Context) (*Book, error)
+	predicates            []predicate.Book
 }
 
 var _ ent.Mutation = (*BookMutation)(nil)
@@ -380,6 +398,165 @@ func (m *BookMutation) ResetPublicizedAt() {
 	m.publicized_at = nil
 }
 
+// SetVersion sets the "version" field.
+func (m *BookMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *BookMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *BookMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *BookMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *BookMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *BookMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *BookMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *BookMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[book.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *BookMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[book.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *BookMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, book.FieldDeletedAt)
+}
+
+// AddPricePolicyIDs adds the "price_policies" edge to the PricePolicy entity by ids.
+func (m *BookMutation) AddPricePolicyIDs(ids ...int) {
+	if m.price_policies == nil {
+		m.price_policies = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.price_policies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPricePolicies clears the "price_policies" edge to the PricePolicy entity.
+func (m *BookMutation) ClearPricePolicies() {
+	m.clearedprice_policies = true
+}
+
+// PricePoliciesCleared reports if the "price_policies" edge to the PricePolicy entity was cleared.
+func (m *BookMutation) PricePoliciesCleared() bool {
+	return m.clearedprice_policies
+}
+
+// RemovePricePolicyIDs removes the "price_policies" edge to the PricePolicy entity by IDs.
+func (m *BookMutation) RemovePricePolicyIDs(ids ...int) {
+	if m.removedprice_policies == nil {
+		m.removedprice_policies = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.price_policies, ids[i])
+		m.removedprice_policies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPricePolicies returns the removed IDs of the "price_policies" edge to the PricePolicy entity.
+func (m *BookMutation) RemovedPricePoliciesIDs() (ids []int) {
+	for id := range m.removedprice_policies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PricePoliciesIDs returns the "price_policies" edge IDs in the mutation.
+func (m *BookMutation) PricePoliciesIDs() (ids []int) {
+	for id := range m.price_policies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPricePolicies resets all changes to the "price_policies" edge.
+func (m *BookMutation) ResetPricePolicies() {
+	m.price_policies = nil
+	m.clearedprice_policies = false
+	m.removedprice_policies = nil
+}
+
 // Where appends a list predicates to the BookMutation builder.
 func (m *BookMutation) Where(ps ...predicate.Book) {
 	m.predicates = append(m.predicates, ps...)
@@ -414,7 +591,7 @@ func (m *BookMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BookMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 8)
 	if m.isbn != nil {
 		fields = append(fields, book.FieldIsbn)
 	}
@@ -433,6 +610,12 @@ func (m *BookMutation) Fields() []string {
 	if m.publicized_at != nil {
 		fields = append(fields, book.FieldPublicizedAt)
 	}
+	if m.version != nil {
+		fields = append(fields, book.FieldVersion)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, book.FieldDeletedAt)
+	}
 	return fields
 }
 
@@ -453,6 +636,10 @@ func (m *BookMutation) Field(name string) (ent.Value, bool) {
 		return m.Quantity()
 	case book.FieldPublicizedAt:
 		return m.PublicizedAt()
+	case book.FieldVersion:
+		return m.Version()
+	case book.FieldDeletedAt:
+		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -474,6 +661,10 @@ func (m *BookMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldQuantity(ctx)
 	case book.FieldPublicizedAt:
 		return m.OldPublicizedAt(ctx)
+	case book.FieldVersion:
+		return m.OldVersion(ctx)
+	case book.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Book field %s", name)
 }
@@ -525,6 +716,20 @@ func (m *BookMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPublicizedAt(v)
 		return nil
+	case book.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case book.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Book field %s", name)
 }
@@ -536,6 +741,9 @@ func (m *BookMutation) AddedFields() []string {
 	if m.addquantity != nil {
 		fields = append(fields, book.FieldQuantity)
 	}
+	if m.addversion != nil {
+		fields = append(fields, book.FieldVersion)
+	}
 	return fields
 }
 
@@ -546,6 +754,8 @@ func (m *BookMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case book.FieldQuantity:
 		return m.AddedQuantity()
+	case book.FieldVersion:
+		return m.AddedVersion()
 	}
 	return nil, false
 }
@@ -562,6 +772,13 @@ func (m *BookMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddQuantity(v)
 		return nil
+	case book.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Book numeric field %s", name)
 }
@@ -569,7 +786,11 @@ func (m *BookMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *BookMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(book.FieldDeletedAt) {
+		fields = append(fields, book.FieldDeletedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -582,6 +803,11 @@ func (m *BookMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *BookMutation) ClearField(name string) error {
+	switch name {
+	case book.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
 	return fmt.Errorf("unknown Book nullable field %s", name)
 }
 
@@ -607,54 +833,7810 @@ func (m *BookMutation) ResetField(name string) error {
 	case book.FieldPublicizedAt:
 		m.ResetPublicizedAt()
 		return nil
+	case book.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case book.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Book field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *BookMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.price_policies != nil {
+		edges = append(edges, book.EdgePricePolicies)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
 func (m *BookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case book.EdgePricePolicies:
+		ids := make([]ent.Value, 0, len(m.price_policies))
+		for id := range m.price_policies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *BookMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.removedprice_policies != nil {
+		edges = append(edges, book.EdgePricePolicies)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *BookMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case book.EdgePricePolicies:
+		ids := make([]ent.Value, 0, len(m.removedprice_policies))
+		for id := range m.removedprice_policies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *BookMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.clearedprice_policies {
+		edges = append(edges, book.EdgePricePolicies)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *BookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case book.EdgePricePolicies:
+		return m.clearedprice_policies
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *BookMutation) ClearEdge(name string) error {
+	switch name {
+	}
 	return fmt.Errorf("unknown Book unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *BookMutation) ResetEdge(name string) error {
+	switch name {
+	case book.EdgePricePolicies:
+		m.ResetPricePolicies()
+		return nil
+	}
 	return fmt.Errorf("unknown Book edge %s", name)
 }
+
+// DraftMutation represents an operation that mutates the Draft nodes in the graph.
+type DraftMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	title         *string
+	subtitle      *string
+	author        *string
+	translator    *string
+	pages         *int
+	addpages      *int
+	rating        *float64
+	addrating     *float64
+	illustrated   *bool
+	submitted_at  *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Draft, error)
+	predicates    []predicate.Draft
+}
+
+var _ ent.Mutation = (*DraftMutation)(nil)
+
+// draftOption allows management of the mutation configuration using functional options.
+type draftOption func(*DraftMutation)
+
+// newDraftMutation creates new mutation for the Draft entity.
+func newDraftMutation(c config, op Op, opts ...draftOption) *DraftMutation {
+	m := &DraftMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDraft,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDraftID sets the ID field of the mutation.
+func withDraftID(id int) draftOption {
+	return func(m *DraftMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Draft
+		)
+		m.oldValue = func(ctx context.Context) (*Draft, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Draft.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDraft sets the old Draft of the mutation.
+func withDraft(node *Draft) draftOption {
+	return func(m *DraftMutation) {
+		m.oldValue = func(context.Context) (*Draft, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DraftMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DraftMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DraftMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DraftMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Draft.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *DraftMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *DraftMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Draft entity.
+// If the Draft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DraftMutation) OldTitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *DraftMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[draft.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *DraftMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[draft.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *DraftMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, draft.FieldTitle)
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (m *DraftMutation) SetSubtitle(s string) {
+	m.subtitle = &s
+}
+
+// Subtitle returns the value of the "subtitle" field in the mutation.
+func (m *DraftMutation) Subtitle() (r string, exists bool) {
+	v := m.subtitle
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubtitle returns the old "subtitle" field's value of the Draft entity.
+// If the Draft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DraftMutation) OldSubtitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtitle: %w", err)
+	}
+	return oldValue.Subtitle, nil
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (m *DraftMutation) ClearSubtitle() {
+	m.subtitle = nil
+	m.clearedFields[draft.FieldSubtitle] = struct{}{}
+}
+
+// SubtitleCleared returns if the "subtitle" field was cleared in this mutation.
+func (m *DraftMutation) SubtitleCleared() bool {
+	_, ok := m.clearedFields[draft.FieldSubtitle]
+	return ok
+}
+
+// ResetSubtitle resets all changes to the "subtitle" field.
+func (m *DraftMutation) ResetSubtitle() {
+	m.subtitle = nil
+	delete(m.clearedFields, draft.FieldSubtitle)
+}
+
+// SetAuthor sets the "author" field.
+func (m *DraftMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *DraftMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the Draft entity.
+// If the Draft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DraftMutation) OldAuthor(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (m *DraftMutation) ClearAuthor() {
+	m.author = nil
+	m.clearedFields[draft.FieldAuthor] = struct{}{}
+}
+
+// AuthorCleared returns if the "author" field was cleared in this mutation.
+func (m *DraftMutation) AuthorCleared() bool {
+	_, ok := m.clearedFields[draft.FieldAuthor]
+	return ok
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *DraftMutation) ResetAuthor() {
+	m.author = nil
+	delete(m.clearedFields, draft.FieldAuthor)
+}
+
+// SetTranslator sets the "translator" field.
+func (m *DraftMutation) SetTranslator(s string) {
+	m.translator = &s
+}
+
+// Translator returns the value of the "translator" field in the mutation.
+func (m *DraftMutation) Translator() (r string, exists bool) {
+	v := m.translator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTranslator returns the old "translator" field's value of the Draft entity.
+// If the Draft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DraftMutation) OldTranslator(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTranslator is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTranslator requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTranslator: %w", err)
+	}
+	return oldValue.Translator, nil
+}
+
+// ClearTranslator clears the value of the "translator" field.
+func (m *DraftMutation) ClearTranslator() {
+	m.translator = nil
+	m.clearedFields[draft.FieldTranslator] = struct{}{}
+}
+
+// TranslatorCleared returns if the "translator" field was cleared in this mutation.
+func (m *DraftMutation) TranslatorCleared() bool {
+	_, ok := m.clearedFields[draft.FieldTranslator]
+	return ok
+}
+
+// ResetTranslator resets all changes to the "translator" field.
+func (m *DraftMutation) ResetTranslator() {
+	m.translator = nil
+	delete(m.clearedFields, draft.FieldTranslator)
+}
+
+// SetPages sets the "pages" field.
+func (m *DraftMutation) SetPages(i int) {
+	m.pages = &i
+	m.addpages = nil
+}
+
+// Pages returns the value of the "pages" field in the mutation.
+func (m *DraftMutation) Pages() (r int, exists bool) {
+	v := m.pages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPages returns the old "pages" field's value of the Draft entity.
+// If the Draft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DraftMutation) OldPages(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPages: %w", err)
+	}
+	return oldValue.Pages, nil
+}
+
+// AddPages adds i to the "pages" field.
+func (m *DraftMutation) AddPages(i int) {
+	if m.addpages != nil {
+		*m.addpages += i
+	} else {
+		m.addpages = &i
+	}
+}
+
+// AddedPages returns the value that was added to the "pages" field in this mutation.
+func (m *DraftMutation) AddedPages() (r int, exists bool) {
+	v := m.addpages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPages clears the value of the "pages" field.
+func (m *DraftMutation) ClearPages() {
+	m.pages = nil
+	m.addpages = nil
+	m.clearedFields[draft.FieldPages] = struct{}{}
+}
+
+// PagesCleared returns if the "pages" field was cleared in this mutation.
+func (m *DraftMutation) PagesCleared() bool {
+	_, ok := m.clearedFields[draft.FieldPages]
+	return ok
+}
+
+// ResetPages resets all changes to the "pages" field.
+func (m *DraftMutation) ResetPages() {
+	m.pages = nil
+	m.addpages = nil
+	delete(m.clearedFields, draft.FieldPages)
+}
+
+// SetRating sets the "rating" field.
+func (m *DraftMutation) SetRating(f float64) {
+	m.rating = &f
+	m.addrating = nil
+}
+
+// Rating returns the value of the "rating" field in the mutation.
+func (m *DraftMutation) Rating() (r float64, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the Draft entity.
+// If the Draft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DraftMutation) OldRating(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+	}
+	return oldValue.Rating, nil
+}
+
+// AddRating adds f to the "rating" field.
+func (m *DraftMutation) AddRating(f float64) {
+	if m.addrating != nil {
+		*m.addrating += f
+	} else {
+		m.addrating = &f
+	}
+}
+
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *DraftMutation) AddedRating() (r float64, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRating clears the value of the "rating" field.
+func (m *DraftMutation) ClearRating() {
+	m.rating = nil
+	m.addrating = nil
+	m.clearedFields[draft.FieldRating] = struct{}{}
+}
+
+// RatingCleared returns if the "rating" field was cleared in this mutation.
+func (m *DraftMutation) RatingCleared() bool {
+	_, ok := m.clearedFields[draft.FieldRating]
+	return ok
+}
+
+// ResetRating resets all changes to the "rating" field.
+func (m *DraftMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
+	delete(m.clearedFields, draft.FieldRating)
+}
+
+// SetIllustrated sets the "illustrated" field.
+func (m *DraftMutation) SetIllustrated(b bool) {
+	m.illustrated = &b
+}
+
+// Illustrated returns the value of the "illustrated" field in the mutation.
+func (m *DraftMutation) Illustrated() (r bool, exists bool) {
+	v := m.illustrated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIllustrated returns the old "illustrated" field's value of the Draft entity.
+// If the Draft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DraftMutation) OldIllustrated(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIllustrated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIllustrated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIllustrated: %w", err)
+	}
+	return oldValue.Illustrated, nil
+}
+
+// ClearIllustrated clears the value of the "illustrated" field.
+func (m *DraftMutation) ClearIllustrated() {
+	m.illustrated = nil
+	m.clearedFields[draft.FieldIllustrated] = struct{}{}
+}
+
+// IllustratedCleared returns if the "illustrated" field was cleared in this mutation.
+func (m *DraftMutation) IllustratedCleared() bool {
+	_, ok := m.clearedFields[draft.FieldIllustrated]
+	return ok
+}
+
+// ResetIllustrated resets all changes to the "illustrated" field.
+func (m *DraftMutation) ResetIllustrated() {
+	m.illustrated = nil
+	delete(m.clearedFields, draft.FieldIllustrated)
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (m *DraftMutation) SetSubmittedAt(t time.Time) {
+	m.submitted_at = &t
+}
+
+// SubmittedAt returns the value of the "submitted_at" field in the mutation.
+func (m *DraftMutation) SubmittedAt() (r time.Time, exists bool) {
+	v := m.submitted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedAt returns the old "submitted_at" field's value of the Draft entity.
+// If the Draft object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DraftMutation) OldSubmittedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedAt: %w", err)
+	}
+	return oldValue.SubmittedAt, nil
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (m *DraftMutation) ClearSubmittedAt() {
+	m.submitted_at = nil
+	m.clearedFields[draft.FieldSubmittedAt] = struct{}{}
+}
+
+// SubmittedAtCleared returns if the "submitted_at" field was cleared in this mutation.
+func (m *DraftMutation) SubmittedAtCleared() bool {
+	_, ok := m.clearedFields[draft.FieldSubmittedAt]
+	return ok
+}
+
+// ResetSubmittedAt resets all changes to the "submitted_at" field.
+func (m *DraftMutation) ResetSubmittedAt() {
+	m.submitted_at = nil
+	delete(m.clearedFields, draft.FieldSubmittedAt)
+}
+
+// Where appends a list predicates to the DraftMutation builder.
+func (m *DraftMutation) Where(ps ...predicate.Draft) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DraftMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DraftMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Draft, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DraftMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DraftMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Draft).
+func (m *DraftMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DraftMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.title != nil {
+		fields = append(fields, draft.FieldTitle)
+	}
+	if m.subtitle != nil {
+		fields = append(fields, draft.FieldSubtitle)
+	}
+	if m.author != nil {
+		fields = append(fields, draft.FieldAuthor)
+	}
+	if m.translator != nil {
+		fields = append(fields, draft.FieldTranslator)
+	}
+	if m.pages != nil {
+		fields = append(fields, draft.FieldPages)
+	}
+	if m.rating != nil {
+		fields = append(fields, draft.FieldRating)
+	}
+	if m.illustrated != nil {
+		fields = append(fields, draft.FieldIllustrated)
+	}
+	if m.submitted_at != nil {
+		fields = append(fields, draft.FieldSubmittedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DraftMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case draft.FieldTitle:
+		return m.Title()
+	case draft.FieldSubtitle:
+		return m.Subtitle()
+	case draft.FieldAuthor:
+		return m.Author()
+	case draft.FieldTranslator:
+		return m.Translator()
+	case draft.FieldPages:
+		return m.Pages()
+	case draft.FieldRating:
+		return m.Rating()
+	case draft.FieldIllustrated:
+		return m.Illustrated()
+	case draft.FieldSubmittedAt:
+		return m.SubmittedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DraftMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case draft.FieldTitle:
+		return m.OldTitle(ctx)
+	case draft.FieldSubtitle:
+		return m.OldSubtitle(ctx)
+	case draft.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case draft.FieldTranslator:
+		return m.OldTranslator(ctx)
+	case draft.FieldPages:
+		return m.OldPages(ctx)
+	case draft.FieldRating:
+		return m.OldRating(ctx)
+	case draft.FieldIllustrated:
+		return m.OldIllustrated(ctx)
+	case draft.FieldSubmittedAt:
+		return m.OldSubmittedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Draft field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DraftMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case draft.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case draft.FieldSubtitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtitle(v)
+		return nil
+	case draft.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case draft.FieldTranslator:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTranslator(v)
+		return nil
+	case draft.FieldPages:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPages(v)
+		return nil
+	case draft.FieldRating:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case draft.FieldIllustrated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIllustrated(v)
+		return nil
+	case draft.FieldSubmittedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Draft field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DraftMutation) AddedFields() []string {
+	var fields []string
+	if m.addpages != nil {
+		fields = append(fields, draft.FieldPages)
+	}
+	if m.addrating != nil {
+		fields = append(fields, draft.FieldRating)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DraftMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case draft.FieldPages:
+		return m.AddedPages()
+	case draft.FieldRating:
+		return m.AddedRating()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DraftMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case draft.FieldPages:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPages(v)
+		return nil
+	case draft.FieldRating:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRating(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Draft numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DraftMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(draft.FieldTitle) {
+		fields = append(fields, draft.FieldTitle)
+	}
+	if m.FieldCleared(draft.FieldSubtitle) {
+		fields = append(fields, draft.FieldSubtitle)
+	}
+	if m.FieldCleared(draft.FieldAuthor) {
+		fields = append(fields, draft.FieldAuthor)
+	}
+	if m.FieldCleared(draft.FieldTranslator) {
+		fields = append(fields, draft.FieldTranslator)
+	}
+	if m.FieldCleared(draft.FieldPages) {
+		fields = append(fields, draft.FieldPages)
+	}
+	if m.FieldCleared(draft.FieldRating) {
+		fields = append(fields, draft.FieldRating)
+	}
+	if m.FieldCleared(draft.FieldIllustrated) {
+		fields = append(fields, draft.FieldIllustrated)
+	}
+	if m.FieldCleared(draft.FieldSubmittedAt) {
+		fields = append(fields, draft.FieldSubmittedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DraftMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DraftMutation) ClearField(name string) error {
+	switch name {
+	case draft.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case draft.FieldSubtitle:
+		m.ClearSubtitle()
+		return nil
+	case draft.FieldAuthor:
+		m.ClearAuthor()
+		return nil
+	case draft.FieldTranslator:
+		m.ClearTranslator()
+		return nil
+	case draft.FieldPages:
+		m.ClearPages()
+		return nil
+	case draft.FieldRating:
+		m.ClearRating()
+		return nil
+	case draft.FieldIllustrated:
+		m.ClearIllustrated()
+		return nil
+	case draft.FieldSubmittedAt:
+		m.ClearSubmittedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Draft nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DraftMutation) ResetField(name string) error {
+	switch name {
+	case draft.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case draft.FieldSubtitle:
+		m.ResetSubtitle()
+		return nil
+	case draft.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case draft.FieldTranslator:
+		m.ResetTranslator()
+		return nil
+	case draft.FieldPages:
+		m.ResetPages()
+		return nil
+	case draft.FieldRating:
+		m.ResetRating()
+		return nil
+	case draft.FieldIllustrated:
+		m.ResetIllustrated()
+		return nil
+	case draft.FieldSubmittedAt:
+		m.ResetSubmittedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Draft field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DraftMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DraftMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DraftMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DraftMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DraftMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DraftMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DraftMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Draft unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DraftMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Draft edge %s", name)
+}
+
+// ListingMutation represents an operation that mutates the Listing nodes in the graph.
+type ListingMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	reference       *uuid.UUID
+	attributes      *model.ListingAttributes
+	tags            *model.TextArray
+	price           *float64
+	addprice        *float64
+	cover           *[]byte
+	subtitle        *string
+	discontinued_at *time.Time
+	listed_at       *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Listing, error)
+	predicates      []predicate.Listing
+}
+
+var _ ent.Mutation = (*ListingMutation)(nil)
+
+// listingOption allows management of the mutation configuration using functional options.
+type listingOption func(*ListingMutation)
+
+// newListingMutation creates new mutation for the Listing entity.
+func newListingMutation(c config, op Op, opts ...listingOption) *ListingMutation {
+	m := &ListingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeListing,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withListingID sets the ID field of the mutation.
+func withListingID(id int) listingOption {
+	return func(m *ListingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Listing
+		)
+		m.oldValue = func(ctx context.Context) (*Listing, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Listing.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withListing sets the old Listing of the mutation.
+func withListing(node *Listing) listingOption {
+	return func(m *ListingMutation) {
+		m.oldValue = func(context.Context) (*Listing, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ListingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ListingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ListingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ListingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Listing.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetReference sets the "reference" field.
+func (m *ListingMutation) SetReference(u uuid.UUID) {
+	m.reference = &u
+}
+
+// Reference returns the value of the "reference" field in the mutation.
+func (m *ListingMutation) Reference() (r uuid.UUID, exists bool) {
+	v := m.reference
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReference returns the old "reference" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldReference(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReference is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReference requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReference: %w", err)
+	}
+	return oldValue.Reference, nil
+}
+
+// ResetReference resets all changes to the "reference" field.
+func (m *ListingMutation) ResetReference() {
+	m.reference = nil
+}
+
+// SetAttributes sets the "attributes" field.
+func (m *ListingMutation) SetAttributes(ma model.ListingAttributes) {
+	m.attributes = &ma
+}
+
+// Attributes returns the value of the "attributes" field in the mutation.
+func (m *ListingMutation) Attributes() (r model.ListingAttributes, exists bool) {
+	v := m.attributes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttributes returns the old "attributes" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldAttributes(ctx context.Context) (v model.ListingAttributes, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttributes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttributes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttributes: %w", err)
+	}
+	return oldValue.Attributes, nil
+}
+
+// ResetAttributes resets all changes to the "attributes" field.
+func (m *ListingMutation) ResetAttributes() {
+	m.attributes = nil
+}
+
+// SetTags sets the "tags" field.
+func (m *ListingMutation) SetTags(ma model.TextArray) {
+	m.tags = &ma
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *ListingMutation) Tags() (r model.TextArray, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldTags(ctx context.Context) (v model.TextArray, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *ListingMutation) ResetTags() {
+	m.tags = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *ListingMutation) SetPrice(f float64) {
+	m.price = &f
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *ListingMutation) Price() (r float64, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds f to the "price" field.
+func (m *ListingMutation) AddPrice(f float64) {
+	if m.addprice != nil {
+		*m.addprice += f
+	} else {
+		m.addprice = &f
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *ListingMutation) AddedPrice() (r float64, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *ListingMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+}
+
+// SetCover sets the "cover" field.
+func (m *ListingMutation) SetCover(b []byte) {
+	m.cover = &b
+}
+
+// Cover returns the value of the "cover" field in the mutation.
+func (m *ListingMutation) Cover() (r []byte, exists bool) {
+	v := m.cover
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCover returns the old "cover" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldCover(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCover is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCover requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCover: %w", err)
+	}
+	return oldValue.Cover, nil
+}
+
+// ResetCover resets all changes to the "cover" field.
+func (m *ListingMutation) ResetCover() {
+	m.cover = nil
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (m *ListingMutation) SetSubtitle(s string) {
+	m.subtitle = &s
+}
+
+// Subtitle returns the value of the "subtitle" field in the mutation.
+func (m *ListingMutation) Subtitle() (r string, exists bool) {
+	v := m.subtitle
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubtitle returns the old "subtitle" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldSubtitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtitle: %w", err)
+	}
+	return oldValue.Subtitle, nil
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (m *ListingMutation) ClearSubtitle() {
+	m.subtitle = nil
+	m.clearedFields[listing.FieldSubtitle] = struct{}{}
+}
+
+// SubtitleCleared returns if the "subtitle" field was cleared in this mutation.
+func (m *ListingMutation) SubtitleCleared() bool {
+	_, ok := m.clearedFields[listing.FieldSubtitle]
+	return ok
+}
+
+// ResetSubtitle resets all changes to the "subtitle" field.
+func (m *ListingMutation) ResetSubtitle() {
+	m.subtitle = nil
+	delete(m.clearedFields, listing.FieldSubtitle)
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (m *ListingMutation) SetDiscontinuedAt(t time.Time) {
+	m.discontinued_at = &t
+}
+
+// DiscontinuedAt returns the value of the "discontinued_at" field in the mutation.
+func (m *ListingMutation) DiscontinuedAt() (r time.Time, exists bool) {
+	v := m.discontinued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscontinuedAt returns the old "discontinued_at" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldDiscontinuedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscontinuedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscontinuedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscontinuedAt: %w", err)
+	}
+	return oldValue.DiscontinuedAt, nil
+}
+
+// ClearDiscontinuedAt clears the value of the "discontinued_at" field.
+func (m *ListingMutation) ClearDiscontinuedAt() {
+	m.discontinued_at = nil
+	m.clearedFields[listing.FieldDiscontinuedAt] = struct{}{}
+}
+
+// DiscontinuedAtCleared returns if the "discontinued_at" field was cleared in this mutation.
+func (m *ListingMutation) DiscontinuedAtCleared() bool {
+	_, ok := m.clearedFields[listing.FieldDiscontinuedAt]
+	return ok
+}
+
+// ResetDiscontinuedAt resets all changes to the "discontinued_at" field.
+func (m *ListingMutation) ResetDiscontinuedAt() {
+	m.discontinued_at = nil
+	delete(m.clearedFields, listing.FieldDiscontinuedAt)
+}
+
+// SetListedAt sets the "listed_at" field.
+func (m *ListingMutation) SetListedAt(t time.Time) {
+	m.listed_at = &t
+}
+
+// ListedAt returns the value of the "listed_at" field in the mutation.
+func (m *ListingMutation) ListedAt() (r time.Time, exists bool) {
+	v := m.listed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldListedAt returns the old "listed_at" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldListedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldListedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldListedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldListedAt: %w", err)
+	}
+	return oldValue.ListedAt, nil
+}
+
+// ResetListedAt resets all changes to the "listed_at" field.
+func (m *ListingMutation) ResetListedAt() {
+	m.listed_at = nil
+}
+
+// Where appends a list predicates to the ListingMutation builder.
+func (m *ListingMutation) Where(ps ...predicate.Listing) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ListingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ListingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Listing, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ListingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ListingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Listing).
+func (m *ListingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ListingMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.reference != nil {
+		fields = append(fields, listing.FieldReference)
+	}
+	if m.attributes != nil {
+		fields = append(fields, listing.FieldAttributes)
+	}
+	if m.tags != nil {
+		fields = append(fields, listing.FieldTags)
+	}
+	if m.price != nil {
+		fields = append(fields, listing.FieldPrice)
+	}
+	if m.cover != nil {
+		fields = append(fields, listing.FieldCover)
+	}
+	if m.subtitle != nil {
+		fields = append(fields, listing.FieldSubtitle)
+	}
+	if m.discontinued_at != nil {
+		fields = append(fields, listing.FieldDiscontinuedAt)
+	}
+	if m.listed_at != nil {
+		fields = append(fields, listing.FieldListedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ListingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case listing.FieldReference:
+		return m.Reference()
+	case listing.FieldAttributes:
+		return m.Attributes()
+	case listing.FieldTags:
+		return m.Tags()
+	case listing.FieldPrice:
+		return m.Price()
+	case listing.FieldCover:
+		return m.Cover()
+	case listing.FieldSubtitle:
+		return m.Subtitle()
+	case listing.FieldDiscontinuedAt:
+		return m.DiscontinuedAt()
+	case listing.FieldListedAt:
+		return m.ListedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ListingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case listing.FieldReference:
+		return m.OldReference(ctx)
+	case listing.FieldAttributes:
+		return m.OldAttributes(ctx)
+	case listing.FieldTags:
+		return m.OldTags(ctx)
+	case listing.FieldPrice:
+		return m.OldPrice(ctx)
+	case listing.FieldCover:
+		return m.OldCover(ctx)
+	case listing.FieldSubtitle:
+		return m.OldSubtitle(ctx)
+	case listing.FieldDiscontinuedAt:
+		return m.OldDiscontinuedAt(ctx)
+	case listing.FieldListedAt:
+		return m.OldListedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Listing field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case listing.FieldReference:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReference(v)
+		return nil
+	case listing.FieldAttributes:
+		v, ok := value.(model.ListingAttributes)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttributes(v)
+		return nil
+	case listing.FieldTags:
+		v, ok := value.(model.TextArray)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case listing.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case listing.FieldCover:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCover(v)
+		return nil
+	case listing.FieldSubtitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtitle(v)
+		return nil
+	case listing.FieldDiscontinuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscontinuedAt(v)
+		return nil
+	case listing.FieldListedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetListedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Listing field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ListingMutation) AddedFields() []string {
+	var fields []string
+	if m.addprice != nil {
+		fields = append(fields, listing.FieldPrice)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ListingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case listing.FieldPrice:
+		return m.AddedPrice()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case listing.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Listing numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ListingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(listing.FieldSubtitle) {
+		fields = append(fields, listing.FieldSubtitle)
+	}
+	if m.FieldCleared(listing.FieldDiscontinuedAt) {
+		fields = append(fields, listing.FieldDiscontinuedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ListingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ListingMutation) ClearField(name string) error {
+	switch name {
+	case listing.FieldSubtitle:
+		m.ClearSubtitle()
+		return nil
+	case listing.FieldDiscontinuedAt:
+		m.ClearDiscontinuedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ListingMutation) ResetField(name string) error {
+	switch name {
+	case listing.FieldReference:
+		m.ResetReference()
+		return nil
+	case listing.FieldAttributes:
+		m.ResetAttributes()
+		return nil
+	case listing.FieldTags:
+		m.ResetTags()
+		return nil
+	case listing.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case listing.FieldCover:
+		m.ResetCover()
+		return nil
+	case listing.FieldSubtitle:
+		m.ResetSubtitle()
+		return nil
+	case listing.FieldDiscontinuedAt:
+		m.ResetDiscontinuedAt()
+		return nil
+	case listing.FieldListedAt:
+		m.ResetListedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ListingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ListingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ListingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ListingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ListingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ListingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ListingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Listing unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ListingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Listing edge %s", name)
+}
+
+// PricePolicyMutation represents an operation that mutates the PricePolicy nodes in the graph.
+type PricePolicyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	price         *float64
+	addprice      *float64
+	start_date    *time.Time
+	end_date      *time.Time
+	clearedFields map[string]struct{}
+	book          *int
+	clearedbook   bool
+	done          bool
+	oldValue      func(context.Context) (*PricePolicy, error)
+	predicates    []predicate.PricePolicy
+}
+
+var _ ent.Mutation = (*PricePolicyMutation)(nil)
+
+// pricepolicyOption allows management of the mutation configuration using functional options.
+type pricepolicyOption func(*PricePolicyMutation)
+
+// newPricePolicyMutation creates new mutation for the PricePolicy entity.
+func newPricePolicyMutation(c config, op Op, opts ...pricepolicyOption) *PricePolicyMutation {
+	m := &PricePolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePricePolicy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPricePolicyID sets the ID field of the mutation.
+func withPricePolicyID(id int) pricepolicyOption {
+	return func(m *PricePolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PricePolicy
+		)
+		m.oldValue = func(ctx context.Context) (*PricePolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PricePolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPricePolicy sets the old PricePolicy of the mutation.
+func withPricePolicy(node *PricePolicy) pricepolicyOption {
+	return func(m *PricePolicyMutation) {
+		m.oldValue = func(context.Context) (*PricePolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PricePolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PricePolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PricePolicyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PricePolicyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PricePolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBookID sets the "book_id" field.
+func (m *PricePolicyMutation) SetBookID(i int) {
+	m.book = &i
+}
+
+// BookID returns the value of the "book_id" field in the mutation.
+func (m *PricePolicyMutation) BookID() (r int, exists bool) {
+	v := m.book
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBookID returns the old "book_id" field's value of the PricePolicy entity.
+// If the PricePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PricePolicyMutation) OldBookID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBookID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBookID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBookID: %w", err)
+	}
+	return oldValue.BookID, nil
+}
+
+// ResetBookID resets all changes to the "book_id" field.
+func (m *PricePolicyMutation) ResetBookID() {
+	m.book = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *PricePolicyMutation) SetPrice(f float64) {
+	m.price = &f
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *PricePolicyMutation) Price() (r float64, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the PricePolicy entity.
+// If the PricePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PricePolicyMutation) OldPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds f to the "price" field.
+func (m *PricePolicyMutation) AddPrice(f float64) {
+	if m.addprice != nil {
+		*m.addprice += f
+	} else {
+		m.addprice = &f
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *PricePolicyMutation) AddedPrice() (r float64, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *PricePolicyMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+}
+
+// SetStartDate sets the "start_date" field.
+func (m *PricePolicyMutation) SetStartDate(t time.Time) {
+	m.start_date = &t
+}
+
+// StartDate returns the value of the "start_date" field in the mutation.
+func (m *PricePolicyMutation) StartDate() (r time.Time, exists bool) {
+	v := m.start_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartDate returns the old "start_date" field's value of the PricePolicy entity.
+// If the PricePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PricePolicyMutation) OldStartDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+	}
+	return oldValue.StartDate, nil
+}
+
+// ResetStartDate resets all changes to the "start_date" field.
+func (m *PricePolicyMutation) ResetStartDate() {
+	m.start_date = nil
+}
+
+// SetEndDate sets the "end_date" field.
+func (m *PricePolicyMutation) SetEndDate(t time.Time) {
+	m.end_date = &t
+}
+
+// EndDate returns the value of the "end_date" field in the mutation.
+func (m *PricePolicyMutation) EndDate() (r time.Time, exists bool) {
+	v := m.end_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndDate returns the old "end_date" field's value of the PricePolicy entity.
+// If the PricePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PricePolicyMutation) OldEndDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+	}
+	return oldValue.EndDate, nil
+}
+
+// ResetEndDate resets all changes to the "end_date" field.
+func (m *PricePolicyMutation) ResetEndDate() {
+	m.end_date = nil
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (m *PricePolicyMutation) ClearBook() {
+	m.clearedbook = true
+	m.clearedFields[pricepolicy.FieldBookID] = struct{}{}
+}
+
+// BookCleared reports if the "book" edge to the Book entity was cleared.
+func (m *PricePolicyMutation) BookCleared() bool {
+	return m.clearedbook
+}
+
+// BookIDs returns the "book" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookID instead. It exists only for internal usage by the builders.
+func (m *PricePolicyMutation) BookIDs() (ids []int) {
+	if id := m.book; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBook resets all changes to the "book" edge.
+func (m *PricePolicyMutation) ResetBook() {
+	m.book = nil
+	m.clearedbook = false
+}
+
+// Where appends a list predicates to the PricePolicyMutation builder.
+func (m *PricePolicyMutation) Where(ps ...predicate.PricePolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PricePolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PricePolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PricePolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PricePolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PricePolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PricePolicy).
+func (m *PricePolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PricePolicyMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.book != nil {
+		fields = append(fields, pricepolicy.FieldBookID)
+	}
+	if m.price != nil {
+		fields = append(fields, pricepolicy.FieldPrice)
+	}
+	if m.start_date != nil {
+		fields = append(fields, pricepolicy.FieldStartDate)
+	}
+	if m.end_date != nil {
+		fields = append(fields, pricepolicy.FieldEndDate)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PricePolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pricepolicy.FieldBookID:
+		return m.BookID()
+	case pricepolicy.FieldPrice:
+		return m.Price()
+	case pricepolicy.FieldStartDate:
+		return m.StartDate()
+	case pricepolicy.FieldEndDate:
+		return m.EndDate()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PricePolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pricepolicy.FieldBookID:
+		return m.OldBookID(ctx)
+	case pricepolicy.FieldPrice:
+		return m.OldPrice(ctx)
+	case pricepolicy.FieldStartDate:
+		return m.OldStartDate(ctx)
+	case pricepolicy.FieldEndDate:
+		return m.OldEndDate(ctx)
+	}
+	return nil, fmt.Errorf("unknown PricePolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PricePolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pricepolicy.FieldBookID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBookID(v)
+		return nil
+	case pricepolicy.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case pricepolicy.FieldStartDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartDate(v)
+		return nil
+	case pricepolicy.FieldEndDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndDate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PricePolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PricePolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addprice != nil {
+		fields = append(fields, pricepolicy.FieldPrice)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PricePolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pricepolicy.FieldPrice:
+		return m.AddedPrice()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PricePolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pricepolicy.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PricePolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PricePolicyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PricePolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PricePolicyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PricePolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PricePolicyMutation) ResetField(name string) error {
+	switch name {
+	case pricepolicy.FieldBookID:
+		m.ResetBookID()
+		return nil
+	case pricepolicy.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case pricepolicy.FieldStartDate:
+		m.ResetStartDate()
+		return nil
+	case pricepolicy.FieldEndDate:
+		m.ResetEndDate()
+		return nil
+	}
+	return fmt.Errorf("unknown PricePolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PricePolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.book != nil {
+		edges = append(edges, pricepolicy.EdgeBook)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PricePolicyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pricepolicy.EdgeBook:
+		if id := m.book; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PricePolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PricePolicyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PricePolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbook {
+		edges = append(edges, pricepolicy.EdgeBook)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PricePolicyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pricepolicy.EdgeBook:
+		return m.clearedbook
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PricePolicyMutation) ClearEdge(name string) error {
+	switch name {
+	case pricepolicy.EdgeBook:
+		m.ClearBook()
+		return nil
+	}
+	return fmt.Errorf("unknown PricePolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PricePolicyMutation) ResetEdge(name string) error {
+	switch name {
+	case pricepolicy.EdgeBook:
+		m.ResetBook()
+		return nil
+	}
+	return fmt.Errorf("unknown PricePolicy edge %s", name)
+}
+
+// UUIDBookMutation represents an operation that mutates the UUIDBook nodes in the graph.
+type UUIDBookMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	isbn          *string
+	title         *string
+	author        *string
+	genre         *string
+	quantity      *int
+	addquantity   *int
+	publicized_at *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*UUIDBook, error)
+	predicates    []predicate.UUIDBook
+}
+
+var _ ent.Mutation = (*UUIDBookMutation)(nil)
+
+// uuidbookOption allows management of the mutation configuration using functional options.
+type uuidbookOption func(*UUIDBookMutation)
+
+// newUUIDBookMutation creates new mutation for the UUIDBook entity.
+func newUUIDBookMutation(c config, op Op, opts ...uuidbookOption) *UUIDBookMutation {
+	m := &UUIDBookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUUIDBook,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUUIDBookID sets the ID field of the mutation.
+func withUUIDBookID(id uuid.UUID) uuidbookOption {
+	return func(m *UUIDBookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UUIDBook
+		)
+		m.oldValue = func(ctx context.Context) (*UUIDBook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UUIDBook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUUIDBook sets the old UUIDBook of the mutation.
+func withUUIDBook(node *UUIDBook) uuidbookOption {
+	return func(m *UUIDBookMutation) {
+		m.oldValue = func(context.Context) (*UUIDBook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UUIDBookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UUIDBookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UUIDBook entities.
+func (m *UUIDBookMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UUIDBookMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UUIDBookMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UUIDBook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIsbn sets the "isbn" field.
+func (m *UUIDBookMutation) SetIsbn(s string) {
+	m.isbn = &s
+}
+
+// Isbn returns the value of the "isbn" field in the mutation.
+func (m *UUIDBookMutation) Isbn() (r string, exists bool) {
+	v := m.isbn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsbn returns the old "isbn" field's value of the UUIDBook entity.
+// If the UUIDBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UUIDBookMutation) OldIsbn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsbn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsbn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsbn: %w", err)
+	}
+	return oldValue.Isbn, nil
+}
+
+// ResetIsbn resets all changes to the "isbn" field.
+func (m *UUIDBookMutation) ResetIsbn() {
+	m.isbn = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *UUIDBookMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *UUIDBookMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the UUIDBook entity.
+// If the UUIDBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UUIDBookMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *UUIDBookMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *UUIDBookMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *UUIDBookMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the UUIDBook entity.
+// If the UUIDBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UUIDBookMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *UUIDBookMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetGenre sets the "genre" field.
+func (m *UUIDBookMutation) SetGenre(s string) {
+	m.genre = &s
+}
+
+// Genre returns the value of the "genre" field in the mutation.
+func (m *UUIDBookMutation) Genre() (r string, exists bool) {
+	v := m.genre
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenre returns the old "genre" field's value of the UUIDBook entity.
+// If the UUIDBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UUIDBookMutation) OldGenre(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenre is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenre requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenre: %w", err)
+	}
+	return oldValue.Genre, nil
+}
+
+// ResetGenre resets all changes to the "genre" field.
+func (m *UUIDBookMutation) ResetGenre() {
+	m.genre = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *UUIDBookMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *UUIDBookMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the UUIDBook entity.
+// If the UUIDBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UUIDBookMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *UUIDBookMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *UUIDBookMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *UUIDBookMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (m *UUIDBookMutation) SetPublicizedAt(t time.Time) {
+	m.publicized_at = &t
+}
+
+// PublicizedAt returns the value of the "publicized_at" field in the mutation.
+func (m *UUIDBookMutation) PublicizedAt() (r time.Time, exists bool) {
+	v := m.publicized_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicizedAt returns the old "publicized_at" field's value of the UUIDBook entity.
+// If the UUIDBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UUIDBookMutation) OldPublicizedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicizedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicizedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicizedAt: %w", err)
+	}
+	return oldValue.PublicizedAt, nil
+}
+
+// ResetPublicizedAt resets all changes to the "publicized_at" field.
+func (m *UUIDBookMutation) ResetPublicizedAt() {
+	m.publicized_at = nil
+}
+
+// Where appends a list predicates to the UUIDBookMutation builder.
+func (m *UUIDBookMutation) Where(ps ...predicate.UUIDBook) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UUIDBookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UUIDBookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UUIDBook, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UUIDBookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UUIDBookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UUIDBook).
+func (m *UUIDBookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UUIDBookMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.isbn != nil {
+		fields = append(fields, uuidbook.FieldIsbn)
+	}
+	if m.title != nil {
+		fields = append(fields, uuidbook.FieldTitle)
+	}
+	if m.author != nil {
+		fields = append(fields, uuidbook.FieldAuthor)
+	}
+	if m.genre != nil {
+		fields = append(fields, uuidbook.FieldGenre)
+	}
+	if m.quantity != nil {
+		fields = append(fields, uuidbook.FieldQuantity)
+	}
+	if m.publicized_at != nil {
+		fields = append(fields, uuidbook.FieldPublicizedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UUIDBookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case uuidbook.FieldIsbn:
+		return m.Isbn()
+	case uuidbook.FieldTitle:
+		return m.Title()
+	case uuidbook.FieldAuthor:
+		return m.Author()
+	case uuidbook.FieldGenre:
+		return m.Genre()
+	case uuidbook.FieldQuantity:
+		return m.Quantity()
+	case uuidbook.FieldPublicizedAt:
+		return m.PublicizedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UUIDBookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case uuidbook.FieldIsbn:
+		return m.OldIsbn(ctx)
+	case uuidbook.FieldTitle:
+		return m.OldTitle(ctx)
+	case uuidbook.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case uuidbook.FieldGenre:
+		return m.OldGenre(ctx)
+	case uuidbook.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case uuidbook.FieldPublicizedAt:
+		return m.OldPublicizedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UUIDBook field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UUIDBookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case uuidbook.FieldIsbn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsbn(v)
+		return nil
+	case uuidbook.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case uuidbook.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case uuidbook.FieldGenre:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenre(v)
+		return nil
+	case uuidbook.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case uuidbook.FieldPublicizedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicizedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UUIDBook field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UUIDBookMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, uuidbook.FieldQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UUIDBookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case uuidbook.FieldQuantity:
+		return m.AddedQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UUIDBookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case uuidbook.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UUIDBook numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UUIDBookMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UUIDBookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UUIDBookMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UUIDBook nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UUIDBookMutation) ResetField(name string) error {
+	switch name {
+	case uuidbook.FieldIsbn:
+		m.ResetIsbn()
+		return nil
+	case uuidbook.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case uuidbook.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case uuidbook.FieldGenre:
+		m.ResetGenre()
+		return nil
+	case uuidbook.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case uuidbook.FieldPublicizedAt:
+		m.ResetPublicizedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UUIDBook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UUIDBookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UUIDBookMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UUIDBookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UUIDBookMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UUIDBookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UUIDBookMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UUIDBookMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UUIDBook unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UUIDBookMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UUIDBook edge %s", name)
+}
+
+// WideMutation represents an operation that mutates the Wide nodes in the graph.
+type WideMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	column001     *string
+	column002     *int
+	addcolumn002  *int
+	column003     *float64
+	addcolumn003  *float64
+	column004     *time.Time
+	column005     *string
+	column006     *int
+	addcolumn006  *int
+	column007     *float64
+	addcolumn007  *float64
+	column008     *time.Time
+	column009     *string
+	column010     *int
+	addcolumn010  *int
+	column011     *float64
+	addcolumn011  *float64
+	column012     *time.Time
+	column013     *string
+	column014     *int
+	addcolumn014  *int
+	column015     *float64
+	addcolumn015  *float64
+	column016     *time.Time
+	column017     *string
+	column018     *int
+	addcolumn018  *int
+	column019     *float64
+	addcolumn019  *float64
+	column020     *time.Time
+	column021     *string
+	column022     *int
+	addcolumn022  *int
+	column023     *float64
+	addcolumn023  *float64
+	column024     *time.Time
+	column025     *string
+	column026     *int
+	addcolumn026  *int
+	column027     *float64
+	addcolumn027  *float64
+	column028     *time.Time
+	column029     *string
+	column030     *int
+	addcolumn030  *int
+	column031     *float64
+	addcolumn031  *float64
+	column032     *time.Time
+	column033     *string
+	column034     *int
+	addcolumn034  *int
+	column035     *float64
+	addcolumn035  *float64
+	column036     *time.Time
+	column037     *string
+	column038     *int
+	addcolumn038  *int
+	column039     *float64
+	addcolumn039  *float64
+	column040     *time.Time
+	column041     *string
+	column042     *int
+	addcolumn042  *int
+	column043     *float64
+	addcolumn043  *float64
+	column044     *time.Time
+	column045     *string
+	column046     *int
+	addcolumn046  *int
+	column047     *float64
+	addcolumn047  *float64
+	column048     *time.Time
+	column049     *string
+	column050     *int
+	addcolumn050  *int
+	column051     *float64
+	addcolumn051  *float64
+	column052     *time.Time
+	column053     *string
+	column054     *int
+	addcolumn054  *int
+	column055     *float64
+	addcolumn055  *float64
+	column056     *time.Time
+	column057     *string
+	column058     *int
+	addcolumn058  *int
+	column059     *float64
+	addcolumn059  *float64
+	column060     *time.Time
+	column061     *string
+	column062     *int
+	addcolumn062  *int
+	column063     *float64
+	addcolumn063  *float64
+	column064     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Wide, error)
+	predicates    []predicate.Wide
+}
+
+var _ ent.Mutation = (*WideMutation)(nil)
+
+// wideOption allows management of the mutation configuration using functional options.
+type wideOption func(*WideMutation)
+
+// newWideMutation creates new mutation for the Wide entity.
+func newWideMutation(c config, op Op, opts ...wideOption) *WideMutation {
+	m := &WideMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWide,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWideID sets the ID field of the mutation.
+func withWideID(id int) wideOption {
+	return func(m *WideMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Wide
+		)
+		m.oldValue = func(ctx context.Context) (*Wide, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Wide.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWide sets the old Wide of the mutation.
+func withWide(node *Wide) wideOption {
+	return func(m *WideMutation) {
+		m.oldValue = func(context.Context) (*Wide, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WideMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WideMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WideMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WideMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Wide.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetColumn001 sets the "column001" field.
+func (m *WideMutation) SetColumn001(s string) {
+	m.column001 = &s
+}
+
+// Column001 returns the value of the "column001" field in the mutation.
+func (m *WideMutation) Column001() (r string, exists bool) {
+	v := m.column001
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn001 returns the old "column001" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn001(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn001 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn001 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn001: %w", err)
+	}
+	return oldValue.Column001, nil
+}
+
+// ResetColumn001 resets all changes to the "column001" field.
+func (m *WideMutation) ResetColumn001() {
+	m.column001 = nil
+}
+
+// SetColumn002 sets the "column002" field.
+func (m *WideMutation) SetColumn002(i int) {
+	m.column002 = &i
+	m.addcolumn002 = nil
+}
+
+// Column002 returns the value of the "column002" field in the mutation.
+func (m *WideMutation) Column002() (r int, exists bool) {
+	v := m.column002
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn002 returns the old "column002" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn002(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn002 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn002 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn002: %w", err)
+	}
+	return oldValue.Column002, nil
+}
+
+// AddColumn002 adds i to the "column002" field.
+func (m *WideMutation) AddColumn002(i int) {
+	if m.addcolumn002 != nil {
+		*m.addcolumn002 += i
+	} else {
+		m.addcolumn002 = &i
+	}
+}
+
+// AddedColumn002 returns the value that was added to the "column002" field in this mutation.
+func (m *WideMutation) AddedColumn002() (r int, exists bool) {
+	v := m.addcolumn002
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn002 resets all changes to the "column002" field.
+func (m *WideMutation) ResetColumn002() {
+	m.column002 = nil
+	m.addcolumn002 = nil
+}
+
+// SetColumn003 sets the "column003" field.
+func (m *WideMutation) SetColumn003(f float64) {
+	m.column003 = &f
+	m.addcolumn003 = nil
+}
+
+// Column003 returns the value of the "column003" field in the mutation.
+func (m *WideMutation) Column003() (r float64, exists bool) {
+	v := m.column003
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn003 returns the old "column003" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn003(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn003 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn003 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn003: %w", err)
+	}
+	return oldValue.Column003, nil
+}
+
+// AddColumn003 adds f to the "column003" field.
+func (m *WideMutation) AddColumn003(f float64) {
+	if m.addcolumn003 != nil {
+		*m.addcolumn003 += f
+	} else {
+		m.addcolumn003 = &f
+	}
+}
+
+// AddedColumn003 returns the value that was added to the "column003" field in this mutation.
+func (m *WideMutation) AddedColumn003() (r float64, exists bool) {
+	v := m.addcolumn003
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn003 resets all changes to the "column003" field.
+func (m *WideMutation) ResetColumn003() {
+	m.column003 = nil
+	m.addcolumn003 = nil
+}
+
+// SetColumn004 sets the "column004" field.
+func (m *WideMutation) SetColumn004(t time.Time) {
+	m.column004 = &t
+}
+
+// Column004 returns the value of the "column004" field in the mutation.
+func (m *WideMutation) Column004() (r time.Time, exists bool) {
+	v := m.column004
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn004 returns the old "column004" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn004(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn004 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn004 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn004: %w", err)
+	}
+	return oldValue.Column004, nil
+}
+
+// ResetColumn004 resets all changes to the "column004" field.
+func (m *WideMutation) ResetColumn004() {
+	m.column004 = nil
+}
+
+// SetColumn005 sets the "column005" field.
+func (m *WideMutation) SetColumn005(s string) {
+	m.column005 = &s
+}
+
+// Column005 returns the value of the "column005" field in the mutation.
+func (m *WideMutation) Column005() (r string, exists bool) {
+	v := m.column005
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn005 returns the old "column005" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn005(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn005 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn005 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn005: %w", err)
+	}
+	return oldValue.Column005, nil
+}
+
+// ResetColumn005 resets all changes to the "column005" field.
+func (m *WideMutation) ResetColumn005() {
+	m.column005 = nil
+}
+
+// SetColumn006 sets the "column006" field.
+func (m *WideMutation) SetColumn006(i int) {
+	m.column006 = &i
+	m.addcolumn006 = nil
+}
+
+// Column006 returns the value of the "column006" field in the mutation.
+func (m *WideMutation) Column006() (r int, exists bool) {
+	v := m.column006
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn006 returns the old "column006" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn006(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn006 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn006 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn006: %w", err)
+	}
+	return oldValue.Column006, nil
+}
+
+// AddColumn006 adds i to the "column006" field.
+func (m *WideMutation) AddColumn006(i int) {
+	if m.addcolumn006 != nil {
+		*m.addcolumn006 += i
+	} else {
+		m.addcolumn006 = &i
+	}
+}
+
+// AddedColumn006 returns the value that was added to the "column006" field in this mutation.
+func (m *WideMutation) AddedColumn006() (r int, exists bool) {
+	v := m.addcolumn006
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn006 resets all changes to the "column006" field.
+func (m *WideMutation) ResetColumn006() {
+	m.column006 = nil
+	m.addcolumn006 = nil
+}
+
+// SetColumn007 sets the "column007" field.
+func (m *WideMutation) SetColumn007(f float64) {
+	m.column007 = &f
+	m.addcolumn007 = nil
+}
+
+// Column007 returns the value of the "column007" field in the mutation.
+func (m *WideMutation) Column007() (r float64, exists bool) {
+	v := m.column007
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn007 returns the old "column007" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn007(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn007 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn007 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn007: %w", err)
+	}
+	return oldValue.Column007, nil
+}
+
+// AddColumn007 adds f to the "column007" field.
+func (m *WideMutation) AddColumn007(f float64) {
+	if m.addcolumn007 != nil {
+		*m.addcolumn007 += f
+	} else {
+		m.addcolumn007 = &f
+	}
+}
+
+// AddedColumn007 returns the value that was added to the "column007" field in this mutation.
+func (m *WideMutation) AddedColumn007() (r float64, exists bool) {
+	v := m.addcolumn007
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn007 resets all changes to the "column007" field.
+func (m *WideMutation) ResetColumn007() {
+	m.column007 = nil
+	m.addcolumn007 = nil
+}
+
+// SetColumn008 sets the "column008" field.
+func (m *WideMutation) SetColumn008(t time.Time) {
+	m.column008 = &t
+}
+
+// Column008 returns the value of the "column008" field in the mutation.
+func (m *WideMutation) Column008() (r time.Time, exists bool) {
+	v := m.column008
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn008 returns the old "column008" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn008(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn008 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn008 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn008: %w", err)
+	}
+	return oldValue.Column008, nil
+}
+
+// ResetColumn008 resets all changes to the "column008" field.
+func (m *WideMutation) ResetColumn008() {
+	m.column008 = nil
+}
+
+// SetColumn009 sets the "column009" field.
+func (m *WideMutation) SetColumn009(s string) {
+	m.column009 = &s
+}
+
+// Column009 returns the value of the "column009" field in the mutation.
+func (m *WideMutation) Column009() (r string, exists bool) {
+	v := m.column009
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn009 returns the old "column009" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn009(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn009 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn009 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn009: %w", err)
+	}
+	return oldValue.Column009, nil
+}
+
+// ResetColumn009 resets all changes to the "column009" field.
+func (m *WideMutation) ResetColumn009() {
+	m.column009 = nil
+}
+
+// SetColumn010 sets the "column010" field.
+func (m *WideMutation) SetColumn010(i int) {
+	m.column010 = &i
+	m.addcolumn010 = nil
+}
+
+// Column010 returns the value of the "column010" field in the mutation.
+func (m *WideMutation) Column010() (r int, exists bool) {
+	v := m.column010
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn010 returns the old "column010" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn010(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn010 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn010 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn010: %w", err)
+	}
+	return oldValue.Column010, nil
+}
+
+// AddColumn010 adds i to the "column010" field.
+func (m *WideMutation) AddColumn010(i int) {
+	if m.addcolumn010 != nil {
+		*m.addcolumn010 += i
+	} else {
+		m.addcolumn010 = &i
+	}
+}
+
+// AddedColumn010 returns the value that was added to the "column010" field in this mutation.
+func (m *WideMutation) AddedColumn010() (r int, exists bool) {
+	v := m.addcolumn010
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn010 resets all changes to the "column010" field.
+func (m *WideMutation) ResetColumn010() {
+	m.column010 = nil
+	m.addcolumn010 = nil
+}
+
+// SetColumn011 sets the "column011" field.
+func (m *WideMutation) SetColumn011(f float64) {
+	m.column011 = &f
+	m.addcolumn011 = nil
+}
+
+// Column011 returns the value of the "column011" field in the mutation.
+func (m *WideMutation) Column011() (r float64, exists bool) {
+	v := m.column011
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn011 returns the old "column011" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn011(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn011 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn011 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn011: %w", err)
+	}
+	return oldValue.Column011, nil
+}
+
+// AddColumn011 adds f to the "column011" field.
+func (m *WideMutation) AddColumn011(f float64) {
+	if m.addcolumn011 != nil {
+		*m.addcolumn011 += f
+	} else {
+		m.addcolumn011 = &f
+	}
+}
+
+// AddedColumn011 returns the value that was added to the "column011" field in this mutation.
+func (m *WideMutation) AddedColumn011() (r float64, exists bool) {
+	v := m.addcolumn011
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn011 resets all changes to the "column011" field.
+func (m *WideMutation) ResetColumn011() {
+	m.column011 = nil
+	m.addcolumn011 = nil
+}
+
+// SetColumn012 sets the "column012" field.
+func (m *WideMutation) SetColumn012(t time.Time) {
+	m.column012 = &t
+}
+
+// Column012 returns the value of the "column012" field in the mutation.
+func (m *WideMutation) Column012() (r time.Time, exists bool) {
+	v := m.column012
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn012 returns the old "column012" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn012(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn012 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn012 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn012: %w", err)
+	}
+	return oldValue.Column012, nil
+}
+
+// ResetColumn012 resets all changes to the "column012" field.
+func (m *WideMutation) ResetColumn012() {
+	m.column012 = nil
+}
+
+// SetColumn013 sets the "column013" field.
+func (m *WideMutation) SetColumn013(s string) {
+	m.column013 = &s
+}
+
+// Column013 returns the value of the "column013" field in the mutation.
+func (m *WideMutation) Column013() (r string, exists bool) {
+	v := m.column013
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn013 returns the old "column013" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn013(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn013 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn013 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn013: %w", err)
+	}
+	return oldValue.Column013, nil
+}
+
+// ResetColumn013 resets all changes to the "column013" field.
+func (m *WideMutation) ResetColumn013() {
+	m.column013 = nil
+}
+
+// SetColumn014 sets the "column014" field.
+func (m *WideMutation) SetColumn014(i int) {
+	m.column014 = &i
+	m.addcolumn014 = nil
+}
+
+// Column014 returns the value of the "column014" field in the mutation.
+func (m *WideMutation) Column014() (r int, exists bool) {
+	v := m.column014
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn014 returns the old "column014" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn014(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn014 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn014 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn014: %w", err)
+	}
+	return oldValue.Column014, nil
+}
+
+// AddColumn014 adds i to the "column014" field.
+func (m *WideMutation) AddColumn014(i int) {
+	if m.addcolumn014 != nil {
+		*m.addcolumn014 += i
+	} else {
+		m.addcolumn014 = &i
+	}
+}
+
+// AddedColumn014 returns the value that was added to the "column014" field in this mutation.
+func (m *WideMutation) AddedColumn014() (r int, exists bool) {
+	v := m.addcolumn014
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn014 resets all changes to the "column014" field.
+func (m *WideMutation) ResetColumn014() {
+	m.column014 = nil
+	m.addcolumn014 = nil
+}
+
+// SetColumn015 sets the "column015" field.
+func (m *WideMutation) SetColumn015(f float64) {
+	m.column015 = &f
+	m.addcolumn015 = nil
+}
+
+// Column015 returns the value of the "column015" field in the mutation.
+func (m *WideMutation) Column015() (r float64, exists bool) {
+	v := m.column015
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn015 returns the old "column015" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn015(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn015 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn015 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn015: %w", err)
+	}
+	return oldValue.Column015, nil
+}
+
+// AddColumn015 adds f to the "column015" field.
+func (m *WideMutation) AddColumn015(f float64) {
+	if m.addcolumn015 != nil {
+		*m.addcolumn015 += f
+	} else {
+		m.addcolumn015 = &f
+	}
+}
+
+// AddedColumn015 returns the value that was added to the "column015" field in this mutation.
+func (m *WideMutation) AddedColumn015() (r float64, exists bool) {
+	v := m.addcolumn015
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn015 resets all changes to the "column015" field.
+func (m *WideMutation) ResetColumn015() {
+	m.column015 = nil
+	m.addcolumn015 = nil
+}
+
+// SetColumn016 sets the "column016" field.
+func (m *WideMutation) SetColumn016(t time.Time) {
+	m.column016 = &t
+}
+
+// Column016 returns the value of the "column016" field in the mutation.
+func (m *WideMutation) Column016() (r time.Time, exists bool) {
+	v := m.column016
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn016 returns the old "column016" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn016(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn016 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn016 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn016: %w", err)
+	}
+	return oldValue.Column016, nil
+}
+
+// ResetColumn016 resets all changes to the "column016" field.
+func (m *WideMutation) ResetColumn016() {
+	m.column016 = nil
+}
+
+// SetColumn017 sets the "column017" field.
+func (m *WideMutation) SetColumn017(s string) {
+	m.column017 = &s
+}
+
+// Column017 returns the value of the "column017" field in the mutation.
+func (m *WideMutation) Column017() (r string, exists bool) {
+	v := m.column017
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn017 returns the old "column017" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn017(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn017 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn017 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn017: %w", err)
+	}
+	return oldValue.Column017, nil
+}
+
+// ResetColumn017 resets all changes to the "column017" field.
+func (m *WideMutation) ResetColumn017() {
+	m.column017 = nil
+}
+
+// SetColumn018 sets the "column018" field.
+func (m *WideMutation) SetColumn018(i int) {
+	m.column018 = &i
+	m.addcolumn018 = nil
+}
+
+// Column018 returns the value of the "column018" field in the mutation.
+func (m *WideMutation) Column018() (r int, exists bool) {
+	v := m.column018
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn018 returns the old "column018" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn018(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn018 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn018 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn018: %w", err)
+	}
+	return oldValue.Column018, nil
+}
+
+// AddColumn018 adds i to the "column018" field.
+func (m *WideMutation) AddColumn018(i int) {
+	if m.addcolumn018 != nil {
+		*m.addcolumn018 += i
+	} else {
+		m.addcolumn018 = &i
+	}
+}
+
+// AddedColumn018 returns the value that was added to the "column018" field in this mutation.
+func (m *WideMutation) AddedColumn018() (r int, exists bool) {
+	v := m.addcolumn018
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn018 resets all changes to the "column018" field.
+func (m *WideMutation) ResetColumn018() {
+	m.column018 = nil
+	m.addcolumn018 = nil
+}
+
+// SetColumn019 sets the "column019" field.
+func (m *WideMutation) SetColumn019(f float64) {
+	m.column019 = &f
+	m.addcolumn019 = nil
+}
+
+// Column019 returns the value of the "column019" field in the mutation.
+func (m *WideMutation) Column019() (r float64, exists bool) {
+	v := m.column019
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn019 returns the old "column019" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn019(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn019 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn019 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn019: %w", err)
+	}
+	return oldValue.Column019, nil
+}
+
+// AddColumn019 adds f to the "column019" field.
+func (m *WideMutation) AddColumn019(f float64) {
+	if m.addcolumn019 != nil {
+		*m.addcolumn019 += f
+	} else {
+		m.addcolumn019 = &f
+	}
+}
+
+// AddedColumn019 returns the value that was added to the "column019" field in this mutation.
+func (m *WideMutation) AddedColumn019() (r float64, exists bool) {
+	v := m.addcolumn019
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn019 resets all changes to the "column019" field.
+func (m *WideMutation) ResetColumn019() {
+	m.column019 = nil
+	m.addcolumn019 = nil
+}
+
+// SetColumn020 sets the "column020" field.
+func (m *WideMutation) SetColumn020(t time.Time) {
+	m.column020 = &t
+}
+
+// Column020 returns the value of the "column020" field in the mutation.
+func (m *WideMutation) Column020() (r time.Time, exists bool) {
+	v := m.column020
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn020 returns the old "column020" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn020(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn020 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn020 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn020: %w", err)
+	}
+	return oldValue.Column020, nil
+}
+
+// ResetColumn020 resets all changes to the "column020" field.
+func (m *WideMutation) ResetColumn020() {
+	m.column020 = nil
+}
+
+// SetColumn021 sets the "column021" field.
+func (m *WideMutation) SetColumn021(s string) {
+	m.column021 = &s
+}
+
+// Column021 returns the value of the "column021" field in the mutation.
+func (m *WideMutation) Column021() (r string, exists bool) {
+	v := m.column021
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn021 returns the old "column021" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn021(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn021 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn021 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn021: %w", err)
+	}
+	return oldValue.Column021, nil
+}
+
+// ResetColumn021 resets all changes to the "column021" field.
+func (m *WideMutation) ResetColumn021() {
+	m.column021 = nil
+}
+
+// SetColumn022 sets the "column022" field.
+func (m *WideMutation) SetColumn022(i int) {
+	m.column022 = &i
+	m.addcolumn022 = nil
+}
+
+// Column022 returns the value of the "column022" field in the mutation.
+func (m *WideMutation) Column022() (r int, exists bool) {
+	v := m.column022
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn022 returns the old "column022" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn022(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn022 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn022 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn022: %w", err)
+	}
+	return oldValue.Column022, nil
+}
+
+// AddColumn022 adds i to the "column022" field.
+func (m *WideMutation) AddColumn022(i int) {
+	if m.addcolumn022 != nil {
+		*m.addcolumn022 += i
+	} else {
+		m.addcolumn022 = &i
+	}
+}
+
+// AddedColumn022 returns the value that was added to the "column022" field in this mutation.
+func (m *WideMutation) AddedColumn022() (r int, exists bool) {
+	v := m.addcolumn022
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn022 resets all changes to the "column022" field.
+func (m *WideMutation) ResetColumn022() {
+	m.column022 = nil
+	m.addcolumn022 = nil
+}
+
+// SetColumn023 sets the "column023" field.
+func (m *WideMutation) SetColumn023(f float64) {
+	m.column023 = &f
+	m.addcolumn023 = nil
+}
+
+// Column023 returns the value of the "column023" field in the mutation.
+func (m *WideMutation) Column023() (r float64, exists bool) {
+	v := m.column023
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn023 returns the old "column023" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn023(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn023 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn023 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn023: %w", err)
+	}
+	return oldValue.Column023, nil
+}
+
+// AddColumn023 adds f to the "column023" field.
+func (m *WideMutation) AddColumn023(f float64) {
+	if m.addcolumn023 != nil {
+		*m.addcolumn023 += f
+	} else {
+		m.addcolumn023 = &f
+	}
+}
+
+// AddedColumn023 returns the value that was added to the "column023" field in this mutation.
+func (m *WideMutation) AddedColumn023() (r float64, exists bool) {
+	v := m.addcolumn023
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn023 resets all changes to the "column023" field.
+func (m *WideMutation) ResetColumn023() {
+	m.column023 = nil
+	m.addcolumn023 = nil
+}
+
+// SetColumn024 sets the "column024" field.
+func (m *WideMutation) SetColumn024(t time.Time) {
+	m.column024 = &t
+}
+
+// Column024 returns the value of the "column024" field in the mutation.
+func (m *WideMutation) Column024() (r time.Time, exists bool) {
+	v := m.column024
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn024 returns the old "column024" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn024(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn024 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn024 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn024: %w", err)
+	}
+	return oldValue.Column024, nil
+}
+
+// ResetColumn024 resets all changes to the "column024" field.
+func (m *WideMutation) ResetColumn024() {
+	m.column024 = nil
+}
+
+// SetColumn025 sets the "column025" field.
+func (m *WideMutation) SetColumn025(s string) {
+	m.column025 = &s
+}
+
+// Column025 returns the value of the "column025" field in the mutation.
+func (m *WideMutation) Column025() (r string, exists bool) {
+	v := m.column025
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn025 returns the old "column025" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn025(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn025 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn025 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn025: %w", err)
+	}
+	return oldValue.Column025, nil
+}
+
+// ResetColumn025 resets all changes to the "column025" field.
+func (m *WideMutation) ResetColumn025() {
+	m.column025 = nil
+}
+
+// SetColumn026 sets the "column026" field.
+func (m *WideMutation) SetColumn026(i int) {
+	m.column026 = &i
+	m.addcolumn026 = nil
+}
+
+// Column026 returns the value of the "column026" field in the mutation.
+func (m *WideMutation) Column026() (r int, exists bool) {
+	v := m.column026
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn026 returns the old "column026" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn026(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn026 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn026 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn026: %w", err)
+	}
+	return oldValue.Column026, nil
+}
+
+// AddColumn026 adds i to the "column026" field.
+func (m *WideMutation) AddColumn026(i int) {
+	if m.addcolumn026 != nil {
+		*m.addcolumn026 += i
+	} else {
+		m.addcolumn026 = &i
+	}
+}
+
+// AddedColumn026 returns the value that was added to the "column026" field in this mutation.
+func (m *WideMutation) AddedColumn026() (r int, exists bool) {
+	v := m.addcolumn026
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn026 resets all changes to the "column026" field.
+func (m *WideMutation) ResetColumn026() {
+	m.column026 = nil
+	m.addcolumn026 = nil
+}
+
+// SetColumn027 sets the "column027" field.
+func (m *WideMutation) SetColumn027(f float64) {
+	m.column027 = &f
+	m.addcolumn027 = nil
+}
+
+// Column027 returns the value of the "column027" field in the mutation.
+func (m *WideMutation) Column027() (r float64, exists bool) {
+	v := m.column027
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn027 returns the old "column027" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn027(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn027 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn027 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn027: %w", err)
+	}
+	return oldValue.Column027, nil
+}
+
+// AddColumn027 adds f to the "column027" field.
+func (m *WideMutation) AddColumn027(f float64) {
+	if m.addcolumn027 != nil {
+		*m.addcolumn027 += f
+	} else {
+		m.addcolumn027 = &f
+	}
+}
+
+// AddedColumn027 returns the value that was added to the "column027" field in this mutation.
+func (m *WideMutation) AddedColumn027() (r float64, exists bool) {
+	v := m.addcolumn027
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn027 resets all changes to the "column027" field.
+func (m *WideMutation) ResetColumn027() {
+	m.column027 = nil
+	m.addcolumn027 = nil
+}
+
+// SetColumn028 sets the "column028" field.
+func (m *WideMutation) SetColumn028(t time.Time) {
+	m.column028 = &t
+}
+
+// Column028 returns the value of the "column028" field in the mutation.
+func (m *WideMutation) Column028() (r time.Time, exists bool) {
+	v := m.column028
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn028 returns the old "column028" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn028(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn028 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn028 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn028: %w", err)
+	}
+	return oldValue.Column028, nil
+}
+
+// ResetColumn028 resets all changes to the "column028" field.
+func (m *WideMutation) ResetColumn028() {
+	m.column028 = nil
+}
+
+// SetColumn029 sets the "column029" field.
+func (m *WideMutation) SetColumn029(s string) {
+	m.column029 = &s
+}
+
+// Column029 returns the value of the "column029" field in the mutation.
+func (m *WideMutation) Column029() (r string, exists bool) {
+	v := m.column029
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn029 returns the old "column029" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn029(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn029 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn029 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn029: %w", err)
+	}
+	return oldValue.Column029, nil
+}
+
+// ResetColumn029 resets all changes to the "column029" field.
+func (m *WideMutation) ResetColumn029() {
+	m.column029 = nil
+}
+
+// SetColumn030 sets the "column030" field.
+func (m *WideMutation) SetColumn030(i int) {
+	m.column030 = &i
+	m.addcolumn030 = nil
+}
+
+// Column030 returns the value of the "column030" field in the mutation.
+func (m *WideMutation) Column030() (r int, exists bool) {
+	v := m.column030
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn030 returns the old "column030" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn030(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn030 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn030 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn030: %w", err)
+	}
+	return oldValue.Column030, nil
+}
+
+// AddColumn030 adds i to the "column030" field.
+func (m *WideMutation) AddColumn030(i int) {
+	if m.addcolumn030 != nil {
+		*m.addcolumn030 += i
+	} else {
+		m.addcolumn030 = &i
+	}
+}
+
+// AddedColumn030 returns the value that was added to the "column030" field in this mutation.
+func (m *WideMutation) AddedColumn030() (r int, exists bool) {
+	v := m.addcolumn030
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn030 resets all changes to the "column030" field.
+func (m *WideMutation) ResetColumn030() {
+	m.column030 = nil
+	m.addcolumn030 = nil
+}
+
+// SetColumn031 sets the "column031" field.
+func (m *WideMutation) SetColumn031(f float64) {
+	m.column031 = &f
+	m.addcolumn031 = nil
+}
+
+// Column031 returns the value of the "column031" field in the mutation.
+func (m *WideMutation) Column031() (r float64, exists bool) {
+	v := m.column031
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn031 returns the old "column031" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn031(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn031 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn031 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn031: %w", err)
+	}
+	return oldValue.Column031, nil
+}
+
+// AddColumn031 adds f to the "column031" field.
+func (m *WideMutation) AddColumn031(f float64) {
+	if m.addcolumn031 != nil {
+		*m.addcolumn031 += f
+	} else {
+		m.addcolumn031 = &f
+	}
+}
+
+// AddedColumn031 returns the value that was added to the "column031" field in this mutation.
+func (m *WideMutation) AddedColumn031() (r float64, exists bool) {
+	v := m.addcolumn031
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn031 resets all changes to the "column031" field.
+func (m *WideMutation) ResetColumn031() {
+	m.column031 = nil
+	m.addcolumn031 = nil
+}
+
+// SetColumn032 sets the "column032" field.
+func (m *WideMutation) SetColumn032(t time.Time) {
+	m.column032 = &t
+}
+
+// Column032 returns the value of the "column032" field in the mutation.
+func (m *WideMutation) Column032() (r time.Time, exists bool) {
+	v := m.column032
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn032 returns the old "column032" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn032(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn032 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn032 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn032: %w", err)
+	}
+	return oldValue.Column032, nil
+}
+
+// ResetColumn032 resets all changes to the "column032" field.
+func (m *WideMutation) ResetColumn032() {
+	m.column032 = nil
+}
+
+// SetColumn033 sets the "column033" field.
+func (m *WideMutation) SetColumn033(s string) {
+	m.column033 = &s
+}
+
+// Column033 returns the value of the "column033" field in the mutation.
+func (m *WideMutation) Column033() (r string, exists bool) {
+	v := m.column033
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn033 returns the old "column033" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn033(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn033 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn033 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn033: %w", err)
+	}
+	return oldValue.Column033, nil
+}
+
+// ResetColumn033 resets all changes to the "column033" field.
+func (m *WideMutation) ResetColumn033() {
+	m.column033 = nil
+}
+
+// SetColumn034 sets the "column034" field.
+func (m *WideMutation) SetColumn034(i int) {
+	m.column034 = &i
+	m.addcolumn034 = nil
+}
+
+// Column034 returns the value of the "column034" field in the mutation.
+func (m *WideMutation) Column034() (r int, exists bool) {
+	v := m.column034
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn034 returns the old "column034" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn034(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn034 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn034 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn034: %w", err)
+	}
+	return oldValue.Column034, nil
+}
+
+// AddColumn034 adds i to the "column034" field.
+func (m *WideMutation) AddColumn034(i int) {
+	if m.addcolumn034 != nil {
+		*m.addcolumn034 += i
+	} else {
+		m.addcolumn034 = &i
+	}
+}
+
+// AddedColumn034 returns the value that was added to the "column034" field in this mutation.
+func (m *WideMutation) AddedColumn034() (r int, exists bool) {
+	v := m.addcolumn034
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn034 resets all changes to the "column034" field.
+func (m *WideMutation) ResetColumn034() {
+	m.column034 = nil
+	m.addcolumn034 = nil
+}
+
+// SetColumn035 sets the "column035" field.
+func (m *WideMutation) SetColumn035(f float64) {
+	m.column035 = &f
+	m.addcolumn035 = nil
+}
+
+// Column035 returns the value of the "column035" field in the mutation.
+func (m *WideMutation) Column035() (r float64, exists bool) {
+	v := m.column035
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn035 returns the old "column035" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn035(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn035 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn035 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn035: %w", err)
+	}
+	return oldValue.Column035, nil
+}
+
+// AddColumn035 adds f to the "column035" field.
+func (m *WideMutation) AddColumn035(f float64) {
+	if m.addcolumn035 != nil {
+		*m.addcolumn035 += f
+	} else {
+		m.addcolumn035 = &f
+	}
+}
+
+// AddedColumn035 returns the value that was added to the "column035" field in this mutation.
+func (m *WideMutation) AddedColumn035() (r float64, exists bool) {
+	v := m.addcolumn035
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn035 resets all changes to the "column035" field.
+func (m *WideMutation) ResetColumn035() {
+	m.column035 = nil
+	m.addcolumn035 = nil
+}
+
+// SetColumn036 sets the "column036" field.
+func (m *WideMutation) SetColumn036(t time.Time) {
+	m.column036 = &t
+}
+
+// Column036 returns the value of the "column036" field in the mutation.
+func (m *WideMutation) Column036() (r time.Time, exists bool) {
+	v := m.column036
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn036 returns the old "column036" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn036(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn036 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn036 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn036: %w", err)
+	}
+	return oldValue.Column036, nil
+}
+
+// ResetColumn036 resets all changes to the "column036" field.
+func (m *WideMutation) ResetColumn036() {
+	m.column036 = nil
+}
+
+// SetColumn037 sets the "column037" field.
+func (m *WideMutation) SetColumn037(s string) {
+	m.column037 = &s
+}
+
+// Column037 returns the value of the "column037" field in the mutation.
+func (m *WideMutation) Column037() (r string, exists bool) {
+	v := m.column037
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn037 returns the old "column037" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn037(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn037 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn037 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn037: %w", err)
+	}
+	return oldValue.Column037, nil
+}
+
+// ResetColumn037 resets all changes to the "column037" field.
+func (m *WideMutation) ResetColumn037() {
+	m.column037 = nil
+}
+
+// SetColumn038 sets the "column038" field.
+func (m *WideMutation) SetColumn038(i int) {
+	m.column038 = &i
+	m.addcolumn038 = nil
+}
+
+// Column038 returns the value of the "column038" field in the mutation.
+func (m *WideMutation) Column038() (r int, exists bool) {
+	v := m.column038
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn038 returns the old "column038" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn038(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn038 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn038 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn038: %w", err)
+	}
+	return oldValue.Column038, nil
+}
+
+// AddColumn038 adds i to the "column038" field.
+func (m *WideMutation) AddColumn038(i int) {
+	if m.addcolumn038 != nil {
+		*m.addcolumn038 += i
+	} else {
+		m.addcolumn038 = &i
+	}
+}
+
+// AddedColumn038 returns the value that was added to the "column038" field in this mutation.
+func (m *WideMutation) AddedColumn038() (r int, exists bool) {
+	v := m.addcolumn038
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn038 resets all changes to the "column038" field.
+func (m *WideMutation) ResetColumn038() {
+	m.column038 = nil
+	m.addcolumn038 = nil
+}
+
+// SetColumn039 sets the "column039" field.
+func (m *WideMutation) SetColumn039(f float64) {
+	m.column039 = &f
+	m.addcolumn039 = nil
+}
+
+// Column039 returns the value of the "column039" field in the mutation.
+func (m *WideMutation) Column039() (r float64, exists bool) {
+	v := m.column039
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn039 returns the old "column039" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn039(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn039 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn039 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn039: %w", err)
+	}
+	return oldValue.Column039, nil
+}
+
+// AddColumn039 adds f to the "column039" field.
+func (m *WideMutation) AddColumn039(f float64) {
+	if m.addcolumn039 != nil {
+		*m.addcolumn039 += f
+	} else {
+		m.addcolumn039 = &f
+	}
+}
+
+// AddedColumn039 returns the value that was added to the "column039" field in this mutation.
+func (m *WideMutation) AddedColumn039() (r float64, exists bool) {
+	v := m.addcolumn039
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn039 resets all changes to the "column039" field.
+func (m *WideMutation) ResetColumn039() {
+	m.column039 = nil
+	m.addcolumn039 = nil
+}
+
+// SetColumn040 sets the "column040" field.
+func (m *WideMutation) SetColumn040(t time.Time) {
+	m.column040 = &t
+}
+
+// Column040 returns the value of the "column040" field in the mutation.
+func (m *WideMutation) Column040() (r time.Time, exists bool) {
+	v := m.column040
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn040 returns the old "column040" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn040(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn040 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn040 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn040: %w", err)
+	}
+	return oldValue.Column040, nil
+}
+
+// ResetColumn040 resets all changes to the "column040" field.
+func (m *WideMutation) ResetColumn040() {
+	m.column040 = nil
+}
+
+// SetColumn041 sets the "column041" field.
+func (m *WideMutation) SetColumn041(s string) {
+	m.column041 = &s
+}
+
+// Column041 returns the value of the "column041" field in the mutation.
+func (m *WideMutation) Column041() (r string, exists bool) {
+	v := m.column041
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn041 returns the old "column041" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn041(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn041 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn041 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn041: %w", err)
+	}
+	return oldValue.Column041, nil
+}
+
+// ResetColumn041 resets all changes to the "column041" field.
+func (m *WideMutation) ResetColumn041() {
+	m.column041 = nil
+}
+
+// SetColumn042 sets the "column042" field.
+func (m *WideMutation) SetColumn042(i int) {
+	m.column042 = &i
+	m.addcolumn042 = nil
+}
+
+// Column042 returns the value of the "column042" field in the mutation.
+func (m *WideMutation) Column042() (r int, exists bool) {
+	v := m.column042
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn042 returns the old "column042" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn042(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn042 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn042 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn042: %w", err)
+	}
+	return oldValue.Column042, nil
+}
+
+// AddColumn042 adds i to the "column042" field.
+func (m *WideMutation) AddColumn042(i int) {
+	if m.addcolumn042 != nil {
+		*m.addcolumn042 += i
+	} else {
+		m.addcolumn042 = &i
+	}
+}
+
+// AddedColumn042 returns the value that was added to the "column042" field in this mutation.
+func (m *WideMutation) AddedColumn042() (r int, exists bool) {
+	v := m.addcolumn042
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn042 resets all changes to the "column042" field.
+func (m *WideMutation) ResetColumn042() {
+	m.column042 = nil
+	m.addcolumn042 = nil
+}
+
+// SetColumn043 sets the "column043" field.
+func (m *WideMutation) SetColumn043(f float64) {
+	m.column043 = &f
+	m.addcolumn043 = nil
+}
+
+// Column043 returns the value of the "column043" field in the mutation.
+func (m *WideMutation) Column043() (r float64, exists bool) {
+	v := m.column043
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn043 returns the old "column043" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn043(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn043 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn043 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn043: %w", err)
+	}
+	return oldValue.Column043, nil
+}
+
+// AddColumn043 adds f to the "column043" field.
+func (m *WideMutation) AddColumn043(f float64) {
+	if m.addcolumn043 != nil {
+		*m.addcolumn043 += f
+	} else {
+		m.addcolumn043 = &f
+	}
+}
+
+// AddedColumn043 returns the value that was added to the "column043" field in this mutation.
+func (m *WideMutation) AddedColumn043() (r float64, exists bool) {
+	v := m.addcolumn043
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn043 resets all changes to the "column043" field.
+func (m *WideMutation) ResetColumn043() {
+	m.column043 = nil
+	m.addcolumn043 = nil
+}
+
+// SetColumn044 sets the "column044" field.
+func (m *WideMutation) SetColumn044(t time.Time) {
+	m.column044 = &t
+}
+
+// Column044 returns the value of the "column044" field in the mutation.
+func (m *WideMutation) Column044() (r time.Time, exists bool) {
+	v := m.column044
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn044 returns the old "column044" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn044(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn044 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn044 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn044: %w", err)
+	}
+	return oldValue.Column044, nil
+}
+
+// ResetColumn044 resets all changes to the "column044" field.
+func (m *WideMutation) ResetColumn044() {
+	m.column044 = nil
+}
+
+// SetColumn045 sets the "column045" field.
+func (m *WideMutation) SetColumn045(s string) {
+	m.column045 = &s
+}
+
+// Column045 returns the value of the "column045" field in the mutation.
+func (m *WideMutation) Column045() (r string, exists bool) {
+	v := m.column045
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn045 returns the old "column045" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn045(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn045 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn045 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn045: %w", err)
+	}
+	return oldValue.Column045, nil
+}
+
+// ResetColumn045 resets all changes to the "column045" field.
+func (m *WideMutation) ResetColumn045() {
+	m.column045 = nil
+}
+
+// SetColumn046 sets the "column046" field.
+func (m *WideMutation) SetColumn046(i int) {
+	m.column046 = &i
+	m.addcolumn046 = nil
+}
+
+// Column046 returns the value of the "column046" field in the mutation.
+func (m *WideMutation) Column046() (r int, exists bool) {
+	v := m.column046
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn046 returns the old "column046" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn046(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn046 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn046 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn046: %w", err)
+	}
+	return oldValue.Column046, nil
+}
+
+// AddColumn046 adds i to the "column046" field.
+func (m *WideMutation) AddColumn046(i int) {
+	if m.addcolumn046 != nil {
+		*m.addcolumn046 += i
+	} else {
+		m.addcolumn046 = &i
+	}
+}
+
+// AddedColumn046 returns the value that was added to the "column046" field in this mutation.
+func (m *WideMutation) AddedColumn046() (r int, exists bool) {
+	v := m.addcolumn046
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn046 resets all changes to the "column046" field.
+func (m *WideMutation) ResetColumn046() {
+	m.column046 = nil
+	m.addcolumn046 = nil
+}
+
+// SetColumn047 sets the "column047" field.
+func (m *WideMutation) SetColumn047(f float64) {
+	m.column047 = &f
+	m.addcolumn047 = nil
+}
+
+// Column047 returns the value of the "column047" field in the mutation.
+func (m *WideMutation) Column047() (r float64, exists bool) {
+	v := m.column047
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn047 returns the old "column047" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn047(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn047 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn047 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn047: %w", err)
+	}
+	return oldValue.Column047, nil
+}
+
+// AddColumn047 adds f to the "column047" field.
+func (m *WideMutation) AddColumn047(f float64) {
+	if m.addcolumn047 != nil {
+		*m.addcolumn047 += f
+	} else {
+		m.addcolumn047 = &f
+	}
+}
+
+// AddedColumn047 returns the value that was added to the "column047" field in this mutation.
+func (m *WideMutation) AddedColumn047() (r float64, exists bool) {
+	v := m.addcolumn047
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn047 resets all changes to the "column047" field.
+func (m *WideMutation) ResetColumn047() {
+	m.column047 = nil
+	m.addcolumn047 = nil
+}
+
+// SetColumn048 sets the "column048" field.
+func (m *WideMutation) SetColumn048(t time.Time) {
+	m.column048 = &t
+}
+
+// Column048 returns the value of the "column048" field in the mutation.
+func (m *WideMutation) Column048() (r time.Time, exists bool) {
+	v := m.column048
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn048 returns the old "column048" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn048(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn048 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn048 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn048: %w", err)
+	}
+	return oldValue.Column048, nil
+}
+
+// ResetColumn048 resets all changes to the "column048" field.
+func (m *WideMutation) ResetColumn048() {
+	m.column048 = nil
+}
+
+// SetColumn049 sets the "column049" field.
+func (m *WideMutation) SetColumn049(s string) {
+	m.column049 = &s
+}
+
+// Column049 returns the value of the "column049" field in the mutation.
+func (m *WideMutation) Column049() (r string, exists bool) {
+	v := m.column049
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn049 returns the old "column049" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn049(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn049 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn049 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn049: %w", err)
+	}
+	return oldValue.Column049, nil
+}
+
+// ResetColumn049 resets all changes to the "column049" field.
+func (m *WideMutation) ResetColumn049() {
+	m.column049 = nil
+}
+
+// SetColumn050 sets the "column050" field.
+func (m *WideMutation) SetColumn050(i int) {
+	m.column050 = &i
+	m.addcolumn050 = nil
+}
+
+// Column050 returns the value of the "column050" field in the mutation.
+func (m *WideMutation) Column050() (r int, exists bool) {
+	v := m.column050
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn050 returns the old "column050" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn050(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn050 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn050 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn050: %w", err)
+	}
+	return oldValue.Column050, nil
+}
+
+// AddColumn050 adds i to the "column050" field.
+func (m *WideMutation) AddColumn050(i int) {
+	if m.addcolumn050 != nil {
+		*m.addcolumn050 += i
+	} else {
+		m.addcolumn050 = &i
+	}
+}
+
+// AddedColumn050 returns the value that was added to the "column050" field in this mutation.
+func (m *WideMutation) AddedColumn050() (r int, exists bool) {
+	v := m.addcolumn050
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn050 resets all changes to the "column050" field.
+func (m *WideMutation) ResetColumn050() {
+	m.column050 = nil
+	m.addcolumn050 = nil
+}
+
+// SetColumn051 sets the "column051" field.
+func (m *WideMutation) SetColumn051(f float64) {
+	m.column051 = &f
+	m.addcolumn051 = nil
+}
+
+// Column051 returns the value of the "column051" field in the mutation.
+func (m *WideMutation) Column051() (r float64, exists bool) {
+	v := m.column051
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn051 returns the old "column051" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn051(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn051 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn051 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn051: %w", err)
+	}
+	return oldValue.Column051, nil
+}
+
+// AddColumn051 adds f to the "column051" field.
+func (m *WideMutation) AddColumn051(f float64) {
+	if m.addcolumn051 != nil {
+		*m.addcolumn051 += f
+	} else {
+		m.addcolumn051 = &f
+	}
+}
+
+// AddedColumn051 returns the value that was added to the "column051" field in this mutation.
+func (m *WideMutation) AddedColumn051() (r float64, exists bool) {
+	v := m.addcolumn051
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn051 resets all changes to the "column051" field.
+func (m *WideMutation) ResetColumn051() {
+	m.column051 = nil
+	m.addcolumn051 = nil
+}
+
+// SetColumn052 sets the "column052" field.
+func (m *WideMutation) SetColumn052(t time.Time) {
+	m.column052 = &t
+}
+
+// Column052 returns the value of the "column052" field in the mutation.
+func (m *WideMutation) Column052() (r time.Time, exists bool) {
+	v := m.column052
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn052 returns the old "column052" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn052(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn052 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn052 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn052: %w", err)
+	}
+	return oldValue.Column052, nil
+}
+
+// ResetColumn052 resets all changes to the "column052" field.
+func (m *WideMutation) ResetColumn052() {
+	m.column052 = nil
+}
+
+// SetColumn053 sets the "column053" field.
+func (m *WideMutation) SetColumn053(s string) {
+	m.column053 = &s
+}
+
+// Column053 returns the value of the "column053" field in the mutation.
+func (m *WideMutation) Column053() (r string, exists bool) {
+	v := m.column053
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn053 returns the old "column053" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn053(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn053 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn053 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn053: %w", err)
+	}
+	return oldValue.Column053, nil
+}
+
+// ResetColumn053 resets all changes to the "column053" field.
+func (m *WideMutation) ResetColumn053() {
+	m.column053 = nil
+}
+
+// SetColumn054 sets the "column054" field.
+func (m *WideMutation) SetColumn054(i int) {
+	m.column054 = &i
+	m.addcolumn054 = nil
+}
+
+// Column054 returns the value of the "column054" field in the mutation.
+func (m *WideMutation) Column054() (r int, exists bool) {
+	v := m.column054
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn054 returns the old "column054" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn054(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn054 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn054 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn054: %w", err)
+	}
+	return oldValue.Column054, nil
+}
+
+// AddColumn054 adds i to the "column054" field.
+func (m *WideMutation) AddColumn054(i int) {
+	if m.addcolumn054 != nil {
+		*m.addcolumn054 += i
+	} else {
+		m.addcolumn054 = &i
+	}
+}
+
+// AddedColumn054 returns the value that was added to the "column054" field in this mutation.
+func (m *WideMutation) AddedColumn054() (r int, exists bool) {
+	v := m.addcolumn054
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn054 resets all changes to the "column054" field.
+func (m *WideMutation) ResetColumn054() {
+	m.column054 = nil
+	m.addcolumn054 = nil
+}
+
+// SetColumn055 sets the "column055" field.
+func (m *WideMutation) SetColumn055(f float64) {
+	m.column055 = &f
+	m.addcolumn055 = nil
+}
+
+// Column055 returns the value of the "column055" field in the mutation.
+func (m *WideMutation) Column055() (r float64, exists bool) {
+	v := m.column055
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn055 returns the old "column055" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn055(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn055 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn055 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn055: %w", err)
+	}
+	return oldValue.Column055, nil
+}
+
+// AddColumn055 adds f to the "column055" field.
+func (m *WideMutation) AddColumn055(f float64) {
+	if m.addcolumn055 != nil {
+		*m.addcolumn055 += f
+	} else {
+		m.addcolumn055 = &f
+	}
+}
+
+// AddedColumn055 returns the value that was added to the "column055" field in this mutation.
+func (m *WideMutation) AddedColumn055() (r float64, exists bool) {
+	v := m.addcolumn055
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn055 resets all changes to the "column055" field.
+func (m *WideMutation) ResetColumn055() {
+	m.column055 = nil
+	m.addcolumn055 = nil
+}
+
+// SetColumn056 sets the "column056" field.
+func (m *WideMutation) SetColumn056(t time.Time) {
+	m.column056 = &t
+}
+
+// Column056 returns the value of the "column056" field in the mutation.
+func (m *WideMutation) Column056() (r time.Time, exists bool) {
+	v := m.column056
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn056 returns the old "column056" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn056(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn056 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn056 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn056: %w", err)
+	}
+	return oldValue.Column056, nil
+}
+
+// ResetColumn056 resets all changes to the "column056" field.
+func (m *WideMutation) ResetColumn056() {
+	m.column056 = nil
+}
+
+// SetColumn057 sets the "column057" field.
+func (m *WideMutation) SetColumn057(s string) {
+	m.column057 = &s
+}
+
+// Column057 returns the value of the "column057" field in the mutation.
+func (m *WideMutation) Column057() (r string, exists bool) {
+	v := m.column057
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn057 returns the old "column057" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn057(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn057 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn057 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn057: %w", err)
+	}
+	return oldValue.Column057, nil
+}
+
+// ResetColumn057 resets all changes to the "column057" field.
+func (m *WideMutation) ResetColumn057() {
+	m.column057 = nil
+}
+
+// SetColumn058 sets the "column058" field.
+func (m *WideMutation) SetColumn058(i int) {
+	m.column058 = &i
+	m.addcolumn058 = nil
+}
+
+// Column058 returns the value of the "column058" field in the mutation.
+func (m *WideMutation) Column058() (r int, exists bool) {
+	v := m.column058
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn058 returns the old "column058" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn058(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn058 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn058 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn058: %w", err)
+	}
+	return oldValue.Column058, nil
+}
+
+// AddColumn058 adds i to the "column058" field.
+func (m *WideMutation) AddColumn058(i int) {
+	if m.addcolumn058 != nil {
+		*m.addcolumn058 += i
+	} else {
+		m.addcolumn058 = &i
+	}
+}
+
+// AddedColumn058 returns the value that was added to the "column058" field in this mutation.
+func (m *WideMutation) AddedColumn058() (r int, exists bool) {
+	v := m.addcolumn058
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn058 resets all changes to the "column058" field.
+func (m *WideMutation) ResetColumn058() {
+	m.column058 = nil
+	m.addcolumn058 = nil
+}
+
+// SetColumn059 sets the "column059" field.
+func (m *WideMutation) SetColumn059(f float64) {
+	m.column059 = &f
+	m.addcolumn059 = nil
+}
+
+// Column059 returns the value of the "column059" field in the mutation.
+func (m *WideMutation) Column059() (r float64, exists bool) {
+	v := m.column059
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn059 returns the old "column059" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn059(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn059 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn059 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn059: %w", err)
+	}
+	return oldValue.Column059, nil
+}
+
+// AddColumn059 adds f to the "column059" field.
+func (m *WideMutation) AddColumn059(f float64) {
+	if m.addcolumn059 != nil {
+		*m.addcolumn059 += f
+	} else {
+		m.addcolumn059 = &f
+	}
+}
+
+// AddedColumn059 returns the value that was added to the "column059" field in this mutation.
+func (m *WideMutation) AddedColumn059() (r float64, exists bool) {
+	v := m.addcolumn059
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn059 resets all changes to the "column059" field.
+func (m *WideMutation) ResetColumn059() {
+	m.column059 = nil
+	m.addcolumn059 = nil
+}
+
+// SetColumn060 sets the "column060" field.
+func (m *WideMutation) SetColumn060(t time.Time) {
+	m.column060 = &t
+}
+
+// Column060 returns the value of the "column060" field in the mutation.
+func (m *WideMutation) Column060() (r time.Time, exists bool) {
+	v := m.column060
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn060 returns the old "column060" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn060(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn060 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn060 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn060: %w", err)
+	}
+	return oldValue.Column060, nil
+}
+
+// ResetColumn060 resets all changes to the "column060" field.
+func (m *WideMutation) ResetColumn060() {
+	m.column060 = nil
+}
+
+// SetColumn061 sets the "column061" field.
+func (m *WideMutation) SetColumn061(s string) {
+	m.column061 = &s
+}
+
+// Column061 returns the value of the "column061" field in the mutation.
+func (m *WideMutation) Column061() (r string, exists bool) {
+	v := m.column061
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn061 returns the old "column061" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn061(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn061 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn061 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn061: %w", err)
+	}
+	return oldValue.Column061, nil
+}
+
+// ResetColumn061 resets all changes to the "column061" field.
+func (m *WideMutation) ResetColumn061() {
+	m.column061 = nil
+}
+
+// SetColumn062 sets the "column062" field.
+func (m *WideMutation) SetColumn062(i int) {
+	m.column062 = &i
+	m.addcolumn062 = nil
+}
+
+// Column062 returns the value of the "column062" field in the mutation.
+func (m *WideMutation) Column062() (r int, exists bool) {
+	v := m.column062
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn062 returns the old "column062" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn062(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn062 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn062 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn062: %w", err)
+	}
+	return oldValue.Column062, nil
+}
+
+// AddColumn062 adds i to the "column062" field.
+func (m *WideMutation) AddColumn062(i int) {
+	if m.addcolumn062 != nil {
+		*m.addcolumn062 += i
+	} else {
+		m.addcolumn062 = &i
+	}
+}
+
+// AddedColumn062 returns the value that was added to the "column062" field in this mutation.
+func (m *WideMutation) AddedColumn062() (r int, exists bool) {
+	v := m.addcolumn062
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn062 resets all changes to the "column062" field.
+func (m *WideMutation) ResetColumn062() {
+	m.column062 = nil
+	m.addcolumn062 = nil
+}
+
+// SetColumn063 sets the "column063" field.
+func (m *WideMutation) SetColumn063(f float64) {
+	m.column063 = &f
+	m.addcolumn063 = nil
+}
+
+// Column063 returns the value of the "column063" field in the mutation.
+func (m *WideMutation) Column063() (r float64, exists bool) {
+	v := m.column063
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn063 returns the old "column063" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn063(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn063 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn063 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn063: %w", err)
+	}
+	return oldValue.Column063, nil
+}
+
+// AddColumn063 adds f to the "column063" field.
+func (m *WideMutation) AddColumn063(f float64) {
+	if m.addcolumn063 != nil {
+		*m.addcolumn063 += f
+	} else {
+		m.addcolumn063 = &f
+	}
+}
+
+// AddedColumn063 returns the value that was added to the "column063" field in this mutation.
+func (m *WideMutation) AddedColumn063() (r float64, exists bool) {
+	v := m.addcolumn063
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColumn063 resets all changes to the "column063" field.
+func (m *WideMutation) ResetColumn063() {
+	m.column063 = nil
+	m.addcolumn063 = nil
+}
+
+// SetColumn064 sets the "column064" field.
+func (m *WideMutation) SetColumn064(t time.Time) {
+	m.column064 = &t
+}
+
+// Column064 returns the value of the "column064" field in the mutation.
+func (m *WideMutation) Column064() (r time.Time, exists bool) {
+	v := m.column064
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn064 returns the old "column064" field's value of the Wide entity.
+// If the Wide object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WideMutation) OldColumn064(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn064 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn064 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn064: %w", err)
+	}
+	return oldValue.Column064, nil
+}
+
+// ResetColumn064 resets all changes to the "column064" field.
+func (m *WideMutation) ResetColumn064() {
+	m.column064 = nil
+}
+
+// Where appends a list predicates to the WideMutation builder.
+func (m *WideMutation) Where(ps ...predicate.Wide) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WideMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WideMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Wide, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WideMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WideMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Wide).
+func (m *WideMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WideMutation) Fields() []string {
+	fields := make([]string, 0, 64)
+	if m.column001 != nil {
+		fields = append(fields, wide.FieldColumn001)
+	}
+	if m.column002 != nil {
+		fields = append(fields, wide.FieldColumn002)
+	}
+	if m.column003 != nil {
+		fields = append(fields, wide.FieldColumn003)
+	}
+	if m.column004 != nil {
+		fields = append(fields, wide.FieldColumn004)
+	}
+	if m.column005 != nil {
+		fields = append(fields, wide.FieldColumn005)
+	}
+	if m.column006 != nil {
+		fields = append(fields, wide.FieldColumn006)
+	}
+	if m.column007 != nil {
+		fields = append(fields, wide.FieldColumn007)
+	}
+	if m.column008 != nil {
+		fields = append(fields, wide.FieldColumn008)
+	}
+	if m.column009 != nil {
+		fields = append(fields, wide.FieldColumn009)
+	}
+	if m.column010 != nil {
+		fields = append(fields, wide.FieldColumn010)
+	}
+	if m.column011 != nil {
+		fields = append(fields, wide.FieldColumn011)
+	}
+	if m.column012 != nil {
+		fields = append(fields, wide.FieldColumn012)
+	}
+	if m.column013 != nil {
+		fields = append(fields, wide.FieldColumn013)
+	}
+	if m.column014 != nil {
+		fields = append(fields, wide.FieldColumn014)
+	}
+	if m.column015 != nil {
+		fields = append(fields, wide.FieldColumn015)
+	}
+	if m.column016 != nil {
+		fields = append(fields, wide.FieldColumn016)
+	}
+	if m.column017 != nil {
+		fields = append(fields, wide.FieldColumn017)
+	}
+	if m.column018 != nil {
+		fields = append(fields, wide.FieldColumn018)
+	}
+	if m.column019 != nil {
+		fields = append(fields, wide.FieldColumn019)
+	}
+	if m.column020 != nil {
+		fields = append(fields, wide.FieldColumn020)
+	}
+	if m.column021 != nil {
+		fields = append(fields, wide.FieldColumn021)
+	}
+	if m.column022 != nil {
+		fields = append(fields, wide.FieldColumn022)
+	}
+	if m.column023 != nil {
+		fields = append(fields, wide.FieldColumn023)
+	}
+	if m.column024 != nil {
+		fields = append(fields, wide.FieldColumn024)
+	}
+	if m.column025 != nil {
+		fields = append(fields, wide.FieldColumn025)
+	}
+	if m.column026 != nil {
+		fields = append(fields, wide.FieldColumn026)
+	}
+	if m.column027 != nil {
+		fields = append(fields, wide.FieldColumn027)
+	}
+	if m.column028 != nil {
+		fields = append(fields, wide.FieldColumn028)
+	}
+	if m.column029 != nil {
+		fields = append(fields, wide.FieldColumn029)
+	}
+	if m.column030 != nil {
+		fields = append(fields, wide.FieldColumn030)
+	}
+	if m.column031 != nil {
+		fields = append(fields, wide.FieldColumn031)
+	}
+	if m.column032 != nil {
+		fields = append(fields, wide.FieldColumn032)
+	}
+	if m.column033 != nil {
+		fields = append(fields, wide.FieldColumn033)
+	}
+	if m.column034 != nil {
+		fields = append(fields, wide.FieldColumn034)
+	}
+	if m.column035 != nil {
+		fields = append(fields, wide.FieldColumn035)
+	}
+	if m.column036 != nil {
+		fields = append(fields, wide.FieldColumn036)
+	}
+	if m.column037 != nil {
+		fields = append(fields, wide.FieldColumn037)
+	}
+	if m.column038 != nil {
+		fields = append(fields, wide.FieldColumn038)
+	}
+	if m.column039 != nil {
+		fields = append(fields, wide.FieldColumn039)
+	}
+	if m.column040 != nil {
+		fields = append(fields, wide.FieldColumn040)
+	}
+	if m.column041 != nil {
+		fields = append(fields, wide.FieldColumn041)
+	}
+	if m.column042 != nil {
+		fields = append(fields, wide.FieldColumn042)
+	}
+	if m.column043 != nil {
+		fields = append(fields, wide.FieldColumn043)
+	}
+	if m.column044 != nil {
+		fields = append(fields, wide.FieldColumn044)
+	}
+	if m.column045 != nil {
+		fields = append(fields, wide.FieldColumn045)
+	}
+	if m.column046 != nil {
+		fields = append(fields, wide.FieldColumn046)
+	}
+	if m.column047 != nil {
+		fields = append(fields, wide.FieldColumn047)
+	}
+	if m.column048 != nil {
+		fields = append(fields, wide.FieldColumn048)
+	}
+	if m.column049 != nil {
+		fields = append(fields, wide.FieldColumn049)
+	}
+	if m.column050 != nil {
+		fields = append(fields, wide.FieldColumn050)
+	}
+	if m.column051 != nil {
+		fields = append(fields, wide.FieldColumn051)
+	}
+	if m.column052 != nil {
+		fields = append(fields, wide.FieldColumn052)
+	}
+	if m.column053 != nil {
+		fields = append(fields, wide.FieldColumn053)
+	}
+	if m.column054 != nil {
+		fields = append(fields, wide.FieldColumn054)
+	}
+	if m.column055 != nil {
+		fields = append(fields, wide.FieldColumn055)
+	}
+	if m.column056 != nil {
+		fields = append(fields, wide.FieldColumn056)
+	}
+	if m.column057 != nil {
+		fields = append(fields, wide.FieldColumn057)
+	}
+	if m.column058 != nil {
+		fields = append(fields, wide.FieldColumn058)
+	}
+	if m.column059 != nil {
+		fields = append(fields, wide.FieldColumn059)
+	}
+	if m.column060 != nil {
+		fields = append(fields, wide.FieldColumn060)
+	}
+	if m.column061 != nil {
+		fields = append(fields, wide.FieldColumn061)
+	}
+	if m.column062 != nil {
+		fields = append(fields, wide.FieldColumn062)
+	}
+	if m.column063 != nil {
+		fields = append(fields, wide.FieldColumn063)
+	}
+	if m.column064 != nil {
+		fields = append(fields, wide.FieldColumn064)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WideMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case wide.FieldColumn001:
+		return m.Column001()
+	case wide.FieldColumn002:
+		return m.Column002()
+	case wide.FieldColumn003:
+		return m.Column003()
+	case wide.FieldColumn004:
+		return m.Column004()
+	case wide.FieldColumn005:
+		return m.Column005()
+	case wide.FieldColumn006:
+		return m.Column006()
+	case wide.FieldColumn007:
+		return m.Column007()
+	case wide.FieldColumn008:
+		return m.Column008()
+	case wide.FieldColumn009:
+		return m.Column009()
+	case wide.FieldColumn010:
+		return m.Column010()
+	case wide.FieldColumn011:
+		return m.Column011()
+	case wide.FieldColumn012:
+		return m.Column012()
+	case wide.FieldColumn013:
+		return m.Column013()
+	case wide.FieldColumn014:
+		return m.Column014()
+	case wide.FieldColumn015:
+		return m.Column015()
+	case wide.FieldColumn016:
+		return m.Column016()
+	case wide.FieldColumn017:
+		return m.Column017()
+	case wide.FieldColumn018:
+		return m.Column018()
+	case wide.FieldColumn019:
+		return m.Column019()
+	case wide.FieldColumn020:
+		return m.Column020()
+	case wide.FieldColumn021:
+		return m.Column021()
+	case wide.FieldColumn022:
+		return m.Column022()
+	case wide.FieldColumn023:
+		return m.Column023()
+	case wide.FieldColumn024:
+		return m.Column024()
+	case wide.FieldColumn025:
+		return m.Column025()
+	case wide.FieldColumn026:
+		return m.Column026()
+	case wide.FieldColumn027:
+		return m.Column027()
+	case wide.FieldColumn028:
+		return m.Column028()
+	case wide.FieldColumn029:
+		return m.Column029()
+	case wide.FieldColumn030:
+		return m.Column030()
+	case wide.FieldColumn031:
+		return m.Column031()
+	case wide.FieldColumn032:
+		return m.Column032()
+	case wide.FieldColumn033:
+		return m.Column033()
+	case wide.FieldColumn034:
+		return m.Column034()
+	case wide.FieldColumn035:
+		return m.Column035()
+	case wide.FieldColumn036:
+		return m.Column036()
+	case wide.FieldColumn037:
+		return m.Column037()
+	case wide.FieldColumn038:
+		return m.Column038()
+	case wide.FieldColumn039:
+		return m.Column039()
+	case wide.FieldColumn040:
+		return m.Column040()
+	case wide.FieldColumn041:
+		return m.Column041()
+	case wide.FieldColumn042:
+		return m.Column042()
+	case wide.FieldColumn043:
+		return m.Column043()
+	case wide.FieldColumn044:
+		return m.Column044()
+	case wide.FieldColumn045:
+		return m.Column045()
+	case wide.FieldColumn046:
+		return m.Column046()
+	case wide.FieldColumn047:
+		return m.Column047()
+	case wide.FieldColumn048:
+		return m.Column048()
+	case wide.FieldColumn049:
+		return m.Column049()
+	case wide.FieldColumn050:
+		return m.Column050()
+	case wide.FieldColumn051:
+		return m.Column051()
+	case wide.FieldColumn052:
+		return m.Column052()
+	case wide.FieldColumn053:
+		return m.Column053()
+	case wide.FieldColumn054:
+		return m.Column054()
+	case wide.FieldColumn055:
+		return m.Column055()
+	case wide.FieldColumn056:
+		return m.Column056()
+	case wide.FieldColumn057:
+		return m.Column057()
+	case wide.FieldColumn058:
+		return m.Column058()
+	case wide.FieldColumn059:
+		return m.Column059()
+	case wide.FieldColumn060:
+		return m.Column060()
+	case wide.FieldColumn061:
+		return m.Column061()
+	case wide.FieldColumn062:
+		return m.Column062()
+	case wide.FieldColumn063:
+		return m.Column063()
+	case wide.FieldColumn064:
+		return m.Column064()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WideMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case wide.FieldColumn001:
+		return m.OldColumn001(ctx)
+	case wide.FieldColumn002:
+		return m.OldColumn002(ctx)
+	case wide.FieldColumn003:
+		return m.OldColumn003(ctx)
+	case wide.FieldColumn004:
+		return m.OldColumn004(ctx)
+	case wide.FieldColumn005:
+		return m.OldColumn005(ctx)
+	case wide.FieldColumn006:
+		return m.OldColumn006(ctx)
+	case wide.FieldColumn007:
+		return m.OldColumn007(ctx)
+	case wide.FieldColumn008:
+		return m.OldColumn008(ctx)
+	case wide.FieldColumn009:
+		return m.OldColumn009(ctx)
+	case wide.FieldColumn010:
+		return m.OldColumn010(ctx)
+	case wide.FieldColumn011:
+		return m.OldColumn011(ctx)
+	case wide.FieldColumn012:
+		return m.OldColumn012(ctx)
+	case wide.FieldColumn013:
+		return m.OldColumn013(ctx)
+	case wide.FieldColumn014:
+		return m.OldColumn014(ctx)
+	case wide.FieldColumn015:
+		return m.OldColumn015(ctx)
+	case wide.FieldColumn016:
+		return m.OldColumn016(ctx)
+	case wide.FieldColumn017:
+		return m.OldColumn017(ctx)
+	case wide.FieldColumn018:
+		return m.OldColumn018(ctx)
+	case wide.FieldColumn019:
+		return m.OldColumn019(ctx)
+	case wide.FieldColumn020:
+		return m.OldColumn020(ctx)
+	case wide.FieldColumn021:
+		return m.OldColumn021(ctx)
+	case wide.FieldColumn022:
+		return m.OldColumn022(ctx)
+	case wide.FieldColumn023:
+		return m.OldColumn023(ctx)
+	case wide.FieldColumn024:
+		return m.OldColumn024(ctx)
+	case wide.FieldColumn025:
+		return m.OldColumn025(ctx)
+	case wide.FieldColumn026:
+		return m.OldColumn026(ctx)
+	case wide.FieldColumn027:
+		return m.OldColumn027(ctx)
+	case wide.FieldColumn028:
+		return m.OldColumn028(ctx)
+	case wide.FieldColumn029:
+		return m.OldColumn029(ctx)
+	case wide.FieldColumn030:
+		return m.OldColumn030(ctx)
+	case wide.FieldColumn031:
+		return m.OldColumn031(ctx)
+	case wide.FieldColumn032:
+		return m.OldColumn032(ctx)
+	case wide.FieldColumn033:
+		return m.OldColumn033(ctx)
+	case wide.FieldColumn034:
+		return m.OldColumn034(ctx)
+	case wide.FieldColumn035:
+		return m.OldColumn035(ctx)
+	case wide.FieldColumn036:
+		return m.OldColumn036(ctx)
+	case wide.FieldColumn037:
+		return m.OldColumn037(ctx)
+	case wide.FieldColumn038:
+		return m.OldColumn038(ctx)
+	case wide.FieldColumn039:
+		return m.OldColumn039(ctx)
+	case wide.FieldColumn040:
+		return m.OldColumn040(ctx)
+	case wide.FieldColumn041:
+		return m.OldColumn041(ctx)
+	case wide.FieldColumn042:
+		return m.OldColumn042(ctx)
+	case wide.FieldColumn043:
+		return m.OldColumn043(ctx)
+	case wide.FieldColumn044:
+		return m.OldColumn044(ctx)
+	case wide.FieldColumn045:
+		return m.OldColumn045(ctx)
+	case wide.FieldColumn046:
+		return m.OldColumn046(ctx)
+	case wide.FieldColumn047:
+		return m.OldColumn047(ctx)
+	case wide.FieldColumn048:
+		return m.OldColumn048(ctx)
+	case wide.FieldColumn049:
+		return m.OldColumn049(ctx)
+	case wide.FieldColumn050:
+		return m.OldColumn050(ctx)
+	case wide.FieldColumn051:
+		return m.OldColumn051(ctx)
+	case wide.FieldColumn052:
+		return m.OldColumn052(ctx)
+	case wide.FieldColumn053:
+		return m.OldColumn053(ctx)
+	case wide.FieldColumn054:
+		return m.OldColumn054(ctx)
+	case wide.FieldColumn055:
+		return m.OldColumn055(ctx)
+	case wide.FieldColumn056:
+		return m.OldColumn056(ctx)
+	case wide.FieldColumn057:
+		return m.OldColumn057(ctx)
+	case wide.FieldColumn058:
+		return m.OldColumn058(ctx)
+	case wide.FieldColumn059:
+		return m.OldColumn059(ctx)
+	case wide.FieldColumn060:
+		return m.OldColumn060(ctx)
+	case wide.FieldColumn061:
+		return m.OldColumn061(ctx)
+	case wide.FieldColumn062:
+		return m.OldColumn062(ctx)
+	case wide.FieldColumn063:
+		return m.OldColumn063(ctx)
+	case wide.FieldColumn064:
+		return m.OldColumn064(ctx)
+	}
+	return nil, fmt.Errorf("unknown Wide field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WideMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case wide.FieldColumn001:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn001(v)
+		return nil
+	case wide.FieldColumn002:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn002(v)
+		return nil
+	case wide.FieldColumn003:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn003(v)
+		return nil
+	case wide.FieldColumn004:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn004(v)
+		return nil
+	case wide.FieldColumn005:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn005(v)
+		return nil
+	case wide.FieldColumn006:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn006(v)
+		return nil
+	case wide.FieldColumn007:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn007(v)
+		return nil
+	case wide.FieldColumn008:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn008(v)
+		return nil
+	case wide.FieldColumn009:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn009(v)
+		return nil
+	case wide.FieldColumn010:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn010(v)
+		return nil
+	case wide.FieldColumn011:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn011(v)
+		return nil
+	case wide.FieldColumn012:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn012(v)
+		return nil
+	case wide.FieldColumn013:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn013(v)
+		return nil
+	case wide.FieldColumn014:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn014(v)
+		return nil
+	case wide.FieldColumn015:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn015(v)
+		return nil
+	case wide.FieldColumn016:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn016(v)
+		return nil
+	case wide.FieldColumn017:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn017(v)
+		return nil
+	case wide.FieldColumn018:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn018(v)
+		return nil
+	case wide.FieldColumn019:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn019(v)
+		return nil
+	case wide.FieldColumn020:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn020(v)
+		return nil
+	case wide.FieldColumn021:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn021(v)
+		return nil
+	case wide.FieldColumn022:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn022(v)
+		return nil
+	case wide.FieldColumn023:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn023(v)
+		return nil
+	case wide.FieldColumn024:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn024(v)
+		return nil
+	case wide.FieldColumn025:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn025(v)
+		return nil
+	case wide.FieldColumn026:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn026(v)
+		return nil
+	case wide.FieldColumn027:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn027(v)
+		return nil
+	case wide.FieldColumn028:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn028(v)
+		return nil
+	case wide.FieldColumn029:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn029(v)
+		return nil
+	case wide.FieldColumn030:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn030(v)
+		return nil
+	case wide.FieldColumn031:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn031(v)
+		return nil
+	case wide.FieldColumn032:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn032(v)
+		return nil
+	case wide.FieldColumn033:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn033(v)
+		return nil
+	case wide.FieldColumn034:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn034(v)
+		return nil
+	case wide.FieldColumn035:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn035(v)
+		return nil
+	case wide.FieldColumn036:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn036(v)
+		return nil
+	case wide.FieldColumn037:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn037(v)
+		return nil
+	case wide.FieldColumn038:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn038(v)
+		return nil
+	case wide.FieldColumn039:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn039(v)
+		return nil
+	case wide.FieldColumn040:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn040(v)
+		return nil
+	case wide.FieldColumn041:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn041(v)
+		return nil
+	case wide.FieldColumn042:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn042(v)
+		return nil
+	case wide.FieldColumn043:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn043(v)
+		return nil
+	case wide.FieldColumn044:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn044(v)
+		return nil
+	case wide.FieldColumn045:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn045(v)
+		return nil
+	case wide.FieldColumn046:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn046(v)
+		return nil
+	case wide.FieldColumn047:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn047(v)
+		return nil
+	case wide.FieldColumn048:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn048(v)
+		return nil
+	case wide.FieldColumn049:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn049(v)
+		return nil
+	case wide.FieldColumn050:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn050(v)
+		return nil
+	case wide.FieldColumn051:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn051(v)
+		return nil
+	case wide.FieldColumn052:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn052(v)
+		return nil
+	case wide.FieldColumn053:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn053(v)
+		return nil
+	case wide.FieldColumn054:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn054(v)
+		return nil
+	case wide.FieldColumn055:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn055(v)
+		return nil
+	case wide.FieldColumn056:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn056(v)
+		return nil
+	case wide.FieldColumn057:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn057(v)
+		return nil
+	case wide.FieldColumn058:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn058(v)
+		return nil
+	case wide.FieldColumn059:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn059(v)
+		return nil
+	case wide.FieldColumn060:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn060(v)
+		return nil
+	case wide.FieldColumn061:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn061(v)
+		return nil
+	case wide.FieldColumn062:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn062(v)
+		return nil
+	case wide.FieldColumn063:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn063(v)
+		return nil
+	case wide.FieldColumn064:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn064(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Wide field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WideMutation) AddedFields() []string {
+	var fields []string
+	if m.addcolumn002 != nil {
+		fields = append(fields, wide.FieldColumn002)
+	}
+	if m.addcolumn003 != nil {
+		fields = append(fields, wide.FieldColumn003)
+	}
+	if m.addcolumn006 != nil {
+		fields = append(fields, wide.FieldColumn006)
+	}
+	if m.addcolumn007 != nil {
+		fields = append(fields, wide.FieldColumn007)
+	}
+	if m.addcolumn010 != nil {
+		fields = append(fields, wide.FieldColumn010)
+	}
+	if m.addcolumn011 != nil {
+		fields = append(fields, wide.FieldColumn011)
+	}
+	if m.addcolumn014 != nil {
+		fields = append(fields, wide.FieldColumn014)
+	}
+	if m.addcolumn015 != nil {
+		fields = append(fields, wide.FieldColumn015)
+	}
+	if m.addcolumn018 != nil {
+		fields = append(fields, wide.FieldColumn018)
+	}
+	if m.addcolumn019 != nil {
+		fields = append(fields, wide.FieldColumn019)
+	}
+	if m.addcolumn022 != nil {
+		fields = append(fields, wide.FieldColumn022)
+	}
+	if m.addcolumn023 != nil {
+		fields = append(fields, wide.FieldColumn023)
+	}
+	if m.addcolumn026 != nil {
+		fields = append(fields, wide.FieldColumn026)
+	}
+	if m.addcolumn027 != nil {
+		fields = append(fields, wide.FieldColumn027)
+	}
+	if m.addcolumn030 != nil {
+		fields = append(fields, wide.FieldColumn030)
+	}
+	if m.addcolumn031 != nil {
+		fields = append(fields, wide.FieldColumn031)
+	}
+	if m.addcolumn034 != nil {
+		fields = append(fields, wide.FieldColumn034)
+	}
+	if m.addcolumn035 != nil {
+		fields = append(fields, wide.FieldColumn035)
+	}
+	if m.addcolumn038 != nil {
+		fields = append(fields, wide.FieldColumn038)
+	}
+	if m.addcolumn039 != nil {
+		fields = append(fields, wide.FieldColumn039)
+	}
+	if m.addcolumn042 != nil {
+		fields = append(fields, wide.FieldColumn042)
+	}
+	if m.addcolumn043 != nil {
+		fields = append(fields, wide.FieldColumn043)
+	}
+	if m.addcolumn046 != nil {
+		fields = append(fields, wide.FieldColumn046)
+	}
+	if m.addcolumn047 != nil {
+		fields = append(fields, wide.FieldColumn047)
+	}
+	if m.addcolumn050 != nil {
+		fields = append(fields, wide.FieldColumn050)
+	}
+	if m.addcolumn051 != nil {
+		fields = append(fields, wide.FieldColumn051)
+	}
+	if m.addcolumn054 != nil {
+		fields = append(fields, wide.FieldColumn054)
+	}
+	if m.addcolumn055 != nil {
+		fields = append(fields, wide.FieldColumn055)
+	}
+	if m.addcolumn058 != nil {
+		fields = append(fields, wide.FieldColumn058)
+	}
+	if m.addcolumn059 != nil {
+		fields = append(fields, wide.FieldColumn059)
+	}
+	if m.addcolumn062 != nil {
+		fields = append(fields, wide.FieldColumn062)
+	}
+	if m.addcolumn063 != nil {
+		fields = append(fields, wide.FieldColumn063)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WideMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case wide.FieldColumn002:
+		return m.AddedColumn002()
+	case wide.FieldColumn003:
+		return m.AddedColumn003()
+	case wide.FieldColumn006:
+		return m.AddedColumn006()
+	case wide.FieldColumn007:
+		return m.AddedColumn007()
+	case wide.FieldColumn010:
+		return m.AddedColumn010()
+	case wide.FieldColumn011:
+		return m.AddedColumn011()
+	case wide.FieldColumn014:
+		return m.AddedColumn014()
+	case wide.FieldColumn015:
+		return m.AddedColumn015()
+	case wide.FieldColumn018:
+		return m.AddedColumn018()
+	case wide.FieldColumn019:
+		return m.AddedColumn019()
+	case wide.FieldColumn022:
+		return m.AddedColumn022()
+	case wide.FieldColumn023:
+		return m.AddedColumn023()
+	case wide.FieldColumn026:
+		return m.AddedColumn026()
+	case wide.FieldColumn027:
+		return m.AddedColumn027()
+	case wide.FieldColumn030:
+		return m.AddedColumn030()
+	case wide.FieldColumn031:
+		return m.AddedColumn031()
+	case wide.FieldColumn034:
+		return m.AddedColumn034()
+	case wide.FieldColumn035:
+		return m.AddedColumn035()
+	case wide.FieldColumn038:
+		return m.AddedColumn038()
+	case wide.FieldColumn039:
+		return m.AddedColumn039()
+	case wide.FieldColumn042:
+		return m.AddedColumn042()
+	case wide.FieldColumn043:
+		return m.AddedColumn043()
+	case wide.FieldColumn046:
+		return m.AddedColumn046()
+	case wide.FieldColumn047:
+		return m.AddedColumn047()
+	case wide.FieldColumn050:
+		return m.AddedColumn050()
+	case wide.FieldColumn051:
+		return m.AddedColumn051()
+	case wide.FieldColumn054:
+		return m.AddedColumn054()
+	case wide.FieldColumn055:
+		return m.AddedColumn055()
+	case wide.FieldColumn058:
+		return m.AddedColumn058()
+	case wide.FieldColumn059:
+		return m.AddedColumn059()
+	case wide.FieldColumn062:
+		return m.AddedColumn062()
+	case wide.FieldColumn063:
+		return m.AddedColumn063()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WideMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case wide.FieldColumn002:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn002(v)
+		return nil
+	case wide.FieldColumn003:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn003(v)
+		return nil
+	case wide.FieldColumn006:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn006(v)
+		return nil
+	case wide.FieldColumn007:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn007(v)
+		return nil
+	case wide.FieldColumn010:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn010(v)
+		return nil
+	case wide.FieldColumn011:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn011(v)
+		return nil
+	case wide.FieldColumn014:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn014(v)
+		return nil
+	case wide.FieldColumn015:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn015(v)
+		return nil
+	case wide.FieldColumn018:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn018(v)
+		return nil
+	case wide.FieldColumn019:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn019(v)
+		return nil
+	case wide.FieldColumn022:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn022(v)
+		return nil
+	case wide.FieldColumn023:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn023(v)
+		return nil
+	case wide.FieldColumn026:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn026(v)
+		return nil
+	case wide.FieldColumn027:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn027(v)
+		return nil
+	case wide.FieldColumn030:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn030(v)
+		return nil
+	case wide.FieldColumn031:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn031(v)
+		return nil
+	case wide.FieldColumn034:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn034(v)
+		return nil
+	case wide.FieldColumn035:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn035(v)
+		return nil
+	case wide.FieldColumn038:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn038(v)
+		return nil
+	case wide.FieldColumn039:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn039(v)
+		return nil
+	case wide.FieldColumn042:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn042(v)
+		return nil
+	case wide.FieldColumn043:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn043(v)
+		return nil
+	case wide.FieldColumn046:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn046(v)
+		return nil
+	case wide.FieldColumn047:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn047(v)
+		return nil
+	case wide.FieldColumn050:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn050(v)
+		return nil
+	case wide.FieldColumn051:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn051(v)
+		return nil
+	case wide.FieldColumn054:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn054(v)
+		return nil
+	case wide.FieldColumn055:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn055(v)
+		return nil
+	case wide.FieldColumn058:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn058(v)
+		return nil
+	case wide.FieldColumn059:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn059(v)
+		return nil
+	case wide.FieldColumn062:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn062(v)
+		return nil
+	case wide.FieldColumn063:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn063(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Wide numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WideMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WideMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WideMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Wide nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WideMutation) ResetField(name string) error {
+	switch name {
+	case wide.FieldColumn001:
+		m.ResetColumn001()
+		return nil
+	case wide.FieldColumn002:
+		m.ResetColumn002()
+		return nil
+	case wide.FieldColumn003:
+		m.ResetColumn003()
+		return nil
+	case wide.FieldColumn004:
+		m.ResetColumn004()
+		return nil
+	case wide.FieldColumn005:
+		m.ResetColumn005()
+		return nil
+	case wide.FieldColumn006:
+		m.ResetColumn006()
+		return nil
+	case wide.FieldColumn007:
+		m.ResetColumn007()
+		return nil
+	case wide.FieldColumn008:
+		m.ResetColumn008()
+		return nil
+	case wide.FieldColumn009:
+		m.ResetColumn009()
+		return nil
+	case wide.FieldColumn010:
+		m.ResetColumn010()
+		return nil
+	case wide.FieldColumn011:
+		m.ResetColumn011()
+		return nil
+	case wide.FieldColumn012:
+		m.ResetColumn012()
+		return nil
+	case wide.FieldColumn013:
+		m.ResetColumn013()
+		return nil
+	case wide.FieldColumn014:
+		m.ResetColumn014()
+		return nil
+	case wide.FieldColumn015:
+		m.ResetColumn015()
+		return nil
+	case wide.FieldColumn016:
+		m.ResetColumn016()
+		return nil
+	case wide.FieldColumn017:
+		m.ResetColumn017()
+		return nil
+	case wide.FieldColumn018:
+		m.ResetColumn018()
+		return nil
+	case wide.FieldColumn019:
+		m.ResetColumn019()
+		return nil
+	case wide.FieldColumn020:
+		m.ResetColumn020()
+		return nil
+	case wide.FieldColumn021:
+		m.ResetColumn021()
+		return nil
+	case wide.FieldColumn022:
+		m.ResetColumn022()
+		return nil
+	case wide.FieldColumn023:
+		m.ResetColumn023()
+		return nil
+	case wide.FieldColumn024:
+		m.ResetColumn024()
+		return nil
+	case wide.FieldColumn025:
+		m.ResetColumn025()
+		return nil
+	case wide.FieldColumn026:
+		m.ResetColumn026()
+		return nil
+	case wide.FieldColumn027:
+		m.ResetColumn027()
+		return nil
+	case wide.FieldColumn028:
+		m.ResetColumn028()
+		return nil
+	case wide.FieldColumn029:
+		m.ResetColumn029()
+		return nil
+	case wide.FieldColumn030:
+		m.ResetColumn030()
+		return nil
+	case wide.FieldColumn031:
+		m.ResetColumn031()
+		return nil
+	case wide.FieldColumn032:
+		m.ResetColumn032()
+		return nil
+	case wide.FieldColumn033:
+		m.ResetColumn033()
+		return nil
+	case wide.FieldColumn034:
+		m.ResetColumn034()
+		return nil
+	case wide.FieldColumn035:
+		m.ResetColumn035()
+		return nil
+	case wide.FieldColumn036:
+		m.ResetColumn036()
+		return nil
+	case wide.FieldColumn037:
+		m.ResetColumn037()
+		return nil
+	case wide.FieldColumn038:
+		m.ResetColumn038()
+		return nil
+	case wide.FieldColumn039:
+		m.ResetColumn039()
+		return nil
+	case wide.FieldColumn040:
+		m.ResetColumn040()
+		return nil
+	case wide.FieldColumn041:
+		m.ResetColumn041()
+		return nil
+	case wide.FieldColumn042:
+		m.ResetColumn042()
+		return nil
+	case wide.FieldColumn043:
+		m.ResetColumn043()
+		return nil
+	case wide.FieldColumn044:
+		m.ResetColumn044()
+		return nil
+	case wide.FieldColumn045:
+		m.ResetColumn045()
+		return nil
+	case wide.FieldColumn046:
+		m.ResetColumn046()
+		return nil
+	case wide.FieldColumn047:
+		m.ResetColumn047()
+		return nil
+	case wide.FieldColumn048:
+		m.ResetColumn048()
+		return nil
+	case wide.FieldColumn049:
+		m.ResetColumn049()
+		return nil
+	case wide.FieldColumn050:
+		m.ResetColumn050()
+		return nil
+	case wide.FieldColumn051:
+		m.ResetColumn051()
+		return nil
+	case wide.FieldColumn052:
+		m.ResetColumn052()
+		return nil
+	case wide.FieldColumn053:
+		m.ResetColumn053()
+		return nil
+	case wide.FieldColumn054:
+		m.ResetColumn054()
+		return nil
+	case wide.FieldColumn055:
+		m.ResetColumn055()
+		return nil
+	case wide.FieldColumn056:
+		m.ResetColumn056()
+		return nil
+	case wide.FieldColumn057:
+		m.ResetColumn057()
+		return nil
+	case wide.FieldColumn058:
+		m.ResetColumn058()
+		return nil
+	case wide.FieldColumn059:
+		m.ResetColumn059()
+		return nil
+	case wide.FieldColumn060:
+		m.ResetColumn060()
+		return nil
+	case wide.FieldColumn061:
+		m.ResetColumn061()
+		return nil
+	case wide.FieldColumn062:
+		m.ResetColumn062()
+		return nil
+	case wide.FieldColumn063:
+		m.ResetColumn063()
+		return nil
+	case wide.FieldColumn064:
+		m.ResetColumn064()
+		return nil
+	}
+	return fmt.Errorf("unknown Wide field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WideMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WideMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WideMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WideMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WideMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WideMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WideMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Wide unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WideMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Wide edge %s", name)
+}
@@ -8,3 +8,18 @@ import (
 
 // Book is the predicate function for book builders.
 type Book func(*sql.Selector)
+
+// Draft is the predicate function for draft builders.
+type Draft func(*sql.Selector)
+
+// Listing is the predicate function for listing builders.
+type Listing func(*sql.Selector)
+
+// PricePolicy is the predicate function for pricepolicy builders.
+type PricePolicy func(*sql.Selector)
+
+// UUIDBook is the predicate function for uuidbook builders.
+type UUIDBook func(*sql.Selector)
+
+// Wide is the predicate function for wide builders.
+type Wide func(*sql.Selector)
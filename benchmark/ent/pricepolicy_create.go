@@ -0,0 +1,656 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/book"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/pricepolicy"
+)
+
+// PricePolicyCreate is the builder for creating a PricePolicy entity.
+type PricePolicyCreate struct {
+	config
+	mutation *PricePolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetBookID sets the "book_id" field.
+func (ppc *PricePolicyCreate) SetBookID(i int) *PricePolicyCreate {
+	ppc.mutation.SetBookID(i)
+	return ppc
+}
+
+// SetPrice sets the "price" field.
+func (ppc *PricePolicyCreate) SetPrice(f float64) *PricePolicyCreate {
+	ppc.mutation.SetPrice(f)
+	return ppc
+}
+
+// SetStartDate sets the "start_date" field.
+func (ppc *PricePolicyCreate) SetStartDate(t time.Time) *PricePolicyCreate {
+	ppc.mutation.SetStartDate(t)
+	return ppc
+}
+
+// SetEndDate sets the "end_date" field.
+func (ppc *PricePolicyCreate) SetEndDate(t time.Time) *PricePolicyCreate {
+	ppc.mutation.SetEndDate(t)
+	return ppc
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (ppc *PricePolicyCreate) SetBook(b *Book) *PricePolicyCreate {
+	return ppc.SetBookID(b.ID)
+}
+
+// Mutation returns the PricePolicyMutation object of the builder.
+func (ppc *PricePolicyCreate) Mutation() *PricePolicyMutation {
+	return ppc.mutation
+}
+
+// Save creates the PricePolicy in the database.
+func (ppc *PricePolicyCreate) Save(ctx context.Context) (*PricePolicy, error) {
+	return withHooks(ctx, ppc.sqlSave, ppc.mutation, ppc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ppc *PricePolicyCreate) SaveX(ctx context.Context) *PricePolicy {
+	v, err := ppc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppc *PricePolicyCreate) Exec(ctx context.Context) error {
+	_, err := ppc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppc *PricePolicyCreate) ExecX(ctx context.Context) {
+	if err := ppc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppc *PricePolicyCreate) check() error {
+	if _, ok := ppc.mutation.BookID(); !ok {
+		return &ValidationError{Name: "book_id", err: errors.New(`ent: missing required field "PricePolicy.book_id"`)}
+	}
+	if _, ok := ppc.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "PricePolicy.price"`)}
+	}
+	if _, ok := ppc.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "PricePolicy.start_date"`)}
+	}
+	if _, ok := ppc.mutation.EndDate(); !ok {
+		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "PricePolicy.end_date"`)}
+	}
+	if _, ok := ppc.mutation.BookID(); !ok {
+		return &ValidationError{Name: "book", err: errors.New(`ent: missing required edge "PricePolicy.book"`)}
+	}
+	return nil
+}
+
+func (ppc *PricePolicyCreate) sqlSave(ctx context.Context) (*PricePolicy, error) {
+	if err := ppc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ppc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ppc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ppc.mutation.id = &_node.ID
+	ppc.mutation.done = true
+	return _node, nil
+}
+
+func (ppc *PricePolicyCreate) createSpec() (*PricePolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PricePolicy{config: ppc.config}
+		_spec = sqlgraph.NewCreateSpec(pricepolicy.Table, sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ppc.conflict
+	if value, ok := ppc.mutation.Price(); ok {
+		_spec.SetField(pricepolicy.FieldPrice, field.TypeFloat64, value)
+		_node.Price = value
+	}
+	if value, ok := ppc.mutation.StartDate(); ok {
+		_spec.SetField(pricepolicy.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := ppc.mutation.EndDate(); ok {
+		_spec.SetField(pricepolicy.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = value
+	}
+	if nodes := ppc.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pricepolicy.BookTable,
+			Columns: []string{pricepolicy.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BookID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PricePolicy.Create().
+//		SetBookID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PricePolicyUpsert) {
+//			SetBookID(v+v).
+//		}).
+//		Exec(ctx)
+func (ppc *PricePolicyCreate) OnConflict(opts ...sql.ConflictOption) *PricePolicyUpsertOne {
+	ppc.conflict = opts
+	return &PricePolicyUpsertOne{
+		create: ppc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PricePolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ppc *PricePolicyCreate) OnConflictColumns(columns ...string) *PricePolicyUpsertOne {
+	ppc.conflict = append(ppc.conflict, sql.ConflictColumns(columns...))
+	return &PricePolicyUpsertOne{
+		create: ppc,
+	}
+}
+
+type (
+	// PricePolicyUpsertOne is the builder for "upsert"-ing
+	//  one PricePolicy node.
+	PricePolicyUpsertOne struct {
+		create *PricePolicyCreate
+	}
+
+	// PricePolicyUpsert is the "OnConflict" setter.
+	PricePolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetBookID sets the "book_id" field.
+func (u *PricePolicyUpsert) SetBookID(v int) *PricePolicyUpsert {
+	u.Set(pricepolicy.FieldBookID, v)
+	return u
+}
+
+// UpdateBookID sets the "book_id" field to the value that was provided on create.
+func (u *PricePolicyUpsert) UpdateBookID() *PricePolicyUpsert {
+	u.SetExcluded(pricepolicy.FieldBookID)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *PricePolicyUpsert) SetPrice(v float64) *PricePolicyUpsert {
+	u.Set(pricepolicy.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *PricePolicyUpsert) UpdatePrice() *PricePolicyUpsert {
+	u.SetExcluded(pricepolicy.FieldPrice)
+	return u
+}
+
+// AddPrice adds v to the "price" field.
+func (u *PricePolicyUpsert) AddPrice(v float64) *PricePolicyUpsert {
+	u.Add(pricepolicy.FieldPrice, v)
+	return u
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *PricePolicyUpsert) SetStartDate(v time.Time) *PricePolicyUpsert {
+	u.Set(pricepolicy.FieldStartDate, v)
+	return u
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *PricePolicyUpsert) UpdateStartDate() *PricePolicyUpsert {
+	u.SetExcluded(pricepolicy.FieldStartDate)
+	return u
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *PricePolicyUpsert) SetEndDate(v time.Time) *PricePolicyUpsert {
+	u.Set(pricepolicy.FieldEndDate, v)
+	return u
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *PricePolicyUpsert) UpdateEndDate() *PricePolicyUpsert {
+	u.SetExcluded(pricepolicy.FieldEndDate)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PricePolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PricePolicyUpsertOne) UpdateNewValues() *PricePolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PricePolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PricePolicyUpsertOne) Ignore() *PricePolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PricePolicyUpsertOne) DoNothing() *PricePolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PricePolicyCreate.OnConflict
+// documentation for more info.
+func (u *PricePolicyUpsertOne) Update(set func(*PricePolicyUpsert)) *PricePolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PricePolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBookID sets the "book_id" field.
+func (u *PricePolicyUpsertOne) SetBookID(v int) *PricePolicyUpsertOne {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.SetBookID(v)
+	})
+}
+
+// UpdateBookID sets the "book_id" field to the value that was provided on create.
+func (u *PricePolicyUpsertOne) UpdateBookID() *PricePolicyUpsertOne {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.UpdateBookID()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *PricePolicyUpsertOne) SetPrice(v float64) *PricePolicyUpsertOne {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *PricePolicyUpsertOne) AddPrice(v float64) *PricePolicyUpsertOne {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *PricePolicyUpsertOne) UpdatePrice() *PricePolicyUpsertOne {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *PricePolicyUpsertOne) SetStartDate(v time.Time) *PricePolicyUpsertOne {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *PricePolicyUpsertOne) UpdateStartDate() *PricePolicyUpsertOne {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *PricePolicyUpsertOne) SetEndDate(v time.Time) *PricePolicyUpsertOne {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *PricePolicyUpsertOne) UpdateEndDate() *PricePolicyUpsertOne {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// Exec executes the query.
+func (u *PricePolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PricePolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PricePolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PricePolicyUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PricePolicyUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PricePolicyCreateBulk is the builder for creating many PricePolicy entities in bulk.
+type PricePolicyCreateBulk struct {
+	config
+	err      error
+	builders []*PricePolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PricePolicy entities in the database.
+func (ppcb *PricePolicyCreateBulk) Save(ctx context.Context) ([]*PricePolicy, error) {
+	if ppcb.err != nil {
+		return nil, ppcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ppcb.builders))
+	nodes := make([]*PricePolicy, len(ppcb.builders))
+	mutators := make([]Mutator, len(ppcb.builders))
+	for i := range ppcb.builders {
+		func(i int, root context.Context) {
+			builder := ppcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PricePolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ppcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ppcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ppcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ppcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppcb *PricePolicyCreateBulk) SaveX(ctx context.Context) []*PricePolicy {
+	v, err := ppcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppcb *PricePolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := ppcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppcb *PricePolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := ppcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PricePolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PricePolicyUpsert) {
+//			SetBookID(v+v).
+//		}).
+//		Exec(ctx)
+func (ppcb *PricePolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *PricePolicyUpsertBulk {
+	ppcb.conflict = opts
+	return &PricePolicyUpsertBulk{
+		create: ppcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PricePolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ppcb *PricePolicyCreateBulk) OnConflictColumns(columns ...string) *PricePolicyUpsertBulk {
+	ppcb.conflict = append(ppcb.conflict, sql.ConflictColumns(columns...))
+	return &PricePolicyUpsertBulk{
+		create: ppcb,
+	}
+}
+
+// PricePolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of PricePolicy nodes.
+type PricePolicyUpsertBulk struct {
+	create *PricePolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PricePolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PricePolicyUpsertBulk) UpdateNewValues() *PricePolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PricePolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PricePolicyUpsertBulk) Ignore() *PricePolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PricePolicyUpsertBulk) DoNothing() *PricePolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PricePolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *PricePolicyUpsertBulk) Update(set func(*PricePolicyUpsert)) *PricePolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PricePolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBookID sets the "book_id" field.
+func (u *PricePolicyUpsertBulk) SetBookID(v int) *PricePolicyUpsertBulk {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.SetBookID(v)
+	})
+}
+
+// UpdateBookID sets the "book_id" field to the value that was provided on create.
+func (u *PricePolicyUpsertBulk) UpdateBookID() *PricePolicyUpsertBulk {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.UpdateBookID()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *PricePolicyUpsertBulk) SetPrice(v float64) *PricePolicyUpsertBulk {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *PricePolicyUpsertBulk) AddPrice(v float64) *PricePolicyUpsertBulk {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *PricePolicyUpsertBulk) UpdatePrice() *PricePolicyUpsertBulk {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *PricePolicyUpsertBulk) SetStartDate(v time.Time) *PricePolicyUpsertBulk {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *PricePolicyUpsertBulk) UpdateStartDate() *PricePolicyUpsertBulk {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *PricePolicyUpsertBulk) SetEndDate(v time.Time) *PricePolicyUpsertBulk {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *PricePolicyUpsertBulk) UpdateEndDate() *PricePolicyUpsertBulk {
+	return u.Update(func(s *PricePolicyUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// Exec executes the query.
+func (u *PricePolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PricePolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PricePolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PricePolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/book"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/pricepolicy"
+)
+
+// PricePolicyQuery is the builder for querying PricePolicy entities.
+type PricePolicyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []pricepolicy.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PricePolicy
+	withBook   *BookQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PricePolicyQuery builder.
+func (ppq *PricePolicyQuery) Where(ps ...predicate.PricePolicy) *PricePolicyQuery {
+	ppq.predicates = append(ppq.predicates, ps...)
+	return ppq
+}
+
+// Limit the number of records to be returned by this query.
+func (ppq *PricePolicyQuery) Limit(limit int) *PricePolicyQuery {
+	ppq.ctx.Limit = &limit
+	return ppq
+}
+
+// Offset to start from.
+func (ppq *PricePolicyQuery) Offset(offset int) *PricePolicyQuery {
+	ppq.ctx.Offset = &offset
+	return ppq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ppq *PricePolicyQuery) Unique(unique bool) *PricePolicyQuery {
+	ppq.ctx.Unique = &unique
+	return ppq
+}
+
+// Order specifies how the records should be ordered.
+func (ppq *PricePolicyQuery) Order(o ...pricepolicy.OrderOption) *PricePolicyQuery {
+	ppq.order = append(ppq.order, o...)
+	return ppq
+}
+
+// QueryBook chains the current query on the "book" edge.
+func (ppq *PricePolicyQuery) QueryBook() *BookQuery {
+	query := (&BookClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pricepolicy.Table, pricepolicy.FieldID, selector),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pricepolicy.BookTable, pricepolicy.BookColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PricePolicy entity from the query.
+// Returns a *NotFoundError when no PricePolicy was found.
+func (ppq *PricePolicyQuery) First(ctx context.Context) (*PricePolicy, error) {
+	nodes, err := ppq.Limit(1).All(setContextOp(ctx, ppq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pricepolicy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ppq *PricePolicyQuery) FirstX(ctx context.Context) *PricePolicy {
+	node, err := ppq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PricePolicy ID from the query.
+// Returns a *NotFoundError when no PricePolicy ID was found.
+func (ppq *PricePolicyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ppq.Limit(1).IDs(setContextOp(ctx, ppq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pricepolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ppq *PricePolicyQuery) FirstIDX(ctx context.Context) int {
+	id, err := ppq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PricePolicy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PricePolicy entity is found.
+// Returns a *NotFoundError when no PricePolicy entities are found.
+func (ppq *PricePolicyQuery) Only(ctx context.Context) (*PricePolicy, error) {
+	nodes, err := ppq.Limit(2).All(setContextOp(ctx, ppq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pricepolicy.Label}
+	default:
+		return nil, &NotSingularError{pricepolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ppq *PricePolicyQuery) OnlyX(ctx context.Context) *PricePolicy {
+	node, err := ppq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PricePolicy ID in the query.
+// Returns a *NotSingularError when more than one PricePolicy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ppq *PricePolicyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ppq.Limit(2).IDs(setContextOp(ctx, ppq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pricepolicy.Label}
+	default:
+		err = &NotSingularError{pricepolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ppq *PricePolicyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ppq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PricePolicies.
+func (ppq *PricePolicyQuery) All(ctx context.Context) ([]*PricePolicy, error) {
+	ctx = setContextOp(ctx, ppq.ctx, "All")
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PricePolicy, *PricePolicyQuery]()
+	return withInterceptors[[]*PricePolicy](ctx, ppq, qr, ppq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ppq *PricePolicyQuery) AllX(ctx context.Context) []*PricePolicy {
+	nodes, err := ppq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PricePolicy IDs.
+func (ppq *PricePolicyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ppq.ctx.Unique == nil && ppq.path != nil {
+		ppq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ppq.ctx, "IDs")
+	if err = ppq.Select(pricepolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ppq *PricePolicyQuery) IDsX(ctx context.Context) []int {
+	ids, err := ppq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ppq *PricePolicyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ppq.ctx, "Count")
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ppq, querierCount[*PricePolicyQuery](), ppq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ppq *PricePolicyQuery) CountX(ctx context.Context) int {
+	count, err := ppq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ppq *PricePolicyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ppq.ctx, "Exist")
+	switch _, err := ppq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ppq *PricePolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := ppq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PricePolicyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ppq *PricePolicyQuery) Clone() *PricePolicyQuery {
+	if ppq == nil {
+		return nil
+	}
+	return &PricePolicyQuery{
+		config:     ppq.config,
+		ctx:        ppq.ctx.Clone(),
+		order:      append([]pricepolicy.OrderOption{}, ppq.order...),
+		inters:     append([]Interceptor{}, ppq.inters...),
+		predicates: append([]predicate.PricePolicy{}, ppq.predicates...),
+		withBook:   ppq.withBook.Clone(),
+		// clone intermediate query.
+		sql:  ppq.sql.Clone(),
+		path: ppq.path,
+	}
+}
+
+// WithBook tells the query-builder to eager-load the nodes that are connected to
+// the "book" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *PricePolicyQuery) WithBook(opts ...func(*BookQuery)) *PricePolicyQuery {
+	query := (&BookClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withBook = query
+	return ppq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BookID int `json:"book_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PricePolicy.Query().
+//		GroupBy(pricepolicy.FieldBookID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ppq *PricePolicyQuery) GroupBy(field string, fields ...string) *PricePolicyGroupBy {
+	ppq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PricePolicyGroupBy{build: ppq}
+	grbuild.flds = &ppq.ctx.Fields
+	grbuild.label = pricepolicy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BookID int `json:"book_id,omitempty"`
+//	}
+//
+//	client.PricePolicy.Query().
+//		Select(pricepolicy.FieldBookID).
+//		Scan(ctx, &v)
+func (ppq *PricePolicyQuery) Select(fields ...string) *PricePolicySelect {
+	ppq.ctx.Fields = append(ppq.ctx.Fields, fields...)
+	sbuild := &PricePolicySelect{PricePolicyQuery: ppq}
+	sbuild.label = pricepolicy.Label
+	sbuild.flds, sbuild.scan = &ppq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PricePolicySelect configured with the given aggregations.
+func (ppq *PricePolicyQuery) Aggregate(fns ...AggregateFunc) *PricePolicySelect {
+	return ppq.Select().Aggregate(fns...)
+}
+
+func (ppq *PricePolicyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ppq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ppq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ppq.ctx.Fields {
+		if !pricepolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ppq.path != nil {
+		prev, err := ppq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ppq.sql = prev
+	}
+	return nil
+}
+
+func (ppq *PricePolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PricePolicy, error) {
+	var (
+		nodes       = []*PricePolicy{}
+		_spec       = ppq.querySpec()
+		loadedTypes = [1]bool{
+			ppq.withBook != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PricePolicy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PricePolicy{config: ppq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ppq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ppq.withBook; query != nil {
+		if err := ppq.loadBook(ctx, query, nodes, nil,
+			func(n *PricePolicy, e *Book) { n.Edges.Book = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ppq *PricePolicyQuery) loadBook(ctx context.Context, query *BookQuery, nodes []*PricePolicy, init func(*PricePolicy), assign func(*PricePolicy, *Book)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PricePolicy)
+	for i := range nodes {
+		fk := nodes[i].BookID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(book.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "book_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ppq *PricePolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ppq.querySpec()
+	_spec.Node.Columns = ppq.ctx.Fields
+	if len(ppq.ctx.Fields) > 0 {
+		_spec.Unique = ppq.ctx.Unique != nil && *ppq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ppq.driver, _spec)
+}
+
+func (ppq *PricePolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(pricepolicy.Table, pricepolicy.Columns, sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt))
+	_spec.From = ppq.sql
+	if unique := ppq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ppq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ppq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricepolicy.FieldID)
+		for i := range fields {
+			if fields[i] != pricepolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ppq.withBook != nil {
+			_spec.Node.AddColumnOnce(pricepolicy.FieldBookID)
+		}
+	}
+	if ps := ppq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ppq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ppq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ppq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ppq *PricePolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ppq.driver.Dialect())
+	t1 := builder.Table(pricepolicy.Table)
+	columns := ppq.ctx.Fields
+	if len(columns) == 0 {
+		columns = pricepolicy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ppq.sql != nil {
+		selector = ppq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ppq.ctx.Unique != nil && *ppq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ppq.predicates {
+		p(selector)
+	}
+	for _, p := range ppq.order {
+		p(selector)
+	}
+	if offset := ppq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ppq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PricePolicyGroupBy is the group-by builder for PricePolicy entities.
+type PricePolicyGroupBy struct {
+	selector
+	build *PricePolicyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ppgb *PricePolicyGroupBy) Aggregate(fns ...AggregateFunc) *PricePolicyGroupBy {
+	ppgb.fns = append(ppgb.fns, fns...)
+	return ppgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ppgb *PricePolicyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ppgb.build.ctx, "GroupBy")
+	if err := ppgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PricePolicyQuery, *PricePolicyGroupBy](ctx, ppgb.build, ppgb, ppgb.build.inters, v)
+}
+
+func (ppgb *PricePolicyGroupBy) sqlScan(ctx context.Context, root *PricePolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ppgb.fns))
+	for _, fn := range ppgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ppgb.flds)+len(ppgb.fns))
+		for _, f := range *ppgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ppgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ppgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PricePolicySelect is the builder for selecting fields of PricePolicy entities.
+type PricePolicySelect struct {
+	*PricePolicyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pps *PricePolicySelect) Aggregate(fns ...AggregateFunc) *PricePolicySelect {
+	pps.fns = append(pps.fns, fns...)
+	return pps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pps *PricePolicySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pps.ctx, "Select")
+	if err := pps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PricePolicyQuery, *PricePolicySelect](ctx, pps.PricePolicyQuery, pps, pps.inters, v)
+}
+
+func (pps *PricePolicySelect) sqlScan(ctx context.Context, root *PricePolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pps.fns))
+	for _, fn := range pps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/book"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/pricepolicy"
+)
+
+// PricePolicyUpdate is the builder for updating PricePolicy entities.
+type PricePolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PricePolicyMutation
+}
+
+// Where appends a list predicates to the PricePolicyUpdate builder.
+func (ppu *PricePolicyUpdate) Where(ps ...predicate.PricePolicy) *PricePolicyUpdate {
+	ppu.mutation.Where(ps...)
+	return ppu
+}
+
+// SetBookID sets the "book_id" field.
+func (ppu *PricePolicyUpdate) SetBookID(i int) *PricePolicyUpdate {
+	ppu.mutation.SetBookID(i)
+	return ppu
+}
+
+// SetNillableBookID sets the "book_id" field if the given value is not nil.
+func (ppu *PricePolicyUpdate) SetNillableBookID(i *int) *PricePolicyUpdate {
+	if i != nil {
+		ppu.SetBookID(*i)
+	}
+	return ppu
+}
+
+// SetPrice sets the "price" field.
+func (ppu *PricePolicyUpdate) SetPrice(f float64) *PricePolicyUpdate {
+	ppu.mutation.ResetPrice()
+	ppu.mutation.SetPrice(f)
+	return ppu
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (ppu *PricePolicyUpdate) SetNillablePrice(f *float64) *PricePolicyUpdate {
+	if f != nil {
+		ppu.SetPrice(*f)
+	}
+	return ppu
+}
+
+// AddPrice adds f to the "price" field.
+func (ppu *PricePolicyUpdate) AddPrice(f float64) *PricePolicyUpdate {
+	ppu.mutation.AddPrice(f)
+	return ppu
+}
+
+// SetStartDate sets the "start_date" field.
+func (ppu *PricePolicyUpdate) SetStartDate(t time.Time) *PricePolicyUpdate {
+	ppu.mutation.SetStartDate(t)
+	return ppu
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (ppu *PricePolicyUpdate) SetNillableStartDate(t *time.Time) *PricePolicyUpdate {
+	if t != nil {
+		ppu.SetStartDate(*t)
+	}
+	return ppu
+}
+
+// SetEndDate sets the "end_date" field.
+func (ppu *PricePolicyUpdate) SetEndDate(t time.Time) *PricePolicyUpdate {
+	ppu.mutation.SetEndDate(t)
+	return ppu
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (ppu *PricePolicyUpdate) SetNillableEndDate(t *time.Time) *PricePolicyUpdate {
+	if t != nil {
+		ppu.SetEndDate(*t)
+	}
+	return ppu
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (ppu *PricePolicyUpdate) SetBook(b *Book) *PricePolicyUpdate {
+	return ppu.SetBookID(b.ID)
+}
+
+// Mutation returns the PricePolicyMutation object of the builder.
+func (ppu *PricePolicyUpdate) Mutation() *PricePolicyMutation {
+	return ppu.mutation
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (ppu *PricePolicyUpdate) ClearBook() *PricePolicyUpdate {
+	ppu.mutation.ClearBook()
+	return ppu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ppu *PricePolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ppu.sqlSave, ppu.mutation, ppu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppu *PricePolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := ppu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ppu *PricePolicyUpdate) Exec(ctx context.Context) error {
+	_, err := ppu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppu *PricePolicyUpdate) ExecX(ctx context.Context) {
+	if err := ppu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppu *PricePolicyUpdate) check() error {
+	if _, ok := ppu.mutation.BookID(); ppu.mutation.BookCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PricePolicy.book"`)
+	}
+	return nil
+}
+
+func (ppu *PricePolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ppu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pricepolicy.Table, pricepolicy.Columns, sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt))
+	if ps := ppu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppu.mutation.Price(); ok {
+		_spec.SetField(pricepolicy.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := ppu.mutation.AddedPrice(); ok {
+		_spec.AddField(pricepolicy.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := ppu.mutation.StartDate(); ok {
+		_spec.SetField(pricepolicy.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := ppu.mutation.EndDate(); ok {
+		_spec.SetField(pricepolicy.FieldEndDate, field.TypeTime, value)
+	}
+	if ppu.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pricepolicy.BookTable,
+			Columns: []string{pricepolicy.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pricepolicy.BookTable,
+			Columns: []string{pricepolicy.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ppu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricepolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ppu.mutation.done = true
+	return n, nil
+}
+
+// PricePolicyUpdateOne is the builder for updating a single PricePolicy entity.
+type PricePolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PricePolicyMutation
+}
+
+// SetBookID sets the "book_id" field.
+func (ppuo *PricePolicyUpdateOne) SetBookID(i int) *PricePolicyUpdateOne {
+	ppuo.mutation.SetBookID(i)
+	return ppuo
+}
+
+// SetNillableBookID sets the "book_id" field if the given value is not nil.
+func (ppuo *PricePolicyUpdateOne) SetNillableBookID(i *int) *PricePolicyUpdateOne {
+	if i != nil {
+		ppuo.SetBookID(*i)
+	}
+	return ppuo
+}
+
+// SetPrice sets the "price" field.
+func (ppuo *PricePolicyUpdateOne) SetPrice(f float64) *PricePolicyUpdateOne {
+	ppuo.mutation.ResetPrice()
+	ppuo.mutation.SetPrice(f)
+	return ppuo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (ppuo *PricePolicyUpdateOne) SetNillablePrice(f *float64) *PricePolicyUpdateOne {
+	if f != nil {
+		ppuo.SetPrice(*f)
+	}
+	return ppuo
+}
+
+// AddPrice adds f to the "price" field.
+func (ppuo *PricePolicyUpdateOne) AddPrice(f float64) *PricePolicyUpdateOne {
+	ppuo.mutation.AddPrice(f)
+	return ppuo
+}
+
+// SetStartDate sets the "start_date" field.
+func (ppuo *PricePolicyUpdateOne) SetStartDate(t time.Time) *PricePolicyUpdateOne {
+	ppuo.mutation.SetStartDate(t)
+	return ppuo
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (ppuo *PricePolicyUpdateOne) SetNillableStartDate(t *time.Time) *PricePolicyUpdateOne {
+	if t != nil {
+		ppuo.SetStartDate(*t)
+	}
+	return ppuo
+}
+
+// SetEndDate sets the "end_date" field.
+func (ppuo *PricePolicyUpdateOne) SetEndDate(t time.Time) *PricePolicyUpdateOne {
+	ppuo.mutation.SetEndDate(t)
+	return ppuo
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (ppuo *PricePolicyUpdateOne) SetNillableEndDate(t *time.Time) *PricePolicyUpdateOne {
+	if t != nil {
+		ppuo.SetEndDate(*t)
+	}
+	return ppuo
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (ppuo *PricePolicyUpdateOne) SetBook(b *Book) *PricePolicyUpdateOne {
+	return ppuo.SetBookID(b.ID)
+}
+
+// Mutation returns the PricePolicyMutation object of the builder.
+func (ppuo *PricePolicyUpdateOne) Mutation() *PricePolicyMutation {
+	return ppuo.mutation
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (ppuo *PricePolicyUpdateOne) ClearBook() *PricePolicyUpdateOne {
+	ppuo.mutation.ClearBook()
+	return ppuo
+}
+
+// Where appends a list predicates to the PricePolicyUpdate builder.
+func (ppuo *PricePolicyUpdateOne) Where(ps ...predicate.PricePolicy) *PricePolicyUpdateOne {
+	ppuo.mutation.Where(ps...)
+	return ppuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ppuo *PricePolicyUpdateOne) Select(field string, fields ...string) *PricePolicyUpdateOne {
+	ppuo.fields = append([]string{field}, fields...)
+	return ppuo
+}
+
+// Save executes the query and returns the updated PricePolicy entity.
+func (ppuo *PricePolicyUpdateOne) Save(ctx context.Context) (*PricePolicy, error) {
+	return withHooks(ctx, ppuo.sqlSave, ppuo.mutation, ppuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppuo *PricePolicyUpdateOne) SaveX(ctx context.Context) *PricePolicy {
+	node, err := ppuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ppuo *PricePolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := ppuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppuo *PricePolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := ppuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppuo *PricePolicyUpdateOne) check() error {
+	if _, ok := ppuo.mutation.BookID(); ppuo.mutation.BookCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PricePolicy.book"`)
+	}
+	return nil
+}
+
+func (ppuo *PricePolicyUpdateOne) sqlSave(ctx context.Context) (_node *PricePolicy, err error) {
+	if err := ppuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pricepolicy.Table, pricepolicy.Columns, sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt))
+	id, ok := ppuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PricePolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ppuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricepolicy.FieldID)
+		for _, f := range fields {
+			if !pricepolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pricepolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ppuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppuo.mutation.Price(); ok {
+		_spec.SetField(pricepolicy.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := ppuo.mutation.AddedPrice(); ok {
+		_spec.AddField(pricepolicy.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := ppuo.mutation.StartDate(); ok {
+		_spec.SetField(pricepolicy.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := ppuo.mutation.EndDate(); ok {
+		_spec.SetField(pricepolicy.FieldEndDate, field.TypeTime, value)
+	}
+	if ppuo.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pricepolicy.BookTable,
+			Columns: []string{pricepolicy.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pricepolicy.BookTable,
+			Columns: []string{pricepolicy.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PricePolicy{config: ppuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ppuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricepolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ppuo.mutation.done = true
+	return _node, nil
+}
@@ -2,8 +2,19 @@
 
 package ent
 
+import (
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/book"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/schema"
+)
+
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	bookFields := schema.Book{}.Fields()
+	_ = bookFields
+	// bookDescVersion is the schema descriptor for version field.
+	bookDescVersion := bookFields[6].Descriptor()
+	// book.DefaultVersion holds the default value on creation for the version field.
+	book.DefaultVersion = bookDescVersion.Default.(int)
 }
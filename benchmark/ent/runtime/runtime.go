@@ -2,7 +2,7 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/runtime.go
+// The schema-stitching logic is generated in github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/runtime.go
 
 const (
 	Version = "v0.13.1"                                         // Version of ent codegen.
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -13,16 +14,20 @@ type Book struct {
 // Fields of the Book.
 func (Book) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("isbn"),
+		field.String("isbn").Unique(),
 		field.String("title"),
 		field.String("author"),
 		field.String("genre"),
 		field.Int("quantity"),
 		field.Time("publicized_at"),
+		field.Int("version").Default(1),
+		field.Time("deleted_at").Optional().Nillable(),
 	}
 }
 
 // Edges of the Book.
 func (Book) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("price_policies", PricePolicy.Type),
+	}
 }
@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Draft holds the schema definition for the Draft entity.
+type Draft struct {
+	ent.Schema
+}
+
+// Fields of the Draft.
+func (Draft) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").Optional().Nillable(),
+		field.String("subtitle").Optional().Nillable(),
+		field.String("author").Optional().Nillable(),
+		field.String("translator").Optional().Nillable(),
+		field.Int("pages").Optional().Nillable(),
+		field.Float("rating").Optional().Nillable(),
+		field.Bool("illustrated").Optional().Nillable(),
+		field.Time("submitted_at").Optional().Nillable(),
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// Listing holds the schema definition for the Listing entity.
+type Listing struct {
+	ent.Schema
+}
+
+// Fields of the Listing.
+func (Listing) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("reference", uuid.UUID{}).Unique(),
+		field.JSON("attributes", model.ListingAttributes{}),
+		// field.Strings is stored as JSON, so the TEXT[] column needs a type scanning the array itself.
+		field.Other("tags", model.TextArray{}).
+			SchemaType(map[string]string{dialect.Postgres: "text[]"}),
+		field.Float("price").
+			SchemaType(map[string]string{dialect.Postgres: "numeric(10,2)"}),
+		field.Bytes("cover"),
+		field.String("subtitle").Optional().Nillable(),
+		field.Time("discontinued_at").Optional().Nillable(),
+		field.Time("listed_at"),
+	}
+}
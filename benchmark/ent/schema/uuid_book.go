@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UUIDBook holds the schema definition for the UUIDBook entity.
+type UUIDBook struct {
+	ent.Schema
+}
+
+// Fields of the UUIDBook.
+func (UUIDBook) Fields() []ent.Field {
+	return []ent.Field{
+		// Without a Default function the key is generated by the database unless it is set, so both can be
+		// measured on the same entity.
+		field.UUID("id", uuid.UUID{}).
+			Annotations(entsql.Default("gen_random_uuid()")),
+		field.String("isbn").Unique(),
+		field.String("title"),
+		field.String("author"),
+		field.String("genre"),
+		field.Int("quantity"),
+		field.Time("publicized_at"),
+	}
+}
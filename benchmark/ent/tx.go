@@ -14,6 +14,16 @@ type Tx struct {
 	config
 	// Book is the client for interacting with the Book builders.
 	Book *BookClient
+	// Draft is the client for interacting with the Draft builders.
+	Draft *DraftClient
+	// Listing is the client for interacting with the Listing builders.
+	Listing *ListingClient
+	// PricePolicy is the client for interacting with the PricePolicy builders.
+	PricePolicy *PricePolicyClient
+	// UUIDBook is the client for interacting with the UUIDBook builders.
+	UUIDBook *UUIDBookClient
+	// Wide is the client for interacting with the Wide builders.
+	Wide *WideClient
 
 	// lazily loaded.
 	client     *Client
@@ -146,6 +156,11 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Book = NewBookClient(tx.config)
+	tx.Draft = NewDraftClient(tx.config)
+	tx.Listing = NewListingClient(tx.config)
+	tx.PricePolicy = NewPricePolicyClient(tx.config)
+	tx.UUIDBook = NewUUIDBookClient(tx.config)
+	tx.Wide = NewWideClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/uuidbook"
+)
+
+// UUIDBook is the model entity for the UUIDBook schema.
+type UUIDBook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Isbn holds the value of the "isbn" field.
+	Isbn string `json:"isbn,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Author holds the value of the "author" field.
+	Author string `json:"author,omitempty"`
+	// Genre holds the value of the "genre" field.
+	Genre string `json:"genre,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// PublicizedAt holds the value of the "publicized_at" field.
+	PublicizedAt time.Time `json:"publicized_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UUIDBook) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case uuidbook.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case uuidbook.FieldIsbn, uuidbook.FieldTitle, uuidbook.FieldAuthor, uuidbook.FieldGenre:
+			values[i] = new(sql.NullString)
+		case uuidbook.FieldPublicizedAt:
+			values[i] = new(sql.NullTime)
+		case uuidbook.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UUIDBook fields.
+func (ub *UUIDBook) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case uuidbook.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ub.ID = *value
+			}
+		case uuidbook.FieldIsbn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field isbn", values[i])
+			} else if value.Valid {
+				ub.Isbn = value.String
+			}
+		case uuidbook.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				ub.Title = value.String
+			}
+		case uuidbook.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				ub.Author = value.String
+			}
+		case uuidbook.FieldGenre:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field genre", values[i])
+			} else if value.Valid {
+				ub.Genre = value.String
+			}
+		case uuidbook.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				ub.Quantity = int(value.Int64)
+			}
+		case uuidbook.FieldPublicizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field publicized_at", values[i])
+			} else if value.Valid {
+				ub.PublicizedAt = value.Time
+			}
+		default:
+			ub.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UUIDBook.
+// This includes values selected through modifiers, order, etc.
+func (ub *UUIDBook) Value(name string) (ent.Value, error) {
+	return ub.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UUIDBook.
+// Note that you need to call UUIDBook.Unwrap() before calling this method if this UUIDBook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ub *UUIDBook) Update() *UUIDBookUpdateOne {
+	return NewUUIDBookClient(ub.config).UpdateOne(ub)
+}
+
+// Unwrap unwraps the UUIDBook entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ub *UUIDBook) Unwrap() *UUIDBook {
+	_tx, ok := ub.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UUIDBook is not a transactional entity")
+	}
+	ub.config.driver = _tx.drv
+	return ub
+}
+
+// String implements the fmt.Stringer.
+func (ub *UUIDBook) String() string {
+	var builder strings.Builder
+	builder.WriteString("UUIDBook(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ub.ID))
+	builder.WriteString("isbn=")
+	builder.WriteString(ub.Isbn)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(ub.Title)
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(ub.Author)
+	builder.WriteString(", ")
+	builder.WriteString("genre=")
+	builder.WriteString(ub.Genre)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", ub.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("publicized_at=")
+	builder.WriteString(ub.PublicizedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UUIDBooks is a parsable slice of UUIDBook.
+type UUIDBooks []*UUIDBook
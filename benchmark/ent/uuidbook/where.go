@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package uuidbook
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLTE(FieldID, id))
+}
+
+// Isbn applies equality check predicate on the "isbn" field. It's identical to IsbnEQ.
+func Isbn(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldIsbn, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldTitle, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldAuthor, v))
+}
+
+// Genre applies equality check predicate on the "genre" field. It's identical to GenreEQ.
+func Genre(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldGenre, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldQuantity, v))
+}
+
+// PublicizedAt applies equality check predicate on the "publicized_at" field. It's identical to PublicizedAtEQ.
+func PublicizedAt(v time.Time) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldPublicizedAt, v))
+}
+
+// IsbnEQ applies the EQ predicate on the "isbn" field.
+func IsbnEQ(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldIsbn, v))
+}
+
+// IsbnNEQ applies the NEQ predicate on the "isbn" field.
+func IsbnNEQ(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNEQ(FieldIsbn, v))
+}
+
+// IsbnIn applies the In predicate on the "isbn" field.
+func IsbnIn(vs ...string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldIn(FieldIsbn, vs...))
+}
+
+// IsbnNotIn applies the NotIn predicate on the "isbn" field.
+func IsbnNotIn(vs ...string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNotIn(FieldIsbn, vs...))
+}
+
+// IsbnGT applies the GT predicate on the "isbn" field.
+func IsbnGT(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGT(FieldIsbn, v))
+}
+
+// IsbnGTE applies the GTE predicate on the "isbn" field.
+func IsbnGTE(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGTE(FieldIsbn, v))
+}
+
+// IsbnLT applies the LT predicate on the "isbn" field.
+func IsbnLT(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLT(FieldIsbn, v))
+}
+
+// IsbnLTE applies the LTE predicate on the "isbn" field.
+func IsbnLTE(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLTE(FieldIsbn, v))
+}
+
+// IsbnContains applies the Contains predicate on the "isbn" field.
+func IsbnContains(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldContains(FieldIsbn, v))
+}
+
+// IsbnHasPrefix applies the HasPrefix predicate on the "isbn" field.
+func IsbnHasPrefix(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldHasPrefix(FieldIsbn, v))
+}
+
+// IsbnHasSuffix applies the HasSuffix predicate on the "isbn" field.
+func IsbnHasSuffix(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldHasSuffix(FieldIsbn, v))
+}
+
+// IsbnEqualFold applies the EqualFold predicate on the "isbn" field.
+func IsbnEqualFold(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEqualFold(FieldIsbn, v))
+}
+
+// IsbnContainsFold applies the ContainsFold predicate on the "isbn" field.
+func IsbnContainsFold(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldContainsFold(FieldIsbn, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// GenreEQ applies the EQ predicate on the "genre" field.
+func GenreEQ(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldGenre, v))
+}
+
+// GenreNEQ applies the NEQ predicate on the "genre" field.
+func GenreNEQ(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNEQ(FieldGenre, v))
+}
+
+// GenreIn applies the In predicate on the "genre" field.
+func GenreIn(vs ...string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldIn(FieldGenre, vs...))
+}
+
+// GenreNotIn applies the NotIn predicate on the "genre" field.
+func GenreNotIn(vs ...string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNotIn(FieldGenre, vs...))
+}
+
+// GenreGT applies the GT predicate on the "genre" field.
+func GenreGT(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGT(FieldGenre, v))
+}
+
+// GenreGTE applies the GTE predicate on the "genre" field.
+func GenreGTE(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGTE(FieldGenre, v))
+}
+
+// GenreLT applies the LT predicate on the "genre" field.
+func GenreLT(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLT(FieldGenre, v))
+}
+
+// GenreLTE applies the LTE predicate on the "genre" field.
+func GenreLTE(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLTE(FieldGenre, v))
+}
+
+// GenreContains applies the Contains predicate on the "genre" field.
+func GenreContains(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldContains(FieldGenre, v))
+}
+
+// GenreHasPrefix applies the HasPrefix predicate on the "genre" field.
+func GenreHasPrefix(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldHasPrefix(FieldGenre, v))
+}
+
+// GenreHasSuffix applies the HasSuffix predicate on the "genre" field.
+func GenreHasSuffix(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldHasSuffix(FieldGenre, v))
+}
+
+// GenreEqualFold applies the EqualFold predicate on the "genre" field.
+func GenreEqualFold(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEqualFold(FieldGenre, v))
+}
+
+// GenreContainsFold applies the ContainsFold predicate on the "genre" field.
+func GenreContainsFold(v string) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldContainsFold(FieldGenre, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLTE(FieldQuantity, v))
+}
+
+// PublicizedAtEQ applies the EQ predicate on the "publicized_at" field.
+func PublicizedAtEQ(v time.Time) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldEQ(FieldPublicizedAt, v))
+}
+
+// PublicizedAtNEQ applies the NEQ predicate on the "publicized_at" field.
+func PublicizedAtNEQ(v time.Time) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNEQ(FieldPublicizedAt, v))
+}
+
+// PublicizedAtIn applies the In predicate on the "publicized_at" field.
+func PublicizedAtIn(vs ...time.Time) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldIn(FieldPublicizedAt, vs...))
+}
+
+// PublicizedAtNotIn applies the NotIn predicate on the "publicized_at" field.
+func PublicizedAtNotIn(vs ...time.Time) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldNotIn(FieldPublicizedAt, vs...))
+}
+
+// PublicizedAtGT applies the GT predicate on the "publicized_at" field.
+func PublicizedAtGT(v time.Time) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGT(FieldPublicizedAt, v))
+}
+
+// PublicizedAtGTE applies the GTE predicate on the "publicized_at" field.
+func PublicizedAtGTE(v time.Time) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldGTE(FieldPublicizedAt, v))
+}
+
+// PublicizedAtLT applies the LT predicate on the "publicized_at" field.
+func PublicizedAtLT(v time.Time) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLT(FieldPublicizedAt, v))
+}
+
+// PublicizedAtLTE applies the LTE predicate on the "publicized_at" field.
+func PublicizedAtLTE(v time.Time) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.FieldLTE(FieldPublicizedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UUIDBook) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UUIDBook) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UUIDBook) predicate.UUIDBook {
+	return predicate.UUIDBook(sql.NotPredicates(p))
+}
@@ -0,0 +1,776 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/uuidbook"
+)
+
+// UUIDBookCreate is the builder for creating a UUIDBook entity.
+type UUIDBookCreate struct {
+	config
+	mutation *UUIDBookMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetIsbn sets the "isbn" field.
+func (ubc *UUIDBookCreate) SetIsbn(s string) *UUIDBookCreate {
+	ubc.mutation.SetIsbn(s)
+	return ubc
+}
+
+// SetTitle sets the "title" field.
+func (ubc *UUIDBookCreate) SetTitle(s string) *UUIDBookCreate {
+	ubc.mutation.SetTitle(s)
+	return ubc
+}
+
+// SetAuthor sets the "author" field.
+func (ubc *UUIDBookCreate) SetAuthor(s string) *UUIDBookCreate {
+	ubc.mutation.SetAuthor(s)
+	return ubc
+}
+
+// SetGenre sets the "genre" field.
+func (ubc *UUIDBookCreate) SetGenre(s string) *UUIDBookCreate {
+	ubc.mutation.SetGenre(s)
+	return ubc
+}
+
+// SetQuantity sets the "quantity" field.
+func (ubc *UUIDBookCreate) SetQuantity(i int) *UUIDBookCreate {
+	ubc.mutation.SetQuantity(i)
+	return ubc
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (ubc *UUIDBookCreate) SetPublicizedAt(t time.Time) *UUIDBookCreate {
+	ubc.mutation.SetPublicizedAt(t)
+	return ubc
+}
+
+// SetID sets the "id" field.
+func (ubc *UUIDBookCreate) SetID(u uuid.UUID) *UUIDBookCreate {
+	ubc.mutation.SetID(u)
+	return ubc
+}
+
+// Mutation returns the UUIDBookMutation object of the builder.
+func (ubc *UUIDBookCreate) Mutation() *UUIDBookMutation {
+	return ubc.mutation
+}
+
+// Save creates the UUIDBook in the database.
+func (ubc *UUIDBookCreate) Save(ctx context.Context) (*UUIDBook, error) {
+	return withHooks(ctx, ubc.sqlSave, ubc.mutation, ubc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ubc *UUIDBookCreate) SaveX(ctx context.Context) *UUIDBook {
+	v, err := ubc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ubc *UUIDBookCreate) Exec(ctx context.Context) error {
+	_, err := ubc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ubc *UUIDBookCreate) ExecX(ctx context.Context) {
+	if err := ubc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ubc *UUIDBookCreate) check() error {
+	if _, ok := ubc.mutation.Isbn(); !ok {
+		return &ValidationError{Name: "isbn", err: errors.New(`ent: missing required field "UUIDBook.isbn"`)}
+	}
+	if _, ok := ubc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "UUIDBook.title"`)}
+	}
+	if _, ok := ubc.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required field "UUIDBook.author"`)}
+	}
+	if _, ok := ubc.mutation.Genre(); !ok {
+		return &ValidationError{Name: "genre", err: errors.New(`ent: missing required field "UUIDBook.genre"`)}
+	}
+	if _, ok := ubc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "UUIDBook.quantity"`)}
+	}
+	if _, ok := ubc.mutation.PublicizedAt(); !ok {
+		return &ValidationError{Name: "publicized_at", err: errors.New(`ent: missing required field "UUIDBook.publicized_at"`)}
+	}
+	return nil
+}
+
+func (ubc *UUIDBookCreate) sqlSave(ctx context.Context) (*UUIDBook, error) {
+	if err := ubc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ubc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ubc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ubc.mutation.id = &_node.ID
+	ubc.mutation.done = true
+	return _node, nil
+}
+
+func (ubc *UUIDBookCreate) createSpec() (*UUIDBook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UUIDBook{config: ubc.config}
+		_spec = sqlgraph.NewCreateSpec(uuidbook.Table, sqlgraph.NewFieldSpec(uuidbook.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = ubc.conflict
+	if id, ok := ubc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ubc.mutation.Isbn(); ok {
+		_spec.SetField(uuidbook.FieldIsbn, field.TypeString, value)
+		_node.Isbn = value
+	}
+	if value, ok := ubc.mutation.Title(); ok {
+		_spec.SetField(uuidbook.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := ubc.mutation.Author(); ok {
+		_spec.SetField(uuidbook.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := ubc.mutation.Genre(); ok {
+		_spec.SetField(uuidbook.FieldGenre, field.TypeString, value)
+		_node.Genre = value
+	}
+	if value, ok := ubc.mutation.Quantity(); ok {
+		_spec.SetField(uuidbook.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := ubc.mutation.PublicizedAt(); ok {
+		_spec.SetField(uuidbook.FieldPublicizedAt, field.TypeTime, value)
+		_node.PublicizedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UUIDBook.Create().
+//		SetIsbn(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UUIDBookUpsert) {
+//			SetIsbn(v+v).
+//		}).
+//		Exec(ctx)
+func (ubc *UUIDBookCreate) OnConflict(opts ...sql.ConflictOption) *UUIDBookUpsertOne {
+	ubc.conflict = opts
+	return &UUIDBookUpsertOne{
+		create: ubc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UUIDBook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ubc *UUIDBookCreate) OnConflictColumns(columns ...string) *UUIDBookUpsertOne {
+	ubc.conflict = append(ubc.conflict, sql.ConflictColumns(columns...))
+	return &UUIDBookUpsertOne{
+		create: ubc,
+	}
+}
+
+type (
+	// UUIDBookUpsertOne is the builder for "upsert"-ing
+	//  one UUIDBook node.
+	UUIDBookUpsertOne struct {
+		create *UUIDBookCreate
+	}
+
+	// UUIDBookUpsert is the "OnConflict" setter.
+	UUIDBookUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetIsbn sets the "isbn" field.
+func (u *UUIDBookUpsert) SetIsbn(v string) *UUIDBookUpsert {
+	u.Set(uuidbook.FieldIsbn, v)
+	return u
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *UUIDBookUpsert) UpdateIsbn() *UUIDBookUpsert {
+	u.SetExcluded(uuidbook.FieldIsbn)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *UUIDBookUpsert) SetTitle(v string) *UUIDBookUpsert {
+	u.Set(uuidbook.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *UUIDBookUpsert) UpdateTitle() *UUIDBookUpsert {
+	u.SetExcluded(uuidbook.FieldTitle)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *UUIDBookUpsert) SetAuthor(v string) *UUIDBookUpsert {
+	u.Set(uuidbook.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *UUIDBookUpsert) UpdateAuthor() *UUIDBookUpsert {
+	u.SetExcluded(uuidbook.FieldAuthor)
+	return u
+}
+
+// SetGenre sets the "genre" field.
+func (u *UUIDBookUpsert) SetGenre(v string) *UUIDBookUpsert {
+	u.Set(uuidbook.FieldGenre, v)
+	return u
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *UUIDBookUpsert) UpdateGenre() *UUIDBookUpsert {
+	u.SetExcluded(uuidbook.FieldGenre)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *UUIDBookUpsert) SetQuantity(v int) *UUIDBookUpsert {
+	u.Set(uuidbook.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *UUIDBookUpsert) UpdateQuantity() *UUIDBookUpsert {
+	u.SetExcluded(uuidbook.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *UUIDBookUpsert) AddQuantity(v int) *UUIDBookUpsert {
+	u.Add(uuidbook.FieldQuantity, v)
+	return u
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *UUIDBookUpsert) SetPublicizedAt(v time.Time) *UUIDBookUpsert {
+	u.Set(uuidbook.FieldPublicizedAt, v)
+	return u
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *UUIDBookUpsert) UpdatePublicizedAt() *UUIDBookUpsert {
+	u.SetExcluded(uuidbook.FieldPublicizedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UUIDBook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(uuidbook.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UUIDBookUpsertOne) UpdateNewValues() *UUIDBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(uuidbook.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UUIDBook.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UUIDBookUpsertOne) Ignore() *UUIDBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UUIDBookUpsertOne) DoNothing() *UUIDBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UUIDBookCreate.OnConflict
+// documentation for more info.
+func (u *UUIDBookUpsertOne) Update(set func(*UUIDBookUpsert)) *UUIDBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UUIDBookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetIsbn sets the "isbn" field.
+func (u *UUIDBookUpsertOne) SetIsbn(v string) *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.SetIsbn(v)
+	})
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *UUIDBookUpsertOne) UpdateIsbn() *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.UpdateIsbn()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *UUIDBookUpsertOne) SetTitle(v string) *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *UUIDBookUpsertOne) UpdateTitle() *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *UUIDBookUpsertOne) SetAuthor(v string) *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *UUIDBookUpsertOne) UpdateAuthor() *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetGenre sets the "genre" field.
+func (u *UUIDBookUpsertOne) SetGenre(v string) *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.SetGenre(v)
+	})
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *UUIDBookUpsertOne) UpdateGenre() *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.UpdateGenre()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *UUIDBookUpsertOne) SetQuantity(v int) *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *UUIDBookUpsertOne) AddQuantity(v int) *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *UUIDBookUpsertOne) UpdateQuantity() *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *UUIDBookUpsertOne) SetPublicizedAt(v time.Time) *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.SetPublicizedAt(v)
+	})
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *UUIDBookUpsertOne) UpdatePublicizedAt() *UUIDBookUpsertOne {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.UpdatePublicizedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UUIDBookUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UUIDBookCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UUIDBookUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UUIDBookUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UUIDBookUpsertOne.ID is not supported by MySQL driver. Use UUIDBookUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UUIDBookUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UUIDBookCreateBulk is the builder for creating many UUIDBook entities in bulk.
+type UUIDBookCreateBulk struct {
+	config
+	err      error
+	builders []*UUIDBookCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UUIDBook entities in the database.
+func (ubcb *UUIDBookCreateBulk) Save(ctx context.Context) ([]*UUIDBook, error) {
+	if ubcb.err != nil {
+		return nil, ubcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ubcb.builders))
+	nodes := make([]*UUIDBook, len(ubcb.builders))
+	mutators := make([]Mutator, len(ubcb.builders))
+	for i := range ubcb.builders {
+		func(i int, root context.Context) {
+			builder := ubcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UUIDBookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ubcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ubcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ubcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ubcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ubcb *UUIDBookCreateBulk) SaveX(ctx context.Context) []*UUIDBook {
+	v, err := ubcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ubcb *UUIDBookCreateBulk) Exec(ctx context.Context) error {
+	_, err := ubcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ubcb *UUIDBookCreateBulk) ExecX(ctx context.Context) {
+	if err := ubcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UUIDBook.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UUIDBookUpsert) {
+//			SetIsbn(v+v).
+//		}).
+//		Exec(ctx)
+func (ubcb *UUIDBookCreateBulk) OnConflict(opts ...sql.ConflictOption) *UUIDBookUpsertBulk {
+	ubcb.conflict = opts
+	return &UUIDBookUpsertBulk{
+		create: ubcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UUIDBook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ubcb *UUIDBookCreateBulk) OnConflictColumns(columns ...string) *UUIDBookUpsertBulk {
+	ubcb.conflict = append(ubcb.conflict, sql.ConflictColumns(columns...))
+	return &UUIDBookUpsertBulk{
+		create: ubcb,
+	}
+}
+
+// UUIDBookUpsertBulk is the builder for "upsert"-ing
+// a bulk of UUIDBook nodes.
+type UUIDBookUpsertBulk struct {
+	create *UUIDBookCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UUIDBook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(uuidbook.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UUIDBookUpsertBulk) UpdateNewValues() *UUIDBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(uuidbook.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UUIDBook.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UUIDBookUpsertBulk) Ignore() *UUIDBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UUIDBookUpsertBulk) DoNothing() *UUIDBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UUIDBookCreateBulk.OnConflict
+// documentation for more info.
+func (u *UUIDBookUpsertBulk) Update(set func(*UUIDBookUpsert)) *UUIDBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UUIDBookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetIsbn sets the "isbn" field.
+func (u *UUIDBookUpsertBulk) SetIsbn(v string) *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.SetIsbn(v)
+	})
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *UUIDBookUpsertBulk) UpdateIsbn() *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.UpdateIsbn()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *UUIDBookUpsertBulk) SetTitle(v string) *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *UUIDBookUpsertBulk) UpdateTitle() *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *UUIDBookUpsertBulk) SetAuthor(v string) *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *UUIDBookUpsertBulk) UpdateAuthor() *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetGenre sets the "genre" field.
+func (u *UUIDBookUpsertBulk) SetGenre(v string) *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.SetGenre(v)
+	})
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *UUIDBookUpsertBulk) UpdateGenre() *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.UpdateGenre()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *UUIDBookUpsertBulk) SetQuantity(v int) *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *UUIDBookUpsertBulk) AddQuantity(v int) *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *UUIDBookUpsertBulk) UpdateQuantity() *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *UUIDBookUpsertBulk) SetPublicizedAt(v time.Time) *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.SetPublicizedAt(v)
+	})
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *UUIDBookUpsertBulk) UpdatePublicizedAt() *UUIDBookUpsertBulk {
+	return u.Update(func(s *UUIDBookUpsert) {
+		s.UpdatePublicizedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UUIDBookUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UUIDBookCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UUIDBookCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UUIDBookUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
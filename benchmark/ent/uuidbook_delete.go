@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/uuidbook"
+)
+
+// UUIDBookDelete is the builder for deleting a UUIDBook entity.
+type UUIDBookDelete struct {
+	config
+	hooks    []Hook
+	mutation *UUIDBookMutation
+}
+
+// Where appends a list predicates to the UUIDBookDelete builder.
+func (ubd *UUIDBookDelete) Where(ps ...predicate.UUIDBook) *UUIDBookDelete {
+	ubd.mutation.Where(ps...)
+	return ubd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ubd *UUIDBookDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ubd.sqlExec, ubd.mutation, ubd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ubd *UUIDBookDelete) ExecX(ctx context.Context) int {
+	n, err := ubd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ubd *UUIDBookDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(uuidbook.Table, sqlgraph.NewFieldSpec(uuidbook.FieldID, field.TypeUUID))
+	if ps := ubd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ubd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ubd.mutation.done = true
+	return affected, err
+}
+
+// UUIDBookDeleteOne is the builder for deleting a single UUIDBook entity.
+type UUIDBookDeleteOne struct {
+	ubd *UUIDBookDelete
+}
+
+// Where appends a list predicates to the UUIDBookDelete builder.
+func (ubdo *UUIDBookDeleteOne) Where(ps ...predicate.UUIDBook) *UUIDBookDeleteOne {
+	ubdo.ubd.mutation.Where(ps...)
+	return ubdo
+}
+
+// Exec executes the deletion query.
+func (ubdo *UUIDBookDeleteOne) Exec(ctx context.Context) error {
+	n, err := ubdo.ubd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{uuidbook.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ubdo *UUIDBookDeleteOne) ExecX(ctx context.Context) {
+	if err := ubdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/uuidbook"
+)
+
+// UUIDBookQuery is the builder for querying UUIDBook entities.
+type UUIDBookQuery struct {
+	config
+	ctx        *QueryContext
+	order      []uuidbook.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UUIDBook
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UUIDBookQuery builder.
+func (ubq *UUIDBookQuery) Where(ps ...predicate.UUIDBook) *UUIDBookQuery {
+	ubq.predicates = append(ubq.predicates, ps...)
+	return ubq
+}
+
+// Limit the number of records to be returned by this query.
+func (ubq *UUIDBookQuery) Limit(limit int) *UUIDBookQuery {
+	ubq.ctx.Limit = &limit
+	return ubq
+}
+
+// Offset to start from.
+func (ubq *UUIDBookQuery) Offset(offset int) *UUIDBookQuery {
+	ubq.ctx.Offset = &offset
+	return ubq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ubq *UUIDBookQuery) Unique(unique bool) *UUIDBookQuery {
+	ubq.ctx.Unique = &unique
+	return ubq
+}
+
+// Order specifies how the records should be ordered.
+func (ubq *UUIDBookQuery) Order(o ...uuidbook.OrderOption) *UUIDBookQuery {
+	ubq.order = append(ubq.order, o...)
+	return ubq
+}
+
+// First returns the first UUIDBook entity from the query.
+// Returns a *NotFoundError when no UUIDBook was found.
+func (ubq *UUIDBookQuery) First(ctx context.Context) (*UUIDBook, error) {
+	nodes, err := ubq.Limit(1).All(setContextOp(ctx, ubq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{uuidbook.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ubq *UUIDBookQuery) FirstX(ctx context.Context) *UUIDBook {
+	node, err := ubq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UUIDBook ID from the query.
+// Returns a *NotFoundError when no UUIDBook ID was found.
+func (ubq *UUIDBookQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ubq.Limit(1).IDs(setContextOp(ctx, ubq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{uuidbook.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ubq *UUIDBookQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ubq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UUIDBook entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UUIDBook entity is found.
+// Returns a *NotFoundError when no UUIDBook entities are found.
+func (ubq *UUIDBookQuery) Only(ctx context.Context) (*UUIDBook, error) {
+	nodes, err := ubq.Limit(2).All(setContextOp(ctx, ubq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{uuidbook.Label}
+	default:
+		return nil, &NotSingularError{uuidbook.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ubq *UUIDBookQuery) OnlyX(ctx context.Context) *UUIDBook {
+	node, err := ubq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UUIDBook ID in the query.
+// Returns a *NotSingularError when more than one UUIDBook ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ubq *UUIDBookQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ubq.Limit(2).IDs(setContextOp(ctx, ubq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{uuidbook.Label}
+	default:
+		err = &NotSingularError{uuidbook.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ubq *UUIDBookQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ubq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UUIDBooks.
+func (ubq *UUIDBookQuery) All(ctx context.Context) ([]*UUIDBook, error) {
+	ctx = setContextOp(ctx, ubq.ctx, "All")
+	if err := ubq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UUIDBook, *UUIDBookQuery]()
+	return withInterceptors[[]*UUIDBook](ctx, ubq, qr, ubq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ubq *UUIDBookQuery) AllX(ctx context.Context) []*UUIDBook {
+	nodes, err := ubq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UUIDBook IDs.
+func (ubq *UUIDBookQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ubq.ctx.Unique == nil && ubq.path != nil {
+		ubq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ubq.ctx, "IDs")
+	if err = ubq.Select(uuidbook.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ubq *UUIDBookQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ubq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ubq *UUIDBookQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ubq.ctx, "Count")
+	if err := ubq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ubq, querierCount[*UUIDBookQuery](), ubq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ubq *UUIDBookQuery) CountX(ctx context.Context) int {
+	count, err := ubq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ubq *UUIDBookQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ubq.ctx, "Exist")
+	switch _, err := ubq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ubq *UUIDBookQuery) ExistX(ctx context.Context) bool {
+	exist, err := ubq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UUIDBookQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ubq *UUIDBookQuery) Clone() *UUIDBookQuery {
+	if ubq == nil {
+		return nil
+	}
+	return &UUIDBookQuery{
+		config:     ubq.config,
+		ctx:        ubq.ctx.Clone(),
+		order:      append([]uuidbook.OrderOption{}, ubq.order...),
+		inters:     append([]Interceptor{}, ubq.inters...),
+		predicates: append([]predicate.UUIDBook{}, ubq.predicates...),
+		// clone intermediate query.
+		sql:  ubq.sql.Clone(),
+		path: ubq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Isbn string `json:"isbn,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UUIDBook.Query().
+//		GroupBy(uuidbook.FieldIsbn).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ubq *UUIDBookQuery) GroupBy(field string, fields ...string) *UUIDBookGroupBy {
+	ubq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UUIDBookGroupBy{build: ubq}
+	grbuild.flds = &ubq.ctx.Fields
+	grbuild.label = uuidbook.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Isbn string `json:"isbn,omitempty"`
+//	}
+//
+//	client.UUIDBook.Query().
+//		Select(uuidbook.FieldIsbn).
+//		Scan(ctx, &v)
+func (ubq *UUIDBookQuery) Select(fields ...string) *UUIDBookSelect {
+	ubq.ctx.Fields = append(ubq.ctx.Fields, fields...)
+	sbuild := &UUIDBookSelect{UUIDBookQuery: ubq}
+	sbuild.label = uuidbook.Label
+	sbuild.flds, sbuild.scan = &ubq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UUIDBookSelect configured with the given aggregations.
+func (ubq *UUIDBookQuery) Aggregate(fns ...AggregateFunc) *UUIDBookSelect {
+	return ubq.Select().Aggregate(fns...)
+}
+
+func (ubq *UUIDBookQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ubq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ubq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ubq.ctx.Fields {
+		if !uuidbook.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ubq.path != nil {
+		prev, err := ubq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ubq.sql = prev
+	}
+	return nil
+}
+
+func (ubq *UUIDBookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UUIDBook, error) {
+	var (
+		nodes = []*UUIDBook{}
+		_spec = ubq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UUIDBook).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UUIDBook{config: ubq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ubq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ubq *UUIDBookQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ubq.querySpec()
+	_spec.Node.Columns = ubq.ctx.Fields
+	if len(ubq.ctx.Fields) > 0 {
+		_spec.Unique = ubq.ctx.Unique != nil && *ubq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ubq.driver, _spec)
+}
+
+func (ubq *UUIDBookQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(uuidbook.Table, uuidbook.Columns, sqlgraph.NewFieldSpec(uuidbook.FieldID, field.TypeUUID))
+	_spec.From = ubq.sql
+	if unique := ubq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ubq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ubq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, uuidbook.FieldID)
+		for i := range fields {
+			if fields[i] != uuidbook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ubq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ubq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ubq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ubq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ubq *UUIDBookQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ubq.driver.Dialect())
+	t1 := builder.Table(uuidbook.Table)
+	columns := ubq.ctx.Fields
+	if len(columns) == 0 {
+		columns = uuidbook.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ubq.sql != nil {
+		selector = ubq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ubq.ctx.Unique != nil && *ubq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ubq.predicates {
+		p(selector)
+	}
+	for _, p := range ubq.order {
+		p(selector)
+	}
+	if offset := ubq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ubq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UUIDBookGroupBy is the group-by builder for UUIDBook entities.
+type UUIDBookGroupBy struct {
+	selector
+	build *UUIDBookQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ubgb *UUIDBookGroupBy) Aggregate(fns ...AggregateFunc) *UUIDBookGroupBy {
+	ubgb.fns = append(ubgb.fns, fns...)
+	return ubgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ubgb *UUIDBookGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ubgb.build.ctx, "GroupBy")
+	if err := ubgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UUIDBookQuery, *UUIDBookGroupBy](ctx, ubgb.build, ubgb, ubgb.build.inters, v)
+}
+
+func (ubgb *UUIDBookGroupBy) sqlScan(ctx context.Context, root *UUIDBookQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ubgb.fns))
+	for _, fn := range ubgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ubgb.flds)+len(ubgb.fns))
+		for _, f := range *ubgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ubgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ubgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UUIDBookSelect is the builder for selecting fields of UUIDBook entities.
+type UUIDBookSelect struct {
+	*UUIDBookQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ubs *UUIDBookSelect) Aggregate(fns ...AggregateFunc) *UUIDBookSelect {
+	ubs.fns = append(ubs.fns, fns...)
+	return ubs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ubs *UUIDBookSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ubs.ctx, "Select")
+	if err := ubs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UUIDBookQuery, *UUIDBookSelect](ctx, ubs.UUIDBookQuery, ubs, ubs.inters, v)
+}
+
+func (ubs *UUIDBookSelect) sqlScan(ctx context.Context, root *UUIDBookQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ubs.fns))
+	for _, fn := range ubs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ubs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ubs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/uuidbook"
+)
+
+// UUIDBookUpdate is the builder for updating UUIDBook entities.
+type UUIDBookUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UUIDBookMutation
+}
+
+// Where appends a list predicates to the UUIDBookUpdate builder.
+func (ubu *UUIDBookUpdate) Where(ps ...predicate.UUIDBook) *UUIDBookUpdate {
+	ubu.mutation.Where(ps...)
+	return ubu
+}
+
+// SetIsbn sets the "isbn" field.
+func (ubu *UUIDBookUpdate) SetIsbn(s string) *UUIDBookUpdate {
+	ubu.mutation.SetIsbn(s)
+	return ubu
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (ubu *UUIDBookUpdate) SetNillableIsbn(s *string) *UUIDBookUpdate {
+	if s != nil {
+		ubu.SetIsbn(*s)
+	}
+	return ubu
+}
+
+// SetTitle sets the "title" field.
+func (ubu *UUIDBookUpdate) SetTitle(s string) *UUIDBookUpdate {
+	ubu.mutation.SetTitle(s)
+	return ubu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ubu *UUIDBookUpdate) SetNillableTitle(s *string) *UUIDBookUpdate {
+	if s != nil {
+		ubu.SetTitle(*s)
+	}
+	return ubu
+}
+
+// SetAuthor sets the "author" field.
+func (ubu *UUIDBookUpdate) SetAuthor(s string) *UUIDBookUpdate {
+	ubu.mutation.SetAuthor(s)
+	return ubu
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (ubu *UUIDBookUpdate) SetNillableAuthor(s *string) *UUIDBookUpdate {
+	if s != nil {
+		ubu.SetAuthor(*s)
+	}
+	return ubu
+}
+
+// SetGenre sets the "genre" field.
+func (ubu *UUIDBookUpdate) SetGenre(s string) *UUIDBookUpdate {
+	ubu.mutation.SetGenre(s)
+	return ubu
+}
+
+// SetNillableGenre sets the "genre" field if the given value is not nil.
+func (ubu *UUIDBookUpdate) SetNillableGenre(s *string) *UUIDBookUpdate {
+	if s != nil {
+		ubu.SetGenre(*s)
+	}
+	return ubu
+}
+
+// SetQuantity sets the "quantity" field.
+func (ubu *UUIDBookUpdate) SetQuantity(i int) *UUIDBookUpdate {
+	ubu.mutation.ResetQuantity()
+	ubu.mutation.SetQuantity(i)
+	return ubu
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (ubu *UUIDBookUpdate) SetNillableQuantity(i *int) *UUIDBookUpdate {
+	if i != nil {
+		ubu.SetQuantity(*i)
+	}
+	return ubu
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (ubu *UUIDBookUpdate) AddQuantity(i int) *UUIDBookUpdate {
+	ubu.mutation.AddQuantity(i)
+	return ubu
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (ubu *UUIDBookUpdate) SetPublicizedAt(t time.Time) *UUIDBookUpdate {
+	ubu.mutation.SetPublicizedAt(t)
+	return ubu
+}
+
+// SetNillablePublicizedAt sets the "publicized_at" field if the given value is not nil.
+func (ubu *UUIDBookUpdate) SetNillablePublicizedAt(t *time.Time) *UUIDBookUpdate {
+	if t != nil {
+		ubu.SetPublicizedAt(*t)
+	}
+	return ubu
+}
+
+// Mutation returns the UUIDBookMutation object of the builder.
+func (ubu *UUIDBookUpdate) Mutation() *UUIDBookMutation {
+	return ubu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ubu *UUIDBookUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ubu.sqlSave, ubu.mutation, ubu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ubu *UUIDBookUpdate) SaveX(ctx context.Context) int {
+	affected, err := ubu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ubu *UUIDBookUpdate) Exec(ctx context.Context) error {
+	_, err := ubu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ubu *UUIDBookUpdate) ExecX(ctx context.Context) {
+	if err := ubu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ubu *UUIDBookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(uuidbook.Table, uuidbook.Columns, sqlgraph.NewFieldSpec(uuidbook.FieldID, field.TypeUUID))
+	if ps := ubu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ubu.mutation.Isbn(); ok {
+		_spec.SetField(uuidbook.FieldIsbn, field.TypeString, value)
+	}
+	if value, ok := ubu.mutation.Title(); ok {
+		_spec.SetField(uuidbook.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := ubu.mutation.Author(); ok {
+		_spec.SetField(uuidbook.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := ubu.mutation.Genre(); ok {
+		_spec.SetField(uuidbook.FieldGenre, field.TypeString, value)
+	}
+	if value, ok := ubu.mutation.Quantity(); ok {
+		_spec.SetField(uuidbook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := ubu.mutation.AddedQuantity(); ok {
+		_spec.AddField(uuidbook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := ubu.mutation.PublicizedAt(); ok {
+		_spec.SetField(uuidbook.FieldPublicizedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ubu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{uuidbook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ubu.mutation.done = true
+	return n, nil
+}
+
+// UUIDBookUpdateOne is the builder for updating a single UUIDBook entity.
+type UUIDBookUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UUIDBookMutation
+}
+
+// SetIsbn sets the "isbn" field.
+func (ubuo *UUIDBookUpdateOne) SetIsbn(s string) *UUIDBookUpdateOne {
+	ubuo.mutation.SetIsbn(s)
+	return ubuo
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (ubuo *UUIDBookUpdateOne) SetNillableIsbn(s *string) *UUIDBookUpdateOne {
+	if s != nil {
+		ubuo.SetIsbn(*s)
+	}
+	return ubuo
+}
+
+// SetTitle sets the "title" field.
+func (ubuo *UUIDBookUpdateOne) SetTitle(s string) *UUIDBookUpdateOne {
+	ubuo.mutation.SetTitle(s)
+	return ubuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ubuo *UUIDBookUpdateOne) SetNillableTitle(s *string) *UUIDBookUpdateOne {
+	if s != nil {
+		ubuo.SetTitle(*s)
+	}
+	return ubuo
+}
+
+// SetAuthor sets the "author" field.
+func (ubuo *UUIDBookUpdateOne) SetAuthor(s string) *UUIDBookUpdateOne {
+	ubuo.mutation.SetAuthor(s)
+	return ubuo
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (ubuo *UUIDBookUpdateOne) SetNillableAuthor(s *string) *UUIDBookUpdateOne {
+	if s != nil {
+		ubuo.SetAuthor(*s)
+	}
+	return ubuo
+}
+
+// SetGenre sets the "genre" field.
+func (ubuo *UUIDBookUpdateOne) SetGenre(s string) *UUIDBookUpdateOne {
+	ubuo.mutation.SetGenre(s)
+	return ubuo
+}
+
+// SetNillableGenre sets the "genre" field if the given value is not nil.
+func (ubuo *UUIDBookUpdateOne) SetNillableGenre(s *string) *UUIDBookUpdateOne {
+	if s != nil {
+		ubuo.SetGenre(*s)
+	}
+	return ubuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (ubuo *UUIDBookUpdateOne) SetQuantity(i int) *UUIDBookUpdateOne {
+	ubuo.mutation.ResetQuantity()
+	ubuo.mutation.SetQuantity(i)
+	return ubuo
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (ubuo *UUIDBookUpdateOne) SetNillableQuantity(i *int) *UUIDBookUpdateOne {
+	if i != nil {
+		ubuo.SetQuantity(*i)
+	}
+	return ubuo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (ubuo *UUIDBookUpdateOne) AddQuantity(i int) *UUIDBookUpdateOne {
+	ubuo.mutation.AddQuantity(i)
+	return ubuo
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (ubuo *UUIDBookUpdateOne) SetPublicizedAt(t time.Time) *UUIDBookUpdateOne {
+	ubuo.mutation.SetPublicizedAt(t)
+	return ubuo
+}
+
+// SetNillablePublicizedAt sets the "publicized_at" field if the given value is not nil.
+func (ubuo *UUIDBookUpdateOne) SetNillablePublicizedAt(t *time.Time) *UUIDBookUpdateOne {
+	if t != nil {
+		ubuo.SetPublicizedAt(*t)
+	}
+	return ubuo
+}
+
+// Mutation returns the UUIDBookMutation object of the builder.
+func (ubuo *UUIDBookUpdateOne) Mutation() *UUIDBookMutation {
+	return ubuo.mutation
+}
+
+// Where appends a list predicates to the UUIDBookUpdate builder.
+func (ubuo *UUIDBookUpdateOne) Where(ps ...predicate.UUIDBook) *UUIDBookUpdateOne {
+	ubuo.mutation.Where(ps...)
+	return ubuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ubuo *UUIDBookUpdateOne) Select(field string, fields ...string) *UUIDBookUpdateOne {
+	ubuo.fields = append([]string{field}, fields...)
+	return ubuo
+}
+
+// Save executes the query and returns the updated UUIDBook entity.
+func (ubuo *UUIDBookUpdateOne) Save(ctx context.Context) (*UUIDBook, error) {
+	return withHooks(ctx, ubuo.sqlSave, ubuo.mutation, ubuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ubuo *UUIDBookUpdateOne) SaveX(ctx context.Context) *UUIDBook {
+	node, err := ubuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ubuo *UUIDBookUpdateOne) Exec(ctx context.Context) error {
+	_, err := ubuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ubuo *UUIDBookUpdateOne) ExecX(ctx context.Context) {
+	if err := ubuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ubuo *UUIDBookUpdateOne) sqlSave(ctx context.Context) (_node *UUIDBook, err error) {
+	_spec := sqlgraph.NewUpdateSpec(uuidbook.Table, uuidbook.Columns, sqlgraph.NewFieldSpec(uuidbook.FieldID, field.TypeUUID))
+	id, ok := ubuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UUIDBook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ubuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, uuidbook.FieldID)
+		for _, f := range fields {
+			if !uuidbook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != uuidbook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ubuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ubuo.mutation.Isbn(); ok {
+		_spec.SetField(uuidbook.FieldIsbn, field.TypeString, value)
+	}
+	if value, ok := ubuo.mutation.Title(); ok {
+		_spec.SetField(uuidbook.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := ubuo.mutation.Author(); ok {
+		_spec.SetField(uuidbook.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := ubuo.mutation.Genre(); ok {
+		_spec.SetField(uuidbook.FieldGenre, field.TypeString, value)
+	}
+	if value, ok := ubuo.mutation.Quantity(); ok {
+		_spec.SetField(uuidbook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := ubuo.mutation.AddedQuantity(); ok {
+		_spec.AddField(uuidbook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := ubuo.mutation.PublicizedAt(); ok {
+		_spec.SetField(uuidbook.FieldPublicizedAt, field.TypeTime, value)
+	}
+	_node = &UUIDBook{config: ubuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ubuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{uuidbook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ubuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,801 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/wide"
+)
+
+// Wide is the model entity for the Wide schema.
+type Wide struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Column001 holds the value of the "column001" field.
+	Column001 string `json:"column001,omitempty"`
+	// Column002 holds the value of the "column002" field.
+	Column002 int `json:"column002,omitempty"`
+	// Column003 holds the value of the "column003" field.
+	Column003 float64 `json:"column003,omitempty"`
+	// Column004 holds the value of the "column004" field.
+	Column004 time.Time `json:"column004,omitempty"`
+	// Column005 holds the value of the "column005" field.
+	Column005 string `json:"column005,omitempty"`
+	// Column006 holds the value of the "column006" field.
+	Column006 int `json:"column006,omitempty"`
+	// Column007 holds the value of the "column007" field.
+	Column007 float64 `json:"column007,omitempty"`
+	// Column008 holds the value of the "column008" field.
+	Column008 time.Time `json:"column008,omitempty"`
+	// Column009 holds the value of the "column009" field.
+	Column009 string `json:"column009,omitempty"`
+	// Column010 holds the value of the "column010" field.
+	Column010 int `json:"column010,omitempty"`
+	// Column011 holds the value of the "column011" field.
+	Column011 float64 `json:"column011,omitempty"`
+	// Column012 holds the value of the "column012" field.
+	Column012 time.Time `json:"column012,omitempty"`
+	// Column013 holds the value of the "column013" field.
+	Column013 string `json:"column013,omitempty"`
+	// Column014 holds the value of the "column014" field.
+	Column014 int `json:"column014,omitempty"`
+	// Column015 holds the value of the "column015" field.
+	Column015 float64 `json:"column015,omitempty"`
+	// Column016 holds the value of the "column016" field.
+	Column016 time.Time `json:"column016,omitempty"`
+	// Column017 holds the value of the "column017" field.
+	Column017 string `json:"column017,omitempty"`
+	// Column018 holds the value of the "column018" field.
+	Column018 int `json:"column018,omitempty"`
+	// Column019 holds the value of the "column019" field.
+	Column019 float64 `json:"column019,omitempty"`
+	// Column020 holds the value of the "column020" field.
+	Column020 time.Time `json:"column020,omitempty"`
+	// Column021 holds the value of the "column021" field.
+	Column021 string `json:"column021,omitempty"`
+	// Column022 holds the value of the "column022" field.
+	Column022 int `json:"column022,omitempty"`
+	// Column023 holds the value of the "column023" field.
+	Column023 float64 `json:"column023,omitempty"`
+	// Column024 holds the value of the "column024" field.
+	Column024 time.Time `json:"column024,omitempty"`
+	// Column025 holds the value of the "column025" field.
+	Column025 string `json:"column025,omitempty"`
+	// Column026 holds the value of the "column026" field.
+	Column026 int `json:"column026,omitempty"`
+	// Column027 holds the value of the "column027" field.
+	Column027 float64 `json:"column027,omitempty"`
+	// Column028 holds the value of the "column028" field.
+	Column028 time.Time `json:"column028,omitempty"`
+	// Column029 holds the value of the "column029" field.
+	Column029 string `json:"column029,omitempty"`
+	// Column030 holds the value of the "column030" field.
+	Column030 int `json:"column030,omitempty"`
+	// Column031 holds the value of the "column031" field.
+	Column031 float64 `json:"column031,omitempty"`
+	// Column032 holds the value of the "column032" field.
+	Column032 time.Time `json:"column032,omitempty"`
+	// Column033 holds the value of the "column033" field.
+	Column033 string `json:"column033,omitempty"`
+	// Column034 holds the value of the "column034" field.
+	Column034 int `json:"column034,omitempty"`
+	// Column035 holds the value of the "column035" field.
+	Column035 float64 `json:"column035,omitempty"`
+	// Column036 holds the value of the "column036" field.
+	Column036 time.Time `json:"column036,omitempty"`
+	// Column037 holds the value of the "column037" field.
+	Column037 string `json:"column037,omitempty"`
+	// Column038 holds the value of the "column038" field.
+	Column038 int `json:"column038,omitempty"`
+	// Column039 holds the value of the "column039" field.
+	Column039 float64 `json:"column039,omitempty"`
+	// Column040 holds the value of the "column040" field.
+	Column040 time.Time `json:"column040,omitempty"`
+	// Column041 holds the value of the "column041" field.
+	Column041 string `json:"column041,omitempty"`
+	// Column042 holds the value of the "column042" field.
+	Column042 int `json:"column042,omitempty"`
+	// Column043 holds the value of the "column043" field.
+	Column043 float64 `json:"column043,omitempty"`
+	// Column044 holds the value of the "column044" field.
+	Column044 time.Time `json:"column044,omitempty"`
+	// Column045 holds the value of the "column045" field.
+	Column045 string `json:"column045,omitempty"`
+	// Column046 holds the value of the "column046" field.
+	Column046 int `json:"column046,omitempty"`
+	// Column047 holds the value of the "column047" field.
+	Column047 float64 `json:"column047,omitempty"`
+	// Column048 holds the value of the "column048" field.
+	Column048 time.Time `json:"column048,omitempty"`
+	// Column049 holds the value of the "column049" field.
+	Column049 string `json:"column049,omitempty"`
+	// Column050 holds the value of the "column050" field.
+	Column050 int `json:"column050,omitempty"`
+	// Column051 holds the value of the "column051" field.
+	Column051 float64 `json:"column051,omitempty"`
+	// Column052 holds the value of the "column052" field.
+	Column052 time.Time `json:"column052,omitempty"`
+	// Column053 holds the value of the "column053" field.
+	Column053 string `json:"column053,omitempty"`
+	// Column054 holds the value of the "column054" field.
+	Column054 int `json:"column054,omitempty"`
+	// Column055 holds the value of the "column055" field.
+	Column055 float64 `json:"column055,omitempty"`
+	// Column056 holds the value of the "column056" field.
+	Column056 time.Time `json:"column056,omitempty"`
+	// Column057 holds the value of the "column057" field.
+	Column057 string `json:"column057,omitempty"`
+	// Column058 holds the value of the "column058" field.
+	Column058 int `json:"column058,omitempty"`
+	// Column059 holds the value of the "column059" field.
+	Column059 float64 `json:"column059,omitempty"`
+	// Column060 holds the value of the "column060" field.
+	Column060 time.Time `json:"column060,omitempty"`
+	// Column061 holds the value of the "column061" field.
+	Column061 string `json:"column061,omitempty"`
+	// Column062 holds the value of the "column062" field.
+	Column062 int `json:"column062,omitempty"`
+	// Column063 holds the value of the "column063" field.
+	Column063 float64 `json:"column063,omitempty"`
+	// Column064 holds the value of the "column064" field.
+	Column064    time.Time `json:"column064,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Wide) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wide.FieldColumn003, wide.FieldColumn007, wide.FieldColumn011, wide.FieldColumn015, wide.FieldColumn019, wide.FieldColumn023, wide.FieldColumn027, wide.FieldColumn031, wide.FieldColumn035, wide.FieldColumn039, wide.FieldColumn043, wide.FieldColumn047, wide.FieldColumn051, wide.FieldColumn055, wide.FieldColumn059, wide.FieldColumn063:
+			values[i] = new(sql.NullFloat64)
+		case wide.FieldID, wide.FieldColumn002, wide.FieldColumn006, wide.FieldColumn010, wide.FieldColumn014, wide.FieldColumn018, wide.FieldColumn022, wide.FieldColumn026, wide.FieldColumn030, wide.FieldColumn034, wide.FieldColumn038, wide.FieldColumn042, wide.FieldColumn046, wide.FieldColumn050, wide.FieldColumn054, wide.FieldColumn058, wide.FieldColumn062:
+			values[i] = new(sql.NullInt64)
+		case wide.FieldColumn001, wide.FieldColumn005, wide.FieldColumn009, wide.FieldColumn013, wide.FieldColumn017, wide.FieldColumn021, wide.FieldColumn025, wide.FieldColumn029, wide.FieldColumn033, wide.FieldColumn037, wide.FieldColumn041, wide.FieldColumn045, wide.FieldColumn049, wide.FieldColumn053, wide.FieldColumn057, wide.FieldColumn061:
+			values[i] = new(sql.NullString)
+		case wide.FieldColumn004, wide.FieldColumn008, wide.FieldColumn012, wide.FieldColumn016, wide.FieldColumn020, wide.FieldColumn024, wide.FieldColumn028, wide.FieldColumn032, wide.FieldColumn036, wide.FieldColumn040, wide.FieldColumn044, wide.FieldColumn048, wide.FieldColumn052, wide.FieldColumn056, wide.FieldColumn060, wide.FieldColumn064:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Wide fields.
+func (w *Wide) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wide.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			w.ID = int(value.Int64)
+		case wide.FieldColumn001:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column001", values[i])
+			} else if value.Valid {
+				w.Column001 = value.String
+			}
+		case wide.FieldColumn002:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column002", values[i])
+			} else if value.Valid {
+				w.Column002 = int(value.Int64)
+			}
+		case wide.FieldColumn003:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column003", values[i])
+			} else if value.Valid {
+				w.Column003 = value.Float64
+			}
+		case wide.FieldColumn004:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column004", values[i])
+			} else if value.Valid {
+				w.Column004 = value.Time
+			}
+		case wide.FieldColumn005:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column005", values[i])
+			} else if value.Valid {
+				w.Column005 = value.String
+			}
+		case wide.FieldColumn006:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column006", values[i])
+			} else if value.Valid {
+				w.Column006 = int(value.Int64)
+			}
+		case wide.FieldColumn007:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column007", values[i])
+			} else if value.Valid {
+				w.Column007 = value.Float64
+			}
+		case wide.FieldColumn008:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column008", values[i])
+			} else if value.Valid {
+				w.Column008 = value.Time
+			}
+		case wide.FieldColumn009:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column009", values[i])
+			} else if value.Valid {
+				w.Column009 = value.String
+			}
+		case wide.FieldColumn010:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column010", values[i])
+			} else if value.Valid {
+				w.Column010 = int(value.Int64)
+			}
+		case wide.FieldColumn011:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column011", values[i])
+			} else if value.Valid {
+				w.Column011 = value.Float64
+			}
+		case wide.FieldColumn012:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column012", values[i])
+			} else if value.Valid {
+				w.Column012 = value.Time
+			}
+		case wide.FieldColumn013:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column013", values[i])
+			} else if value.Valid {
+				w.Column013 = value.String
+			}
+		case wide.FieldColumn014:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column014", values[i])
+			} else if value.Valid {
+				w.Column014 = int(value.Int64)
+			}
+		case wide.FieldColumn015:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column015", values[i])
+			} else if value.Valid {
+				w.Column015 = value.Float64
+			}
+		case wide.FieldColumn016:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column016", values[i])
+			} else if value.Valid {
+				w.Column016 = value.Time
+			}
+		case wide.FieldColumn017:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column017", values[i])
+			} else if value.Valid {
+				w.Column017 = value.String
+			}
+		case wide.FieldColumn018:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column018", values[i])
+			} else if value.Valid {
+				w.Column018 = int(value.Int64)
+			}
+		case wide.FieldColumn019:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column019", values[i])
+			} else if value.Valid {
+				w.Column019 = value.Float64
+			}
+		case wide.FieldColumn020:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column020", values[i])
+			} else if value.Valid {
+				w.Column020 = value.Time
+			}
+		case wide.FieldColumn021:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column021", values[i])
+			} else if value.Valid {
+				w.Column021 = value.String
+			}
+		case wide.FieldColumn022:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column022", values[i])
+			} else if value.Valid {
+				w.Column022 = int(value.Int64)
+			}
+		case wide.FieldColumn023:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column023", values[i])
+			} else if value.Valid {
+				w.Column023 = value.Float64
+			}
+		case wide.FieldColumn024:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column024", values[i])
+			} else if value.Valid {
+				w.Column024 = value.Time
+			}
+		case wide.FieldColumn025:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column025", values[i])
+			} else if value.Valid {
+				w.Column025 = value.String
+			}
+		case wide.FieldColumn026:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column026", values[i])
+			} else if value.Valid {
+				w.Column026 = int(value.Int64)
+			}
+		case wide.FieldColumn027:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column027", values[i])
+			} else if value.Valid {
+				w.Column027 = value.Float64
+			}
+		case wide.FieldColumn028:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column028", values[i])
+			} else if value.Valid {
+				w.Column028 = value.Time
+			}
+		case wide.FieldColumn029:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column029", values[i])
+			} else if value.Valid {
+				w.Column029 = value.String
+			}
+		case wide.FieldColumn030:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column030", values[i])
+			} else if value.Valid {
+				w.Column030 = int(value.Int64)
+			}
+		case wide.FieldColumn031:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column031", values[i])
+			} else if value.Valid {
+				w.Column031 = value.Float64
+			}
+		case wide.FieldColumn032:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column032", values[i])
+			} else if value.Valid {
+				w.Column032 = value.Time
+			}
+		case wide.FieldColumn033:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column033", values[i])
+			} else if value.Valid {
+				w.Column033 = value.String
+			}
+		case wide.FieldColumn034:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column034", values[i])
+			} else if value.Valid {
+				w.Column034 = int(value.Int64)
+			}
+		case wide.FieldColumn035:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column035", values[i])
+			} else if value.Valid {
+				w.Column035 = value.Float64
+			}
+		case wide.FieldColumn036:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column036", values[i])
+			} else if value.Valid {
+				w.Column036 = value.Time
+			}
+		case wide.FieldColumn037:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column037", values[i])
+			} else if value.Valid {
+				w.Column037 = value.String
+			}
+		case wide.FieldColumn038:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column038", values[i])
+			} else if value.Valid {
+				w.Column038 = int(value.Int64)
+			}
+		case wide.FieldColumn039:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column039", values[i])
+			} else if value.Valid {
+				w.Column039 = value.Float64
+			}
+		case wide.FieldColumn040:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column040", values[i])
+			} else if value.Valid {
+				w.Column040 = value.Time
+			}
+		case wide.FieldColumn041:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column041", values[i])
+			} else if value.Valid {
+				w.Column041 = value.String
+			}
+		case wide.FieldColumn042:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column042", values[i])
+			} else if value.Valid {
+				w.Column042 = int(value.Int64)
+			}
+		case wide.FieldColumn043:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column043", values[i])
+			} else if value.Valid {
+				w.Column043 = value.Float64
+			}
+		case wide.FieldColumn044:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column044", values[i])
+			} else if value.Valid {
+				w.Column044 = value.Time
+			}
+		case wide.FieldColumn045:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column045", values[i])
+			} else if value.Valid {
+				w.Column045 = value.String
+			}
+		case wide.FieldColumn046:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column046", values[i])
+			} else if value.Valid {
+				w.Column046 = int(value.Int64)
+			}
+		case wide.FieldColumn047:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column047", values[i])
+			} else if value.Valid {
+				w.Column047 = value.Float64
+			}
+		case wide.FieldColumn048:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column048", values[i])
+			} else if value.Valid {
+				w.Column048 = value.Time
+			}
+		case wide.FieldColumn049:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column049", values[i])
+			} else if value.Valid {
+				w.Column049 = value.String
+			}
+		case wide.FieldColumn050:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column050", values[i])
+			} else if value.Valid {
+				w.Column050 = int(value.Int64)
+			}
+		case wide.FieldColumn051:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column051", values[i])
+			} else if value.Valid {
+				w.Column051 = value.Float64
+			}
+		case wide.FieldColumn052:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column052", values[i])
+			} else if value.Valid {
+				w.Column052 = value.Time
+			}
+		case wide.FieldColumn053:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column053", values[i])
+			} else if value.Valid {
+				w.Column053 = value.String
+			}
+		case wide.FieldColumn054:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column054", values[i])
+			} else if value.Valid {
+				w.Column054 = int(value.Int64)
+			}
+		case wide.FieldColumn055:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column055", values[i])
+			} else if value.Valid {
+				w.Column055 = value.Float64
+			}
+		case wide.FieldColumn056:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column056", values[i])
+			} else if value.Valid {
+				w.Column056 = value.Time
+			}
+		case wide.FieldColumn057:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column057", values[i])
+			} else if value.Valid {
+				w.Column057 = value.String
+			}
+		case wide.FieldColumn058:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column058", values[i])
+			} else if value.Valid {
+				w.Column058 = int(value.Int64)
+			}
+		case wide.FieldColumn059:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column059", values[i])
+			} else if value.Valid {
+				w.Column059 = value.Float64
+			}
+		case wide.FieldColumn060:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column060", values[i])
+			} else if value.Valid {
+				w.Column060 = value.Time
+			}
+		case wide.FieldColumn061:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column061", values[i])
+			} else if value.Valid {
+				w.Column061 = value.String
+			}
+		case wide.FieldColumn062:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column062", values[i])
+			} else if value.Valid {
+				w.Column062 = int(value.Int64)
+			}
+		case wide.FieldColumn063:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field column063", values[i])
+			} else if value.Valid {
+				w.Column063 = value.Float64
+			}
+		case wide.FieldColumn064:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field column064", values[i])
+			} else if value.Valid {
+				w.Column064 = value.Time
+			}
+		default:
+			w.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Wide.
+// This includes values selected through modifiers, order, etc.
+func (w *Wide) Value(name string) (ent.Value, error) {
+	return w.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Wide.
+// Note that you need to call Wide.Unwrap() before calling this method if this Wide
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (w *Wide) Update() *WideUpdateOne {
+	return NewWideClient(w.config).UpdateOne(w)
+}
+
+// Unwrap unwraps the Wide entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (w *Wide) Unwrap() *Wide {
+	_tx, ok := w.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Wide is not a transactional entity")
+	}
+	w.config.driver = _tx.drv
+	return w
+}
+
+// String implements the fmt.Stringer.
+func (w *Wide) String() string {
+	var builder strings.Builder
+	builder.WriteString("Wide(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", w.ID))
+	builder.WriteString("column001=")
+	builder.WriteString(w.Column001)
+	builder.WriteString(", ")
+	builder.WriteString("column002=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column002))
+	builder.WriteString(", ")
+	builder.WriteString("column003=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column003))
+	builder.WriteString(", ")
+	builder.WriteString("column004=")
+	builder.WriteString(w.Column004.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column005=")
+	builder.WriteString(w.Column005)
+	builder.WriteString(", ")
+	builder.WriteString("column006=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column006))
+	builder.WriteString(", ")
+	builder.WriteString("column007=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column007))
+	builder.WriteString(", ")
+	builder.WriteString("column008=")
+	builder.WriteString(w.Column008.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column009=")
+	builder.WriteString(w.Column009)
+	builder.WriteString(", ")
+	builder.WriteString("column010=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column010))
+	builder.WriteString(", ")
+	builder.WriteString("column011=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column011))
+	builder.WriteString(", ")
+	builder.WriteString("column012=")
+	builder.WriteString(w.Column012.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column013=")
+	builder.WriteString(w.Column013)
+	builder.WriteString(", ")
+	builder.WriteString("column014=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column014))
+	builder.WriteString(", ")
+	builder.WriteString("column015=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column015))
+	builder.WriteString(", ")
+	builder.WriteString("column016=")
+	builder.WriteString(w.Column016.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column017=")
+	builder.WriteString(w.Column017)
+	builder.WriteString(", ")
+	builder.WriteString("column018=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column018))
+	builder.WriteString(", ")
+	builder.WriteString("column019=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column019))
+	builder.WriteString(", ")
+	builder.WriteString("column020=")
+	builder.WriteString(w.Column020.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column021=")
+	builder.WriteString(w.Column021)
+	builder.WriteString(", ")
+	builder.WriteString("column022=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column022))
+	builder.WriteString(", ")
+	builder.WriteString("column023=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column023))
+	builder.WriteString(", ")
+	builder.WriteString("column024=")
+	builder.WriteString(w.Column024.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column025=")
+	builder.WriteString(w.Column025)
+	builder.WriteString(", ")
+	builder.WriteString("column026=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column026))
+	builder.WriteString(", ")
+	builder.WriteString("column027=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column027))
+	builder.WriteString(", ")
+	builder.WriteString("column028=")
+	builder.WriteString(w.Column028.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column029=")
+	builder.WriteString(w.Column029)
+	builder.WriteString(", ")
+	builder.WriteString("column030=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column030))
+	builder.WriteString(", ")
+	builder.WriteString("column031=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column031))
+	builder.WriteString(", ")
+	builder.WriteString("column032=")
+	builder.WriteString(w.Column032.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column033=")
+	builder.WriteString(w.Column033)
+	builder.WriteString(", ")
+	builder.WriteString("column034=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column034))
+	builder.WriteString(", ")
+	builder.WriteString("column035=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column035))
+	builder.WriteString(", ")
+	builder.WriteString("column036=")
+	builder.WriteString(w.Column036.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column037=")
+	builder.WriteString(w.Column037)
+	builder.WriteString(", ")
+	builder.WriteString("column038=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column038))
+	builder.WriteString(", ")
+	builder.WriteString("column039=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column039))
+	builder.WriteString(", ")
+	builder.WriteString("column040=")
+	builder.WriteString(w.Column040.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column041=")
+	builder.WriteString(w.Column041)
+	builder.WriteString(", ")
+	builder.WriteString("column042=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column042))
+	builder.WriteString(", ")
+	builder.WriteString("column043=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column043))
+	builder.WriteString(", ")
+	builder.WriteString("column044=")
+	builder.WriteString(w.Column044.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column045=")
+	builder.WriteString(w.Column045)
+	builder.WriteString(", ")
+	builder.WriteString("column046=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column046))
+	builder.WriteString(", ")
+	builder.WriteString("column047=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column047))
+	builder.WriteString(", ")
+	builder.WriteString("column048=")
+	builder.WriteString(w.Column048.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column049=")
+	builder.WriteString(w.Column049)
+	builder.WriteString(", ")
+	builder.WriteString("column050=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column050))
+	builder.WriteString(", ")
+	builder.WriteString("column051=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column051))
+	builder.WriteString(", ")
+	builder.WriteString("column052=")
+	builder.WriteString(w.Column052.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column053=")
+	builder.WriteString(w.Column053)
+	builder.WriteString(", ")
+	builder.WriteString("column054=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column054))
+	builder.WriteString(", ")
+	builder.WriteString("column055=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column055))
+	builder.WriteString(", ")
+	builder.WriteString("column056=")
+	builder.WriteString(w.Column056.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column057=")
+	builder.WriteString(w.Column057)
+	builder.WriteString(", ")
+	builder.WriteString("column058=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column058))
+	builder.WriteString(", ")
+	builder.WriteString("column059=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column059))
+	builder.WriteString(", ")
+	builder.WriteString("column060=")
+	builder.WriteString(w.Column060.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("column061=")
+	builder.WriteString(w.Column061)
+	builder.WriteString(", ")
+	builder.WriteString("column062=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column062))
+	builder.WriteString(", ")
+	builder.WriteString("column063=")
+	builder.WriteString(fmt.Sprintf("%v", w.Column063))
+	builder.WriteString(", ")
+	builder.WriteString("column064=")
+	builder.WriteString(w.Column064.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Wides is a parsable slice of Wide.
+type Wides []*Wide
@@ -0,0 +1,3350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wide
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldID, id))
+}
+
+// Column001 applies equality check predicate on the "column001" field. It's identical to Column001EQ.
+func Column001(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn001, v))
+}
+
+// Column002 applies equality check predicate on the "column002" field. It's identical to Column002EQ.
+func Column002(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn002, v))
+}
+
+// Column003 applies equality check predicate on the "column003" field. It's identical to Column003EQ.
+func Column003(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn003, v))
+}
+
+// Column004 applies equality check predicate on the "column004" field. It's identical to Column004EQ.
+func Column004(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn004, v))
+}
+
+// Column005 applies equality check predicate on the "column005" field. It's identical to Column005EQ.
+func Column005(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn005, v))
+}
+
+// Column006 applies equality check predicate on the "column006" field. It's identical to Column006EQ.
+func Column006(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn006, v))
+}
+
+// Column007 applies equality check predicate on the "column007" field. It's identical to Column007EQ.
+func Column007(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn007, v))
+}
+
+// Column008 applies equality check predicate on the "column008" field. It's identical to Column008EQ.
+func Column008(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn008, v))
+}
+
+// Column009 applies equality check predicate on the "column009" field. It's identical to Column009EQ.
+func Column009(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn009, v))
+}
+
+// Column010 applies equality check predicate on the "column010" field. It's identical to Column010EQ.
+func Column010(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn010, v))
+}
+
+// Column011 applies equality check predicate on the "column011" field. It's identical to Column011EQ.
+func Column011(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn011, v))
+}
+
+// Column012 applies equality check predicate on the "column012" field. It's identical to Column012EQ.
+func Column012(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn012, v))
+}
+
+// Column013 applies equality check predicate on the "column013" field. It's identical to Column013EQ.
+func Column013(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn013, v))
+}
+
+// Column014 applies equality check predicate on the "column014" field. It's identical to Column014EQ.
+func Column014(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn014, v))
+}
+
+// Column015 applies equality check predicate on the "column015" field. It's identical to Column015EQ.
+func Column015(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn015, v))
+}
+
+// Column016 applies equality check predicate on the "column016" field. It's identical to Column016EQ.
+func Column016(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn016, v))
+}
+
+// Column017 applies equality check predicate on the "column017" field. It's identical to Column017EQ.
+func Column017(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn017, v))
+}
+
+// Column018 applies equality check predicate on the "column018" field. It's identical to Column018EQ.
+func Column018(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn018, v))
+}
+
+// Column019 applies equality check predicate on the "column019" field. It's identical to Column019EQ.
+func Column019(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn019, v))
+}
+
+// Column020 applies equality check predicate on the "column020" field. It's identical to Column020EQ.
+func Column020(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn020, v))
+}
+
+// Column021 applies equality check predicate on the "column021" field. It's identical to Column021EQ.
+func Column021(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn021, v))
+}
+
+// Column022 applies equality check predicate on the "column022" field. It's identical to Column022EQ.
+func Column022(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn022, v))
+}
+
+// Column023 applies equality check predicate on the "column023" field. It's identical to Column023EQ.
+func Column023(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn023, v))
+}
+
+// Column024 applies equality check predicate on the "column024" field. It's identical to Column024EQ.
+func Column024(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn024, v))
+}
+
+// Column025 applies equality check predicate on the "column025" field. It's identical to Column025EQ.
+func Column025(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn025, v))
+}
+
+// Column026 applies equality check predicate on the "column026" field. It's identical to Column026EQ.
+func Column026(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn026, v))
+}
+
+// Column027 applies equality check predicate on the "column027" field. It's identical to Column027EQ.
+func Column027(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn027, v))
+}
+
+// Column028 applies equality check predicate on the "column028" field. It's identical to Column028EQ.
+func Column028(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn028, v))
+}
+
+// Column029 applies equality check predicate on the "column029" field. It's identical to Column029EQ.
+func Column029(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn029, v))
+}
+
+// Column030 applies equality check predicate on the "column030" field. It's identical to Column030EQ.
+func Column030(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn030, v))
+}
+
+// Column031 applies equality check predicate on the "column031" field. It's identical to Column031EQ.
+func Column031(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn031, v))
+}
+
+// Column032 applies equality check predicate on the "column032" field. It's identical to Column032EQ.
+func Column032(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn032, v))
+}
+
+// Column033 applies equality check predicate on the "column033" field. It's identical to Column033EQ.
+func Column033(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn033, v))
+}
+
+// Column034 applies equality check predicate on the "column034" field. It's identical to Column034EQ.
+func Column034(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn034, v))
+}
+
+// Column035 applies equality check predicate on the "column035" field. It's identical to Column035EQ.
+func Column035(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn035, v))
+}
+
+// Column036 applies equality check predicate on the "column036" field. It's identical to Column036EQ.
+func Column036(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn036, v))
+}
+
+// Column037 applies equality check predicate on the "column037" field. It's identical to Column037EQ.
+func Column037(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn037, v))
+}
+
+// Column038 applies equality check predicate on the "column038" field. It's identical to Column038EQ.
+func Column038(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn038, v))
+}
+
+// Column039 applies equality check predicate on the "column039" field. It's identical to Column039EQ.
+func Column039(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn039, v))
+}
+
+// Column040 applies equality check predicate on the "column040" field. It's identical to Column040EQ.
+func Column040(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn040, v))
+}
+
+// Column041 applies equality check predicate on the "column041" field. It's identical to Column041EQ.
+func Column041(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn041, v))
+}
+
+// Column042 applies equality check predicate on the "column042" field. It's identical to Column042EQ.
+func Column042(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn042, v))
+}
+
+// Column043 applies equality check predicate on the "column043" field. It's identical to Column043EQ.
+func Column043(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn043, v))
+}
+
+// Column044 applies equality check predicate on the "column044" field. It's identical to Column044EQ.
+func Column044(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn044, v))
+}
+
+// Column045 applies equality check predicate on the "column045" field. It's identical to Column045EQ.
+func Column045(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn045, v))
+}
+
+// Column046 applies equality check predicate on the "column046" field. It's identical to Column046EQ.
+func Column046(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn046, v))
+}
+
+// Column047 applies equality check predicate on the "column047" field. It's identical to Column047EQ.
+func Column047(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn047, v))
+}
+
+// Column048 applies equality check predicate on the "column048" field. It's identical to Column048EQ.
+func Column048(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn048, v))
+}
+
+// Column049 applies equality check predicate on the "column049" field. It's identical to Column049EQ.
+func Column049(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn049, v))
+}
+
+// Column050 applies equality check predicate on the "column050" field. It's identical to Column050EQ.
+func Column050(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn050, v))
+}
+
+// Column051 applies equality check predicate on the "column051" field. It's identical to Column051EQ.
+func Column051(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn051, v))
+}
+
+// Column052 applies equality check predicate on the "column052" field. It's identical to Column052EQ.
+func Column052(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn052, v))
+}
+
+// Column053 applies equality check predicate on the "column053" field. It's identical to Column053EQ.
+func Column053(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn053, v))
+}
+
+// Column054 applies equality check predicate on the "column054" field. It's identical to Column054EQ.
+func Column054(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn054, v))
+}
+
+// Column055 applies equality check predicate on the "column055" field. It's identical to Column055EQ.
+func Column055(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn055, v))
+}
+
+// Column056 applies equality check predicate on the "column056" field. It's identical to Column056EQ.
+func Column056(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn056, v))
+}
+
+// Column057 applies equality check predicate on the "column057" field. It's identical to Column057EQ.
+func Column057(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn057, v))
+}
+
+// Column058 applies equality check predicate on the "column058" field. It's identical to Column058EQ.
+func Column058(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn058, v))
+}
+
+// Column059 applies equality check predicate on the "column059" field. It's identical to Column059EQ.
+func Column059(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn059, v))
+}
+
+// Column060 applies equality check predicate on the "column060" field. It's identical to Column060EQ.
+func Column060(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn060, v))
+}
+
+// Column061 applies equality check predicate on the "column061" field. It's identical to Column061EQ.
+func Column061(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn061, v))
+}
+
+// Column062 applies equality check predicate on the "column062" field. It's identical to Column062EQ.
+func Column062(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn062, v))
+}
+
+// Column063 applies equality check predicate on the "column063" field. It's identical to Column063EQ.
+func Column063(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn063, v))
+}
+
+// Column064 applies equality check predicate on the "column064" field. It's identical to Column064EQ.
+func Column064(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn064, v))
+}
+
+// Column001EQ applies the EQ predicate on the "column001" field.
+func Column001EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn001, v))
+}
+
+// Column001NEQ applies the NEQ predicate on the "column001" field.
+func Column001NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn001, v))
+}
+
+// Column001In applies the In predicate on the "column001" field.
+func Column001In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn001, vs...))
+}
+
+// Column001NotIn applies the NotIn predicate on the "column001" field.
+func Column001NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn001, vs...))
+}
+
+// Column001GT applies the GT predicate on the "column001" field.
+func Column001GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn001, v))
+}
+
+// Column001GTE applies the GTE predicate on the "column001" field.
+func Column001GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn001, v))
+}
+
+// Column001LT applies the LT predicate on the "column001" field.
+func Column001LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn001, v))
+}
+
+// Column001LTE applies the LTE predicate on the "column001" field.
+func Column001LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn001, v))
+}
+
+// Column001Contains applies the Contains predicate on the "column001" field.
+func Column001Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn001, v))
+}
+
+// Column001HasPrefix applies the HasPrefix predicate on the "column001" field.
+func Column001HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn001, v))
+}
+
+// Column001HasSuffix applies the HasSuffix predicate on the "column001" field.
+func Column001HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn001, v))
+}
+
+// Column001EqualFold applies the EqualFold predicate on the "column001" field.
+func Column001EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn001, v))
+}
+
+// Column001ContainsFold applies the ContainsFold predicate on the "column001" field.
+func Column001ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn001, v))
+}
+
+// Column002EQ applies the EQ predicate on the "column002" field.
+func Column002EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn002, v))
+}
+
+// Column002NEQ applies the NEQ predicate on the "column002" field.
+func Column002NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn002, v))
+}
+
+// Column002In applies the In predicate on the "column002" field.
+func Column002In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn002, vs...))
+}
+
+// Column002NotIn applies the NotIn predicate on the "column002" field.
+func Column002NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn002, vs...))
+}
+
+// Column002GT applies the GT predicate on the "column002" field.
+func Column002GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn002, v))
+}
+
+// Column002GTE applies the GTE predicate on the "column002" field.
+func Column002GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn002, v))
+}
+
+// Column002LT applies the LT predicate on the "column002" field.
+func Column002LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn002, v))
+}
+
+// Column002LTE applies the LTE predicate on the "column002" field.
+func Column002LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn002, v))
+}
+
+// Column003EQ applies the EQ predicate on the "column003" field.
+func Column003EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn003, v))
+}
+
+// Column003NEQ applies the NEQ predicate on the "column003" field.
+func Column003NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn003, v))
+}
+
+// Column003In applies the In predicate on the "column003" field.
+func Column003In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn003, vs...))
+}
+
+// Column003NotIn applies the NotIn predicate on the "column003" field.
+func Column003NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn003, vs...))
+}
+
+// Column003GT applies the GT predicate on the "column003" field.
+func Column003GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn003, v))
+}
+
+// Column003GTE applies the GTE predicate on the "column003" field.
+func Column003GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn003, v))
+}
+
+// Column003LT applies the LT predicate on the "column003" field.
+func Column003LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn003, v))
+}
+
+// Column003LTE applies the LTE predicate on the "column003" field.
+func Column003LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn003, v))
+}
+
+// Column004EQ applies the EQ predicate on the "column004" field.
+func Column004EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn004, v))
+}
+
+// Column004NEQ applies the NEQ predicate on the "column004" field.
+func Column004NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn004, v))
+}
+
+// Column004In applies the In predicate on the "column004" field.
+func Column004In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn004, vs...))
+}
+
+// Column004NotIn applies the NotIn predicate on the "column004" field.
+func Column004NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn004, vs...))
+}
+
+// Column004GT applies the GT predicate on the "column004" field.
+func Column004GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn004, v))
+}
+
+// Column004GTE applies the GTE predicate on the "column004" field.
+func Column004GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn004, v))
+}
+
+// Column004LT applies the LT predicate on the "column004" field.
+func Column004LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn004, v))
+}
+
+// Column004LTE applies the LTE predicate on the "column004" field.
+func Column004LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn004, v))
+}
+
+// Column005EQ applies the EQ predicate on the "column005" field.
+func Column005EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn005, v))
+}
+
+// Column005NEQ applies the NEQ predicate on the "column005" field.
+func Column005NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn005, v))
+}
+
+// Column005In applies the In predicate on the "column005" field.
+func Column005In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn005, vs...))
+}
+
+// Column005NotIn applies the NotIn predicate on the "column005" field.
+func Column005NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn005, vs...))
+}
+
+// Column005GT applies the GT predicate on the "column005" field.
+func Column005GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn005, v))
+}
+
+// Column005GTE applies the GTE predicate on the "column005" field.
+func Column005GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn005, v))
+}
+
+// Column005LT applies the LT predicate on the "column005" field.
+func Column005LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn005, v))
+}
+
+// Column005LTE applies the LTE predicate on the "column005" field.
+func Column005LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn005, v))
+}
+
+// Column005Contains applies the Contains predicate on the "column005" field.
+func Column005Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn005, v))
+}
+
+// Column005HasPrefix applies the HasPrefix predicate on the "column005" field.
+func Column005HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn005, v))
+}
+
+// Column005HasSuffix applies the HasSuffix predicate on the "column005" field.
+func Column005HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn005, v))
+}
+
+// Column005EqualFold applies the EqualFold predicate on the "column005" field.
+func Column005EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn005, v))
+}
+
+// Column005ContainsFold applies the ContainsFold predicate on the "column005" field.
+func Column005ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn005, v))
+}
+
+// Column006EQ applies the EQ predicate on the "column006" field.
+func Column006EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn006, v))
+}
+
+// Column006NEQ applies the NEQ predicate on the "column006" field.
+func Column006NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn006, v))
+}
+
+// Column006In applies the In predicate on the "column006" field.
+func Column006In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn006, vs...))
+}
+
+// Column006NotIn applies the NotIn predicate on the "column006" field.
+func Column006NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn006, vs...))
+}
+
+// Column006GT applies the GT predicate on the "column006" field.
+func Column006GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn006, v))
+}
+
+// Column006GTE applies the GTE predicate on the "column006" field.
+func Column006GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn006, v))
+}
+
+// Column006LT applies the LT predicate on the "column006" field.
+func Column006LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn006, v))
+}
+
+// Column006LTE applies the LTE predicate on the "column006" field.
+func Column006LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn006, v))
+}
+
+// Column007EQ applies the EQ predicate on the "column007" field.
+func Column007EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn007, v))
+}
+
+// Column007NEQ applies the NEQ predicate on the "column007" field.
+func Column007NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn007, v))
+}
+
+// Column007In applies the In predicate on the "column007" field.
+func Column007In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn007, vs...))
+}
+
+// Column007NotIn applies the NotIn predicate on the "column007" field.
+func Column007NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn007, vs...))
+}
+
+// Column007GT applies the GT predicate on the "column007" field.
+func Column007GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn007, v))
+}
+
+// Column007GTE applies the GTE predicate on the "column007" field.
+func Column007GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn007, v))
+}
+
+// Column007LT applies the LT predicate on the "column007" field.
+func Column007LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn007, v))
+}
+
+// Column007LTE applies the LTE predicate on the "column007" field.
+func Column007LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn007, v))
+}
+
+// Column008EQ applies the EQ predicate on the "column008" field.
+func Column008EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn008, v))
+}
+
+// Column008NEQ applies the NEQ predicate on the "column008" field.
+func Column008NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn008, v))
+}
+
+// Column008In applies the In predicate on the "column008" field.
+func Column008In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn008, vs...))
+}
+
+// Column008NotIn applies the NotIn predicate on the "column008" field.
+func Column008NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn008, vs...))
+}
+
+// Column008GT applies the GT predicate on the "column008" field.
+func Column008GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn008, v))
+}
+
+// Column008GTE applies the GTE predicate on the "column008" field.
+func Column008GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn008, v))
+}
+
+// Column008LT applies the LT predicate on the "column008" field.
+func Column008LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn008, v))
+}
+
+// Column008LTE applies the LTE predicate on the "column008" field.
+func Column008LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn008, v))
+}
+
+// Column009EQ applies the EQ predicate on the "column009" field.
+func Column009EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn009, v))
+}
+
+// Column009NEQ applies the NEQ predicate on the "column009" field.
+func Column009NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn009, v))
+}
+
+// Column009In applies the In predicate on the "column009" field.
+func Column009In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn009, vs...))
+}
+
+// Column009NotIn applies the NotIn predicate on the "column009" field.
+func Column009NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn009, vs...))
+}
+
+// Column009GT applies the GT predicate on the "column009" field.
+func Column009GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn009, v))
+}
+
+// Column009GTE applies the GTE predicate on the "column009" field.
+func Column009GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn009, v))
+}
+
+// Column009LT applies the LT predicate on the "column009" field.
+func Column009LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn009, v))
+}
+
+// Column009LTE applies the LTE predicate on the "column009" field.
+func Column009LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn009, v))
+}
+
+// Column009Contains applies the Contains predicate on the "column009" field.
+func Column009Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn009, v))
+}
+
+// Column009HasPrefix applies the HasPrefix predicate on the "column009" field.
+func Column009HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn009, v))
+}
+
+// Column009HasSuffix applies the HasSuffix predicate on the "column009" field.
+func Column009HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn009, v))
+}
+
+// Column009EqualFold applies the EqualFold predicate on the "column009" field.
+func Column009EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn009, v))
+}
+
+// Column009ContainsFold applies the ContainsFold predicate on the "column009" field.
+func Column009ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn009, v))
+}
+
+// Column010EQ applies the EQ predicate on the "column010" field.
+func Column010EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn010, v))
+}
+
+// Column010NEQ applies the NEQ predicate on the "column010" field.
+func Column010NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn010, v))
+}
+
+// Column010In applies the In predicate on the "column010" field.
+func Column010In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn010, vs...))
+}
+
+// Column010NotIn applies the NotIn predicate on the "column010" field.
+func Column010NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn010, vs...))
+}
+
+// Column010GT applies the GT predicate on the "column010" field.
+func Column010GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn010, v))
+}
+
+// Column010GTE applies the GTE predicate on the "column010" field.
+func Column010GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn010, v))
+}
+
+// Column010LT applies the LT predicate on the "column010" field.
+func Column010LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn010, v))
+}
+
+// Column010LTE applies the LTE predicate on the "column010" field.
+func Column010LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn010, v))
+}
+
+// Column011EQ applies the EQ predicate on the "column011" field.
+func Column011EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn011, v))
+}
+
+// Column011NEQ applies the NEQ predicate on the "column011" field.
+func Column011NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn011, v))
+}
+
+// Column011In applies the In predicate on the "column011" field.
+func Column011In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn011, vs...))
+}
+
+// Column011NotIn applies the NotIn predicate on the "column011" field.
+func Column011NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn011, vs...))
+}
+
+// Column011GT applies the GT predicate on the "column011" field.
+func Column011GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn011, v))
+}
+
+// Column011GTE applies the GTE predicate on the "column011" field.
+func Column011GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn011, v))
+}
+
+// Column011LT applies the LT predicate on the "column011" field.
+func Column011LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn011, v))
+}
+
+// Column011LTE applies the LTE predicate on the "column011" field.
+func Column011LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn011, v))
+}
+
+// Column012EQ applies the EQ predicate on the "column012" field.
+func Column012EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn012, v))
+}
+
+// Column012NEQ applies the NEQ predicate on the "column012" field.
+func Column012NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn012, v))
+}
+
+// Column012In applies the In predicate on the "column012" field.
+func Column012In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn012, vs...))
+}
+
+// Column012NotIn applies the NotIn predicate on the "column012" field.
+func Column012NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn012, vs...))
+}
+
+// Column012GT applies the GT predicate on the "column012" field.
+func Column012GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn012, v))
+}
+
+// Column012GTE applies the GTE predicate on the "column012" field.
+func Column012GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn012, v))
+}
+
+// Column012LT applies the LT predicate on the "column012" field.
+func Column012LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn012, v))
+}
+
+// Column012LTE applies the LTE predicate on the "column012" field.
+func Column012LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn012, v))
+}
+
+// Column013EQ applies the EQ predicate on the "column013" field.
+func Column013EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn013, v))
+}
+
+// Column013NEQ applies the NEQ predicate on the "column013" field.
+func Column013NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn013, v))
+}
+
+// Column013In applies the In predicate on the "column013" field.
+func Column013In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn013, vs...))
+}
+
+// Column013NotIn applies the NotIn predicate on the "column013" field.
+func Column013NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn013, vs...))
+}
+
+// Column013GT applies the GT predicate on the "column013" field.
+func Column013GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn013, v))
+}
+
+// Column013GTE applies the GTE predicate on the "column013" field.
+func Column013GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn013, v))
+}
+
+// Column013LT applies the LT predicate on the "column013" field.
+func Column013LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn013, v))
+}
+
+// Column013LTE applies the LTE predicate on the "column013" field.
+func Column013LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn013, v))
+}
+
+// Column013Contains applies the Contains predicate on the "column013" field.
+func Column013Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn013, v))
+}
+
+// Column013HasPrefix applies the HasPrefix predicate on the "column013" field.
+func Column013HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn013, v))
+}
+
+// Column013HasSuffix applies the HasSuffix predicate on the "column013" field.
+func Column013HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn013, v))
+}
+
+// Column013EqualFold applies the EqualFold predicate on the "column013" field.
+func Column013EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn013, v))
+}
+
+// Column013ContainsFold applies the ContainsFold predicate on the "column013" field.
+func Column013ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn013, v))
+}
+
+// Column014EQ applies the EQ predicate on the "column014" field.
+func Column014EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn014, v))
+}
+
+// Column014NEQ applies the NEQ predicate on the "column014" field.
+func Column014NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn014, v))
+}
+
+// Column014In applies the In predicate on the "column014" field.
+func Column014In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn014, vs...))
+}
+
+// Column014NotIn applies the NotIn predicate on the "column014" field.
+func Column014NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn014, vs...))
+}
+
+// Column014GT applies the GT predicate on the "column014" field.
+func Column014GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn014, v))
+}
+
+// Column014GTE applies the GTE predicate on the "column014" field.
+func Column014GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn014, v))
+}
+
+// Column014LT applies the LT predicate on the "column014" field.
+func Column014LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn014, v))
+}
+
+// Column014LTE applies the LTE predicate on the "column014" field.
+func Column014LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn014, v))
+}
+
+// Column015EQ applies the EQ predicate on the "column015" field.
+func Column015EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn015, v))
+}
+
+// Column015NEQ applies the NEQ predicate on the "column015" field.
+func Column015NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn015, v))
+}
+
+// Column015In applies the In predicate on the "column015" field.
+func Column015In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn015, vs...))
+}
+
+// Column015NotIn applies the NotIn predicate on the "column015" field.
+func Column015NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn015, vs...))
+}
+
+// Column015GT applies the GT predicate on the "column015" field.
+func Column015GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn015, v))
+}
+
+// Column015GTE applies the GTE predicate on the "column015" field.
+func Column015GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn015, v))
+}
+
+// Column015LT applies the LT predicate on the "column015" field.
+func Column015LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn015, v))
+}
+
+// Column015LTE applies the LTE predicate on the "column015" field.
+func Column015LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn015, v))
+}
+
+// Column016EQ applies the EQ predicate on the "column016" field.
+func Column016EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn016, v))
+}
+
+// Column016NEQ applies the NEQ predicate on the "column016" field.
+func Column016NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn016, v))
+}
+
+// Column016In applies the In predicate on the "column016" field.
+func Column016In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn016, vs...))
+}
+
+// Column016NotIn applies the NotIn predicate on the "column016" field.
+func Column016NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn016, vs...))
+}
+
+// Column016GT applies the GT predicate on the "column016" field.
+func Column016GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn016, v))
+}
+
+// Column016GTE applies the GTE predicate on the "column016" field.
+func Column016GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn016, v))
+}
+
+// Column016LT applies the LT predicate on the "column016" field.
+func Column016LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn016, v))
+}
+
+// Column016LTE applies the LTE predicate on the "column016" field.
+func Column016LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn016, v))
+}
+
+// Column017EQ applies the EQ predicate on the "column017" field.
+func Column017EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn017, v))
+}
+
+// Column017NEQ applies the NEQ predicate on the "column017" field.
+func Column017NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn017, v))
+}
+
+// Column017In applies the In predicate on the "column017" field.
+func Column017In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn017, vs...))
+}
+
+// Column017NotIn applies the NotIn predicate on the "column017" field.
+func Column017NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn017, vs...))
+}
+
+// Column017GT applies the GT predicate on the "column017" field.
+func Column017GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn017, v))
+}
+
+// Column017GTE applies the GTE predicate on the "column017" field.
+func Column017GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn017, v))
+}
+
+// Column017LT applies the LT predicate on the "column017" field.
+func Column017LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn017, v))
+}
+
+// Column017LTE applies the LTE predicate on the "column017" field.
+func Column017LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn017, v))
+}
+
+// Column017Contains applies the Contains predicate on the "column017" field.
+func Column017Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn017, v))
+}
+
+// Column017HasPrefix applies the HasPrefix predicate on the "column017" field.
+func Column017HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn017, v))
+}
+
+// Column017HasSuffix applies the HasSuffix predicate on the "column017" field.
+func Column017HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn017, v))
+}
+
+// Column017EqualFold applies the EqualFold predicate on the "column017" field.
+func Column017EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn017, v))
+}
+
+// Column017ContainsFold applies the ContainsFold predicate on the "column017" field.
+func Column017ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn017, v))
+}
+
+// Column018EQ applies the EQ predicate on the "column018" field.
+func Column018EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn018, v))
+}
+
+// Column018NEQ applies the NEQ predicate on the "column018" field.
+func Column018NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn018, v))
+}
+
+// Column018In applies the In predicate on the "column018" field.
+func Column018In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn018, vs...))
+}
+
+// Column018NotIn applies the NotIn predicate on the "column018" field.
+func Column018NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn018, vs...))
+}
+
+// Column018GT applies the GT predicate on the "column018" field.
+func Column018GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn018, v))
+}
+
+// Column018GTE applies the GTE predicate on the "column018" field.
+func Column018GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn018, v))
+}
+
+// Column018LT applies the LT predicate on the "column018" field.
+func Column018LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn018, v))
+}
+
+// Column018LTE applies the LTE predicate on the "column018" field.
+func Column018LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn018, v))
+}
+
+// Column019EQ applies the EQ predicate on the "column019" field.
+func Column019EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn019, v))
+}
+
+// Column019NEQ applies the NEQ predicate on the "column019" field.
+func Column019NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn019, v))
+}
+
+// Column019In applies the In predicate on the "column019" field.
+func Column019In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn019, vs...))
+}
+
+// Column019NotIn applies the NotIn predicate on the "column019" field.
+func Column019NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn019, vs...))
+}
+
+// Column019GT applies the GT predicate on the "column019" field.
+func Column019GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn019, v))
+}
+
+// Column019GTE applies the GTE predicate on the "column019" field.
+func Column019GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn019, v))
+}
+
+// Column019LT applies the LT predicate on the "column019" field.
+func Column019LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn019, v))
+}
+
+// Column019LTE applies the LTE predicate on the "column019" field.
+func Column019LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn019, v))
+}
+
+// Column020EQ applies the EQ predicate on the "column020" field.
+func Column020EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn020, v))
+}
+
+// Column020NEQ applies the NEQ predicate on the "column020" field.
+func Column020NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn020, v))
+}
+
+// Column020In applies the In predicate on the "column020" field.
+func Column020In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn020, vs...))
+}
+
+// Column020NotIn applies the NotIn predicate on the "column020" field.
+func Column020NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn020, vs...))
+}
+
+// Column020GT applies the GT predicate on the "column020" field.
+func Column020GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn020, v))
+}
+
+// Column020GTE applies the GTE predicate on the "column020" field.
+func Column020GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn020, v))
+}
+
+// Column020LT applies the LT predicate on the "column020" field.
+func Column020LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn020, v))
+}
+
+// Column020LTE applies the LTE predicate on the "column020" field.
+func Column020LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn020, v))
+}
+
+// Column021EQ applies the EQ predicate on the "column021" field.
+func Column021EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn021, v))
+}
+
+// Column021NEQ applies the NEQ predicate on the "column021" field.
+func Column021NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn021, v))
+}
+
+// Column021In applies the In predicate on the "column021" field.
+func Column021In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn021, vs...))
+}
+
+// Column021NotIn applies the NotIn predicate on the "column021" field.
+func Column021NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn021, vs...))
+}
+
+// Column021GT applies the GT predicate on the "column021" field.
+func Column021GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn021, v))
+}
+
+// Column021GTE applies the GTE predicate on the "column021" field.
+func Column021GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn021, v))
+}
+
+// Column021LT applies the LT predicate on the "column021" field.
+func Column021LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn021, v))
+}
+
+// Column021LTE applies the LTE predicate on the "column021" field.
+func Column021LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn021, v))
+}
+
+// Column021Contains applies the Contains predicate on the "column021" field.
+func Column021Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn021, v))
+}
+
+// Column021HasPrefix applies the HasPrefix predicate on the "column021" field.
+func Column021HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn021, v))
+}
+
+// Column021HasSuffix applies the HasSuffix predicate on the "column021" field.
+func Column021HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn021, v))
+}
+
+// Column021EqualFold applies the EqualFold predicate on the "column021" field.
+func Column021EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn021, v))
+}
+
+// Column021ContainsFold applies the ContainsFold predicate on the "column021" field.
+func Column021ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn021, v))
+}
+
+// Column022EQ applies the EQ predicate on the "column022" field.
+func Column022EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn022, v))
+}
+
+// Column022NEQ applies the NEQ predicate on the "column022" field.
+func Column022NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn022, v))
+}
+
+// Column022In applies the In predicate on the "column022" field.
+func Column022In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn022, vs...))
+}
+
+// Column022NotIn applies the NotIn predicate on the "column022" field.
+func Column022NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn022, vs...))
+}
+
+// Column022GT applies the GT predicate on the "column022" field.
+func Column022GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn022, v))
+}
+
+// Column022GTE applies the GTE predicate on the "column022" field.
+func Column022GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn022, v))
+}
+
+// Column022LT applies the LT predicate on the "column022" field.
+func Column022LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn022, v))
+}
+
+// Column022LTE applies the LTE predicate on the "column022" field.
+func Column022LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn022, v))
+}
+
+// Column023EQ applies the EQ predicate on the "column023" field.
+func Column023EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn023, v))
+}
+
+// Column023NEQ applies the NEQ predicate on the "column023" field.
+func Column023NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn023, v))
+}
+
+// Column023In applies the In predicate on the "column023" field.
+func Column023In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn023, vs...))
+}
+
+// Column023NotIn applies the NotIn predicate on the "column023" field.
+func Column023NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn023, vs...))
+}
+
+// Column023GT applies the GT predicate on the "column023" field.
+func Column023GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn023, v))
+}
+
+// Column023GTE applies the GTE predicate on the "column023" field.
+func Column023GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn023, v))
+}
+
+// Column023LT applies the LT predicate on the "column023" field.
+func Column023LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn023, v))
+}
+
+// Column023LTE applies the LTE predicate on the "column023" field.
+func Column023LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn023, v))
+}
+
+// Column024EQ applies the EQ predicate on the "column024" field.
+func Column024EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn024, v))
+}
+
+// Column024NEQ applies the NEQ predicate on the "column024" field.
+func Column024NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn024, v))
+}
+
+// Column024In applies the In predicate on the "column024" field.
+func Column024In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn024, vs...))
+}
+
+// Column024NotIn applies the NotIn predicate on the "column024" field.
+func Column024NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn024, vs...))
+}
+
+// Column024GT applies the GT predicate on the "column024" field.
+func Column024GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn024, v))
+}
+
+// Column024GTE applies the GTE predicate on the "column024" field.
+func Column024GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn024, v))
+}
+
+// Column024LT applies the LT predicate on the "column024" field.
+func Column024LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn024, v))
+}
+
+// Column024LTE applies the LTE predicate on the "column024" field.
+func Column024LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn024, v))
+}
+
+// Column025EQ applies the EQ predicate on the "column025" field.
+func Column025EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn025, v))
+}
+
+// Column025NEQ applies the NEQ predicate on the "column025" field.
+func Column025NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn025, v))
+}
+
+// Column025In applies the In predicate on the "column025" field.
+func Column025In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn025, vs...))
+}
+
+// Column025NotIn applies the NotIn predicate on the "column025" field.
+func Column025NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn025, vs...))
+}
+
+// Column025GT applies the GT predicate on the "column025" field.
+func Column025GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn025, v))
+}
+
+// Column025GTE applies the GTE predicate on the "column025" field.
+func Column025GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn025, v))
+}
+
+// Column025LT applies the LT predicate on the "column025" field.
+func Column025LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn025, v))
+}
+
+// Column025LTE applies the LTE predicate on the "column025" field.
+func Column025LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn025, v))
+}
+
+// Column025Contains applies the Contains predicate on the "column025" field.
+func Column025Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn025, v))
+}
+
+// Column025HasPrefix applies the HasPrefix predicate on the "column025" field.
+func Column025HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn025, v))
+}
+
+// Column025HasSuffix applies the HasSuffix predicate on the "column025" field.
+func Column025HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn025, v))
+}
+
+// Column025EqualFold applies the EqualFold predicate on the "column025" field.
+func Column025EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn025, v))
+}
+
+// Column025ContainsFold applies the ContainsFold predicate on the "column025" field.
+func Column025ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn025, v))
+}
+
+// Column026EQ applies the EQ predicate on the "column026" field.
+func Column026EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn026, v))
+}
+
+// Column026NEQ applies the NEQ predicate on the "column026" field.
+func Column026NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn026, v))
+}
+
+// Column026In applies the In predicate on the "column026" field.
+func Column026In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn026, vs...))
+}
+
+// Column026NotIn applies the NotIn predicate on the "column026" field.
+func Column026NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn026, vs...))
+}
+
+// Column026GT applies the GT predicate on the "column026" field.
+func Column026GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn026, v))
+}
+
+// Column026GTE applies the GTE predicate on the "column026" field.
+func Column026GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn026, v))
+}
+
+// Column026LT applies the LT predicate on the "column026" field.
+func Column026LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn026, v))
+}
+
+// Column026LTE applies the LTE predicate on the "column026" field.
+func Column026LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn026, v))
+}
+
+// Column027EQ applies the EQ predicate on the "column027" field.
+func Column027EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn027, v))
+}
+
+// Column027NEQ applies the NEQ predicate on the "column027" field.
+func Column027NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn027, v))
+}
+
+// Column027In applies the In predicate on the "column027" field.
+func Column027In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn027, vs...))
+}
+
+// Column027NotIn applies the NotIn predicate on the "column027" field.
+func Column027NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn027, vs...))
+}
+
+// Column027GT applies the GT predicate on the "column027" field.
+func Column027GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn027, v))
+}
+
+// Column027GTE applies the GTE predicate on the "column027" field.
+func Column027GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn027, v))
+}
+
+// Column027LT applies the LT predicate on the "column027" field.
+func Column027LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn027, v))
+}
+
+// Column027LTE applies the LTE predicate on the "column027" field.
+func Column027LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn027, v))
+}
+
+// Column028EQ applies the EQ predicate on the "column028" field.
+func Column028EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn028, v))
+}
+
+// Column028NEQ applies the NEQ predicate on the "column028" field.
+func Column028NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn028, v))
+}
+
+// Column028In applies the In predicate on the "column028" field.
+func Column028In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn028, vs...))
+}
+
+// Column028NotIn applies the NotIn predicate on the "column028" field.
+func Column028NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn028, vs...))
+}
+
+// Column028GT applies the GT predicate on the "column028" field.
+func Column028GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn028, v))
+}
+
+// Column028GTE applies the GTE predicate on the "column028" field.
+func Column028GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn028, v))
+}
+
+// Column028LT applies the LT predicate on the "column028" field.
+func Column028LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn028, v))
+}
+
+// Column028LTE applies the LTE predicate on the "column028" field.
+func Column028LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn028, v))
+}
+
+// Column029EQ applies the EQ predicate on the "column029" field.
+func Column029EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn029, v))
+}
+
+// Column029NEQ applies the NEQ predicate on the "column029" field.
+func Column029NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn029, v))
+}
+
+// Column029In applies the In predicate on the "column029" field.
+func Column029In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn029, vs...))
+}
+
+// Column029NotIn applies the NotIn predicate on the "column029" field.
+func Column029NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn029, vs...))
+}
+
+// Column029GT applies the GT predicate on the "column029" field.
+func Column029GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn029, v))
+}
+
+// Column029GTE applies the GTE predicate on the "column029" field.
+func Column029GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn029, v))
+}
+
+// Column029LT applies the LT predicate on the "column029" field.
+func Column029LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn029, v))
+}
+
+// Column029LTE applies the LTE predicate on the "column029" field.
+func Column029LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn029, v))
+}
+
+// Column029Contains applies the Contains predicate on the "column029" field.
+func Column029Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn029, v))
+}
+
+// Column029HasPrefix applies the HasPrefix predicate on the "column029" field.
+func Column029HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn029, v))
+}
+
+// Column029HasSuffix applies the HasSuffix predicate on the "column029" field.
+func Column029HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn029, v))
+}
+
+// Column029EqualFold applies the EqualFold predicate on the "column029" field.
+func Column029EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn029, v))
+}
+
+// Column029ContainsFold applies the ContainsFold predicate on the "column029" field.
+func Column029ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn029, v))
+}
+
+// Column030EQ applies the EQ predicate on the "column030" field.
+func Column030EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn030, v))
+}
+
+// Column030NEQ applies the NEQ predicate on the "column030" field.
+func Column030NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn030, v))
+}
+
+// Column030In applies the In predicate on the "column030" field.
+func Column030In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn030, vs...))
+}
+
+// Column030NotIn applies the NotIn predicate on the "column030" field.
+func Column030NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn030, vs...))
+}
+
+// Column030GT applies the GT predicate on the "column030" field.
+func Column030GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn030, v))
+}
+
+// Column030GTE applies the GTE predicate on the "column030" field.
+func Column030GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn030, v))
+}
+
+// Column030LT applies the LT predicate on the "column030" field.
+func Column030LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn030, v))
+}
+
+// Column030LTE applies the LTE predicate on the "column030" field.
+func Column030LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn030, v))
+}
+
+// Column031EQ applies the EQ predicate on the "column031" field.
+func Column031EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn031, v))
+}
+
+// Column031NEQ applies the NEQ predicate on the "column031" field.
+func Column031NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn031, v))
+}
+
+// Column031In applies the In predicate on the "column031" field.
+func Column031In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn031, vs...))
+}
+
+// Column031NotIn applies the NotIn predicate on the "column031" field.
+func Column031NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn031, vs...))
+}
+
+// Column031GT applies the GT predicate on the "column031" field.
+func Column031GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn031, v))
+}
+
+// Column031GTE applies the GTE predicate on the "column031" field.
+func Column031GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn031, v))
+}
+
+// Column031LT applies the LT predicate on the "column031" field.
+func Column031LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn031, v))
+}
+
+// Column031LTE applies the LTE predicate on the "column031" field.
+func Column031LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn031, v))
+}
+
+// Column032EQ applies the EQ predicate on the "column032" field.
+func Column032EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn032, v))
+}
+
+// Column032NEQ applies the NEQ predicate on the "column032" field.
+func Column032NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn032, v))
+}
+
+// Column032In applies the In predicate on the "column032" field.
+func Column032In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn032, vs...))
+}
+
+// Column032NotIn applies the NotIn predicate on the "column032" field.
+func Column032NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn032, vs...))
+}
+
+// Column032GT applies the GT predicate on the "column032" field.
+func Column032GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn032, v))
+}
+
+// Column032GTE applies the GTE predicate on the "column032" field.
+func Column032GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn032, v))
+}
+
+// Column032LT applies the LT predicate on the "column032" field.
+func Column032LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn032, v))
+}
+
+// Column032LTE applies the LTE predicate on the "column032" field.
+func Column032LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn032, v))
+}
+
+// Column033EQ applies the EQ predicate on the "column033" field.
+func Column033EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn033, v))
+}
+
+// Column033NEQ applies the NEQ predicate on the "column033" field.
+func Column033NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn033, v))
+}
+
+// Column033In applies the In predicate on the "column033" field.
+func Column033In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn033, vs...))
+}
+
+// Column033NotIn applies the NotIn predicate on the "column033" field.
+func Column033NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn033, vs...))
+}
+
+// Column033GT applies the GT predicate on the "column033" field.
+func Column033GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn033, v))
+}
+
+// Column033GTE applies the GTE predicate on the "column033" field.
+func Column033GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn033, v))
+}
+
+// Column033LT applies the LT predicate on the "column033" field.
+func Column033LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn033, v))
+}
+
+// Column033LTE applies the LTE predicate on the "column033" field.
+func Column033LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn033, v))
+}
+
+// Column033Contains applies the Contains predicate on the "column033" field.
+func Column033Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn033, v))
+}
+
+// Column033HasPrefix applies the HasPrefix predicate on the "column033" field.
+func Column033HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn033, v))
+}
+
+// Column033HasSuffix applies the HasSuffix predicate on the "column033" field.
+func Column033HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn033, v))
+}
+
+// Column033EqualFold applies the EqualFold predicate on the "column033" field.
+func Column033EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn033, v))
+}
+
+// Column033ContainsFold applies the ContainsFold predicate on the "column033" field.
+func Column033ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn033, v))
+}
+
+// Column034EQ applies the EQ predicate on the "column034" field.
+func Column034EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn034, v))
+}
+
+// Column034NEQ applies the NEQ predicate on the "column034" field.
+func Column034NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn034, v))
+}
+
+// Column034In applies the In predicate on the "column034" field.
+func Column034In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn034, vs...))
+}
+
+// Column034NotIn applies the NotIn predicate on the "column034" field.
+func Column034NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn034, vs...))
+}
+
+// Column034GT applies the GT predicate on the "column034" field.
+func Column034GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn034, v))
+}
+
+// Column034GTE applies the GTE predicate on the "column034" field.
+func Column034GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn034, v))
+}
+
+// Column034LT applies the LT predicate on the "column034" field.
+func Column034LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn034, v))
+}
+
+// Column034LTE applies the LTE predicate on the "column034" field.
+func Column034LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn034, v))
+}
+
+// Column035EQ applies the EQ predicate on the "column035" field.
+func Column035EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn035, v))
+}
+
+// Column035NEQ applies the NEQ predicate on the "column035" field.
+func Column035NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn035, v))
+}
+
+// Column035In applies the In predicate on the "column035" field.
+func Column035In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn035, vs...))
+}
+
+// Column035NotIn applies the NotIn predicate on the "column035" field.
+func Column035NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn035, vs...))
+}
+
+// Column035GT applies the GT predicate on the "column035" field.
+func Column035GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn035, v))
+}
+
+// Column035GTE applies the GTE predicate on the "column035" field.
+func Column035GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn035, v))
+}
+
+// Column035LT applies the LT predicate on the "column035" field.
+func Column035LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn035, v))
+}
+
+// Column035LTE applies the LTE predicate on the "column035" field.
+func Column035LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn035, v))
+}
+
+// Column036EQ applies the EQ predicate on the "column036" field.
+func Column036EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn036, v))
+}
+
+// Column036NEQ applies the NEQ predicate on the "column036" field.
+func Column036NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn036, v))
+}
+
+// Column036In applies the In predicate on the "column036" field.
+func Column036In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn036, vs...))
+}
+
+// Column036NotIn applies the NotIn predicate on the "column036" field.
+func Column036NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn036, vs...))
+}
+
+// Column036GT applies the GT predicate on the "column036" field.
+func Column036GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn036, v))
+}
+
+// Column036GTE applies the GTE predicate on the "column036" field.
+func Column036GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn036, v))
+}
+
+// Column036LT applies the LT predicate on the "column036" field.
+func Column036LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn036, v))
+}
+
+// Column036LTE applies the LTE predicate on the "column036" field.
+func Column036LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn036, v))
+}
+
+// Column037EQ applies the EQ predicate on the "column037" field.
+func Column037EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn037, v))
+}
+
+// Column037NEQ applies the NEQ predicate on the "column037" field.
+func Column037NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn037, v))
+}
+
+// Column037In applies the In predicate on the "column037" field.
+func Column037In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn037, vs...))
+}
+
+// Column037NotIn applies the NotIn predicate on the "column037" field.
+func Column037NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn037, vs...))
+}
+
+// Column037GT applies the GT predicate on the "column037" field.
+func Column037GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn037, v))
+}
+
+// Column037GTE applies the GTE predicate on the "column037" field.
+func Column037GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn037, v))
+}
+
+// Column037LT applies the LT predicate on the "column037" field.
+func Column037LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn037, v))
+}
+
+// Column037LTE applies the LTE predicate on the "column037" field.
+func Column037LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn037, v))
+}
+
+// Column037Contains applies the Contains predicate on the "column037" field.
+func Column037Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn037, v))
+}
+
+// Column037HasPrefix applies the HasPrefix predicate on the "column037" field.
+func Column037HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn037, v))
+}
+
+// Column037HasSuffix applies the HasSuffix predicate on the "column037" field.
+func Column037HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn037, v))
+}
+
+// Column037EqualFold applies the EqualFold predicate on the "column037" field.
+func Column037EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn037, v))
+}
+
+// Column037ContainsFold applies the ContainsFold predicate on the "column037" field.
+func Column037ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn037, v))
+}
+
+// Column038EQ applies the EQ predicate on the "column038" field.
+func Column038EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn038, v))
+}
+
+// Column038NEQ applies the NEQ predicate on the "column038" field.
+func Column038NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn038, v))
+}
+
+// Column038In applies the In predicate on the "column038" field.
+func Column038In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn038, vs...))
+}
+
+// Column038NotIn applies the NotIn predicate on the "column038" field.
+func Column038NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn038, vs...))
+}
+
+// Column038GT applies the GT predicate on the "column038" field.
+func Column038GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn038, v))
+}
+
+// Column038GTE applies the GTE predicate on the "column038" field.
+func Column038GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn038, v))
+}
+
+// Column038LT applies the LT predicate on the "column038" field.
+func Column038LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn038, v))
+}
+
+// Column038LTE applies the LTE predicate on the "column038" field.
+func Column038LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn038, v))
+}
+
+// Column039EQ applies the EQ predicate on the "column039" field.
+func Column039EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn039, v))
+}
+
+// Column039NEQ applies the NEQ predicate on the "column039" field.
+func Column039NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn039, v))
+}
+
+// Column039In applies the In predicate on the "column039" field.
+func Column039In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn039, vs...))
+}
+
+// Column039NotIn applies the NotIn predicate on the "column039" field.
+func Column039NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn039, vs...))
+}
+
+// Column039GT applies the GT predicate on the "column039" field.
+func Column039GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn039, v))
+}
+
+// Column039GTE applies the GTE predicate on the "column039" field.
+func Column039GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn039, v))
+}
+
+// Column039LT applies the LT predicate on the "column039" field.
+func Column039LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn039, v))
+}
+
+// Column039LTE applies the LTE predicate on the "column039" field.
+func Column039LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn039, v))
+}
+
+// Column040EQ applies the EQ predicate on the "column040" field.
+func Column040EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn040, v))
+}
+
+// Column040NEQ applies the NEQ predicate on the "column040" field.
+func Column040NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn040, v))
+}
+
+// Column040In applies the In predicate on the "column040" field.
+func Column040In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn040, vs...))
+}
+
+// Column040NotIn applies the NotIn predicate on the "column040" field.
+func Column040NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn040, vs...))
+}
+
+// Column040GT applies the GT predicate on the "column040" field.
+func Column040GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn040, v))
+}
+
+// Column040GTE applies the GTE predicate on the "column040" field.
+func Column040GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn040, v))
+}
+
+// Column040LT applies the LT predicate on the "column040" field.
+func Column040LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn040, v))
+}
+
+// Column040LTE applies the LTE predicate on the "column040" field.
+func Column040LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn040, v))
+}
+
+// Column041EQ applies the EQ predicate on the "column041" field.
+func Column041EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn041, v))
+}
+
+// Column041NEQ applies the NEQ predicate on the "column041" field.
+func Column041NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn041, v))
+}
+
+// Column041In applies the In predicate on the "column041" field.
+func Column041In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn041, vs...))
+}
+
+// Column041NotIn applies the NotIn predicate on the "column041" field.
+func Column041NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn041, vs...))
+}
+
+// Column041GT applies the GT predicate on the "column041" field.
+func Column041GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn041, v))
+}
+
+// Column041GTE applies the GTE predicate on the "column041" field.
+func Column041GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn041, v))
+}
+
+// Column041LT applies the LT predicate on the "column041" field.
+func Column041LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn041, v))
+}
+
+// Column041LTE applies the LTE predicate on the "column041" field.
+func Column041LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn041, v))
+}
+
+// Column041Contains applies the Contains predicate on the "column041" field.
+func Column041Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn041, v))
+}
+
+// Column041HasPrefix applies the HasPrefix predicate on the "column041" field.
+func Column041HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn041, v))
+}
+
+// Column041HasSuffix applies the HasSuffix predicate on the "column041" field.
+func Column041HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn041, v))
+}
+
+// Column041EqualFold applies the EqualFold predicate on the "column041" field.
+func Column041EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn041, v))
+}
+
+// Column041ContainsFold applies the ContainsFold predicate on the "column041" field.
+func Column041ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn041, v))
+}
+
+// Column042EQ applies the EQ predicate on the "column042" field.
+func Column042EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn042, v))
+}
+
+// Column042NEQ applies the NEQ predicate on the "column042" field.
+func Column042NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn042, v))
+}
+
+// Column042In applies the In predicate on the "column042" field.
+func Column042In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn042, vs...))
+}
+
+// Column042NotIn applies the NotIn predicate on the "column042" field.
+func Column042NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn042, vs...))
+}
+
+// Column042GT applies the GT predicate on the "column042" field.
+func Column042GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn042, v))
+}
+
+// Column042GTE applies the GTE predicate on the "column042" field.
+func Column042GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn042, v))
+}
+
+// Column042LT applies the LT predicate on the "column042" field.
+func Column042LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn042, v))
+}
+
+// Column042LTE applies the LTE predicate on the "column042" field.
+func Column042LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn042, v))
+}
+
+// Column043EQ applies the EQ predicate on the "column043" field.
+func Column043EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn043, v))
+}
+
+// Column043NEQ applies the NEQ predicate on the "column043" field.
+func Column043NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn043, v))
+}
+
+// Column043In applies the In predicate on the "column043" field.
+func Column043In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn043, vs...))
+}
+
+// Column043NotIn applies the NotIn predicate on the "column043" field.
+func Column043NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn043, vs...))
+}
+
+// Column043GT applies the GT predicate on the "column043" field.
+func Column043GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn043, v))
+}
+
+// Column043GTE applies the GTE predicate on the "column043" field.
+func Column043GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn043, v))
+}
+
+// Column043LT applies the LT predicate on the "column043" field.
+func Column043LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn043, v))
+}
+
+// Column043LTE applies the LTE predicate on the "column043" field.
+func Column043LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn043, v))
+}
+
+// Column044EQ applies the EQ predicate on the "column044" field.
+func Column044EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn044, v))
+}
+
+// Column044NEQ applies the NEQ predicate on the "column044" field.
+func Column044NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn044, v))
+}
+
+// Column044In applies the In predicate on the "column044" field.
+func Column044In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn044, vs...))
+}
+
+// Column044NotIn applies the NotIn predicate on the "column044" field.
+func Column044NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn044, vs...))
+}
+
+// Column044GT applies the GT predicate on the "column044" field.
+func Column044GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn044, v))
+}
+
+// Column044GTE applies the GTE predicate on the "column044" field.
+func Column044GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn044, v))
+}
+
+// Column044LT applies the LT predicate on the "column044" field.
+func Column044LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn044, v))
+}
+
+// Column044LTE applies the LTE predicate on the "column044" field.
+func Column044LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn044, v))
+}
+
+// Column045EQ applies the EQ predicate on the "column045" field.
+func Column045EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn045, v))
+}
+
+// Column045NEQ applies the NEQ predicate on the "column045" field.
+func Column045NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn045, v))
+}
+
+// Column045In applies the In predicate on the "column045" field.
+func Column045In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn045, vs...))
+}
+
+// Column045NotIn applies the NotIn predicate on the "column045" field.
+func Column045NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn045, vs...))
+}
+
+// Column045GT applies the GT predicate on the "column045" field.
+func Column045GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn045, v))
+}
+
+// Column045GTE applies the GTE predicate on the "column045" field.
+func Column045GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn045, v))
+}
+
+// Column045LT applies the LT predicate on the "column045" field.
+func Column045LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn045, v))
+}
+
+// Column045LTE applies the LTE predicate on the "column045" field.
+func Column045LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn045, v))
+}
+
+// Column045Contains applies the Contains predicate on the "column045" field.
+func Column045Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn045, v))
+}
+
+// Column045HasPrefix applies the HasPrefix predicate on the "column045" field.
+func Column045HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn045, v))
+}
+
+// Column045HasSuffix applies the HasSuffix predicate on the "column045" field.
+func Column045HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn045, v))
+}
+
+// Column045EqualFold applies the EqualFold predicate on the "column045" field.
+func Column045EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn045, v))
+}
+
+// Column045ContainsFold applies the ContainsFold predicate on the "column045" field.
+func Column045ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn045, v))
+}
+
+// Column046EQ applies the EQ predicate on the "column046" field.
+func Column046EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn046, v))
+}
+
+// Column046NEQ applies the NEQ predicate on the "column046" field.
+func Column046NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn046, v))
+}
+
+// Column046In applies the In predicate on the "column046" field.
+func Column046In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn046, vs...))
+}
+
+// Column046NotIn applies the NotIn predicate on the "column046" field.
+func Column046NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn046, vs...))
+}
+
+// Column046GT applies the GT predicate on the "column046" field.
+func Column046GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn046, v))
+}
+
+// Column046GTE applies the GTE predicate on the "column046" field.
+func Column046GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn046, v))
+}
+
+// Column046LT applies the LT predicate on the "column046" field.
+func Column046LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn046, v))
+}
+
+// Column046LTE applies the LTE predicate on the "column046" field.
+func Column046LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn046, v))
+}
+
+// Column047EQ applies the EQ predicate on the "column047" field.
+func Column047EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn047, v))
+}
+
+// Column047NEQ applies the NEQ predicate on the "column047" field.
+func Column047NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn047, v))
+}
+
+// Column047In applies the In predicate on the "column047" field.
+func Column047In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn047, vs...))
+}
+
+// Column047NotIn applies the NotIn predicate on the "column047" field.
+func Column047NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn047, vs...))
+}
+
+// Column047GT applies the GT predicate on the "column047" field.
+func Column047GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn047, v))
+}
+
+// Column047GTE applies the GTE predicate on the "column047" field.
+func Column047GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn047, v))
+}
+
+// Column047LT applies the LT predicate on the "column047" field.
+func Column047LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn047, v))
+}
+
+// Column047LTE applies the LTE predicate on the "column047" field.
+func Column047LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn047, v))
+}
+
+// Column048EQ applies the EQ predicate on the "column048" field.
+func Column048EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn048, v))
+}
+
+// Column048NEQ applies the NEQ predicate on the "column048" field.
+func Column048NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn048, v))
+}
+
+// Column048In applies the In predicate on the "column048" field.
+func Column048In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn048, vs...))
+}
+
+// Column048NotIn applies the NotIn predicate on the "column048" field.
+func Column048NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn048, vs...))
+}
+
+// Column048GT applies the GT predicate on the "column048" field.
+func Column048GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn048, v))
+}
+
+// Column048GTE applies the GTE predicate on the "column048" field.
+func Column048GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn048, v))
+}
+
+// Column048LT applies the LT predicate on the "column048" field.
+func Column048LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn048, v))
+}
+
+// Column048LTE applies the LTE predicate on the "column048" field.
+func Column048LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn048, v))
+}
+
+// Column049EQ applies the EQ predicate on the "column049" field.
+func Column049EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn049, v))
+}
+
+// Column049NEQ applies the NEQ predicate on the "column049" field.
+func Column049NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn049, v))
+}
+
+// Column049In applies the In predicate on the "column049" field.
+func Column049In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn049, vs...))
+}
+
+// Column049NotIn applies the NotIn predicate on the "column049" field.
+func Column049NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn049, vs...))
+}
+
+// Column049GT applies the GT predicate on the "column049" field.
+func Column049GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn049, v))
+}
+
+// Column049GTE applies the GTE predicate on the "column049" field.
+func Column049GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn049, v))
+}
+
+// Column049LT applies the LT predicate on the "column049" field.
+func Column049LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn049, v))
+}
+
+// Column049LTE applies the LTE predicate on the "column049" field.
+func Column049LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn049, v))
+}
+
+// Column049Contains applies the Contains predicate on the "column049" field.
+func Column049Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn049, v))
+}
+
+// Column049HasPrefix applies the HasPrefix predicate on the "column049" field.
+func Column049HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn049, v))
+}
+
+// Column049HasSuffix applies the HasSuffix predicate on the "column049" field.
+func Column049HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn049, v))
+}
+
+// Column049EqualFold applies the EqualFold predicate on the "column049" field.
+func Column049EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn049, v))
+}
+
+// Column049ContainsFold applies the ContainsFold predicate on the "column049" field.
+func Column049ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn049, v))
+}
+
+// Column050EQ applies the EQ predicate on the "column050" field.
+func Column050EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn050, v))
+}
+
+// Column050NEQ applies the NEQ predicate on the "column050" field.
+func Column050NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn050, v))
+}
+
+// Column050In applies the In predicate on the "column050" field.
+func Column050In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn050, vs...))
+}
+
+// Column050NotIn applies the NotIn predicate on the "column050" field.
+func Column050NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn050, vs...))
+}
+
+// Column050GT applies the GT predicate on the "column050" field.
+func Column050GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn050, v))
+}
+
+// Column050GTE applies the GTE predicate on the "column050" field.
+func Column050GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn050, v))
+}
+
+// Column050LT applies the LT predicate on the "column050" field.
+func Column050LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn050, v))
+}
+
+// Column050LTE applies the LTE predicate on the "column050" field.
+func Column050LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn050, v))
+}
+
+// Column051EQ applies the EQ predicate on the "column051" field.
+func Column051EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn051, v))
+}
+
+// Column051NEQ applies the NEQ predicate on the "column051" field.
+func Column051NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn051, v))
+}
+
+// Column051In applies the In predicate on the "column051" field.
+func Column051In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn051, vs...))
+}
+
+// Column051NotIn applies the NotIn predicate on the "column051" field.
+func Column051NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn051, vs...))
+}
+
+// Column051GT applies the GT predicate on the "column051" field.
+func Column051GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn051, v))
+}
+
+// Column051GTE applies the GTE predicate on the "column051" field.
+func Column051GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn051, v))
+}
+
+// Column051LT applies the LT predicate on the "column051" field.
+func Column051LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn051, v))
+}
+
+// Column051LTE applies the LTE predicate on the "column051" field.
+func Column051LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn051, v))
+}
+
+// Column052EQ applies the EQ predicate on the "column052" field.
+func Column052EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn052, v))
+}
+
+// Column052NEQ applies the NEQ predicate on the "column052" field.
+func Column052NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn052, v))
+}
+
+// Column052In applies the In predicate on the "column052" field.
+func Column052In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn052, vs...))
+}
+
+// Column052NotIn applies the NotIn predicate on the "column052" field.
+func Column052NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn052, vs...))
+}
+
+// Column052GT applies the GT predicate on the "column052" field.
+func Column052GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn052, v))
+}
+
+// Column052GTE applies the GTE predicate on the "column052" field.
+func Column052GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn052, v))
+}
+
+// Column052LT applies the LT predicate on the "column052" field.
+func Column052LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn052, v))
+}
+
+// Column052LTE applies the LTE predicate on the "column052" field.
+func Column052LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn052, v))
+}
+
+// Column053EQ applies the EQ predicate on the "column053" field.
+func Column053EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn053, v))
+}
+
+// Column053NEQ applies the NEQ predicate on the "column053" field.
+func Column053NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn053, v))
+}
+
+// Column053In applies the In predicate on the "column053" field.
+func Column053In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn053, vs...))
+}
+
+// Column053NotIn applies the NotIn predicate on the "column053" field.
+func Column053NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn053, vs...))
+}
+
+// Column053GT applies the GT predicate on the "column053" field.
+func Column053GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn053, v))
+}
+
+// Column053GTE applies the GTE predicate on the "column053" field.
+func Column053GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn053, v))
+}
+
+// Column053LT applies the LT predicate on the "column053" field.
+func Column053LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn053, v))
+}
+
+// Column053LTE applies the LTE predicate on the "column053" field.
+func Column053LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn053, v))
+}
+
+// Column053Contains applies the Contains predicate on the "column053" field.
+func Column053Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn053, v))
+}
+
+// Column053HasPrefix applies the HasPrefix predicate on the "column053" field.
+func Column053HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn053, v))
+}
+
+// Column053HasSuffix applies the HasSuffix predicate on the "column053" field.
+func Column053HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn053, v))
+}
+
+// Column053EqualFold applies the EqualFold predicate on the "column053" field.
+func Column053EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn053, v))
+}
+
+// Column053ContainsFold applies the ContainsFold predicate on the "column053" field.
+func Column053ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn053, v))
+}
+
+// Column054EQ applies the EQ predicate on the "column054" field.
+func Column054EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn054, v))
+}
+
+// Column054NEQ applies the NEQ predicate on the "column054" field.
+func Column054NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn054, v))
+}
+
+// Column054In applies the In predicate on the "column054" field.
+func Column054In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn054, vs...))
+}
+
+// Column054NotIn applies the NotIn predicate on the "column054" field.
+func Column054NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn054, vs...))
+}
+
+// Column054GT applies the GT predicate on the "column054" field.
+func Column054GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn054, v))
+}
+
+// Column054GTE applies the GTE predicate on the "column054" field.
+func Column054GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn054, v))
+}
+
+// Column054LT applies the LT predicate on the "column054" field.
+func Column054LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn054, v))
+}
+
+// Column054LTE applies the LTE predicate on the "column054" field.
+func Column054LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn054, v))
+}
+
+// Column055EQ applies the EQ predicate on the "column055" field.
+func Column055EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn055, v))
+}
+
+// Column055NEQ applies the NEQ predicate on the "column055" field.
+func Column055NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn055, v))
+}
+
+// Column055In applies the In predicate on the "column055" field.
+func Column055In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn055, vs...))
+}
+
+// Column055NotIn applies the NotIn predicate on the "column055" field.
+func Column055NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn055, vs...))
+}
+
+// Column055GT applies the GT predicate on the "column055" field.
+func Column055GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn055, v))
+}
+
+// Column055GTE applies the GTE predicate on the "column055" field.
+func Column055GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn055, v))
+}
+
+// Column055LT applies the LT predicate on the "column055" field.
+func Column055LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn055, v))
+}
+
+// Column055LTE applies the LTE predicate on the "column055" field.
+func Column055LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn055, v))
+}
+
+// Column056EQ applies the EQ predicate on the "column056" field.
+func Column056EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn056, v))
+}
+
+// Column056NEQ applies the NEQ predicate on the "column056" field.
+func Column056NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn056, v))
+}
+
+// Column056In applies the In predicate on the "column056" field.
+func Column056In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn056, vs...))
+}
+
+// Column056NotIn applies the NotIn predicate on the "column056" field.
+func Column056NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn056, vs...))
+}
+
+// Column056GT applies the GT predicate on the "column056" field.
+func Column056GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn056, v))
+}
+
+// Column056GTE applies the GTE predicate on the "column056" field.
+func Column056GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn056, v))
+}
+
+// Column056LT applies the LT predicate on the "column056" field.
+func Column056LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn056, v))
+}
+
+// Column056LTE applies the LTE predicate on the "column056" field.
+func Column056LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn056, v))
+}
+
+// Column057EQ applies the EQ predicate on the "column057" field.
+func Column057EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn057, v))
+}
+
+// Column057NEQ applies the NEQ predicate on the "column057" field.
+func Column057NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn057, v))
+}
+
+// Column057In applies the In predicate on the "column057" field.
+func Column057In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn057, vs...))
+}
+
+// Column057NotIn applies the NotIn predicate on the "column057" field.
+func Column057NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn057, vs...))
+}
+
+// Column057GT applies the GT predicate on the "column057" field.
+func Column057GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn057, v))
+}
+
+// Column057GTE applies the GTE predicate on the "column057" field.
+func Column057GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn057, v))
+}
+
+// Column057LT applies the LT predicate on the "column057" field.
+func Column057LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn057, v))
+}
+
+// Column057LTE applies the LTE predicate on the "column057" field.
+func Column057LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn057, v))
+}
+
+// Column057Contains applies the Contains predicate on the "column057" field.
+func Column057Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn057, v))
+}
+
+// Column057HasPrefix applies the HasPrefix predicate on the "column057" field.
+func Column057HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn057, v))
+}
+
+// Column057HasSuffix applies the HasSuffix predicate on the "column057" field.
+func Column057HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn057, v))
+}
+
+// Column057EqualFold applies the EqualFold predicate on the "column057" field.
+func Column057EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn057, v))
+}
+
+// Column057ContainsFold applies the ContainsFold predicate on the "column057" field.
+func Column057ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn057, v))
+}
+
+// Column058EQ applies the EQ predicate on the "column058" field.
+func Column058EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn058, v))
+}
+
+// Column058NEQ applies the NEQ predicate on the "column058" field.
+func Column058NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn058, v))
+}
+
+// Column058In applies the In predicate on the "column058" field.
+func Column058In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn058, vs...))
+}
+
+// Column058NotIn applies the NotIn predicate on the "column058" field.
+func Column058NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn058, vs...))
+}
+
+// Column058GT applies the GT predicate on the "column058" field.
+func Column058GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn058, v))
+}
+
+// Column058GTE applies the GTE predicate on the "column058" field.
+func Column058GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn058, v))
+}
+
+// Column058LT applies the LT predicate on the "column058" field.
+func Column058LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn058, v))
+}
+
+// Column058LTE applies the LTE predicate on the "column058" field.
+func Column058LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn058, v))
+}
+
+// Column059EQ applies the EQ predicate on the "column059" field.
+func Column059EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn059, v))
+}
+
+// Column059NEQ applies the NEQ predicate on the "column059" field.
+func Column059NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn059, v))
+}
+
+// Column059In applies the In predicate on the "column059" field.
+func Column059In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn059, vs...))
+}
+
+// Column059NotIn applies the NotIn predicate on the "column059" field.
+func Column059NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn059, vs...))
+}
+
+// Column059GT applies the GT predicate on the "column059" field.
+func Column059GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn059, v))
+}
+
+// Column059GTE applies the GTE predicate on the "column059" field.
+func Column059GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn059, v))
+}
+
+// Column059LT applies the LT predicate on the "column059" field.
+func Column059LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn059, v))
+}
+
+// Column059LTE applies the LTE predicate on the "column059" field.
+func Column059LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn059, v))
+}
+
+// Column060EQ applies the EQ predicate on the "column060" field.
+func Column060EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn060, v))
+}
+
+// Column060NEQ applies the NEQ predicate on the "column060" field.
+func Column060NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn060, v))
+}
+
+// Column060In applies the In predicate on the "column060" field.
+func Column060In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn060, vs...))
+}
+
+// Column060NotIn applies the NotIn predicate on the "column060" field.
+func Column060NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn060, vs...))
+}
+
+// Column060GT applies the GT predicate on the "column060" field.
+func Column060GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn060, v))
+}
+
+// Column060GTE applies the GTE predicate on the "column060" field.
+func Column060GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn060, v))
+}
+
+// Column060LT applies the LT predicate on the "column060" field.
+func Column060LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn060, v))
+}
+
+// Column060LTE applies the LTE predicate on the "column060" field.
+func Column060LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn060, v))
+}
+
+// Column061EQ applies the EQ predicate on the "column061" field.
+func Column061EQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn061, v))
+}
+
+// Column061NEQ applies the NEQ predicate on the "column061" field.
+func Column061NEQ(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn061, v))
+}
+
+// Column061In applies the In predicate on the "column061" field.
+func Column061In(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn061, vs...))
+}
+
+// Column061NotIn applies the NotIn predicate on the "column061" field.
+func Column061NotIn(vs ...string) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn061, vs...))
+}
+
+// Column061GT applies the GT predicate on the "column061" field.
+func Column061GT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn061, v))
+}
+
+// Column061GTE applies the GTE predicate on the "column061" field.
+func Column061GTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn061, v))
+}
+
+// Column061LT applies the LT predicate on the "column061" field.
+func Column061LT(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn061, v))
+}
+
+// Column061LTE applies the LTE predicate on the "column061" field.
+func Column061LTE(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn061, v))
+}
+
+// Column061Contains applies the Contains predicate on the "column061" field.
+func Column061Contains(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContains(FieldColumn061, v))
+}
+
+// Column061HasPrefix applies the HasPrefix predicate on the "column061" field.
+func Column061HasPrefix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasPrefix(FieldColumn061, v))
+}
+
+// Column061HasSuffix applies the HasSuffix predicate on the "column061" field.
+func Column061HasSuffix(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldHasSuffix(FieldColumn061, v))
+}
+
+// Column061EqualFold applies the EqualFold predicate on the "column061" field.
+func Column061EqualFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldEqualFold(FieldColumn061, v))
+}
+
+// Column061ContainsFold applies the ContainsFold predicate on the "column061" field.
+func Column061ContainsFold(v string) predicate.Wide {
+	return predicate.Wide(sql.FieldContainsFold(FieldColumn061, v))
+}
+
+// Column062EQ applies the EQ predicate on the "column062" field.
+func Column062EQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn062, v))
+}
+
+// Column062NEQ applies the NEQ predicate on the "column062" field.
+func Column062NEQ(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn062, v))
+}
+
+// Column062In applies the In predicate on the "column062" field.
+func Column062In(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn062, vs...))
+}
+
+// Column062NotIn applies the NotIn predicate on the "column062" field.
+func Column062NotIn(vs ...int) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn062, vs...))
+}
+
+// Column062GT applies the GT predicate on the "column062" field.
+func Column062GT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn062, v))
+}
+
+// Column062GTE applies the GTE predicate on the "column062" field.
+func Column062GTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn062, v))
+}
+
+// Column062LT applies the LT predicate on the "column062" field.
+func Column062LT(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn062, v))
+}
+
+// Column062LTE applies the LTE predicate on the "column062" field.
+func Column062LTE(v int) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn062, v))
+}
+
+// Column063EQ applies the EQ predicate on the "column063" field.
+func Column063EQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn063, v))
+}
+
+// Column063NEQ applies the NEQ predicate on the "column063" field.
+func Column063NEQ(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn063, v))
+}
+
+// Column063In applies the In predicate on the "column063" field.
+func Column063In(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn063, vs...))
+}
+
+// Column063NotIn applies the NotIn predicate on the "column063" field.
+func Column063NotIn(vs ...float64) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn063, vs...))
+}
+
+// Column063GT applies the GT predicate on the "column063" field.
+func Column063GT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn063, v))
+}
+
+// Column063GTE applies the GTE predicate on the "column063" field.
+func Column063GTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn063, v))
+}
+
+// Column063LT applies the LT predicate on the "column063" field.
+func Column063LT(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn063, v))
+}
+
+// Column063LTE applies the LTE predicate on the "column063" field.
+func Column063LTE(v float64) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn063, v))
+}
+
+// Column064EQ applies the EQ predicate on the "column064" field.
+func Column064EQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldEQ(FieldColumn064, v))
+}
+
+// Column064NEQ applies the NEQ predicate on the "column064" field.
+func Column064NEQ(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNEQ(FieldColumn064, v))
+}
+
+// Column064In applies the In predicate on the "column064" field.
+func Column064In(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldIn(FieldColumn064, vs...))
+}
+
+// Column064NotIn applies the NotIn predicate on the "column064" field.
+func Column064NotIn(vs ...time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldNotIn(FieldColumn064, vs...))
+}
+
+// Column064GT applies the GT predicate on the "column064" field.
+func Column064GT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGT(FieldColumn064, v))
+}
+
+// Column064GTE applies the GTE predicate on the "column064" field.
+func Column064GTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldGTE(FieldColumn064, v))
+}
+
+// Column064LT applies the LT predicate on the "column064" field.
+func Column064LT(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLT(FieldColumn064, v))
+}
+
+// Column064LTE applies the LTE predicate on the "column064" field.
+func Column064LTE(v time.Time) predicate.Wide {
+	return predicate.Wide(sql.FieldLTE(FieldColumn064, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Wide) predicate.Wide {
+	return predicate.Wide(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Wide) predicate.Wide {
+	return predicate.Wide(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Wide) predicate.Wide {
+	return predicate.Wide(sql.NotPredicates(p))
+}
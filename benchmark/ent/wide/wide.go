@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wide
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the wide type in the database.
+	Label = "wide"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldColumn001 holds the string denoting the column001 field in the database.
+	FieldColumn001 = "column001"
+	// FieldColumn002 holds the string denoting the column002 field in the database.
+	FieldColumn002 = "column002"
+	// FieldColumn003 holds the string denoting the column003 field in the database.
+	FieldColumn003 = "column003"
+	// FieldColumn004 holds the string denoting the column004 field in the database.
+	FieldColumn004 = "column004"
+	// FieldColumn005 holds the string denoting the column005 field in the database.
+	FieldColumn005 = "column005"
+	// FieldColumn006 holds the string denoting the column006 field in the database.
+	FieldColumn006 = "column006"
+	// FieldColumn007 holds the string denoting the column007 field in the database.
+	FieldColumn007 = "column007"
+	// FieldColumn008 holds the string denoting the column008 field in the database.
+	FieldColumn008 = "column008"
+	// FieldColumn009 holds the string denoting the column009 field in the database.
+	FieldColumn009 = "column009"
+	// FieldColumn010 holds the string denoting the column010 field in the database.
+	FieldColumn010 = "column010"
+	// FieldColumn011 holds the string denoting the column011 field in the database.
+	FieldColumn011 = "column011"
+	// FieldColumn012 holds the string denoting the column012 field in the database.
+	FieldColumn012 = "column012"
+	// FieldColumn013 holds the string denoting the column013 field in the database.
+	FieldColumn013 = "column013"
+	// FieldColumn014 holds the string denoting the column014 field in the database.
+	FieldColumn014 = "column014"
+	// FieldColumn015 holds the string denoting the column015 field in the database.
+	FieldColumn015 = "column015"
+	// FieldColumn016 holds the string denoting the column016 field in the database.
+	FieldColumn016 = "column016"
+	// FieldColumn017 holds the string denoting the column017 field in the database.
+	FieldColumn017 = "column017"
+	// FieldColumn018 holds the string denoting the column018 field in the database.
+	FieldColumn018 = "column018"
+	// FieldColumn019 holds the string denoting the column019 field in the database.
+	FieldColumn019 = "column019"
+	// FieldColumn020 holds the string denoting the column020 field in the database.
+	FieldColumn020 = "column020"
+	// FieldColumn021 holds the string denoting the column021 field in the database.
+	FieldColumn021 = "column021"
+	// FieldColumn022 holds the string denoting the column022 field in the database.
+	FieldColumn022 = "column022"
+	// FieldColumn023 holds the string denoting the column023 field in the database.
+	FieldColumn023 = "column023"
+	// FieldColumn024 holds the string denoting the column024 field in the database.
+	FieldColumn024 = "column024"
+	// FieldColumn025 holds the string denoting the column025 field in the database.
+	FieldColumn025 = "column025"
+	// FieldColumn026 holds the string denoting the column026 field in the database.
+	FieldColumn026 = "column026"
+	// FieldColumn027 holds the string denoting the column027 field in the database.
+	FieldColumn027 = "column027"
+	// FieldColumn028 holds the string denoting the column028 field in the database.
+	FieldColumn028 = "column028"
+	// FieldColumn029 holds the string denoting the column029 field in the database.
+	FieldColumn029 = "column029"
+	// FieldColumn030 holds the string denoting the column030 field in the database.
+	FieldColumn030 = "column030"
+	// FieldColumn031 holds the string denoting the column031 field in the database.
+	FieldColumn031 = "column031"
+	// FieldColumn032 holds the string denoting the column032 field in the database.
+	FieldColumn032 = "column032"
+	// FieldColumn033 holds the string denoting the column033 field in the database.
+	FieldColumn033 = "column033"
+	// FieldColumn034 holds the string denoting the column034 field in the database.
+	FieldColumn034 = "column034"
+	// FieldColumn035 holds the string denoting the column035 field in the database.
+	FieldColumn035 = "column035"
+	// FieldColumn036 holds the string denoting the column036 field in the database.
+	FieldColumn036 = "column036"
+	// FieldColumn037 holds the string denoting the column037 field in the database.
+	FieldColumn037 = "column037"
+	// FieldColumn038 holds the string denoting the column038 field in the database.
+	FieldColumn038 = "column038"
+	// FieldColumn039 holds the string denoting the column039 field in the database.
+	FieldColumn039 = "column039"
+	// FieldColumn040 holds the string denoting the column040 field in the database.
+	FieldColumn040 = "column040"
+	// FieldColumn041 holds the string denoting the column041 field in the database.
+	FieldColumn041 = "column041"
+	// FieldColumn042 holds the string denoting the column042 field in the database.
+	FieldColumn042 = "column042"
+	// FieldColumn043 holds the string denoting the column043 field in the database.
+	FieldColumn043 = "column043"
+	// FieldColumn044 holds the string denoting the column044 field in the database.
+	FieldColumn044 = "column044"
+	// FieldColumn045 holds the string denoting the column045 field in the database.
+	FieldColumn045 = "column045"
+	// FieldColumn046 holds the string denoting the column046 field in the database.
+	FieldColumn046 = "column046"
+	// FieldColumn047 holds the string denoting the column047 field in the database.
+	FieldColumn047 = "column047"
+	// FieldColumn048 holds the string denoting the column048 field in the database.
+	FieldColumn048 = "column048"
+	// FieldColumn049 holds the string denoting the column049 field in the database.
+	FieldColumn049 = "column049"
+	// FieldColumn050 holds the string denoting the column050 field in the database.
+	FieldColumn050 = "column050"
+	// FieldColumn051 holds the string denoting the column051 field in the database.
+	FieldColumn051 = "column051"
+	// FieldColumn052 holds the string denoting the column052 field in the database.
+	FieldColumn052 = "column052"
+	// FieldColumn053 holds the string denoting the column053 field in the database.
+	FieldColumn053 = "column053"
+	// FieldColumn054 holds the string denoting the column054 field in the database.
+	FieldColumn054 = "column054"
+	// FieldColumn055 holds the string denoting the column055 field in the database.
+	FieldColumn055 = "column055"
+	// FieldColumn056 holds the string denoting the column056 field in the database.
+	FieldColumn056 = "column056"
+	// FieldColumn057 holds the string denoting the column057 field in the database.
+	FieldColumn057 = "column057"
+	// FieldColumn058 holds the string denoting the column058 field in the database.
+	FieldColumn058 = "column058"
+	// FieldColumn059 holds the string denoting the column059 field in the database.
+	FieldColumn059 = "column059"
+	// FieldColumn060 holds the string denoting the column060 field in the database.
+	FieldColumn060 = "column060"
+	// FieldColumn061 holds the string denoting the column061 field in the database.
+	FieldColumn061 = "column061"
+	// FieldColumn062 holds the string denoting the column062 field in the database.
+	FieldColumn062 = "column062"
+	// FieldColumn063 holds the string denoting the column063 field in the database.
+	FieldColumn063 = "column063"
+	// FieldColumn064 holds the string denoting the column064 field in the database.
+	FieldColumn064 = "column064"
+	// Table holds the table name of the wide in the database.
+	Table = "wides"
+)
+
+// Columns holds all SQL columns for wide fields.
+var Columns = []string{
+	FieldID,
+	FieldColumn001,
+	FieldColumn002,
+	FieldColumn003,
+	FieldColumn004,
+	FieldColumn005,
+	FieldColumn006,
+	FieldColumn007,
+	FieldColumn008,
+	FieldColumn009,
+	FieldColumn010,
+	FieldColumn011,
+	FieldColumn012,
+	FieldColumn013,
+	FieldColumn014,
+	FieldColumn015,
+	FieldColumn016,
+	FieldColumn017,
+	FieldColumn018,
+	FieldColumn019,
+	FieldColumn020,
+	FieldColumn021,
+	FieldColumn022,
+	FieldColumn023,
+	FieldColumn024,
+	FieldColumn025,
+	FieldColumn026,
+	FieldColumn027,
+	FieldColumn028,
+	FieldColumn029,
+	FieldColumn030,
+	FieldColumn031,
+	FieldColumn032,
+	FieldColumn033,
+	FieldColumn034,
+	FieldColumn035,
+	FieldColumn036,
+	FieldColumn037,
+	FieldColumn038,
+	FieldColumn039,
+	FieldColumn040,
+	FieldColumn041,
+	FieldColumn042,
+	FieldColumn043,
+	FieldColumn044,
+	FieldColumn045,
+	FieldColumn046,
+	FieldColumn047,
+	FieldColumn048,
+	FieldColumn049,
+	FieldColumn050,
+	FieldColumn051,
+	FieldColumn052,
+	FieldColumn053,
+	FieldColumn054,
+	FieldColumn055,
+	FieldColumn056,
+	FieldColumn057,
+	FieldColumn058,
+	FieldColumn059,
+	FieldColumn060,
+	FieldColumn061,
+	FieldColumn062,
+	FieldColumn063,
+	FieldColumn064,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the Wide queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByColumn001 orders the results by the column001 field.
+func ByColumn001(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn001, opts...).ToFunc()
+}
+
+// ByColumn002 orders the results by the column002 field.
+func ByColumn002(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn002, opts...).ToFunc()
+}
+
+// ByColumn003 orders the results by the column003 field.
+func ByColumn003(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn003, opts...).ToFunc()
+}
+
+// ByColumn004 orders the results by the column004 field.
+func ByColumn004(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn004, opts...).ToFunc()
+}
+
+// ByColumn005 orders the results by the column005 field.
+func ByColumn005(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn005, opts...).ToFunc()
+}
+
+// ByColumn006 orders the results by the column006 field.
+func ByColumn006(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn006, opts...).ToFunc()
+}
+
+// ByColumn007 orders the results by the column007 field.
+func ByColumn007(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn007, opts...).ToFunc()
+}
+
+// ByColumn008 orders the results by the column008 field.
+func ByColumn008(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn008, opts...).ToFunc()
+}
+
+// ByColumn009 orders the results by the column009 field.
+func ByColumn009(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn009, opts...).ToFunc()
+}
+
+// ByColumn010 orders the results by the column010 field.
+func ByColumn010(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn010, opts...).ToFunc()
+}
+
+// ByColumn011 orders the results by the column011 field.
+func ByColumn011(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn011, opts...).ToFunc()
+}
+
+// ByColumn012 orders the results by the column012 field.
+func ByColumn012(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn012, opts...).ToFunc()
+}
+
+// ByColumn013 orders the results by the column013 field.
+func ByColumn013(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn013, opts...).ToFunc()
+}
+
+// ByColumn014 orders the results by the column014 field.
+func ByColumn014(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn014, opts...).ToFunc()
+}
+
+// ByColumn015 orders the results by the column015 field.
+func ByColumn015(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn015, opts...).ToFunc()
+}
+
+// ByColumn016 orders the results by the column016 field.
+func ByColumn016(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn016, opts...).ToFunc()
+}
+
+// ByColumn017 orders the results by the column017 field.
+func ByColumn017(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn017, opts...).ToFunc()
+}
+
+// ByColumn018 orders the results by the column018 field.
+func ByColumn018(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn018, opts...).ToFunc()
+}
+
+// ByColumn019 orders the results by the column019 field.
+func ByColumn019(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn019, opts...).ToFunc()
+}
+
+// ByColumn020 orders the results by the column020 field.
+func ByColumn020(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn020, opts...).ToFunc()
+}
+
+// ByColumn021 orders the results by the column021 field.
+func ByColumn021(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn021, opts...).ToFunc()
+}
+
+// ByColumn022 orders the results by the column022 field.
+func ByColumn022(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn022, opts...).ToFunc()
+}
+
+// ByColumn023 orders the results by the column023 field.
+func ByColumn023(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn023, opts...).ToFunc()
+}
+
+// ByColumn024 orders the results by the column024 field.
+func ByColumn024(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn024, opts...).ToFunc()
+}
+
+// ByColumn025 orders the results by the column025 field.
+func ByColumn025(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn025, opts...).ToFunc()
+}
+
+// ByColumn026 orders the results by the column026 field.
+func ByColumn026(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn026, opts...).ToFunc()
+}
+
+// ByColumn027 orders the results by the column027 field.
+func ByColumn027(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn027, opts...).ToFunc()
+}
+
+// ByColumn028 orders the results by the column028 field.
+func ByColumn028(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn028, opts...).ToFunc()
+}
+
+// ByColumn029 orders the results by the column029 field.
+func ByColumn029(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn029, opts...).ToFunc()
+}
+
+// ByColumn030 orders the results by the column030 field.
+func ByColumn030(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn030, opts...).ToFunc()
+}
+
+// ByColumn031 orders the results by the column031 field.
+func ByColumn031(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn031, opts...).ToFunc()
+}
+
+// ByColumn032 orders the results by the column032 field.
+func ByColumn032(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn032, opts...).ToFunc()
+}
+
+// ByColumn033 orders the results by the column033 field.
+func ByColumn033(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn033, opts...).ToFunc()
+}
+
+// ByColumn034 orders the results by the column034 field.
+func ByColumn034(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn034, opts...).ToFunc()
+}
+
+// ByColumn035 orders the results by the column035 field.
+func ByColumn035(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn035, opts...).ToFunc()
+}
+
+// ByColumn036 orders the results by the column036 field.
+func ByColumn036(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn036, opts...).ToFunc()
+}
+
+// ByColumn037 orders the results by the column037 field.
+func ByColumn037(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn037, opts...).ToFunc()
+}
+
+// ByColumn038 orders the results by the column038 field.
+func ByColumn038(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn038, opts...).ToFunc()
+}
+
+// ByColumn039 orders the results by the column039 field.
+func ByColumn039(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn039, opts...).ToFunc()
+}
+
+// ByColumn040 orders the results by the column040 field.
+func ByColumn040(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn040, opts...).ToFunc()
+}
+
+// ByColumn041 orders the results by the column041 field.
+func ByColumn041(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn041, opts...).ToFunc()
+}
+
+// ByColumn042 orders the results by the column042 field.
+func ByColumn042(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn042, opts...).ToFunc()
+}
+
+// ByColumn043 orders the results by the column043 field.
+func ByColumn043(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn043, opts...).ToFunc()
+}
+
+// ByColumn044 orders the results by the column044 field.
+func ByColumn044(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn044, opts...).ToFunc()
+}
+
+// ByColumn045 orders the results by the column045 field.
+func ByColumn045(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn045, opts...).ToFunc()
+}
+
+// ByColumn046 orders the results by the column046 field.
+func ByColumn046(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn046, opts...).ToFunc()
+}
+
+// ByColumn047 orders the results by the column047 field.
+func ByColumn047(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn047, opts...).ToFunc()
+}
+
+// ByColumn048 orders the results by the column048 field.
+func ByColumn048(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn048, opts...).ToFunc()
+}
+
+// ByColumn049 orders the results by the column049 field.
+func ByColumn049(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn049, opts...).ToFunc()
+}
+
+// ByColumn050 orders the results by the column050 field.
+func ByColumn050(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn050, opts...).ToFunc()
+}
+
+// ByColumn051 orders the results by the column051 field.
+func ByColumn051(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn051, opts...).ToFunc()
+}
+
+// ByColumn052 orders the results by the column052 field.
+func ByColumn052(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn052, opts...).ToFunc()
+}
+
+// ByColumn053 orders the results by the column053 field.
+func ByColumn053(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn053, opts...).ToFunc()
+}
+
+// ByColumn054 orders the results by the column054 field.
+func ByColumn054(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn054, opts...).ToFunc()
+}
+
+// ByColumn055 orders the results by the column055 field.
+func ByColumn055(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn055, opts...).ToFunc()
+}
+
+// ByColumn056 orders the results by the column056 field.
+func ByColumn056(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn056, opts...).ToFunc()
+}
+
+// ByColumn057 orders the results by the column057 field.
+func ByColumn057(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn057, opts...).ToFunc()
+}
+
+// ByColumn058 orders the results by the column058 field.
+func ByColumn058(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn058, opts...).ToFunc()
+}
+
+// ByColumn059 orders the results by the column059 field.
+func ByColumn059(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn059, opts...).ToFunc()
+}
+
+// ByColumn060 orders the results by the column060 field.
+func ByColumn060(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn060, opts...).ToFunc()
+}
+
+// ByColumn061 orders the results by the column061 field.
+func ByColumn061(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn061, opts...).ToFunc()
+}
+
+// ByColumn062 orders the results by the column062 field.
+func ByColumn062(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn062, opts...).ToFunc()
+}
+
+// ByColumn063 orders the results by the column063 field.
+func ByColumn063(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn063, opts...).ToFunc()
+}
+
+// ByColumn064 orders the results by the column064 field.
+func ByColumn064(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn064, opts...).ToFunc()
+}
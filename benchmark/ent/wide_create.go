@@ -0,0 +1,4434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/wide"
+)
+
+// WideCreate is the builder for creating a Wide entity.
+type WideCreate struct {
+	config
+	mutation *WideMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetColumn001 sets the "column001" field.
+func (wc *WideCreate) SetColumn001(s string) *WideCreate {
+	wc.mutation.SetColumn001(s)
+	return wc
+}
+
+// SetColumn002 sets the "column002" field.
+func (wc *WideCreate) SetColumn002(i int) *WideCreate {
+	wc.mutation.SetColumn002(i)
+	return wc
+}
+
+// SetColumn003 sets the "column003" field.
+func (wc *WideCreate) SetColumn003(f float64) *WideCreate {
+	wc.mutation.SetColumn003(f)
+	return wc
+}
+
+// SetColumn004 sets the "column004" field.
+func (wc *WideCreate) SetColumn004(t time.Time) *WideCreate {
+	wc.mutation.SetColumn004(t)
+	return wc
+}
+
+// SetColumn005 sets the "column005" field.
+func (wc *WideCreate) SetColumn005(s string) *WideCreate {
+	wc.mutation.SetColumn005(s)
+	return wc
+}
+
+// SetColumn006 sets the "column006" field.
+func (wc *WideCreate) SetColumn006(i int) *WideCreate {
+	wc.mutation.SetColumn006(i)
+	return wc
+}
+
+// SetColumn007 sets the "column007" field.
+func (wc *WideCreate) SetColumn007(f float64) *WideCreate {
+	wc.mutation.SetColumn007(f)
+	return wc
+}
+
+// SetColumn008 sets the "column008" field.
+func (wc *WideCreate) SetColumn008(t time.Time) *WideCreate {
+	wc.mutation.SetColumn008(t)
+	return wc
+}
+
+// SetColumn009 sets the "column009" field.
+func (wc *WideCreate) SetColumn009(s string) *WideCreate {
+	wc.mutation.SetColumn009(s)
+	return wc
+}
+
+// SetColumn010 sets the "column010" field.
+func (wc *WideCreate) SetColumn010(i int) *WideCreate {
+	wc.mutation.SetColumn010(i)
+	return wc
+}
+
+// SetColumn011 sets the "column011" field.
+func (wc *WideCreate) SetColumn011(f float64) *WideCreate {
+	wc.mutation.SetColumn011(f)
+	return wc
+}
+
+// SetColumn012 sets the "column012" field.
+func (wc *WideCreate) SetColumn012(t time.Time) *WideCreate {
+	wc.mutation.SetColumn012(t)
+	return wc
+}
+
+// SetColumn013 sets the "column013" field.
+func (wc *WideCreate) SetColumn013(s string) *WideCreate {
+	wc.mutation.SetColumn013(s)
+	return wc
+}
+
+// SetColumn014 sets the "column014" field.
+func (wc *WideCreate) SetColumn014(i int) *WideCreate {
+	wc.mutation.SetColumn014(i)
+	return wc
+}
+
+// SetColumn015 sets the "column015" field.
+func (wc *WideCreate) SetColumn015(f float64) *WideCreate {
+	wc.mutation.SetColumn015(f)
+	return wc
+}
+
+// SetColumn016 sets the "column016" field.
+func (wc *WideCreate) SetColumn016(t time.Time) *WideCreate {
+	wc.mutation.SetColumn016(t)
+	return wc
+}
+
+// SetColumn017 sets the "column017" field.
+func (wc *WideCreate) SetColumn017(s string) *WideCreate {
+	wc.mutation.SetColumn017(s)
+	return wc
+}
+
+// SetColumn018 sets the "column018" field.
+func (wc *WideCreate) SetColumn018(i int) *WideCreate {
+	wc.mutation.SetColumn018(i)
+	return wc
+}
+
+// SetColumn019 sets the "column019" field.
+func (wc *WideCreate) SetColumn019(f float64) *WideCreate {
+	wc.mutation.SetColumn019(f)
+	return wc
+}
+
+// SetColumn020 sets the "column020" field.
+func (wc *WideCreate) SetColumn020(t time.Time) *WideCreate {
+	wc.mutation.SetColumn020(t)
+	return wc
+}
+
+// SetColumn021 sets the "column021" field.
+func (wc *WideCreate) SetColumn021(s string) *WideCreate {
+	wc.mutation.SetColumn021(s)
+	return wc
+}
+
+// SetColumn022 sets the "column022" field.
+func (wc *WideCreate) SetColumn022(i int) *WideCreate {
+	wc.mutation.SetColumn022(i)
+	return wc
+}
+
+// SetColumn023 sets the "column023" field.
+func (wc *WideCreate) SetColumn023(f float64) *WideCreate {
+	wc.mutation.SetColumn023(f)
+	return wc
+}
+
+// SetColumn024 sets the "column024" field.
+func (wc *WideCreate) SetColumn024(t time.Time) *WideCreate {
+	wc.mutation.SetColumn024(t)
+	return wc
+}
+
+// SetColumn025 sets the "column025" field.
+func (wc *WideCreate) SetColumn025(s string) *WideCreate {
+	wc.mutation.SetColumn025(s)
+	return wc
+}
+
+// SetColumn026 sets the "column026" field.
+func (wc *WideCreate) SetColumn026(i int) *WideCreate {
+	wc.mutation.SetColumn026(i)
+	return wc
+}
+
+// SetColumn027 sets the "column027" field.
+func (wc *WideCreate) SetColumn027(f float64) *WideCreate {
+	wc.mutation.SetColumn027(f)
+	return wc
+}
+
+// SetColumn028 sets the "column028" field.
+func (wc *WideCreate) SetColumn028(t time.Time) *WideCreate {
+	wc.mutation.SetColumn028(t)
+	return wc
+}
+
+// SetColumn029 sets the "column029" field.
+func (wc *WideCreate) SetColumn029(s string) *WideCreate {
+	wc.mutation.SetColumn029(s)
+	return wc
+}
+
+// SetColumn030 sets the "column030" field.
+func (wc *WideCreate) SetColumn030(i int) *WideCreate {
+	wc.mutation.SetColumn030(i)
+	return wc
+}
+
+// SetColumn031 sets the "column031" field.
+func (wc *WideCreate) SetColumn031(f float64) *WideCreate {
+	wc.mutation.SetColumn031(f)
+	return wc
+}
+
+// SetColumn032 sets the "column032" field.
+func (wc *WideCreate) SetColumn032(t time.Time) *WideCreate {
+	wc.mutation.SetColumn032(t)
+	return wc
+}
+
+// SetColumn033 sets the "column033" field.
+func (wc *WideCreate) SetColumn033(s string) *WideCreate {
+	wc.mutation.SetColumn033(s)
+	return wc
+}
+
+// SetColumn034 sets the "column034" field.
+func (wc *WideCreate) SetColumn034(i int) *WideCreate {
+	wc.mutation.SetColumn034(i)
+	return wc
+}
+
+// SetColumn035 sets the "column035" field.
+func (wc *WideCreate) SetColumn035(f float64) *WideCreate {
+	wc.mutation.SetColumn035(f)
+	return wc
+}
+
+// SetColumn036 sets the "column036" field.
+func (wc *WideCreate) SetColumn036(t time.Time) *WideCreate {
+	wc.mutation.SetColumn036(t)
+	return wc
+}
+
+// SetColumn037 sets the "column037" field.
+func (wc *WideCreate) SetColumn037(s string) *WideCreate {
+	wc.mutation.SetColumn037(s)
+	return wc
+}
+
+// SetColumn038 sets the "column038" field.
+func (wc *WideCreate) SetColumn038(i int) *WideCreate {
+	wc.mutation.SetColumn038(i)
+	return wc
+}
+
+// SetColumn039 sets the "column039" field.
+func (wc *WideCreate) SetColumn039(f float64) *WideCreate {
+	wc.mutation.SetColumn039(f)
+	return wc
+}
+
+// SetColumn040 sets the "column040" field.
+func (wc *WideCreate) SetColumn040(t time.Time) *WideCreate {
+	wc.mutation.SetColumn040(t)
+	return wc
+}
+
+// SetColumn041 sets the "column041" field.
+func (wc *WideCreate) SetColumn041(s string) *WideCreate {
+	wc.mutation.SetColumn041(s)
+	return wc
+}
+
+// SetColumn042 sets the "column042" field.
+func (wc *WideCreate) SetColumn042(i int) *WideCreate {
+	wc.mutation.SetColumn042(i)
+	return wc
+}
+
+// SetColumn043 sets the "column043" field.
+func (wc *WideCreate) SetColumn043(f float64) *WideCreate {
+	wc.mutation.SetColumn043(f)
+	return wc
+}
+
+// SetColumn044 sets the "column044" field.
+func (wc *WideCreate) SetColumn044(t time.Time) *WideCreate {
+	wc.mutation.SetColumn044(t)
+	return wc
+}
+
+// SetColumn045 sets the "column045" field.
+func (wc *WideCreate) SetColumn045(s string) *WideCreate {
+	wc.mutation.SetColumn045(s)
+	return wc
+}
+
+// SetColumn046 sets the "column046" field.
+func (wc *WideCreate) SetColumn046(i int) *WideCreate {
+	wc.mutation.SetColumn046(i)
+	return wc
+}
+
+// SetColumn047 sets the "column047" field.
+func (wc *WideCreate) SetColumn047(f float64) *WideCreate {
+	wc.mutation.SetColumn047(f)
+	return wc
+}
+
+// SetColumn048 sets the "column048" field.
+func (wc *WideCreate) SetColumn048(t time.Time) *WideCreate {
+	wc.mutation.SetColumn048(t)
+	return wc
+}
+
+// SetColumn049 sets the "column049" field.
+func (wc *WideCreate) SetColumn049(s string) *WideCreate {
+	wc.mutation.SetColumn049(s)
+	return wc
+}
+
+// SetColumn050 sets the "column050" field.
+func (wc *WideCreate) SetColumn050(i int) *WideCreate {
+	wc.mutation.SetColumn050(i)
+	return wc
+}
+
+// SetColumn051 sets the "column051" field.
+func (wc *WideCreate) SetColumn051(f float64) *WideCreate {
+	wc.mutation.SetColumn051(f)
+	return wc
+}
+
+// SetColumn052 sets the "column052" field.
+func (wc *WideCreate) SetColumn052(t time.Time) *WideCreate {
+	wc.mutation.SetColumn052(t)
+	return wc
+}
+
+// SetColumn053 sets the "column053" field.
+func (wc *WideCreate) SetColumn053(s string) *WideCreate {
+	wc.mutation.SetColumn053(s)
+	return wc
+}
+
+// SetColumn054 sets the "column054" field.
+func (wc *WideCreate) SetColumn054(i int) *WideCreate {
+	wc.mutation.SetColumn054(i)
+	return wc
+}
+
+// SetColumn055 sets the "column055" field.
+func (wc *WideCreate) SetColumn055(f float64) *WideCreate {
+	wc.mutation.SetColumn055(f)
+	return wc
+}
+
+// SetColumn056 sets the "column056" field.
+func (wc *WideCreate) SetColumn056(t time.Time) *WideCreate {
+	wc.mutation.SetColumn056(t)
+	return wc
+}
+
+// SetColumn057 sets the "column057" field.
+func (wc *WideCreate) SetColumn057(s string) *WideCreate {
+	wc.mutation.SetColumn057(s)
+	return wc
+}
+
+// SetColumn058 sets the "column058" field.
+func (wc *WideCreate) SetColumn058(i int) *WideCreate {
+	wc.mutation.SetColumn058(i)
+	return wc
+}
+
+// SetColumn059 sets the "column059" field.
+func (wc *WideCreate) SetColumn059(f float64) *WideCreate {
+	wc.mutation.SetColumn059(f)
+	return wc
+}
+
+// SetColumn060 sets the "column060" field.
+func (wc *WideCreate) SetColumn060(t time.Time) *WideCreate {
+	wc.mutation.SetColumn060(t)
+	return wc
+}
+
+// SetColumn061 sets the "column061" field.
+func (wc *WideCreate) SetColumn061(s string) *WideCreate {
+	wc.mutation.SetColumn061(s)
+	return wc
+}
+
+// SetColumn062 sets the "column062" field.
+func (wc *WideCreate) SetColumn062(i int) *WideCreate {
+	wc.mutation.SetColumn062(i)
+	return wc
+}
+
+// SetColumn063 sets the "column063" field.
+func (wc *WideCreate) SetColumn063(f float64) *WideCreate {
+	wc.mutation.SetColumn063(f)
+	return wc
+}
+
+// SetColumn064 sets the "column064" field.
+func (wc *WideCreate) SetColumn064(t time.Time) *WideCreate {
+	wc.mutation.SetColumn064(t)
+	return wc
+}
+
+// Mutation returns the WideMutation object of the builder.
+func (wc *WideCreate) Mutation() *WideMutation {
+	return wc.mutation
+}
+
+// Save creates the Wide in the database.
+func (wc *WideCreate) Save(ctx context.Context) (*Wide, error) {
+	return withHooks(ctx, wc.sqlSave, wc.mutation, wc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WideCreate) SaveX(ctx context.Context) *Wide {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wc *WideCreate) Exec(ctx context.Context) error {
+	_, err := wc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wc *WideCreate) ExecX(ctx context.Context) {
+	if err := wc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WideCreate) check() error {
+	if _, ok := wc.mutation.Column001(); !ok {
+		return &ValidationError{Name: "column001", err: errors.New(`ent: missing required field "Wide.column001"`)}
+	}
+	if _, ok := wc.mutation.Column002(); !ok {
+		return &ValidationError{Name: "column002", err: errors.New(`ent: missing required field "Wide.column002"`)}
+	}
+	if _, ok := wc.mutation.Column003(); !ok {
+		return &ValidationError{Name: "column003", err: errors.New(`ent: missing required field "Wide.column003"`)}
+	}
+	if _, ok := wc.mutation.Column004(); !ok {
+		return &ValidationError{Name: "column004", err: errors.New(`ent: missing required field "Wide.column004"`)}
+	}
+	if _, ok := wc.mutation.Column005(); !ok {
+		return &ValidationError{Name: "column005", err: errors.New(`ent: missing required field "Wide.column005"`)}
+	}
+	if _, ok := wc.mutation.Column006(); !ok {
+		return &ValidationError{Name: "column006", err: errors.New(`ent: missing required field "Wide.column006"`)}
+	}
+	if _, ok := wc.mutation.Column007(); !ok {
+		return &ValidationError{Name: "column007", err: errors.New(`ent: missing required field "Wide.column007"`)}
+	}
+	if _, ok := wc.mutation.Column008(); !ok {
+		return &ValidationError{Name: "column008", err: errors.New(`ent: missing required field "Wide.column008"`)}
+	}
+	if _, ok := wc.mutation.Column009(); !ok {
+		return &ValidationError{Name: "column009", err: errors.New(`ent: missing required field "Wide.column009"`)}
+	}
+	if _, ok := wc.mutation.Column010(); !ok {
+		return &ValidationError{Name: "column010", err: errors.New(`ent: missing required field "Wide.column010"`)}
+	}
+	if _, ok := wc.mutation.Column011(); !ok {
+		return &ValidationError{Name: "column011", err: errors.New(`ent: missing required field "Wide.column011"`)}
+	}
+	if _, ok := wc.mutation.Column012(); !ok {
+		return &ValidationError{Name: "column012", err: errors.New(`ent: missing required field "Wide.column012"`)}
+	}
+	if _, ok := wc.mutation.Column013(); !ok {
+		return &ValidationError{Name: "column013", err: errors.New(`ent: missing required field "Wide.column013"`)}
+	}
+	if _, ok := wc.mutation.Column014(); !ok {
+		return &ValidationError{Name: "column014", err: errors.New(`ent: missing required field "Wide.column014"`)}
+	}
+	if _, ok := wc.mutation.Column015(); !ok {
+		return &ValidationError{Name: "column015", err: errors.New(`ent: missing required field "Wide.column015"`)}
+	}
+	if _, ok := wc.mutation.Column016(); !ok {
+		return &ValidationError{Name: "column016", err: errors.New(`ent: missing required field "Wide.column016"`)}
+	}
+	if _, ok := wc.mutation.Column017(); !ok {
+		return &ValidationError{Name: "column017", err: errors.New(`ent: missing required field "Wide.column017"`)}
+	}
+	if _, ok := wc.mutation.Column018(); !ok {
+		return &ValidationError{Name: "column018", err: errors.New(`ent: missing required field "Wide.column018"`)}
+	}
+	if _, ok := wc.mutation.Column019(); !ok {
+		return &ValidationError{Name: "column019", err: errors.New(`ent: missing required field "Wide.column019"`)}
+	}
+	if _, ok := wc.mutation.Column020(); !ok {
+		return &ValidationError{Name: "column020", err: errors.New(`ent: missing required field "Wide.column020"`)}
+	}
+	if _, ok := wc.mutation.Column021(); !ok {
+		return &ValidationError{Name: "column021", err: errors.New(`ent: missing required field "Wide.column021"`)}
+	}
+	if _, ok := wc.mutation.Column022(); !ok {
+		return &ValidationError{Name: "column022", err: errors.New(`ent: missing required field "Wide.column022"`)}
+	}
+	if _, ok := wc.mutation.Column023(); !ok {
+		return &ValidationError{Name: "column023", err: errors.New(`ent: missing required field "Wide.column023"`)}
+	}
+	if _, ok := wc.mutation.Column024(); !ok {
+		return &ValidationError{Name: "column024", err: errors.New(`ent: missing required field "Wide.column024"`)}
+	}
+	if _, ok := wc.mutation.Column025(); !ok {
+		return &ValidationError{Name: "column025", err: errors.New(`ent: missing required field "Wide.column025"`)}
+	}
+	if _, ok := wc.mutation.Column026(); !ok {
+		return &ValidationError{Name: "column026", err: errors.New(`ent: missing required field "Wide.column026"`)}
+	}
+	if _, ok := wc.mutation.Column027(); !ok {
+		return &ValidationError{Name: "column027", err: errors.New(`ent: missing required field "Wide.column027"`)}
+	}
+	if _, ok := wc.mutation.Column028(); !ok {
+		return &ValidationError{Name: "column028", err: errors.New(`ent: missing required field "Wide.column028"`)}
+	}
+	if _, ok := wc.mutation.Column029(); !ok {
+		return &ValidationError{Name: "column029", err: errors.New(`ent: missing required field "Wide.column029"`)}
+	}
+	if _, ok := wc.mutation.Column030(); !ok {
+		return &ValidationError{Name: "column030", err: errors.New(`ent: missing required field "Wide.column030"`)}
+	}
+	if _, ok := wc.mutation.Column031(); !ok {
+		return &ValidationError{Name: "column031", err: errors.New(`ent: missing required field "Wide.column031"`)}
+	}
+	if _, ok := wc.mutation.Column032(); !ok {
+		return &ValidationError{Name: "column032", err: errors.New(`ent: missing required field "Wide.column032"`)}
+	}
+	if _, ok := wc.mutation.Column033(); !ok {
+		return &ValidationError{Name: "column033", err: errors.New(`ent: missing required field "Wide.column033"`)}
+	}
+	if _, ok := wc.mutation.Column034(); !ok {
+		return &ValidationError{Name: "column034", err: errors.New(`ent: missing required field "Wide.column034"`)}
+	}
+	if _, ok := wc.mutation.Column035(); !ok {
+		return &ValidationError{Name: "column035", err: errors.New(`ent: missing required field "Wide.column035"`)}
+	}
+	if _, ok := wc.mutation.Column036(); !ok {
+		return &ValidationError{Name: "column036", err: errors.New(`ent: missing required field "Wide.column036"`)}
+	}
+	if _, ok := wc.mutation.Column037(); !ok {
+		return &ValidationError{Name: "column037", err: errors.New(`ent: missing required field "Wide.column037"`)}
+	}
+	if _, ok := wc.mutation.Column038(); !ok {
+		return &ValidationError{Name: "column038", err: errors.New(`ent: missing required field "Wide.column038"`)}
+	}
+	if _, ok := wc.mutation.Column039(); !ok {
+		return &ValidationError{Name: "column039", err: errors.New(`ent: missing required field "Wide.column039"`)}
+	}
+	if _, ok := wc.mutation.Column040(); !ok {
+		return &ValidationError{Name: "column040", err: errors.New(`ent: missing required field "Wide.column040"`)}
+	}
+	if _, ok := wc.mutation.Column041(); !ok {
+		return &ValidationError{Name: "column041", err: errors.New(`ent: missing required field "Wide.column041"`)}
+	}
+	if _, ok := wc.mutation.Column042(); !ok {
+		return &ValidationError{Name: "column042", err: errors.New(`ent: missing required field "Wide.column042"`)}
+	}
+	if _, ok := wc.mutation.Column043(); !ok {
+		return &ValidationError{Name: "column043", err: errors.New(`ent: missing required field "Wide.column043"`)}
+	}
+	if _, ok := wc.mutation.Column044(); !ok {
+		return &ValidationError{Name: "column044", err: errors.New(`ent: missing required field "Wide.column044"`)}
+	}
+	if _, ok := wc.mutation.Column045(); !ok {
+		return &ValidationError{Name: "column045", err: errors.New(`ent: missing required field "Wide.column045"`)}
+	}
+	if _, ok := wc.mutation.Column046(); !ok {
+		return &ValidationError{Name: "column046", err: errors.New(`ent: missing required field "Wide.column046"`)}
+	}
+	if _, ok := wc.mutation.Column047(); !ok {
+		return &ValidationError{Name: "column047", err: errors.New(`ent: missing required field "Wide.column047"`)}
+	}
+	if _, ok := wc.mutation.Column048(); !ok {
+		return &ValidationError{Name: "column048", err: errors.New(`ent: missing required field "Wide.column048"`)}
+	}
+	if _, ok := wc.mutation.Column049(); !ok {
+		return &ValidationError{Name: "column049", err: errors.New(`ent: missing required field "Wide.column049"`)}
+	}
+	if _, ok := wc.mutation.Column050(); !ok {
+		return &ValidationError{Name: "column050", err: errors.New(`ent: missing required field "Wide.column050"`)}
+	}
+	if _, ok := wc.mutation.Column051(); !ok {
+		return &ValidationError{Name: "column051", err: errors.New(`ent: missing required field "Wide.column051"`)}
+	}
+	if _, ok := wc.mutation.Column052(); !ok {
+		return &ValidationError{Name: "column052", err: errors.New(`ent: missing required field "Wide.column052"`)}
+	}
+	if _, ok := wc.mutation.Column053(); !ok {
+		return &ValidationError{Name: "column053", err: errors.New(`ent: missing required field "Wide.column053"`)}
+	}
+	if _, ok := wc.mutation.Column054(); !ok {
+		return &ValidationError{Name: "column054", err: errors.New(`ent: missing required field "Wide.column054"`)}
+	}
+	if _, ok := wc.mutation.Column055(); !ok {
+		return &ValidationError{Name: "column055", err: errors.New(`ent: missing required field "Wide.column055"`)}
+	}
+	if _, ok := wc.mutation.Column056(); !ok {
+		return &ValidationError{Name: "column056", err: errors.New(`ent: missing required field "Wide.column056"`)}
+	}
+	if _, ok := wc.mutation.Column057(); !ok {
+		return &ValidationError{Name: "column057", err: errors.New(`ent: missing required field "Wide.column057"`)}
+	}
+	if _, ok := wc.mutation.Column058(); !ok {
+		return &ValidationError{Name: "column058", err: errors.New(`ent: missing required field "Wide.column058"`)}
+	}
+	if _, ok := wc.mutation.Column059(); !ok {
+		return &ValidationError{Name: "column059", err: errors.New(`ent: missing required field "Wide.column059"`)}
+	}
+	if _, ok := wc.mutation.Column060(); !ok {
+		return &ValidationError{Name: "column060", err: errors.New(`ent: missing required field "Wide.column060"`)}
+	}
+	if _, ok := wc.mutation.Column061(); !ok {
+		return &ValidationError{Name: "column061", err: errors.New(`ent: missing required field "Wide.column061"`)}
+	}
+	if _, ok := wc.mutation.Column062(); !ok {
+		return &ValidationError{Name: "column062", err: errors.New(`ent: missing required field "Wide.column062"`)}
+	}
+	if _, ok := wc.mutation.Column063(); !ok {
+		return &ValidationError{Name: "column063", err: errors.New(`ent: missing required field "Wide.column063"`)}
+	}
+	if _, ok := wc.mutation.Column064(); !ok {
+		return &ValidationError{Name: "column064", err: errors.New(`ent: missing required field "Wide.column064"`)}
+	}
+	return nil
+}
+
+func (wc *WideCreate) sqlSave(ctx context.Context) (*Wide, error) {
+	if err := wc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wc.mutation.id = &_node.ID
+	wc.mutation.done = true
+	return _node, nil
+}
+
+func (wc *WideCreate) createSpec() (*Wide, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Wide{config: wc.config}
+		_spec = sqlgraph.NewCreateSpec(wide.Table, sqlgraph.NewFieldSpec(wide.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = wc.conflict
+	if value, ok := wc.mutation.Column001(); ok {
+		_spec.SetField(wide.FieldColumn001, field.TypeString, value)
+		_node.Column001 = value
+	}
+	if value, ok := wc.mutation.Column002(); ok {
+		_spec.SetField(wide.FieldColumn002, field.TypeInt, value)
+		_node.Column002 = value
+	}
+	if value, ok := wc.mutation.Column003(); ok {
+		_spec.SetField(wide.FieldColumn003, field.TypeFloat64, value)
+		_node.Column003 = value
+	}
+	if value, ok := wc.mutation.Column004(); ok {
+		_spec.SetField(wide.FieldColumn004, field.TypeTime, value)
+		_node.Column004 = value
+	}
+	if value, ok := wc.mutation.Column005(); ok {
+		_spec.SetField(wide.FieldColumn005, field.TypeString, value)
+		_node.Column005 = value
+	}
+	if value, ok := wc.mutation.Column006(); ok {
+		_spec.SetField(wide.FieldColumn006, field.TypeInt, value)
+		_node.Column006 = value
+	}
+	if value, ok := wc.mutation.Column007(); ok {
+		_spec.SetField(wide.FieldColumn007, field.TypeFloat64, value)
+		_node.Column007 = value
+	}
+	if value, ok := wc.mutation.Column008(); ok {
+		_spec.SetField(wide.FieldColumn008, field.TypeTime, value)
+		_node.Column008 = value
+	}
+	if value, ok := wc.mutation.Column009(); ok {
+		_spec.SetField(wide.FieldColumn009, field.TypeString, value)
+		_node.Column009 = value
+	}
+	if value, ok := wc.mutation.Column010(); ok {
+		_spec.SetField(wide.FieldColumn010, field.TypeInt, value)
+		_node.Column010 = value
+	}
+	if value, ok := wc.mutation.Column011(); ok {
+		_spec.SetField(wide.FieldColumn011, field.TypeFloat64, value)
+		_node.Column011 = value
+	}
+	if value, ok := wc.mutation.Column012(); ok {
+		_spec.SetField(wide.FieldColumn012, field.TypeTime, value)
+		_node.Column012 = value
+	}
+	if value, ok := wc.mutation.Column013(); ok {
+		_spec.SetField(wide.FieldColumn013, field.TypeString, value)
+		_node.Column013 = value
+	}
+	if value, ok := wc.mutation.Column014(); ok {
+		_spec.SetField(wide.FieldColumn014, field.TypeInt, value)
+		_node.Column014 = value
+	}
+	if value, ok := wc.mutation.Column015(); ok {
+		_spec.SetField(wide.FieldColumn015, field.TypeFloat64, value)
+		_node.Column015 = value
+	}
+	if value, ok := wc.mutation.Column016(); ok {
+		_spec.SetField(wide.FieldColumn016, field.TypeTime, value)
+		_node.Column016 = value
+	}
+	if value, ok := wc.mutation.Column017(); ok {
+		_spec.SetField(wide.FieldColumn017, field.TypeString, value)
+		_node.Column017 = value
+	}
+	if value, ok := wc.mutation.Column018(); ok {
+		_spec.SetField(wide.FieldColumn018, field.TypeInt, value)
+		_node.Column018 = value
+	}
+	if value, ok := wc.mutation.Column019(); ok {
+		_spec.SetField(wide.FieldColumn019, field.TypeFloat64, value)
+		_node.Column019 = value
+	}
+	if value, ok := wc.mutation.Column020(); ok {
+		_spec.SetField(wide.FieldColumn020, field.TypeTime, value)
+		_node.Column020 = value
+	}
+	if value, ok := wc.mutation.Column021(); ok {
+		_spec.SetField(wide.FieldColumn021, field.TypeString, value)
+		_node.Column021 = value
+	}
+	if value, ok := wc.mutation.Column022(); ok {
+		_spec.SetField(wide.FieldColumn022, field.TypeInt, value)
+		_node.Column022 = value
+	}
+	if value, ok := wc.mutation.Column023(); ok {
+		_spec.SetField(wide.FieldColumn023, field.TypeFloat64, value)
+		_node.Column023 = value
+	}
+	if value, ok := wc.mutation.Column024(); ok {
+		_spec.SetField(wide.FieldColumn024, field.TypeTime, value)
+		_node.Column024 = value
+	}
+	if value, ok := wc.mutation.Column025(); ok {
+		_spec.SetField(wide.FieldColumn025, field.TypeString, value)
+		_node.Column025 = value
+	}
+	if value, ok := wc.mutation.Column026(); ok {
+		_spec.SetField(wide.FieldColumn026, field.TypeInt, value)
+		_node.Column026 = value
+	}
+	if value, ok := wc.mutation.Column027(); ok {
+		_spec.SetField(wide.FieldColumn027, field.TypeFloat64, value)
+		_node.Column027 = value
+	}
+	if value, ok := wc.mutation.Column028(); ok {
+		_spec.SetField(wide.FieldColumn028, field.TypeTime, value)
+		_node.Column028 = value
+	}
+	if value, ok := wc.mutation.Column029(); ok {
+		_spec.SetField(wide.FieldColumn029, field.TypeString, value)
+		_node.Column029 = value
+	}
+	if value, ok := wc.mutation.Column030(); ok {
+		_spec.SetField(wide.FieldColumn030, field.TypeInt, value)
+		_node.Column030 = value
+	}
+	if value, ok := wc.mutation.Column031(); ok {
+		_spec.SetField(wide.FieldColumn031, field.TypeFloat64, value)
+		_node.Column031 = value
+	}
+	if value, ok := wc.mutation.Column032(); ok {
+		_spec.SetField(wide.FieldColumn032, field.TypeTime, value)
+		_node.Column032 = value
+	}
+	if value, ok := wc.mutation.Column033(); ok {
+		_spec.SetField(wide.FieldColumn033, field.TypeString, value)
+		_node.Column033 = value
+	}
+	if value, ok := wc.mutation.Column034(); ok {
+		_spec.SetField(wide.FieldColumn034, field.TypeInt, value)
+		_node.Column034 = value
+	}
+	if value, ok := wc.mutation.Column035(); ok {
+		_spec.SetField(wide.FieldColumn035, field.TypeFloat64, value)
+		_node.Column035 = value
+	}
+	if value, ok := wc.mutation.Column036(); ok {
+		_spec.SetField(wide.FieldColumn036, field.TypeTime, value)
+		_node.Column036 = value
+	}
+	if value, ok := wc.mutation.Column037(); ok {
+		_spec.SetField(wide.FieldColumn037, field.TypeString, value)
+		_node.Column037 = value
+	}
+	if value, ok := wc.mutation.Column038(); ok {
+		_spec.SetField(wide.FieldColumn038, field.TypeInt, value)
+		_node.Column038 = value
+	}
+	if value, ok := wc.mutation.Column039(); ok {
+		_spec.SetField(wide.FieldColumn039, field.TypeFloat64, value)
+		_node.Column039 = value
+	}
+	if value, ok := wc.mutation.Column040(); ok {
+		_spec.SetField(wide.FieldColumn040, field.TypeTime, value)
+		_node.Column040 = value
+	}
+	if value, ok := wc.mutation.Column041(); ok {
+		_spec.SetField(wide.FieldColumn041, field.TypeString, value)
+		_node.Column041 = value
+	}
+	if value, ok := wc.mutation.Column042(); ok {
+		_spec.SetField(wide.FieldColumn042, field.TypeInt, value)
+		_node.Column042 = value
+	}
+	if value, ok := wc.mutation.Column043(); ok {
+		_spec.SetField(wide.FieldColumn043, field.TypeFloat64, value)
+		_node.Column043 = value
+	}
+	if value, ok := wc.mutation.Column044(); ok {
+		_spec.SetField(wide.FieldColumn044, field.TypeTime, value)
+		_node.Column044 = value
+	}
+	if value, ok := wc.mutation.Column045(); ok {
+		_spec.SetField(wide.FieldColumn045, field.TypeString, value)
+		_node.Column045 = value
+	}
+	if value, ok := wc.mutation.Column046(); ok {
+		_spec.SetField(wide.FieldColumn046, field.TypeInt, value)
+		_node.Column046 = value
+	}
+	if value, ok := wc.mutation.Column047(); ok {
+		_spec.SetField(wide.FieldColumn047, field.TypeFloat64, value)
+		_node.Column047 = value
+	}
+	if value, ok := wc.mutation.Column048(); ok {
+		_spec.SetField(wide.FieldColumn048, field.TypeTime, value)
+		_node.Column048 = value
+	}
+	if value, ok := wc.mutation.Column049(); ok {
+		_spec.SetField(wide.FieldColumn049, field.TypeString, value)
+		_node.Column049 = value
+	}
+	if value, ok := wc.mutation.Column050(); ok {
+		_spec.SetField(wide.FieldColumn050, field.TypeInt, value)
+		_node.Column050 = value
+	}
+	if value, ok := wc.mutation.Column051(); ok {
+		_spec.SetField(wide.FieldColumn051, field.TypeFloat64, value)
+		_node.Column051 = value
+	}
+	if value, ok := wc.mutation.Column052(); ok {
+		_spec.SetField(wide.FieldColumn052, field.TypeTime, value)
+		_node.Column052 = value
+	}
+	if value, ok := wc.mutation.Column053(); ok {
+		_spec.SetField(wide.FieldColumn053, field.TypeString, value)
+		_node.Column053 = value
+	}
+	if value, ok := wc.mutation.Column054(); ok {
+		_spec.SetField(wide.FieldColumn054, field.TypeInt, value)
+		_node.Column054 = value
+	}
+	if value, ok := wc.mutation.Column055(); ok {
+		_spec.SetField(wide.FieldColumn055, field.TypeFloat64, value)
+		_node.Column055 = value
+	}
+	if value, ok := wc.mutation.Column056(); ok {
+		_spec.SetField(wide.FieldColumn056, field.TypeTime, value)
+		_node.Column056 = value
+	}
+	if value, ok := wc.mutation.Column057(); ok {
+		_spec.SetField(wide.FieldColumn057, field.TypeString, value)
+		_node.Column057 = value
+	}
+	if value, ok := wc.mutation.Column058(); ok {
+		_spec.SetField(wide.FieldColumn058, field.TypeInt, value)
+		_node.Column058 = value
+	}
+	if value, ok := wc.mutation.Column059(); ok {
+		_spec.SetField(wide.FieldColumn059, field.TypeFloat64, value)
+		_node.Column059 = value
+	}
+	if value, ok := wc.mutation.Column060(); ok {
+		_spec.SetField(wide.FieldColumn060, field.TypeTime, value)
+		_node.Column060 = value
+	}
+	if value, ok := wc.mutation.Column061(); ok {
+		_spec.SetField(wide.FieldColumn061, field.TypeString, value)
+		_node.Column061 = value
+	}
+	if value, ok := wc.mutation.Column062(); ok {
+		_spec.SetField(wide.FieldColumn062, field.TypeInt, value)
+		_node.Column062 = value
+	}
+	if value, ok := wc.mutation.Column063(); ok {
+		_spec.SetField(wide.FieldColumn063, field.TypeFloat64, value)
+		_node.Column063 = value
+	}
+	if value, ok := wc.mutation.Column064(); ok {
+		_spec.SetField(wide.FieldColumn064, field.TypeTime, value)
+		_node.Column064 = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Wide.Create().
+//		SetColumn001(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WideUpsert) {
+//			SetColumn001(v+v).
+//		}).
+//		Exec(ctx)
+func (wc *WideCreate) OnConflict(opts ...sql.ConflictOption) *WideUpsertOne {
+	wc.conflict = opts
+	return &WideUpsertOne{
+		create: wc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Wide.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wc *WideCreate) OnConflictColumns(columns ...string) *WideUpsertOne {
+	wc.conflict = append(wc.conflict, sql.ConflictColumns(columns...))
+	return &WideUpsertOne{
+		create: wc,
+	}
+}
+
+type (
+	// WideUpsertOne is the builder for "upsert"-ing
+	//  one Wide node.
+	WideUpsertOne struct {
+		create *WideCreate
+	}
+
+	// WideUpsert is the "OnConflict" setter.
+	WideUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetColumn001 sets the "column001" field.
+func (u *WideUpsert) SetColumn001(v string) *WideUpsert {
+	u.Set(wide.FieldColumn001, v)
+	return u
+}
+
+// UpdateColumn001 sets the "column001" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn001() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn001)
+	return u
+}
+
+// SetColumn002 sets the "column002" field.
+func (u *WideUpsert) SetColumn002(v int) *WideUpsert {
+	u.Set(wide.FieldColumn002, v)
+	return u
+}
+
+// UpdateColumn002 sets the "column002" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn002() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn002)
+	return u
+}
+
+// AddColumn002 adds v to the "column002" field.
+func (u *WideUpsert) AddColumn002(v int) *WideUpsert {
+	u.Add(wide.FieldColumn002, v)
+	return u
+}
+
+// SetColumn003 sets the "column003" field.
+func (u *WideUpsert) SetColumn003(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn003, v)
+	return u
+}
+
+// UpdateColumn003 sets the "column003" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn003() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn003)
+	return u
+}
+
+// AddColumn003 adds v to the "column003" field.
+func (u *WideUpsert) AddColumn003(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn003, v)
+	return u
+}
+
+// SetColumn004 sets the "column004" field.
+func (u *WideUpsert) SetColumn004(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn004, v)
+	return u
+}
+
+// UpdateColumn004 sets the "column004" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn004() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn004)
+	return u
+}
+
+// SetColumn005 sets the "column005" field.
+func (u *WideUpsert) SetColumn005(v string) *WideUpsert {
+	u.Set(wide.FieldColumn005, v)
+	return u
+}
+
+// UpdateColumn005 sets the "column005" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn005() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn005)
+	return u
+}
+
+// SetColumn006 sets the "column006" field.
+func (u *WideUpsert) SetColumn006(v int) *WideUpsert {
+	u.Set(wide.FieldColumn006, v)
+	return u
+}
+
+// UpdateColumn006 sets the "column006" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn006() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn006)
+	return u
+}
+
+// AddColumn006 adds v to the "column006" field.
+func (u *WideUpsert) AddColumn006(v int) *WideUpsert {
+	u.Add(wide.FieldColumn006, v)
+	return u
+}
+
+// SetColumn007 sets the "column007" field.
+func (u *WideUpsert) SetColumn007(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn007, v)
+	return u
+}
+
+// UpdateColumn007 sets the "column007" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn007() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn007)
+	return u
+}
+
+// AddColumn007 adds v to the "column007" field.
+func (u *WideUpsert) AddColumn007(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn007, v)
+	return u
+}
+
+// SetColumn008 sets the "column008" field.
+func (u *WideUpsert) SetColumn008(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn008, v)
+	return u
+}
+
+// UpdateColumn008 sets the "column008" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn008() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn008)
+	return u
+}
+
+// SetColumn009 sets the "column009" field.
+func (u *WideUpsert) SetColumn009(v string) *WideUpsert {
+	u.Set(wide.FieldColumn009, v)
+	return u
+}
+
+// UpdateColumn009 sets the "column009" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn009() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn009)
+	return u
+}
+
+// SetColumn010 sets the "column010" field.
+func (u *WideUpsert) SetColumn010(v int) *WideUpsert {
+	u.Set(wide.FieldColumn010, v)
+	return u
+}
+
+// UpdateColumn010 sets the "column010" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn010() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn010)
+	return u
+}
+
+// AddColumn010 adds v to the "column010" field.
+func (u *WideUpsert) AddColumn010(v int) *WideUpsert {
+	u.Add(wide.FieldColumn010, v)
+	return u
+}
+
+// SetColumn011 sets the "column011" field.
+func (u *WideUpsert) SetColumn011(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn011, v)
+	return u
+}
+
+// UpdateColumn011 sets the "column011" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn011() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn011)
+	return u
+}
+
+// AddColumn011 adds v to the "column011" field.
+func (u *WideUpsert) AddColumn011(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn011, v)
+	return u
+}
+
+// SetColumn012 sets the "column012" field.
+func (u *WideUpsert) SetColumn012(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn012, v)
+	return u
+}
+
+// UpdateColumn012 sets the "column012" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn012() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn012)
+	return u
+}
+
+// SetColumn013 sets the "column013" field.
+func (u *WideUpsert) SetColumn013(v string) *WideUpsert {
+	u.Set(wide.FieldColumn013, v)
+	return u
+}
+
+// UpdateColumn013 sets the "column013" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn013() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn013)
+	return u
+}
+
+// SetColumn014 sets the "column014" field.
+func (u *WideUpsert) SetColumn014(v int) *WideUpsert {
+	u.Set(wide.FieldColumn014, v)
+	return u
+}
+
+// UpdateColumn014 sets the "column014" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn014() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn014)
+	return u
+}
+
+// AddColumn014 adds v to the "column014" field.
+func (u *WideUpsert) AddColumn014(v int) *WideUpsert {
+	u.Add(wide.FieldColumn014, v)
+	return u
+}
+
+// SetColumn015 sets the "column015" field.
+func (u *WideUpsert) SetColumn015(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn015, v)
+	return u
+}
+
+// UpdateColumn015 sets the "column015" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn015() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn015)
+	return u
+}
+
+// AddColumn015 adds v to the "column015" field.
+func (u *WideUpsert) AddColumn015(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn015, v)
+	return u
+}
+
+// SetColumn016 sets the "column016" field.
+func (u *WideUpsert) SetColumn016(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn016, v)
+	return u
+}
+
+// UpdateColumn016 sets the "column016" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn016() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn016)
+	return u
+}
+
+// SetColumn017 sets the "column017" field.
+func (u *WideUpsert) SetColumn017(v string) *WideUpsert {
+	u.Set(wide.FieldColumn017, v)
+	return u
+}
+
+// UpdateColumn017 sets the "column017" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn017() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn017)
+	return u
+}
+
+// SetColumn018 sets the "column018" field.
+func (u *WideUpsert) SetColumn018(v int) *WideUpsert {
+	u.Set(wide.FieldColumn018, v)
+	return u
+}
+
+// UpdateColumn018 sets the "column018" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn018() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn018)
+	return u
+}
+
+// AddColumn018 adds v to the "column018" field.
+func (u *WideUpsert) AddColumn018(v int) *WideUpsert {
+	u.Add(wide.FieldColumn018, v)
+	return u
+}
+
+// SetColumn019 sets the "column019" field.
+func (u *WideUpsert) SetColumn019(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn019, v)
+	return u
+}
+
+// UpdateColumn019 sets the "column019" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn019() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn019)
+	return u
+}
+
+// AddColumn019 adds v to the "column019" field.
+func (u *WideUpsert) AddColumn019(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn019, v)
+	return u
+}
+
+// SetColumn020 sets the "column020" field.
+func (u *WideUpsert) SetColumn020(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn020, v)
+	return u
+}
+
+// UpdateColumn020 sets the "column020" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn020() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn020)
+	return u
+}
+
+// SetColumn021 sets the "column021" field.
+func (u *WideUpsert) SetColumn021(v string) *WideUpsert {
+	u.Set(wide.FieldColumn021, v)
+	return u
+}
+
+// UpdateColumn021 sets the "column021" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn021() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn021)
+	return u
+}
+
+// SetColumn022 sets the "column022" field.
+func (u *WideUpsert) SetColumn022(v int) *WideUpsert {
+	u.Set(wide.FieldColumn022, v)
+	return u
+}
+
+// UpdateColumn022 sets the "column022" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn022() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn022)
+	return u
+}
+
+// AddColumn022 adds v to the "column022" field.
+func (u *WideUpsert) AddColumn022(v int) *WideUpsert {
+	u.Add(wide.FieldColumn022, v)
+	return u
+}
+
+// SetColumn023 sets the "column023" field.
+func (u *WideUpsert) SetColumn023(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn023, v)
+	return u
+}
+
+// UpdateColumn023 sets the "column023" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn023() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn023)
+	return u
+}
+
+// AddColumn023 adds v to the "column023" field.
+func (u *WideUpsert) AddColumn023(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn023, v)
+	return u
+}
+
+// SetColumn024 sets the "column024" field.
+func (u *WideUpsert) SetColumn024(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn024, v)
+	return u
+}
+
+// UpdateColumn024 sets the "column024" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn024() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn024)
+	return u
+}
+
+// SetColumn025 sets the "column025" field.
+func (u *WideUpsert) SetColumn025(v string) *WideUpsert {
+	u.Set(wide.FieldColumn025, v)
+	return u
+}
+
+// UpdateColumn025 sets the "column025" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn025() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn025)
+	return u
+}
+
+// SetColumn026 sets the "column026" field.
+func (u *WideUpsert) SetColumn026(v int) *WideUpsert {
+	u.Set(wide.FieldColumn026, v)
+	return u
+}
+
+// UpdateColumn026 sets the "column026" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn026() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn026)
+	return u
+}
+
+// AddColumn026 adds v to the "column026" field.
+func (u *WideUpsert) AddColumn026(v int) *WideUpsert {
+	u.Add(wide.FieldColumn026, v)
+	return u
+}
+
+// SetColumn027 sets the "column027" field.
+func (u *WideUpsert) SetColumn027(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn027, v)
+	return u
+}
+
+// UpdateColumn027 sets the "column027" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn027() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn027)
+	return u
+}
+
+// AddColumn027 adds v to the "column027" field.
+func (u *WideUpsert) AddColumn027(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn027, v)
+	return u
+}
+
+// SetColumn028 sets the "column028" field.
+func (u *WideUpsert) SetColumn028(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn028, v)
+	return u
+}
+
+// UpdateColumn028 sets the "column028" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn028() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn028)
+	return u
+}
+
+// SetColumn029 sets the "column029" field.
+func (u *WideUpsert) SetColumn029(v string) *WideUpsert {
+	u.Set(wide.FieldColumn029, v)
+	return u
+}
+
+// UpdateColumn029 sets the "column029" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn029() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn029)
+	return u
+}
+
+// SetColumn030 sets the "column030" field.
+func (u *WideUpsert) SetColumn030(v int) *WideUpsert {
+	u.Set(wide.FieldColumn030, v)
+	return u
+}
+
+// UpdateColumn030 sets the "column030" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn030() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn030)
+	return u
+}
+
+// AddColumn030 adds v to the "column030" field.
+func (u *WideUpsert) AddColumn030(v int) *WideUpsert {
+	u.Add(wide.FieldColumn030, v)
+	return u
+}
+
+// SetColumn031 sets the "column031" field.
+func (u *WideUpsert) SetColumn031(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn031, v)
+	return u
+}
+
+// UpdateColumn031 sets the "column031" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn031() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn031)
+	return u
+}
+
+// AddColumn031 adds v to the "column031" field.
+func (u *WideUpsert) AddColumn031(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn031, v)
+	return u
+}
+
+// SetColumn032 sets the "column032" field.
+func (u *WideUpsert) SetColumn032(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn032, v)
+	return u
+}
+
+// UpdateColumn032 sets the "column032" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn032() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn032)
+	return u
+}
+
+// SetColumn033 sets the "column033" field.
+func (u *WideUpsert) SetColumn033(v string) *WideUpsert {
+	u.Set(wide.FieldColumn033, v)
+	return u
+}
+
+// UpdateColumn033 sets the "column033" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn033() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn033)
+	return u
+}
+
+// SetColumn034 sets the "column034" field.
+func (u *WideUpsert) SetColumn034(v int) *WideUpsert {
+	u.Set(wide.FieldColumn034, v)
+	return u
+}
+
+// UpdateColumn034 sets the "column034" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn034() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn034)
+	return u
+}
+
+// AddColumn034 adds v to the "column034" field.
+func (u *WideUpsert) AddColumn034(v int) *WideUpsert {
+	u.Add(wide.FieldColumn034, v)
+	return u
+}
+
+// SetColumn035 sets the "column035" field.
+func (u *WideUpsert) SetColumn035(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn035, v)
+	return u
+}
+
+// UpdateColumn035 sets the "column035" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn035() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn035)
+	return u
+}
+
+// AddColumn035 adds v to the "column035" field.
+func (u *WideUpsert) AddColumn035(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn035, v)
+	return u
+}
+
+// SetColumn036 sets the "column036" field.
+func (u *WideUpsert) SetColumn036(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn036, v)
+	return u
+}
+
+// UpdateColumn036 sets the "column036" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn036() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn036)
+	return u
+}
+
+// SetColumn037 sets the "column037" field.
+func (u *WideUpsert) SetColumn037(v string) *WideUpsert {
+	u.Set(wide.FieldColumn037, v)
+	return u
+}
+
+// UpdateColumn037 sets the "column037" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn037() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn037)
+	return u
+}
+
+// SetColumn038 sets the "column038" field.
+func (u *WideUpsert) SetColumn038(v int) *WideUpsert {
+	u.Set(wide.FieldColumn038, v)
+	return u
+}
+
+// UpdateColumn038 sets the "column038" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn038() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn038)
+	return u
+}
+
+// AddColumn038 adds v to the "column038" field.
+func (u *WideUpsert) AddColumn038(v int) *WideUpsert {
+	u.Add(wide.FieldColumn038, v)
+	return u
+}
+
+// SetColumn039 sets the "column039" field.
+func (u *WideUpsert) SetColumn039(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn039, v)
+	return u
+}
+
+// UpdateColumn039 sets the "column039" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn039() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn039)
+	return u
+}
+
+// AddColumn039 adds v to the "column039" field.
+func (u *WideUpsert) AddColumn039(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn039, v)
+	return u
+}
+
+// SetColumn040 sets the "column040" field.
+func (u *WideUpsert) SetColumn040(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn040, v)
+	return u
+}
+
+// UpdateColumn040 sets the "column040" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn040() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn040)
+	return u
+}
+
+// SetColumn041 sets the "column041" field.
+func (u *WideUpsert) SetColumn041(v string) *WideUpsert {
+	u.Set(wide.FieldColumn041, v)
+	return u
+}
+
+// UpdateColumn041 sets the "column041" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn041() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn041)
+	return u
+}
+
+// SetColumn042 sets the "column042" field.
+func (u *WideUpsert) SetColumn042(v int) *WideUpsert {
+	u.Set(wide.FieldColumn042, v)
+	return u
+}
+
+// UpdateColumn042 sets the "column042" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn042() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn042)
+	return u
+}
+
+// AddColumn042 adds v to the "column042" field.
+func (u *WideUpsert) AddColumn042(v int) *WideUpsert {
+	u.Add(wide.FieldColumn042, v)
+	return u
+}
+
+// SetColumn043 sets the "column043" field.
+func (u *WideUpsert) SetColumn043(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn043, v)
+	return u
+}
+
+// UpdateColumn043 sets the "column043" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn043() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn043)
+	return u
+}
+
+// AddColumn043 adds v to the "column043" field.
+func (u *WideUpsert) AddColumn043(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn043, v)
+	return u
+}
+
+// SetColumn044 sets the "column044" field.
+func (u *WideUpsert) SetColumn044(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn044, v)
+	return u
+}
+
+// UpdateColumn044 sets the "column044" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn044() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn044)
+	return u
+}
+
+// SetColumn045 sets the "column045" field.
+func (u *WideUpsert) SetColumn045(v string) *WideUpsert {
+	u.Set(wide.FieldColumn045, v)
+	return u
+}
+
+// UpdateColumn045 sets the "column045" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn045() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn045)
+	return u
+}
+
+// SetColumn046 sets the "column046" field.
+func (u *WideUpsert) SetColumn046(v int) *WideUpsert {
+	u.Set(wide.FieldColumn046, v)
+	return u
+}
+
+// UpdateColumn046 sets the "column046" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn046() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn046)
+	return u
+}
+
+// AddColumn046 adds v to the "column046" field.
+func (u *WideUpsert) AddColumn046(v int) *WideUpsert {
+	u.Add(wide.FieldColumn046, v)
+	return u
+}
+
+// SetColumn047 sets the "column047" field.
+func (u *WideUpsert) SetColumn047(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn047, v)
+	return u
+}
+
+// UpdateColumn047 sets the "column047" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn047() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn047)
+	return u
+}
+
+// AddColumn047 adds v to the "column047" field.
+func (u *WideUpsert) AddColumn047(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn047, v)
+	return u
+}
+
+// SetColumn048 sets the "column048" field.
+func (u *WideUpsert) SetColumn048(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn048, v)
+	return u
+}
+
+// UpdateColumn048 sets the "column048" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn048() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn048)
+	return u
+}
+
+// SetColumn049 sets the "column049" field.
+func (u *WideUpsert) SetColumn049(v string) *WideUpsert {
+	u.Set(wide.FieldColumn049, v)
+	return u
+}
+
+// UpdateColumn049 sets the "column049" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn049() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn049)
+	return u
+}
+
+// SetColumn050 sets the "column050" field.
+func (u *WideUpsert) SetColumn050(v int) *WideUpsert {
+	u.Set(wide.FieldColumn050, v)
+	return u
+}
+
+// UpdateColumn050 sets the "column050" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn050() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn050)
+	return u
+}
+
+// AddColumn050 adds v to the "column050" field.
+func (u *WideUpsert) AddColumn050(v int) *WideUpsert {
+	u.Add(wide.FieldColumn050, v)
+	return u
+}
+
+// SetColumn051 sets the "column051" field.
+func (u *WideUpsert) SetColumn051(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn051, v)
+	return u
+}
+
+// UpdateColumn051 sets the "column051" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn051() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn051)
+	return u
+}
+
+// AddColumn051 adds v to the "column051" field.
+func (u *WideUpsert) AddColumn051(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn051, v)
+	return u
+}
+
+// SetColumn052 sets the "column052" field.
+func (u *WideUpsert) SetColumn052(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn052, v)
+	return u
+}
+
+// UpdateColumn052 sets the "column052" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn052() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn052)
+	return u
+}
+
+// SetColumn053 sets the "column053" field.
+func (u *WideUpsert) SetColumn053(v string) *WideUpsert {
+	u.Set(wide.FieldColumn053, v)
+	return u
+}
+
+// UpdateColumn053 sets the "column053" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn053() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn053)
+	return u
+}
+
+// SetColumn054 sets the "column054" field.
+func (u *WideUpsert) SetColumn054(v int) *WideUpsert {
+	u.Set(wide.FieldColumn054, v)
+	return u
+}
+
+// UpdateColumn054 sets the "column054" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn054() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn054)
+	return u
+}
+
+// AddColumn054 adds v to the "column054" field.
+func (u *WideUpsert) AddColumn054(v int) *WideUpsert {
+	u.Add(wide.FieldColumn054, v)
+	return u
+}
+
+// SetColumn055 sets the "column055" field.
+func (u *WideUpsert) SetColumn055(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn055, v)
+	return u
+}
+
+// UpdateColumn055 sets the "column055" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn055() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn055)
+	return u
+}
+
+// AddColumn055 adds v to the "column055" field.
+func (u *WideUpsert) AddColumn055(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn055, v)
+	return u
+}
+
+// SetColumn056 sets the "column056" field.
+func (u *WideUpsert) SetColumn056(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn056, v)
+	return u
+}
+
+// UpdateColumn056 sets the "column056" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn056() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn056)
+	return u
+}
+
+// SetColumn057 sets the "column057" field.
+func (u *WideUpsert) SetColumn057(v string) *WideUpsert {
+	u.Set(wide.FieldColumn057, v)
+	return u
+}
+
+// UpdateColumn057 sets the "column057" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn057() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn057)
+	return u
+}
+
+// SetColumn058 sets the "column058" field.
+func (u *WideUpsert) SetColumn058(v int) *WideUpsert {
+	u.Set(wide.FieldColumn058, v)
+	return u
+}
+
+// UpdateColumn058 sets the "column058" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn058() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn058)
+	return u
+}
+
+// AddColumn058 adds v to the "column058" field.
+func (u *WideUpsert) AddColumn058(v int) *WideUpsert {
+	u.Add(wide.FieldColumn058, v)
+	return u
+}
+
+// SetColumn059 sets the "column059" field.
+func (u *WideUpsert) SetColumn059(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn059, v)
+	return u
+}
+
+// UpdateColumn059 sets the "column059" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn059() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn059)
+	return u
+}
+
+// AddColumn059 adds v to the "column059" field.
+func (u *WideUpsert) AddColumn059(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn059, v)
+	return u
+}
+
+// SetColumn060 sets the "column060" field.
+func (u *WideUpsert) SetColumn060(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn060, v)
+	return u
+}
+
+// UpdateColumn060 sets the "column060" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn060() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn060)
+	return u
+}
+
+// SetColumn061 sets the "column061" field.
+func (u *WideUpsert) SetColumn061(v string) *WideUpsert {
+	u.Set(wide.FieldColumn061, v)
+	return u
+}
+
+// UpdateColumn061 sets the "column061" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn061() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn061)
+	return u
+}
+
+// SetColumn062 sets the "column062" field.
+func (u *WideUpsert) SetColumn062(v int) *WideUpsert {
+	u.Set(wide.FieldColumn062, v)
+	return u
+}
+
+// UpdateColumn062 sets the "column062" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn062() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn062)
+	return u
+}
+
+// AddColumn062 adds v to the "column062" field.
+func (u *WideUpsert) AddColumn062(v int) *WideUpsert {
+	u.Add(wide.FieldColumn062, v)
+	return u
+}
+
+// SetColumn063 sets the "column063" field.
+func (u *WideUpsert) SetColumn063(v float64) *WideUpsert {
+	u.Set(wide.FieldColumn063, v)
+	return u
+}
+
+// UpdateColumn063 sets the "column063" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn063() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn063)
+	return u
+}
+
+// AddColumn063 adds v to the "column063" field.
+func (u *WideUpsert) AddColumn063(v float64) *WideUpsert {
+	u.Add(wide.FieldColumn063, v)
+	return u
+}
+
+// SetColumn064 sets the "column064" field.
+func (u *WideUpsert) SetColumn064(v time.Time) *WideUpsert {
+	u.Set(wide.FieldColumn064, v)
+	return u
+}
+
+// UpdateColumn064 sets the "column064" field to the value that was provided on create.
+func (u *WideUpsert) UpdateColumn064() *WideUpsert {
+	u.SetExcluded(wide.FieldColumn064)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Wide.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WideUpsertOne) UpdateNewValues() *WideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Wide.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WideUpsertOne) Ignore() *WideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WideUpsertOne) DoNothing() *WideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WideCreate.OnConflict
+// documentation for more info.
+func (u *WideUpsertOne) Update(set func(*WideUpsert)) *WideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WideUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetColumn001 sets the "column001" field.
+func (u *WideUpsertOne) SetColumn001(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn001(v)
+	})
+}
+
+// UpdateColumn001 sets the "column001" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn001() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn001()
+	})
+}
+
+// SetColumn002 sets the "column002" field.
+func (u *WideUpsertOne) SetColumn002(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn002(v)
+	})
+}
+
+// AddColumn002 adds v to the "column002" field.
+func (u *WideUpsertOne) AddColumn002(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn002(v)
+	})
+}
+
+// UpdateColumn002 sets the "column002" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn002() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn002()
+	})
+}
+
+// SetColumn003 sets the "column003" field.
+func (u *WideUpsertOne) SetColumn003(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn003(v)
+	})
+}
+
+// AddColumn003 adds v to the "column003" field.
+func (u *WideUpsertOne) AddColumn003(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn003(v)
+	})
+}
+
+// UpdateColumn003 sets the "column003" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn003() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn003()
+	})
+}
+
+// SetColumn004 sets the "column004" field.
+func (u *WideUpsertOne) SetColumn004(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn004(v)
+	})
+}
+
+// UpdateColumn004 sets the "column004" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn004() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn004()
+	})
+}
+
+// SetColumn005 sets the "column005" field.
+func (u *WideUpsertOne) SetColumn005(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn005(v)
+	})
+}
+
+// UpdateColumn005 sets the "column005" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn005() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn005()
+	})
+}
+
+// SetColumn006 sets the "column006" field.
+func (u *WideUpsertOne) SetColumn006(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn006(v)
+	})
+}
+
+// AddColumn006 adds v to the "column006" field.
+func (u *WideUpsertOne) AddColumn006(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn006(v)
+	})
+}
+
+// UpdateColumn006 sets the "column006" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn006() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn006()
+	})
+}
+
+// SetColumn007 sets the "column007" field.
+func (u *WideUpsertOne) SetColumn007(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn007(v)
+	})
+}
+
+// AddColumn007 adds v to the "column007" field.
+func (u *WideUpsertOne) AddColumn007(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn007(v)
+	})
+}
+
+// UpdateColumn007 sets the "column007" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn007() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn007()
+	})
+}
+
+// SetColumn008 sets the "column008" field.
+func (u *WideUpsertOne) SetColumn008(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn008(v)
+	})
+}
+
+// UpdateColumn008 sets the "column008" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn008() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn008()
+	})
+}
+
+// SetColumn009 sets the "column009" field.
+func (u *WideUpsertOne) SetColumn009(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn009(v)
+	})
+}
+
+// UpdateColumn009 sets the "column009" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn009() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn009()
+	})
+}
+
+// SetColumn010 sets the "column010" field.
+func (u *WideUpsertOne) SetColumn010(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn010(v)
+	})
+}
+
+// AddColumn010 adds v to the "column010" field.
+func (u *WideUpsertOne) AddColumn010(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn010(v)
+	})
+}
+
+// UpdateColumn010 sets the "column010" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn010() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn010()
+	})
+}
+
+// SetColumn011 sets the "column011" field.
+func (u *WideUpsertOne) SetColumn011(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn011(v)
+	})
+}
+
+// AddColumn011 adds v to the "column011" field.
+func (u *WideUpsertOne) AddColumn011(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn011(v)
+	})
+}
+
+// UpdateColumn011 sets the "column011" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn011() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn011()
+	})
+}
+
+// SetColumn012 sets the "column012" field.
+func (u *WideUpsertOne) SetColumn012(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn012(v)
+	})
+}
+
+// UpdateColumn012 sets the "column012" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn012() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn012()
+	})
+}
+
+// SetColumn013 sets the "column013" field.
+func (u *WideUpsertOne) SetColumn013(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn013(v)
+	})
+}
+
+// UpdateColumn013 sets the "column013" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn013() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn013()
+	})
+}
+
+// SetColumn014 sets the "column014" field.
+func (u *WideUpsertOne) SetColumn014(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn014(v)
+	})
+}
+
+// AddColumn014 adds v to the "column014" field.
+func (u *WideUpsertOne) AddColumn014(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn014(v)
+	})
+}
+
+// UpdateColumn014 sets the "column014" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn014() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn014()
+	})
+}
+
+// SetColumn015 sets the "column015" field.
+func (u *WideUpsertOne) SetColumn015(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn015(v)
+	})
+}
+
+// AddColumn015 adds v to the "column015" field.
+func (u *WideUpsertOne) AddColumn015(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn015(v)
+	})
+}
+
+// UpdateColumn015 sets the "column015" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn015() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn015()
+	})
+}
+
+// SetColumn016 sets the "column016" field.
+func (u *WideUpsertOne) SetColumn016(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn016(v)
+	})
+}
+
+// UpdateColumn016 sets the "column016" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn016() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn016()
+	})
+}
+
+// SetColumn017 sets the "column017" field.
+func (u *WideUpsertOne) SetColumn017(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn017(v)
+	})
+}
+
+// UpdateColumn017 sets the "column017" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn017() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn017()
+	})
+}
+
+// SetColumn018 sets the "column018" field.
+func (u *WideUpsertOne) SetColumn018(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn018(v)
+	})
+}
+
+// AddColumn018 adds v to the "column018" field.
+func (u *WideUpsertOne) AddColumn018(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn018(v)
+	})
+}
+
+// UpdateColumn018 sets the "column018" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn018() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn018()
+	})
+}
+
+// SetColumn019 sets the "column019" field.
+func (u *WideUpsertOne) SetColumn019(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn019(v)
+	})
+}
+
+// AddColumn019 adds v to the "column019" field.
+func (u *WideUpsertOne) AddColumn019(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn019(v)
+	})
+}
+
+// UpdateColumn019 sets the "column019" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn019() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn019()
+	})
+}
+
+// SetColumn020 sets the "column020" field.
+func (u *WideUpsertOne) SetColumn020(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn020(v)
+	})
+}
+
+// UpdateColumn020 sets the "column020" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn020() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn020()
+	})
+}
+
+// SetColumn021 sets the "column021" field.
+func (u *WideUpsertOne) SetColumn021(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn021(v)
+	})
+}
+
+// UpdateColumn021 sets the "column021" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn021() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn021()
+	})
+}
+
+// SetColumn022 sets the "column022" field.
+func (u *WideUpsertOne) SetColumn022(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn022(v)
+	})
+}
+
+// AddColumn022 adds v to the "column022" field.
+func (u *WideUpsertOne) AddColumn022(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn022(v)
+	})
+}
+
+// UpdateColumn022 sets the "column022" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn022() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn022()
+	})
+}
+
+// SetColumn023 sets the "column023" field.
+func (u *WideUpsertOne) SetColumn023(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn023(v)
+	})
+}
+
+// AddColumn023 adds v to the "column023" field.
+func (u *WideUpsertOne) AddColumn023(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn023(v)
+	})
+}
+
+// UpdateColumn023 sets the "column023" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn023() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn023()
+	})
+}
+
+// SetColumn024 sets the "column024" field.
+func (u *WideUpsertOne) SetColumn024(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn024(v)
+	})
+}
+
+// UpdateColumn024 sets the "column024" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn024() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn024()
+	})
+}
+
+// SetColumn025 sets the "column025" field.
+func (u *WideUpsertOne) SetColumn025(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn025(v)
+	})
+}
+
+// UpdateColumn025 sets the "column025" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn025() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn025()
+	})
+}
+
+// SetColumn026 sets the "column026" field.
+func (u *WideUpsertOne) SetColumn026(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn026(v)
+	})
+}
+
+// AddColumn026 adds v to the "column026" field.
+func (u *WideUpsertOne) AddColumn026(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn026(v)
+	})
+}
+
+// UpdateColumn026 sets the "column026" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn026() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn026()
+	})
+}
+
+// SetColumn027 sets the "column027" field.
+func (u *WideUpsertOne) SetColumn027(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn027(v)
+	})
+}
+
+// AddColumn027 adds v to the "column027" field.
+func (u *WideUpsertOne) AddColumn027(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn027(v)
+	})
+}
+
+// UpdateColumn027 sets the "column027" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn027() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn027()
+	})
+}
+
+// SetColumn028 sets the "column028" field.
+func (u *WideUpsertOne) SetColumn028(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn028(v)
+	})
+}
+
+// UpdateColumn028 sets the "column028" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn028() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn028()
+	})
+}
+
+// SetColumn029 sets the "column029" field.
+func (u *WideUpsertOne) SetColumn029(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn029(v)
+	})
+}
+
+// UpdateColumn029 sets the "column029" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn029() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn029()
+	})
+}
+
+// SetColumn030 sets the "column030" field.
+func (u *WideUpsertOne) SetColumn030(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn030(v)
+	})
+}
+
+// AddColumn030 adds v to the "column030" field.
+func (u *WideUpsertOne) AddColumn030(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn030(v)
+	})
+}
+
+// UpdateColumn030 sets the "column030" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn030() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn030()
+	})
+}
+
+// SetColumn031 sets the "column031" field.
+func (u *WideUpsertOne) SetColumn031(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn031(v)
+	})
+}
+
+// AddColumn031 adds v to the "column031" field.
+func (u *WideUpsertOne) AddColumn031(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn031(v)
+	})
+}
+
+// UpdateColumn031 sets the "column031" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn031() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn031()
+	})
+}
+
+// SetColumn032 sets the "column032" field.
+func (u *WideUpsertOne) SetColumn032(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn032(v)
+	})
+}
+
+// UpdateColumn032 sets the "column032" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn032() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn032()
+	})
+}
+
+// SetColumn033 sets the "column033" field.
+func (u *WideUpsertOne) SetColumn033(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn033(v)
+	})
+}
+
+// UpdateColumn033 sets the "column033" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn033() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn033()
+	})
+}
+
+// SetColumn034 sets the "column034" field.
+func (u *WideUpsertOne) SetColumn034(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn034(v)
+	})
+}
+
+// AddColumn034 adds v to the "column034" field.
+func (u *WideUpsertOne) AddColumn034(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn034(v)
+	})
+}
+
+// UpdateColumn034 sets the "column034" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn034() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn034()
+	})
+}
+
+// SetColumn035 sets the "column035" field.
+func (u *WideUpsertOne) SetColumn035(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn035(v)
+	})
+}
+
+// AddColumn035 adds v to the "column035" field.
+func (u *WideUpsertOne) AddColumn035(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn035(v)
+	})
+}
+
+// UpdateColumn035 sets the "column035" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn035() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn035()
+	})
+}
+
+// SetColumn036 sets the "column036" field.
+func (u *WideUpsertOne) SetColumn036(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn036(v)
+	})
+}
+
+// UpdateColumn036 sets the "column036" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn036() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn036()
+	})
+}
+
+// SetColumn037 sets the "column037" field.
+func (u *WideUpsertOne) SetColumn037(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn037(v)
+	})
+}
+
+// UpdateColumn037 sets the "column037" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn037() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn037()
+	})
+}
+
+// SetColumn038 sets the "column038" field.
+func (u *WideUpsertOne) SetColumn038(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn038(v)
+	})
+}
+
+// AddColumn038 adds v to the "column038" field.
+func (u *WideUpsertOne) AddColumn038(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn038(v)
+	})
+}
+
+// UpdateColumn038 sets the "column038" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn038() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn038()
+	})
+}
+
+// SetColumn039 sets the "column039" field.
+func (u *WideUpsertOne) SetColumn039(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn039(v)
+	})
+}
+
+// AddColumn039 adds v to the "column039" field.
+func (u *WideUpsertOne) AddColumn039(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn039(v)
+	})
+}
+
+// UpdateColumn039 sets the "column039" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn039() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn039()
+	})
+}
+
+// SetColumn040 sets the "column040" field.
+func (u *WideUpsertOne) SetColumn040(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn040(v)
+	})
+}
+
+// UpdateColumn040 sets the "column040" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn040() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn040()
+	})
+}
+
+// SetColumn041 sets the "column041" field.
+func (u *WideUpsertOne) SetColumn041(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn041(v)
+	})
+}
+
+// UpdateColumn041 sets the "column041" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn041() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn041()
+	})
+}
+
+// SetColumn042 sets the "column042" field.
+func (u *WideUpsertOne) SetColumn042(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn042(v)
+	})
+}
+
+// AddColumn042 adds v to the "column042" field.
+func (u *WideUpsertOne) AddColumn042(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn042(v)
+	})
+}
+
+// UpdateColumn042 sets the "column042" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn042() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn042()
+	})
+}
+
+// SetColumn043 sets the "column043" field.
+func (u *WideUpsertOne) SetColumn043(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn043(v)
+	})
+}
+
+// AddColumn043 adds v to the "column043" field.
+func (u *WideUpsertOne) AddColumn043(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn043(v)
+	})
+}
+
+// UpdateColumn043 sets the "column043" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn043() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn043()
+	})
+}
+
+// SetColumn044 sets the "column044" field.
+func (u *WideUpsertOne) SetColumn044(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn044(v)
+	})
+}
+
+// UpdateColumn044 sets the "column044" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn044() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn044()
+	})
+}
+
+// SetColumn045 sets the "column045" field.
+func (u *WideUpsertOne) SetColumn045(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn045(v)
+	})
+}
+
+// UpdateColumn045 sets the "column045" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn045() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn045()
+	})
+}
+
+// SetColumn046 sets the "column046" field.
+func (u *WideUpsertOne) SetColumn046(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn046(v)
+	})
+}
+
+// AddColumn046 adds v to the "column046" field.
+func (u *WideUpsertOne) AddColumn046(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn046(v)
+	})
+}
+
+// UpdateColumn046 sets the "column046" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn046() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn046()
+	})
+}
+
+// SetColumn047 sets the "column047" field.
+func (u *WideUpsertOne) SetColumn047(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn047(v)
+	})
+}
+
+// AddColumn047 adds v to the "column047" field.
+func (u *WideUpsertOne) AddColumn047(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn047(v)
+	})
+}
+
+// UpdateColumn047 sets the "column047" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn047() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn047()
+	})
+}
+
+// SetColumn048 sets the "column048" field.
+func (u *WideUpsertOne) SetColumn048(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn048(v)
+	})
+}
+
+// UpdateColumn048 sets the "column048" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn048() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn048()
+	})
+}
+
+// SetColumn049 sets the "column049" field.
+func (u *WideUpsertOne) SetColumn049(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn049(v)
+	})
+}
+
+// UpdateColumn049 sets the "column049" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn049() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn049()
+	})
+}
+
+// SetColumn050 sets the "column050" field.
+func (u *WideUpsertOne) SetColumn050(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn050(v)
+	})
+}
+
+// AddColumn050 adds v to the "column050" field.
+func (u *WideUpsertOne) AddColumn050(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn050(v)
+	})
+}
+
+// UpdateColumn050 sets the "column050" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn050() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn050()
+	})
+}
+
+// SetColumn051 sets the "column051" field.
+func (u *WideUpsertOne) SetColumn051(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn051(v)
+	})
+}
+
+// AddColumn051 adds v to the "column051" field.
+func (u *WideUpsertOne) AddColumn051(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn051(v)
+	})
+}
+
+// UpdateColumn051 sets the "column051" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn051() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn051()
+	})
+}
+
+// SetColumn052 sets the "column052" field.
+func (u *WideUpsertOne) SetColumn052(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn052(v)
+	})
+}
+
+// UpdateColumn052 sets the "column052" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn052() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn052()
+	})
+}
+
+// SetColumn053 sets the "column053" field.
+func (u *WideUpsertOne) SetColumn053(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn053(v)
+	})
+}
+
+// UpdateColumn053 sets the "column053" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn053() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn053()
+	})
+}
+
+// SetColumn054 sets the "column054" field.
+func (u *WideUpsertOne) SetColumn054(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn054(v)
+	})
+}
+
+// AddColumn054 adds v to the "column054" field.
+func (u *WideUpsertOne) AddColumn054(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn054(v)
+	})
+}
+
+// UpdateColumn054 sets the "column054" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn054() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn054()
+	})
+}
+
+// SetColumn055 sets the "column055" field.
+func (u *WideUpsertOne) SetColumn055(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn055(v)
+	})
+}
+
+// AddColumn055 adds v to the "column055" field.
+func (u *WideUpsertOne) AddColumn055(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn055(v)
+	})
+}
+
+// UpdateColumn055 sets the "column055" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn055() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn055()
+	})
+}
+
+// SetColumn056 sets the "column056" field.
+func (u *WideUpsertOne) SetColumn056(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn056(v)
+	})
+}
+
+// UpdateColumn056 sets the "column056" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn056() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn056()
+	})
+}
+
+// SetColumn057 sets the "column057" field.
+func (u *WideUpsertOne) SetColumn057(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn057(v)
+	})
+}
+
+// UpdateColumn057 sets the "column057" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn057() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn057()
+	})
+}
+
+// SetColumn058 sets the "column058" field.
+func (u *WideUpsertOne) SetColumn058(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn058(v)
+	})
+}
+
+// AddColumn058 adds v to the "column058" field.
+func (u *WideUpsertOne) AddColumn058(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn058(v)
+	})
+}
+
+// UpdateColumn058 sets the "column058" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn058() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn058()
+	})
+}
+
+// SetColumn059 sets the "column059" field.
+func (u *WideUpsertOne) SetColumn059(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn059(v)
+	})
+}
+
+// AddColumn059 adds v to the "column059" field.
+func (u *WideUpsertOne) AddColumn059(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn059(v)
+	})
+}
+
+// UpdateColumn059 sets the "column059" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn059() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn059()
+	})
+}
+
+// SetColumn060 sets the "column060" field.
+func (u *WideUpsertOne) SetColumn060(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn060(v)
+	})
+}
+
+// UpdateColumn060 sets the "column060" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn060() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn060()
+	})
+}
+
+// SetColumn061 sets the "column061" field.
+func (u *WideUpsertOne) SetColumn061(v string) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn061(v)
+	})
+}
+
+// UpdateColumn061 sets the "column061" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn061() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn061()
+	})
+}
+
+// SetColumn062 sets the "column062" field.
+func (u *WideUpsertOne) SetColumn062(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn062(v)
+	})
+}
+
+// AddColumn062 adds v to the "column062" field.
+func (u *WideUpsertOne) AddColumn062(v int) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn062(v)
+	})
+}
+
+// UpdateColumn062 sets the "column062" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn062() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn062()
+	})
+}
+
+// SetColumn063 sets the "column063" field.
+func (u *WideUpsertOne) SetColumn063(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn063(v)
+	})
+}
+
+// AddColumn063 adds v to the "column063" field.
+func (u *WideUpsertOne) AddColumn063(v float64) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn063(v)
+	})
+}
+
+// UpdateColumn063 sets the "column063" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn063() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn063()
+	})
+}
+
+// SetColumn064 sets the "column064" field.
+func (u *WideUpsertOne) SetColumn064(v time.Time) *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn064(v)
+	})
+}
+
+// UpdateColumn064 sets the "column064" field to the value that was provided on create.
+func (u *WideUpsertOne) UpdateColumn064() *WideUpsertOne {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn064()
+	})
+}
+
+// Exec executes the query.
+func (u *WideUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WideCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WideUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WideUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WideUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WideCreateBulk is the builder for creating many Wide entities in bulk.
+type WideCreateBulk struct {
+	config
+	err      error
+	builders []*WideCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Wide entities in the database.
+func (wcb *WideCreateBulk) Save(ctx context.Context) ([]*Wide, error) {
+	if wcb.err != nil {
+		return nil, wcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Wide, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WideMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WideCreateBulk) SaveX(ctx context.Context) []*Wide {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcb *WideCreateBulk) Exec(ctx context.Context) error {
+	_, err := wcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcb *WideCreateBulk) ExecX(ctx context.Context) {
+	if err := wcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Wide.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WideUpsert) {
+//			SetColumn001(v+v).
+//		}).
+//		Exec(ctx)
+func (wcb *WideCreateBulk) OnConflict(opts ...sql.ConflictOption) *WideUpsertBulk {
+	wcb.conflict = opts
+	return &WideUpsertBulk{
+		create: wcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Wide.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wcb *WideCreateBulk) OnConflictColumns(columns ...string) *WideUpsertBulk {
+	wcb.conflict = append(wcb.conflict, sql.ConflictColumns(columns...))
+	return &WideUpsertBulk{
+		create: wcb,
+	}
+}
+
+// WideUpsertBulk is the builder for "upsert"-ing
+// a bulk of Wide nodes.
+type WideUpsertBulk struct {
+	create *WideCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Wide.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WideUpsertBulk) UpdateNewValues() *WideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Wide.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WideUpsertBulk) Ignore() *WideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WideUpsertBulk) DoNothing() *WideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WideCreateBulk.OnConflict
+// documentation for more info.
+func (u *WideUpsertBulk) Update(set func(*WideUpsert)) *WideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WideUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetColumn001 sets the "column001" field.
+func (u *WideUpsertBulk) SetColumn001(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn001(v)
+	})
+}
+
+// UpdateColumn001 sets the "column001" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn001() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn001()
+	})
+}
+
+// SetColumn002 sets the "column002" field.
+func (u *WideUpsertBulk) SetColumn002(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn002(v)
+	})
+}
+
+// AddColumn002 adds v to the "column002" field.
+func (u *WideUpsertBulk) AddColumn002(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn002(v)
+	})
+}
+
+// UpdateColumn002 sets the "column002" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn002() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn002()
+	})
+}
+
+// SetColumn003 sets the "column003" field.
+func (u *WideUpsertBulk) SetColumn003(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn003(v)
+	})
+}
+
+// AddColumn003 adds v to the "column003" field.
+func (u *WideUpsertBulk) AddColumn003(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn003(v)
+	})
+}
+
+// UpdateColumn003 sets the "column003" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn003() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn003()
+	})
+}
+
+// SetColumn004 sets the "column004" field.
+func (u *WideUpsertBulk) SetColumn004(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn004(v)
+	})
+}
+
+// UpdateColumn004 sets the "column004" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn004() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn004()
+	})
+}
+
+// SetColumn005 sets the "column005" field.
+func (u *WideUpsertBulk) SetColumn005(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn005(v)
+	})
+}
+
+// UpdateColumn005 sets the "column005" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn005() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn005()
+	})
+}
+
+// SetColumn006 sets the "column006" field.
+func (u *WideUpsertBulk) SetColumn006(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn006(v)
+	})
+}
+
+// AddColumn006 adds v to the "column006" field.
+func (u *WideUpsertBulk) AddColumn006(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn006(v)
+	})
+}
+
+// UpdateColumn006 sets the "column006" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn006() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn006()
+	})
+}
+
+// SetColumn007 sets the "column007" field.
+func (u *WideUpsertBulk) SetColumn007(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn007(v)
+	})
+}
+
+// AddColumn007 adds v to the "column007" field.
+func (u *WideUpsertBulk) AddColumn007(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn007(v)
+	})
+}
+
+// UpdateColumn007 sets the "column007" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn007() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn007()
+	})
+}
+
+// SetColumn008 sets the "column008" field.
+func (u *WideUpsertBulk) SetColumn008(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn008(v)
+	})
+}
+
+// UpdateColumn008 sets the "column008" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn008() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn008()
+	})
+}
+
+// SetColumn009 sets the "column009" field.
+func (u *WideUpsertBulk) SetColumn009(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn009(v)
+	})
+}
+
+// UpdateColumn009 sets the "column009" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn009() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn009()
+	})
+}
+
+// SetColumn010 sets the "column010" field.
+func (u *WideUpsertBulk) SetColumn010(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn010(v)
+	})
+}
+
+// AddColumn010 adds v to the "column010" field.
+func (u *WideUpsertBulk) AddColumn010(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn010(v)
+	})
+}
+
+// UpdateColumn010 sets the "column010" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn010() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn010()
+	})
+}
+
+// SetColumn011 sets the "column011" field.
+func (u *WideUpsertBulk) SetColumn011(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn011(v)
+	})
+}
+
+// AddColumn011 adds v to the "column011" field.
+func (u *WideUpsertBulk) AddColumn011(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn011(v)
+	})
+}
+
+// UpdateColumn011 sets the "column011" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn011() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn011()
+	})
+}
+
+// SetColumn012 sets the "column012" field.
+func (u *WideUpsertBulk) SetColumn012(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn012(v)
+	})
+}
+
+// UpdateColumn012 sets the "column012" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn012() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn012()
+	})
+}
+
+// SetColumn013 sets the "column013" field.
+func (u *WideUpsertBulk) SetColumn013(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn013(v)
+	})
+}
+
+// UpdateColumn013 sets the "column013" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn013() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn013()
+	})
+}
+
+// SetColumn014 sets the "column014" field.
+func (u *WideUpsertBulk) SetColumn014(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn014(v)
+	})
+}
+
+// AddColumn014 adds v to the "column014" field.
+func (u *WideUpsertBulk) AddColumn014(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn014(v)
+	})
+}
+
+// UpdateColumn014 sets the "column014" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn014() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn014()
+	})
+}
+
+// SetColumn015 sets the "column015" field.
+func (u *WideUpsertBulk) SetColumn015(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn015(v)
+	})
+}
+
+// AddColumn015 adds v to the "column015" field.
+func (u *WideUpsertBulk) AddColumn015(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn015(v)
+	})
+}
+
+// UpdateColumn015 sets the "column015" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn015() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn015()
+	})
+}
+
+// SetColumn016 sets the "column016" field.
+func (u *WideUpsertBulk) SetColumn016(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn016(v)
+	})
+}
+
+// UpdateColumn016 sets the "column016" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn016() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn016()
+	})
+}
+
+// SetColumn017 sets the "column017" field.
+func (u *WideUpsertBulk) SetColumn017(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn017(v)
+	})
+}
+
+// UpdateColumn017 sets the "column017" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn017() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn017()
+	})
+}
+
+// SetColumn018 sets the "column018" field.
+func (u *WideUpsertBulk) SetColumn018(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn018(v)
+	})
+}
+
+// AddColumn018 adds v to the "column018" field.
+func (u *WideUpsertBulk) AddColumn018(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn018(v)
+	})
+}
+
+// UpdateColumn018 sets the "column018" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn018() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn018()
+	})
+}
+
+// SetColumn019 sets the "column019" field.
+func (u *WideUpsertBulk) SetColumn019(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn019(v)
+	})
+}
+
+// AddColumn019 adds v to the "column019" field.
+func (u *WideUpsertBulk) AddColumn019(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn019(v)
+	})
+}
+
+// UpdateColumn019 sets the "column019" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn019() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn019()
+	})
+}
+
+// SetColumn020 sets the "column020" field.
+func (u *WideUpsertBulk) SetColumn020(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn020(v)
+	})
+}
+
+// UpdateColumn020 sets the "column020" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn020() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn020()
+	})
+}
+
+// SetColumn021 sets the "column021" field.
+func (u *WideUpsertBulk) SetColumn021(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn021(v)
+	})
+}
+
+// UpdateColumn021 sets the "column021" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn021() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn021()
+	})
+}
+
+// SetColumn022 sets the "column022" field.
+func (u *WideUpsertBulk) SetColumn022(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn022(v)
+	})
+}
+
+// AddColumn022 adds v to the "column022" field.
+func (u *WideUpsertBulk) AddColumn022(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn022(v)
+	})
+}
+
+// UpdateColumn022 sets the "column022" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn022() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn022()
+	})
+}
+
+// SetColumn023 sets the "column023" field.
+func (u *WideUpsertBulk) SetColumn023(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn023(v)
+	})
+}
+
+// AddColumn023 adds v to the "column023" field.
+func (u *WideUpsertBulk) AddColumn023(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn023(v)
+	})
+}
+
+// UpdateColumn023 sets the "column023" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn023() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn023()
+	})
+}
+
+// SetColumn024 sets the "column024" field.
+func (u *WideUpsertBulk) SetColumn024(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn024(v)
+	})
+}
+
+// UpdateColumn024 sets the "column024" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn024() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn024()
+	})
+}
+
+// SetColumn025 sets the "column025" field.
+func (u *WideUpsertBulk) SetColumn025(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn025(v)
+	})
+}
+
+// UpdateColumn025 sets the "column025" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn025() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn025()
+	})
+}
+
+// SetColumn026 sets the "column026" field.
+func (u *WideUpsertBulk) SetColumn026(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn026(v)
+	})
+}
+
+// AddColumn026 adds v to the "column026" field.
+func (u *WideUpsertBulk) AddColumn026(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn026(v)
+	})
+}
+
+// UpdateColumn026 sets the "column026" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn026() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn026()
+	})
+}
+
+// SetColumn027 sets the "column027" field.
+func (u *WideUpsertBulk) SetColumn027(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn027(v)
+	})
+}
+
+// AddColumn027 adds v to the "column027" field.
+func (u *WideUpsertBulk) AddColumn027(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn027(v)
+	})
+}
+
+// UpdateColumn027 sets the "column027" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn027() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn027()
+	})
+}
+
+// SetColumn028 sets the "column028" field.
+func (u *WideUpsertBulk) SetColumn028(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn028(v)
+	})
+}
+
+// UpdateColumn028 sets the "column028" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn028() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn028()
+	})
+}
+
+// SetColumn029 sets the "column029" field.
+func (u *WideUpsertBulk) SetColumn029(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn029(v)
+	})
+}
+
+// UpdateColumn029 sets the "column029" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn029() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn029()
+	})
+}
+
+// SetColumn030 sets the "column030" field.
+func (u *WideUpsertBulk) SetColumn030(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn030(v)
+	})
+}
+
+// AddColumn030 adds v to the "column030" field.
+func (u *WideUpsertBulk) AddColumn030(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn030(v)
+	})
+}
+
+// UpdateColumn030 sets the "column030" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn030() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn030()
+	})
+}
+
+// SetColumn031 sets the "column031" field.
+func (u *WideUpsertBulk) SetColumn031(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn031(v)
+	})
+}
+
+// AddColumn031 adds v to the "column031" field.
+func (u *WideUpsertBulk) AddColumn031(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn031(v)
+	})
+}
+
+// UpdateColumn031 sets the "column031" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn031() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn031()
+	})
+}
+
+// SetColumn032 sets the "column032" field.
+func (u *WideUpsertBulk) SetColumn032(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn032(v)
+	})
+}
+
+// UpdateColumn032 sets the "column032" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn032() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn032()
+	})
+}
+
+// SetColumn033 sets the "column033" field.
+func (u *WideUpsertBulk) SetColumn033(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn033(v)
+	})
+}
+
+// UpdateColumn033 sets the "column033" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn033() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn033()
+	})
+}
+
+// SetColumn034 sets the "column034" field.
+func (u *WideUpsertBulk) SetColumn034(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn034(v)
+	})
+}
+
+// AddColumn034 adds v to the "column034" field.
+func (u *WideUpsertBulk) AddColumn034(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn034(v)
+	})
+}
+
+// UpdateColumn034 sets the "column034" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn034() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn034()
+	})
+}
+
+// SetColumn035 sets the "column035" field.
+func (u *WideUpsertBulk) SetColumn035(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn035(v)
+	})
+}
+
+// AddColumn035 adds v to the "column035" field.
+func (u *WideUpsertBulk) AddColumn035(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn035(v)
+	})
+}
+
+// UpdateColumn035 sets the "column035" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn035() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn035()
+	})
+}
+
+// SetColumn036 sets the "column036" field.
+func (u *WideUpsertBulk) SetColumn036(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn036(v)
+	})
+}
+
+// UpdateColumn036 sets the "column036" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn036() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn036()
+	})
+}
+
+// SetColumn037 sets the "column037" field.
+func (u *WideUpsertBulk) SetColumn037(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn037(v)
+	})
+}
+
+// UpdateColumn037 sets the "column037" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn037() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn037()
+	})
+}
+
+// SetColumn038 sets the "column038" field.
+func (u *WideUpsertBulk) SetColumn038(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn038(v)
+	})
+}
+
+// AddColumn038 adds v to the "column038" field.
+func (u *WideUpsertBulk) AddColumn038(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn038(v)
+	})
+}
+
+// UpdateColumn038 sets the "column038" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn038() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn038()
+	})
+}
+
+// SetColumn039 sets the "column039" field.
+func (u *WideUpsertBulk) SetColumn039(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn039(v)
+	})
+}
+
+// AddColumn039 adds v to the "column039" field.
+func (u *WideUpsertBulk) AddColumn039(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn039(v)
+	})
+}
+
+// UpdateColumn039 sets the "column039" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn039() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn039()
+	})
+}
+
+// SetColumn040 sets the "column040" field.
+func (u *WideUpsertBulk) SetColumn040(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn040(v)
+	})
+}
+
+// UpdateColumn040 sets the "column040" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn040() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn040()
+	})
+}
+
+// SetColumn041 sets the "column041" field.
+func (u *WideUpsertBulk) SetColumn041(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn041(v)
+	})
+}
+
+// UpdateColumn041 sets the "column041" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn041() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn041()
+	})
+}
+
+// SetColumn042 sets the "column042" field.
+func (u *WideUpsertBulk) SetColumn042(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn042(v)
+	})
+}
+
+// AddColumn042 adds v to the "column042" field.
+func (u *WideUpsertBulk) AddColumn042(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn042(v)
+	})
+}
+
+// UpdateColumn042 sets the "column042" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn042() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn042()
+	})
+}
+
+// SetColumn043 sets the "column043" field.
+func (u *WideUpsertBulk) SetColumn043(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn043(v)
+	})
+}
+
+// AddColumn043 adds v to the "column043" field.
+func (u *WideUpsertBulk) AddColumn043(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn043(v)
+	})
+}
+
+// UpdateColumn043 sets the "column043" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn043() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn043()
+	})
+}
+
+// SetColumn044 sets the "column044" field.
+func (u *WideUpsertBulk) SetColumn044(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn044(v)
+	})
+}
+
+// UpdateColumn044 sets the "column044" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn044() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn044()
+	})
+}
+
+// SetColumn045 sets the "column045" field.
+func (u *WideUpsertBulk) SetColumn045(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn045(v)
+	})
+}
+
+// UpdateColumn045 sets the "column045" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn045() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn045()
+	})
+}
+
+// SetColumn046 sets the "column046" field.
+func (u *WideUpsertBulk) SetColumn046(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn046(v)
+	})
+}
+
+// AddColumn046 adds v to the "column046" field.
+func (u *WideUpsertBulk) AddColumn046(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn046(v)
+	})
+}
+
+// UpdateColumn046 sets the "column046" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn046() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn046()
+	})
+}
+
+// SetColumn047 sets the "column047" field.
+func (u *WideUpsertBulk) SetColumn047(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn047(v)
+	})
+}
+
+// AddColumn047 adds v to the "column047" field.
+func (u *WideUpsertBulk) AddColumn047(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn047(v)
+	})
+}
+
+// UpdateColumn047 sets the "column047" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn047() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn047()
+	})
+}
+
+// SetColumn048 sets the "column048" field.
+func (u *WideUpsertBulk) SetColumn048(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn048(v)
+	})
+}
+
+// UpdateColumn048 sets the "column048" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn048() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn048()
+	})
+}
+
+// SetColumn049 sets the "column049" field.
+func (u *WideUpsertBulk) SetColumn049(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn049(v)
+	})
+}
+
+// UpdateColumn049 sets the "column049" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn049() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn049()
+	})
+}
+
+// SetColumn050 sets the "column050" field.
+func (u *WideUpsertBulk) SetColumn050(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn050(v)
+	})
+}
+
+// AddColumn050 adds v to the "column050" field.
+func (u *WideUpsertBulk) AddColumn050(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn050(v)
+	})
+}
+
+// UpdateColumn050 sets the "column050" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn050() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn050()
+	})
+}
+
+// SetColumn051 sets the "column051" field.
+func (u *WideUpsertBulk) SetColumn051(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn051(v)
+	})
+}
+
+// AddColumn051 adds v to the "column051" field.
+func (u *WideUpsertBulk) AddColumn051(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn051(v)
+	})
+}
+
+// UpdateColumn051 sets the "column051" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn051() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn051()
+	})
+}
+
+// SetColumn052 sets the "column052" field.
+func (u *WideUpsertBulk) SetColumn052(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn052(v)
+	})
+}
+
+// UpdateColumn052 sets the "column052" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn052() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn052()
+	})
+}
+
+// SetColumn053 sets the "column053" field.
+func (u *WideUpsertBulk) SetColumn053(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn053(v)
+	})
+}
+
+// UpdateColumn053 sets the "column053" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn053() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn053()
+	})
+}
+
+// SetColumn054 sets the "column054" field.
+func (u *WideUpsertBulk) SetColumn054(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn054(v)
+	})
+}
+
+// AddColumn054 adds v to the "column054" field.
+func (u *WideUpsertBulk) AddColumn054(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn054(v)
+	})
+}
+
+// UpdateColumn054 sets the "column054" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn054() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn054()
+	})
+}
+
+// SetColumn055 sets the "column055" field.
+func (u *WideUpsertBulk) SetColumn055(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn055(v)
+	})
+}
+
+// AddColumn055 adds v to the "column055" field.
+func (u *WideUpsertBulk) AddColumn055(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn055(v)
+	})
+}
+
+// UpdateColumn055 sets the "column055" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn055() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn055()
+	})
+}
+
+// SetColumn056 sets the "column056" field.
+func (u *WideUpsertBulk) SetColumn056(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn056(v)
+	})
+}
+
+// UpdateColumn056 sets the "column056" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn056() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn056()
+	})
+}
+
+// SetColumn057 sets the "column057" field.
+func (u *WideUpsertBulk) SetColumn057(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn057(v)
+	})
+}
+
+// UpdateColumn057 sets the "column057" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn057() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn057()
+	})
+}
+
+// SetColumn058 sets the "column058" field.
+func (u *WideUpsertBulk) SetColumn058(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn058(v)
+	})
+}
+
+// AddColumn058 adds v to the "column058" field.
+func (u *WideUpsertBulk) AddColumn058(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn058(v)
+	})
+}
+
+// UpdateColumn058 sets the "column058" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn058() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn058()
+	})
+}
+
+// SetColumn059 sets the "column059" field.
+func (u *WideUpsertBulk) SetColumn059(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn059(v)
+	})
+}
+
+// AddColumn059 adds v to the "column059" field.
+func (u *WideUpsertBulk) AddColumn059(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn059(v)
+	})
+}
+
+// UpdateColumn059 sets the "column059" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn059() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn059()
+	})
+}
+
+// SetColumn060 sets the "column060" field.
+func (u *WideUpsertBulk) SetColumn060(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn060(v)
+	})
+}
+
+// UpdateColumn060 sets the "column060" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn060() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn060()
+	})
+}
+
+// SetColumn061 sets the "column061" field.
+func (u *WideUpsertBulk) SetColumn061(v string) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn061(v)
+	})
+}
+
+// UpdateColumn061 sets the "column061" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn061() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn061()
+	})
+}
+
+// SetColumn062 sets the "column062" field.
+func (u *WideUpsertBulk) SetColumn062(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn062(v)
+	})
+}
+
+// AddColumn062 adds v to the "column062" field.
+func (u *WideUpsertBulk) AddColumn062(v int) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn062(v)
+	})
+}
+
+// UpdateColumn062 sets the "column062" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn062() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn062()
+	})
+}
+
+// SetColumn063 sets the "column063" field.
+func (u *WideUpsertBulk) SetColumn063(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn063(v)
+	})
+}
+
+// AddColumn063 adds v to the "column063" field.
+func (u *WideUpsertBulk) AddColumn063(v float64) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.AddColumn063(v)
+	})
+}
+
+// UpdateColumn063 sets the "column063" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn063() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn063()
+	})
+}
+
+// SetColumn064 sets the "column064" field.
+func (u *WideUpsertBulk) SetColumn064(v time.Time) *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.SetColumn064(v)
+	})
+}
+
+// UpdateColumn064 sets the "column064" field to the value that was provided on create.
+func (u *WideUpsertBulk) UpdateColumn064() *WideUpsertBulk {
+	return u.Update(func(s *WideUpsert) {
+		s.UpdateColumn064()
+	})
+}
+
+// Exec executes the query.
+func (u *WideUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WideCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WideCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WideUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,2992 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/ent/wide"
+)
+
+// WideUpdate is the builder for updating Wide entities.
+type WideUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WideMutation
+}
+
+// Where appends a list predicates to the WideUpdate builder.
+func (wu *WideUpdate) Where(ps ...predicate.Wide) *WideUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetColumn001 sets the "column001" field.
+func (wu *WideUpdate) SetColumn001(s string) *WideUpdate {
+	wu.mutation.SetColumn001(s)
+	return wu
+}
+
+// SetNillableColumn001 sets the "column001" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn001(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn001(*s)
+	}
+	return wu
+}
+
+// SetColumn002 sets the "column002" field.
+func (wu *WideUpdate) SetColumn002(i int) *WideUpdate {
+	wu.mutation.ResetColumn002()
+	wu.mutation.SetColumn002(i)
+	return wu
+}
+
+// SetNillableColumn002 sets the "column002" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn002(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn002(*i)
+	}
+	return wu
+}
+
+// AddColumn002 adds i to the "column002" field.
+func (wu *WideUpdate) AddColumn002(i int) *WideUpdate {
+	wu.mutation.AddColumn002(i)
+	return wu
+}
+
+// SetColumn003 sets the "column003" field.
+func (wu *WideUpdate) SetColumn003(f float64) *WideUpdate {
+	wu.mutation.ResetColumn003()
+	wu.mutation.SetColumn003(f)
+	return wu
+}
+
+// SetNillableColumn003 sets the "column003" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn003(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn003(*f)
+	}
+	return wu
+}
+
+// AddColumn003 adds f to the "column003" field.
+func (wu *WideUpdate) AddColumn003(f float64) *WideUpdate {
+	wu.mutation.AddColumn003(f)
+	return wu
+}
+
+// SetColumn004 sets the "column004" field.
+func (wu *WideUpdate) SetColumn004(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn004(t)
+	return wu
+}
+
+// SetNillableColumn004 sets the "column004" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn004(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn004(*t)
+	}
+	return wu
+}
+
+// SetColumn005 sets the "column005" field.
+func (wu *WideUpdate) SetColumn005(s string) *WideUpdate {
+	wu.mutation.SetColumn005(s)
+	return wu
+}
+
+// SetNillableColumn005 sets the "column005" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn005(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn005(*s)
+	}
+	return wu
+}
+
+// SetColumn006 sets the "column006" field.
+func (wu *WideUpdate) SetColumn006(i int) *WideUpdate {
+	wu.mutation.ResetColumn006()
+	wu.mutation.SetColumn006(i)
+	return wu
+}
+
+// SetNillableColumn006 sets the "column006" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn006(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn006(*i)
+	}
+	return wu
+}
+
+// AddColumn006 adds i to the "column006" field.
+func (wu *WideUpdate) AddColumn006(i int) *WideUpdate {
+	wu.mutation.AddColumn006(i)
+	return wu
+}
+
+// SetColumn007 sets the "column007" field.
+func (wu *WideUpdate) SetColumn007(f float64) *WideUpdate {
+	wu.mutation.ResetColumn007()
+	wu.mutation.SetColumn007(f)
+	return wu
+}
+
+// SetNillableColumn007 sets the "column007" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn007(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn007(*f)
+	}
+	return wu
+}
+
+// AddColumn007 adds f to the "column007" field.
+func (wu *WideUpdate) AddColumn007(f float64) *WideUpdate {
+	wu.mutation.AddColumn007(f)
+	return wu
+}
+
+// SetColumn008 sets the "column008" field.
+func (wu *WideUpdate) SetColumn008(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn008(t)
+	return wu
+}
+
+// SetNillableColumn008 sets the "column008" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn008(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn008(*t)
+	}
+	return wu
+}
+
+// SetColumn009 sets the "column009" field.
+func (wu *WideUpdate) SetColumn009(s string) *WideUpdate {
+	wu.mutation.SetColumn009(s)
+	return wu
+}
+
+// SetNillableColumn009 sets the "column009" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn009(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn009(*s)
+	}
+	return wu
+}
+
+// SetColumn010 sets the "column010" field.
+func (wu *WideUpdate) SetColumn010(i int) *WideUpdate {
+	wu.mutation.ResetColumn010()
+	wu.mutation.SetColumn010(i)
+	return wu
+}
+
+// SetNillableColumn010 sets the "column010" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn010(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn010(*i)
+	}
+	return wu
+}
+
+// AddColumn010 adds i to the "column010" field.
+func (wu *WideUpdate) AddColumn010(i int) *WideUpdate {
+	wu.mutation.AddColumn010(i)
+	return wu
+}
+
+// SetColumn011 sets the "column011" field.
+func (wu *WideUpdate) SetColumn011(f float64) *WideUpdate {
+	wu.mutation.ResetColumn011()
+	wu.mutation.SetColumn011(f)
+	return wu
+}
+
+// SetNillableColumn011 sets the "column011" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn011(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn011(*f)
+	}
+	return wu
+}
+
+// AddColumn011 adds f to the "column011" field.
+func (wu *WideUpdate) AddColumn011(f float64) *WideUpdate {
+	wu.mutation.AddColumn011(f)
+	return wu
+}
+
+// SetColumn012 sets the "column012" field.
+func (wu *WideUpdate) SetColumn012(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn012(t)
+	return wu
+}
+
+// SetNillableColumn012 sets the "column012" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn012(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn012(*t)
+	}
+	return wu
+}
+
+// SetColumn013 sets the "column013" field.
+func (wu *WideUpdate) SetColumn013(s string) *WideUpdate {
+	wu.mutation.SetColumn013(s)
+	return wu
+}
+
+// SetNillableColumn013 sets the "column013" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn013(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn013(*s)
+	}
+	return wu
+}
+
+// SetColumn014 sets the "column014" field.
+func (wu *WideUpdate) SetColumn014(i int) *WideUpdate {
+	wu.mutation.ResetColumn014()
+	wu.mutation.SetColumn014(i)
+	return wu
+}
+
+// SetNillableColumn014 sets the "column014" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn014(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn014(*i)
+	}
+	return wu
+}
+
+// AddColumn014 adds i to the "column014" field.
+func (wu *WideUpdate) AddColumn014(i int) *WideUpdate {
+	wu.mutation.AddColumn014(i)
+	return wu
+}
+
+// SetColumn015 sets the "column015" field.
+func (wu *WideUpdate) SetColumn015(f float64) *WideUpdate {
+	wu.mutation.ResetColumn015()
+	wu.mutation.SetColumn015(f)
+	return wu
+}
+
+// SetNillableColumn015 sets the "column015" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn015(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn015(*f)
+	}
+	return wu
+}
+
+// AddColumn015 adds f to the "column015" field.
+func (wu *WideUpdate) AddColumn015(f float64) *WideUpdate {
+	wu.mutation.AddColumn015(f)
+	return wu
+}
+
+// SetColumn016 sets the "column016" field.
+func (wu *WideUpdate) SetColumn016(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn016(t)
+	return wu
+}
+
+// SetNillableColumn016 sets the "column016" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn016(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn016(*t)
+	}
+	return wu
+}
+
+// SetColumn017 sets the "column017" field.
+func (wu *WideUpdate) SetColumn017(s string) *WideUpdate {
+	wu.mutation.SetColumn017(s)
+	return wu
+}
+
+// SetNillableColumn017 sets the "column017" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn017(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn017(*s)
+	}
+	return wu
+}
+
+// SetColumn018 sets the "column018" field.
+func (wu *WideUpdate) SetColumn018(i int) *WideUpdate {
+	wu.mutation.ResetColumn018()
+	wu.mutation.SetColumn018(i)
+	return wu
+}
+
+// SetNillableColumn018 sets the "column018" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn018(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn018(*i)
+	}
+	return wu
+}
+
+// AddColumn018 adds i to the "column018" field.
+func (wu *WideUpdate) AddColumn018(i int) *WideUpdate {
+	wu.mutation.AddColumn018(i)
+	return wu
+}
+
+// SetColumn019 sets the "column019" field.
+func (wu *WideUpdate) SetColumn019(f float64) *WideUpdate {
+	wu.mutation.ResetColumn019()
+	wu.mutation.SetColumn019(f)
+	return wu
+}
+
+// SetNillableColumn019 sets the "column019" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn019(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn019(*f)
+	}
+	return wu
+}
+
+// AddColumn019 adds f to the "column019" field.
+func (wu *WideUpdate) AddColumn019(f float64) *WideUpdate {
+	wu.mutation.AddColumn019(f)
+	return wu
+}
+
+// SetColumn020 sets the "column020" field.
+func (wu *WideUpdate) SetColumn020(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn020(t)
+	return wu
+}
+
+// SetNillableColumn020 sets the "column020" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn020(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn020(*t)
+	}
+	return wu
+}
+
+// SetColumn021 sets the "column021" field.
+func (wu *WideUpdate) SetColumn021(s string) *WideUpdate {
+	wu.mutation.SetColumn021(s)
+	return wu
+}
+
+// SetNillableColumn021 sets the "column021" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn021(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn021(*s)
+	}
+	return wu
+}
+
+// SetColumn022 sets the "column022" field.
+func (wu *WideUpdate) SetColumn022(i int) *WideUpdate {
+	wu.mutation.ResetColumn022()
+	wu.mutation.SetColumn022(i)
+	return wu
+}
+
+// SetNillableColumn022 sets the "column022" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn022(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn022(*i)
+	}
+	return wu
+}
+
+// AddColumn022 adds i to the "column022" field.
+func (wu *WideUpdate) AddColumn022(i int) *WideUpdate {
+	wu.mutation.AddColumn022(i)
+	return wu
+}
+
+// SetColumn023 sets the "column023" field.
+func (wu *WideUpdate) SetColumn023(f float64) *WideUpdate {
+	wu.mutation.ResetColumn023()
+	wu.mutation.SetColumn023(f)
+	return wu
+}
+
+// SetNillableColumn023 sets the "column023" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn023(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn023(*f)
+	}
+	return wu
+}
+
+// AddColumn023 adds f to the "column023" field.
+func (wu *WideUpdate) AddColumn023(f float64) *WideUpdate {
+	wu.mutation.AddColumn023(f)
+	return wu
+}
+
+// SetColumn024 sets the "column024" field.
+func (wu *WideUpdate) SetColumn024(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn024(t)
+	return wu
+}
+
+// SetNillableColumn024 sets the "column024" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn024(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn024(*t)
+	}
+	return wu
+}
+
+// SetColumn025 sets the "column025" field.
+func (wu *WideUpdate) SetColumn025(s string) *WideUpdate {
+	wu.mutation.SetColumn025(s)
+	return wu
+}
+
+// SetNillableColumn025 sets the "column025" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn025(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn025(*s)
+	}
+	return wu
+}
+
+// SetColumn026 sets the "column026" field.
+func (wu *WideUpdate) SetColumn026(i int) *WideUpdate {
+	wu.mutation.ResetColumn026()
+	wu.mutation.SetColumn026(i)
+	return wu
+}
+
+// SetNillableColumn026 sets the "column026" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn026(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn026(*i)
+	}
+	return wu
+}
+
+// AddColumn026 adds i to the "column026" field.
+func (wu *WideUpdate) AddColumn026(i int) *WideUpdate {
+	wu.mutation.AddColumn026(i)
+	return wu
+}
+
+// SetColumn027 sets the "column027" field.
+func (wu *WideUpdate) SetColumn027(f float64) *WideUpdate {
+	wu.mutation.ResetColumn027()
+	wu.mutation.SetColumn027(f)
+	return wu
+}
+
+// SetNillableColumn027 sets the "column027" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn027(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn027(*f)
+	}
+	return wu
+}
+
+// AddColumn027 adds f to the "column027" field.
+func (wu *WideUpdate) AddColumn027(f float64) *WideUpdate {
+	wu.mutation.AddColumn027(f)
+	return wu
+}
+
+// SetColumn028 sets the "column028" field.
+func (wu *WideUpdate) SetColumn028(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn028(t)
+	return wu
+}
+
+// SetNillableColumn028 sets the "column028" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn028(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn028(*t)
+	}
+	return wu
+}
+
+// SetColumn029 sets the "column029" field.
+func (wu *WideUpdate) SetColumn029(s string) *WideUpdate {
+	wu.mutation.SetColumn029(s)
+	return wu
+}
+
+// SetNillableColumn029 sets the "column029" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn029(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn029(*s)
+	}
+	return wu
+}
+
+// SetColumn030 sets the "column030" field.
+func (wu *WideUpdate) SetColumn030(i int) *WideUpdate {
+	wu.mutation.ResetColumn030()
+	wu.mutation.SetColumn030(i)
+	return wu
+}
+
+// SetNillableColumn030 sets the "column030" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn030(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn030(*i)
+	}
+	return wu
+}
+
+// AddColumn030 adds i to the "column030" field.
+func (wu *WideUpdate) AddColumn030(i int) *WideUpdate {
+	wu.mutation.AddColumn030(i)
+	return wu
+}
+
+// SetColumn031 sets the "column031" field.
+func (wu *WideUpdate) SetColumn031(f float64) *WideUpdate {
+	wu.mutation.ResetColumn031()
+	wu.mutation.SetColumn031(f)
+	return wu
+}
+
+// SetNillableColumn031 sets the "column031" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn031(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn031(*f)
+	}
+	return wu
+}
+
+// AddColumn031 adds f to the "column031" field.
+func (wu *WideUpdate) AddColumn031(f float64) *WideUpdate {
+	wu.mutation.AddColumn031(f)
+	return wu
+}
+
+// SetColumn032 sets the "column032" field.
+func (wu *WideUpdate) SetColumn032(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn032(t)
+	return wu
+}
+
+// SetNillableColumn032 sets the "column032" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn032(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn032(*t)
+	}
+	return wu
+}
+
+// SetColumn033 sets the "column033" field.
+func (wu *WideUpdate) SetColumn033(s string) *WideUpdate {
+	wu.mutation.SetColumn033(s)
+	return wu
+}
+
+// SetNillableColumn033 sets the "column033" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn033(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn033(*s)
+	}
+	return wu
+}
+
+// SetColumn034 sets the "column034" field.
+func (wu *WideUpdate) SetColumn034(i int) *WideUpdate {
+	wu.mutation.ResetColumn034()
+	wu.mutation.SetColumn034(i)
+	return wu
+}
+
+// SetNillableColumn034 sets the "column034" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn034(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn034(*i)
+	}
+	return wu
+}
+
+// AddColumn034 adds i to the "column034" field.
+func (wu *WideUpdate) AddColumn034(i int) *WideUpdate {
+	wu.mutation.AddColumn034(i)
+	return wu
+}
+
+// SetColumn035 sets the "column035" field.
+func (wu *WideUpdate) SetColumn035(f float64) *WideUpdate {
+	wu.mutation.ResetColumn035()
+	wu.mutation.SetColumn035(f)
+	return wu
+}
+
+// SetNillableColumn035 sets the "column035" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn035(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn035(*f)
+	}
+	return wu
+}
+
+// AddColumn035 adds f to the "column035" field.
+func (wu *WideUpdate) AddColumn035(f float64) *WideUpdate {
+	wu.mutation.AddColumn035(f)
+	return wu
+}
+
+// SetColumn036 sets the "column036" field.
+func (wu *WideUpdate) SetColumn036(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn036(t)
+	return wu
+}
+
+// SetNillableColumn036 sets the "column036" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn036(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn036(*t)
+	}
+	return wu
+}
+
+// SetColumn037 sets the "column037" field.
+func (wu *WideUpdate) SetColumn037(s string) *WideUpdate {
+	wu.mutation.SetColumn037(s)
+	return wu
+}
+
+// SetNillableColumn037 sets the "column037" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn037(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn037(*s)
+	}
+	return wu
+}
+
+// SetColumn038 sets the "column038" field.
+func (wu *WideUpdate) SetColumn038(i int) *WideUpdate {
+	wu.mutation.ResetColumn038()
+	wu.mutation.SetColumn038(i)
+	return wu
+}
+
+// SetNillableColumn038 sets the "column038" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn038(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn038(*i)
+	}
+	return wu
+}
+
+// AddColumn038 adds i to the "column038" field.
+func (wu *WideUpdate) AddColumn038(i int) *WideUpdate {
+	wu.mutation.AddColumn038(i)
+	return wu
+}
+
+// SetColumn039 sets the "column039" field.
+func (wu *WideUpdate) SetColumn039(f float64) *WideUpdate {
+	wu.mutation.ResetColumn039()
+	wu.mutation.SetColumn039(f)
+	return wu
+}
+
+// SetNillableColumn039 sets the "column039" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn039(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn039(*f)
+	}
+	return wu
+}
+
+// AddColumn039 adds f to the "column039" field.
+func (wu *WideUpdate) AddColumn039(f float64) *WideUpdate {
+	wu.mutation.AddColumn039(f)
+	return wu
+}
+
+// SetColumn040 sets the "column040" field.
+func (wu *WideUpdate) SetColumn040(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn040(t)
+	return wu
+}
+
+// SetNillableColumn040 sets the "column040" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn040(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn040(*t)
+	}
+	return wu
+}
+
+// SetColumn041 sets the "column041" field.
+func (wu *WideUpdate) SetColumn041(s string) *WideUpdate {
+	wu.mutation.SetColumn041(s)
+	return wu
+}
+
+// SetNillableColumn041 sets the "column041" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn041(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn041(*s)
+	}
+	return wu
+}
+
+// SetColumn042 sets the "column042" field.
+func (wu *WideUpdate) SetColumn042(i int) *WideUpdate {
+	wu.mutation.ResetColumn042()
+	wu.mutation.SetColumn042(i)
+	return wu
+}
+
+// SetNillableColumn042 sets the "column042" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn042(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn042(*i)
+	}
+	return wu
+}
+
+// AddColumn042 adds i to the "column042" field.
+func (wu *WideUpdate) AddColumn042(i int) *WideUpdate {
+	wu.mutation.AddColumn042(i)
+	return wu
+}
+
+// SetColumn043 sets the "column043" field.
+func (wu *WideUpdate) SetColumn043(f float64) *WideUpdate {
+	wu.mutation.ResetColumn043()
+	wu.mutation.SetColumn043(f)
+	return wu
+}
+
+// SetNillableColumn043 sets the "column043" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn043(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn043(*f)
+	}
+	return wu
+}
+
+// AddColumn043 adds f to the "column043" field.
+func (wu *WideUpdate) AddColumn043(f float64) *WideUpdate {
+	wu.mutation.AddColumn043(f)
+	return wu
+}
+
+// SetColumn044 sets the "column044" field.
+func (wu *WideUpdate) SetColumn044(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn044(t)
+	return wu
+}
+
+// SetNillableColumn044 sets the "column044" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn044(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn044(*t)
+	}
+	return wu
+}
+
+// SetColumn045 sets the "column045" field.
+func (wu *WideUpdate) SetColumn045(s string) *WideUpdate {
+	wu.mutation.SetColumn045(s)
+	return wu
+}
+
+// SetNillableColumn045 sets the "column045" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn045(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn045(*s)
+	}
+	return wu
+}
+
+// SetColumn046 sets the "column046" field.
+func (wu *WideUpdate) SetColumn046(i int) *WideUpdate {
+	wu.mutation.ResetColumn046()
+	wu.mutation.SetColumn046(i)
+	return wu
+}
+
+// SetNillableColumn046 sets the "column046" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn046(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn046(*i)
+	}
+	return wu
+}
+
+// AddColumn046 adds i to the "column046" field.
+func (wu *WideUpdate) AddColumn046(i int) *WideUpdate {
+	wu.mutation.AddColumn046(i)
+	return wu
+}
+
+// SetColumn047 sets the "column047" field.
+func (wu *WideUpdate) SetColumn047(f float64) *WideUpdate {
+	wu.mutation.ResetColumn047()
+	wu.mutation.SetColumn047(f)
+	return wu
+}
+
+// SetNillableColumn047 sets the "column047" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn047(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn047(*f)
+	}
+	return wu
+}
+
+// AddColumn047 adds f to the "column047" field.
+func (wu *WideUpdate) AddColumn047(f float64) *WideUpdate {
+	wu.mutation.AddColumn047(f)
+	return wu
+}
+
+// SetColumn048 sets the "column048" field.
+func (wu *WideUpdate) SetColumn048(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn048(t)
+	return wu
+}
+
+// SetNillableColumn048 sets the "column048" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn048(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn048(*t)
+	}
+	return wu
+}
+
+// SetColumn049 sets the "column049" field.
+func (wu *WideUpdate) SetColumn049(s string) *WideUpdate {
+	wu.mutation.SetColumn049(s)
+	return wu
+}
+
+// SetNillableColumn049 sets the "column049" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn049(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn049(*s)
+	}
+	return wu
+}
+
+// SetColumn050 sets the "column050" field.
+func (wu *WideUpdate) SetColumn050(i int) *WideUpdate {
+	wu.mutation.ResetColumn050()
+	wu.mutation.SetColumn050(i)
+	return wu
+}
+
+// SetNillableColumn050 sets the "column050" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn050(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn050(*i)
+	}
+	return wu
+}
+
+// AddColumn050 adds i to the "column050" field.
+func (wu *WideUpdate) AddColumn050(i int) *WideUpdate {
+	wu.mutation.AddColumn050(i)
+	return wu
+}
+
+// SetColumn051 sets the "column051" field.
+func (wu *WideUpdate) SetColumn051(f float64) *WideUpdate {
+	wu.mutation.ResetColumn051()
+	wu.mutation.SetColumn051(f)
+	return wu
+}
+
+// SetNillableColumn051 sets the "column051" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn051(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn051(*f)
+	}
+	return wu
+}
+
+// AddColumn051 adds f to the "column051" field.
+func (wu *WideUpdate) AddColumn051(f float64) *WideUpdate {
+	wu.mutation.AddColumn051(f)
+	return wu
+}
+
+// SetColumn052 sets the "column052" field.
+func (wu *WideUpdate) SetColumn052(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn052(t)
+	return wu
+}
+
+// SetNillableColumn052 sets the "column052" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn052(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn052(*t)
+	}
+	return wu
+}
+
+// SetColumn053 sets the "column053" field.
+func (wu *WideUpdate) SetColumn053(s string) *WideUpdate {
+	wu.mutation.SetColumn053(s)
+	return wu
+}
+
+// SetNillableColumn053 sets the "column053" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn053(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn053(*s)
+	}
+	return wu
+}
+
+// SetColumn054 sets the "column054" field.
+func (wu *WideUpdate) SetColumn054(i int) *WideUpdate {
+	wu.mutation.ResetColumn054()
+	wu.mutation.SetColumn054(i)
+	return wu
+}
+
+// SetNillableColumn054 sets the "column054" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn054(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn054(*i)
+	}
+	return wu
+}
+
+// AddColumn054 adds i to the "column054" field.
+func (wu *WideUpdate) AddColumn054(i int) *WideUpdate {
+	wu.mutation.AddColumn054(i)
+	return wu
+}
+
+// SetColumn055 sets the "column055" field.
+func (wu *WideUpdate) SetColumn055(f float64) *WideUpdate {
+	wu.mutation.ResetColumn055()
+	wu.mutation.SetColumn055(f)
+	return wu
+}
+
+// SetNillableColumn055 sets the "column055" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn055(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn055(*f)
+	}
+	return wu
+}
+
+// AddColumn055 adds f to the "column055" field.
+func (wu *WideUpdate) AddColumn055(f float64) *WideUpdate {
+	wu.mutation.AddColumn055(f)
+	return wu
+}
+
+// SetColumn056 sets the "column056" field.
+func (wu *WideUpdate) SetColumn056(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn056(t)
+	return wu
+}
+
+// SetNillableColumn056 sets the "column056" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn056(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn056(*t)
+	}
+	return wu
+}
+
+// SetColumn057 sets the "column057" field.
+func (wu *WideUpdate) SetColumn057(s string) *WideUpdate {
+	wu.mutation.SetColumn057(s)
+	return wu
+}
+
+// SetNillableColumn057 sets the "column057" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn057(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn057(*s)
+	}
+	return wu
+}
+
+// SetColumn058 sets the "column058" field.
+func (wu *WideUpdate) SetColumn058(i int) *WideUpdate {
+	wu.mutation.ResetColumn058()
+	wu.mutation.SetColumn058(i)
+	return wu
+}
+
+// SetNillableColumn058 sets the "column058" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn058(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn058(*i)
+	}
+	return wu
+}
+
+// AddColumn058 adds i to the "column058" field.
+func (wu *WideUpdate) AddColumn058(i int) *WideUpdate {
+	wu.mutation.AddColumn058(i)
+	return wu
+}
+
+// SetColumn059 sets the "column059" field.
+func (wu *WideUpdate) SetColumn059(f float64) *WideUpdate {
+	wu.mutation.ResetColumn059()
+	wu.mutation.SetColumn059(f)
+	return wu
+}
+
+// SetNillableColumn059 sets the "column059" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn059(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn059(*f)
+	}
+	return wu
+}
+
+// AddColumn059 adds f to the "column059" field.
+func (wu *WideUpdate) AddColumn059(f float64) *WideUpdate {
+	wu.mutation.AddColumn059(f)
+	return wu
+}
+
+// SetColumn060 sets the "column060" field.
+func (wu *WideUpdate) SetColumn060(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn060(t)
+	return wu
+}
+
+// SetNillableColumn060 sets the "column060" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn060(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn060(*t)
+	}
+	return wu
+}
+
+// SetColumn061 sets the "column061" field.
+func (wu *WideUpdate) SetColumn061(s string) *WideUpdate {
+	wu.mutation.SetColumn061(s)
+	return wu
+}
+
+// SetNillableColumn061 sets the "column061" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn061(s *string) *WideUpdate {
+	if s != nil {
+		wu.SetColumn061(*s)
+	}
+	return wu
+}
+
+// SetColumn062 sets the "column062" field.
+func (wu *WideUpdate) SetColumn062(i int) *WideUpdate {
+	wu.mutation.ResetColumn062()
+	wu.mutation.SetColumn062(i)
+	return wu
+}
+
+// SetNillableColumn062 sets the "column062" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn062(i *int) *WideUpdate {
+	if i != nil {
+		wu.SetColumn062(*i)
+	}
+	return wu
+}
+
+// AddColumn062 adds i to the "column062" field.
+func (wu *WideUpdate) AddColumn062(i int) *WideUpdate {
+	wu.mutation.AddColumn062(i)
+	return wu
+}
+
+// SetColumn063 sets the "column063" field.
+func (wu *WideUpdate) SetColumn063(f float64) *WideUpdate {
+	wu.mutation.ResetColumn063()
+	wu.mutation.SetColumn063(f)
+	return wu
+}
+
+// SetNillableColumn063 sets the "column063" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn063(f *float64) *WideUpdate {
+	if f != nil {
+		wu.SetColumn063(*f)
+	}
+	return wu
+}
+
+// AddColumn063 adds f to the "column063" field.
+func (wu *WideUpdate) AddColumn063(f float64) *WideUpdate {
+	wu.mutation.AddColumn063(f)
+	return wu
+}
+
+// SetColumn064 sets the "column064" field.
+func (wu *WideUpdate) SetColumn064(t time.Time) *WideUpdate {
+	wu.mutation.SetColumn064(t)
+	return wu
+}
+
+// SetNillableColumn064 sets the "column064" field if the given value is not nil.
+func (wu *WideUpdate) SetNillableColumn064(t *time.Time) *WideUpdate {
+	if t != nil {
+		wu.SetColumn064(*t)
+	}
+	return wu
+}
+
+// Mutation returns the WideMutation object of the builder.
+func (wu *WideUpdate) Mutation() *WideMutation {
+	return wu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WideUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WideUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WideUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WideUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wu *WideUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wide.Table, wide.Columns, sqlgraph.NewFieldSpec(wide.FieldID, field.TypeInt))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.Column001(); ok {
+		_spec.SetField(wide.FieldColumn001, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column002(); ok {
+		_spec.SetField(wide.FieldColumn002, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn002(); ok {
+		_spec.AddField(wide.FieldColumn002, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column003(); ok {
+		_spec.SetField(wide.FieldColumn003, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn003(); ok {
+		_spec.AddField(wide.FieldColumn003, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column004(); ok {
+		_spec.SetField(wide.FieldColumn004, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column005(); ok {
+		_spec.SetField(wide.FieldColumn005, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column006(); ok {
+		_spec.SetField(wide.FieldColumn006, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn006(); ok {
+		_spec.AddField(wide.FieldColumn006, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column007(); ok {
+		_spec.SetField(wide.FieldColumn007, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn007(); ok {
+		_spec.AddField(wide.FieldColumn007, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column008(); ok {
+		_spec.SetField(wide.FieldColumn008, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column009(); ok {
+		_spec.SetField(wide.FieldColumn009, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column010(); ok {
+		_spec.SetField(wide.FieldColumn010, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn010(); ok {
+		_spec.AddField(wide.FieldColumn010, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column011(); ok {
+		_spec.SetField(wide.FieldColumn011, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn011(); ok {
+		_spec.AddField(wide.FieldColumn011, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column012(); ok {
+		_spec.SetField(wide.FieldColumn012, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column013(); ok {
+		_spec.SetField(wide.FieldColumn013, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column014(); ok {
+		_spec.SetField(wide.FieldColumn014, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn014(); ok {
+		_spec.AddField(wide.FieldColumn014, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column015(); ok {
+		_spec.SetField(wide.FieldColumn015, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn015(); ok {
+		_spec.AddField(wide.FieldColumn015, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column016(); ok {
+		_spec.SetField(wide.FieldColumn016, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column017(); ok {
+		_spec.SetField(wide.FieldColumn017, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column018(); ok {
+		_spec.SetField(wide.FieldColumn018, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn018(); ok {
+		_spec.AddField(wide.FieldColumn018, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column019(); ok {
+		_spec.SetField(wide.FieldColumn019, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn019(); ok {
+		_spec.AddField(wide.FieldColumn019, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column020(); ok {
+		_spec.SetField(wide.FieldColumn020, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column021(); ok {
+		_spec.SetField(wide.FieldColumn021, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column022(); ok {
+		_spec.SetField(wide.FieldColumn022, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn022(); ok {
+		_spec.AddField(wide.FieldColumn022, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column023(); ok {
+		_spec.SetField(wide.FieldColumn023, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn023(); ok {
+		_spec.AddField(wide.FieldColumn023, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column024(); ok {
+		_spec.SetField(wide.FieldColumn024, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column025(); ok {
+		_spec.SetField(wide.FieldColumn025, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column026(); ok {
+		_spec.SetField(wide.FieldColumn026, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn026(); ok {
+		_spec.AddField(wide.FieldColumn026, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column027(); ok {
+		_spec.SetField(wide.FieldColumn027, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn027(); ok {
+		_spec.AddField(wide.FieldColumn027, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column028(); ok {
+		_spec.SetField(wide.FieldColumn028, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column029(); ok {
+		_spec.SetField(wide.FieldColumn029, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column030(); ok {
+		_spec.SetField(wide.FieldColumn030, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn030(); ok {
+		_spec.AddField(wide.FieldColumn030, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column031(); ok {
+		_spec.SetField(wide.FieldColumn031, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn031(); ok {
+		_spec.AddField(wide.FieldColumn031, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column032(); ok {
+		_spec.SetField(wide.FieldColumn032, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column033(); ok {
+		_spec.SetField(wide.FieldColumn033, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column034(); ok {
+		_spec.SetField(wide.FieldColumn034, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn034(); ok {
+		_spec.AddField(wide.FieldColumn034, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column035(); ok {
+		_spec.SetField(wide.FieldColumn035, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn035(); ok {
+		_spec.AddField(wide.FieldColumn035, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column036(); ok {
+		_spec.SetField(wide.FieldColumn036, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column037(); ok {
+		_spec.SetField(wide.FieldColumn037, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column038(); ok {
+		_spec.SetField(wide.FieldColumn038, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn038(); ok {
+		_spec.AddField(wide.FieldColumn038, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column039(); ok {
+		_spec.SetField(wide.FieldColumn039, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn039(); ok {
+		_spec.AddField(wide.FieldColumn039, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column040(); ok {
+		_spec.SetField(wide.FieldColumn040, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column041(); ok {
+		_spec.SetField(wide.FieldColumn041, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column042(); ok {
+		_spec.SetField(wide.FieldColumn042, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn042(); ok {
+		_spec.AddField(wide.FieldColumn042, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column043(); ok {
+		_spec.SetField(wide.FieldColumn043, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn043(); ok {
+		_spec.AddField(wide.FieldColumn043, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column044(); ok {
+		_spec.SetField(wide.FieldColumn044, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column045(); ok {
+		_spec.SetField(wide.FieldColumn045, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column046(); ok {
+		_spec.SetField(wide.FieldColumn046, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn046(); ok {
+		_spec.AddField(wide.FieldColumn046, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column047(); ok {
+		_spec.SetField(wide.FieldColumn047, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn047(); ok {
+		_spec.AddField(wide.FieldColumn047, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column048(); ok {
+		_spec.SetField(wide.FieldColumn048, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column049(); ok {
+		_spec.SetField(wide.FieldColumn049, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column050(); ok {
+		_spec.SetField(wide.FieldColumn050, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn050(); ok {
+		_spec.AddField(wide.FieldColumn050, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column051(); ok {
+		_spec.SetField(wide.FieldColumn051, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn051(); ok {
+		_spec.AddField(wide.FieldColumn051, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column052(); ok {
+		_spec.SetField(wide.FieldColumn052, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column053(); ok {
+		_spec.SetField(wide.FieldColumn053, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column054(); ok {
+		_spec.SetField(wide.FieldColumn054, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn054(); ok {
+		_spec.AddField(wide.FieldColumn054, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column055(); ok {
+		_spec.SetField(wide.FieldColumn055, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn055(); ok {
+		_spec.AddField(wide.FieldColumn055, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column056(); ok {
+		_spec.SetField(wide.FieldColumn056, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column057(); ok {
+		_spec.SetField(wide.FieldColumn057, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column058(); ok {
+		_spec.SetField(wide.FieldColumn058, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn058(); ok {
+		_spec.AddField(wide.FieldColumn058, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column059(); ok {
+		_spec.SetField(wide.FieldColumn059, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn059(); ok {
+		_spec.AddField(wide.FieldColumn059, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column060(); ok {
+		_spec.SetField(wide.FieldColumn060, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Column061(); ok {
+		_spec.SetField(wide.FieldColumn061, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Column062(); ok {
+		_spec.SetField(wide.FieldColumn062, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedColumn062(); ok {
+		_spec.AddField(wide.FieldColumn062, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Column063(); ok {
+		_spec.SetField(wide.FieldColumn063, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.AddedColumn063(); ok {
+		_spec.AddField(wide.FieldColumn063, field.TypeFloat64, value)
+	}
+	if value, ok := wu.mutation.Column064(); ok {
+		_spec.SetField(wide.FieldColumn064, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wide.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WideUpdateOne is the builder for updating a single Wide entity.
+type WideUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WideMutation
+}
+
+// SetColumn001 sets the "column001" field.
+func (wuo *WideUpdateOne) SetColumn001(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn001(s)
+	return wuo
+}
+
+// SetNillableColumn001 sets the "column001" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn001(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn001(*s)
+	}
+	return wuo
+}
+
+// SetColumn002 sets the "column002" field.
+func (wuo *WideUpdateOne) SetColumn002(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn002()
+	wuo.mutation.SetColumn002(i)
+	return wuo
+}
+
+// SetNillableColumn002 sets the "column002" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn002(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn002(*i)
+	}
+	return wuo
+}
+
+// AddColumn002 adds i to the "column002" field.
+func (wuo *WideUpdateOne) AddColumn002(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn002(i)
+	return wuo
+}
+
+// SetColumn003 sets the "column003" field.
+func (wuo *WideUpdateOne) SetColumn003(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn003()
+	wuo.mutation.SetColumn003(f)
+	return wuo
+}
+
+// SetNillableColumn003 sets the "column003" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn003(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn003(*f)
+	}
+	return wuo
+}
+
+// AddColumn003 adds f to the "column003" field.
+func (wuo *WideUpdateOne) AddColumn003(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn003(f)
+	return wuo
+}
+
+// SetColumn004 sets the "column004" field.
+func (wuo *WideUpdateOne) SetColumn004(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn004(t)
+	return wuo
+}
+
+// SetNillableColumn004 sets the "column004" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn004(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn004(*t)
+	}
+	return wuo
+}
+
+// SetColumn005 sets the "column005" field.
+func (wuo *WideUpdateOne) SetColumn005(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn005(s)
+	return wuo
+}
+
+// SetNillableColumn005 sets the "column005" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn005(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn005(*s)
+	}
+	return wuo
+}
+
+// SetColumn006 sets the "column006" field.
+func (wuo *WideUpdateOne) SetColumn006(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn006()
+	wuo.mutation.SetColumn006(i)
+	return wuo
+}
+
+// SetNillableColumn006 sets the "column006" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn006(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn006(*i)
+	}
+	return wuo
+}
+
+// AddColumn006 adds i to the "column006" field.
+func (wuo *WideUpdateOne) AddColumn006(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn006(i)
+	return wuo
+}
+
+// SetColumn007 sets the "column007" field.
+func (wuo *WideUpdateOne) SetColumn007(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn007()
+	wuo.mutation.SetColumn007(f)
+	return wuo
+}
+
+// SetNillableColumn007 sets the "column007" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn007(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn007(*f)
+	}
+	return wuo
+}
+
+// AddColumn007 adds f to the "column007" field.
+func (wuo *WideUpdateOne) AddColumn007(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn007(f)
+	return wuo
+}
+
+// SetColumn008 sets the "column008" field.
+func (wuo *WideUpdateOne) SetColumn008(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn008(t)
+	return wuo
+}
+
+// SetNillableColumn008 sets the "column008" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn008(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn008(*t)
+	}
+	return wuo
+}
+
+// SetColumn009 sets the "column009" field.
+func (wuo *WideUpdateOne) SetColumn009(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn009(s)
+	return wuo
+}
+
+// SetNillableColumn009 sets the "column009" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn009(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn009(*s)
+	}
+	return wuo
+}
+
+// SetColumn010 sets the "column010" field.
+func (wuo *WideUpdateOne) SetColumn010(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn010()
+	wuo.mutation.SetColumn010(i)
+	return wuo
+}
+
+// SetNillableColumn010 sets the "column010" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn010(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn010(*i)
+	}
+	return wuo
+}
+
+// AddColumn010 adds i to the "column010" field.
+func (wuo *WideUpdateOne) AddColumn010(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn010(i)
+	return wuo
+}
+
+// SetColumn011 sets the "column011" field.
+func (wuo *WideUpdateOne) SetColumn011(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn011()
+	wuo.mutation.SetColumn011(f)
+	return wuo
+}
+
+// SetNillableColumn011 sets the "column011" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn011(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn011(*f)
+	}
+	return wuo
+}
+
+// AddColumn011 adds f to the "column011" field.
+func (wuo *WideUpdateOne) AddColumn011(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn011(f)
+	return wuo
+}
+
+// SetColumn012 sets the "column012" field.
+func (wuo *WideUpdateOne) SetColumn012(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn012(t)
+	return wuo
+}
+
+// SetNillableColumn012 sets the "column012" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn012(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn012(*t)
+	}
+	return wuo
+}
+
+// SetColumn013 sets the "column013" field.
+func (wuo *WideUpdateOne) SetColumn013(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn013(s)
+	return wuo
+}
+
+// SetNillableColumn013 sets the "column013" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn013(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn013(*s)
+	}
+	return wuo
+}
+
+// SetColumn014 sets the "column014" field.
+func (wuo *WideUpdateOne) SetColumn014(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn014()
+	wuo.mutation.SetColumn014(i)
+	return wuo
+}
+
+// SetNillableColumn014 sets the "column014" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn014(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn014(*i)
+	}
+	return wuo
+}
+
+// AddColumn014 adds i to the "column014" field.
+func (wuo *WideUpdateOne) AddColumn014(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn014(i)
+	return wuo
+}
+
+// SetColumn015 sets the "column015" field.
+func (wuo *WideUpdateOne) SetColumn015(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn015()
+	wuo.mutation.SetColumn015(f)
+	return wuo
+}
+
+// SetNillableColumn015 sets the "column015" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn015(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn015(*f)
+	}
+	return wuo
+}
+
+// AddColumn015 adds f to the "column015" field.
+func (wuo *WideUpdateOne) AddColumn015(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn015(f)
+	return wuo
+}
+
+// SetColumn016 sets the "column016" field.
+func (wuo *WideUpdateOne) SetColumn016(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn016(t)
+	return wuo
+}
+
+// SetNillableColumn016 sets the "column016" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn016(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn016(*t)
+	}
+	return wuo
+}
+
+// SetColumn017 sets the "column017" field.
+func (wuo *WideUpdateOne) SetColumn017(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn017(s)
+	return wuo
+}
+
+// SetNillableColumn017 sets the "column017" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn017(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn017(*s)
+	}
+	return wuo
+}
+
+// SetColumn018 sets the "column018" field.
+func (wuo *WideUpdateOne) SetColumn018(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn018()
+	wuo.mutation.SetColumn018(i)
+	return wuo
+}
+
+// SetNillableColumn018 sets the "column018" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn018(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn018(*i)
+	}
+	return wuo
+}
+
+// AddColumn018 adds i to the "column018" field.
+func (wuo *WideUpdateOne) AddColumn018(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn018(i)
+	return wuo
+}
+
+// SetColumn019 sets the "column019" field.
+func (wuo *WideUpdateOne) SetColumn019(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn019()
+	wuo.mutation.SetColumn019(f)
+	return wuo
+}
+
+// SetNillableColumn019 sets the "column019" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn019(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn019(*f)
+	}
+	return wuo
+}
+
+// AddColumn019 adds f to the "column019" field.
+func (wuo *WideUpdateOne) AddColumn019(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn019(f)
+	return wuo
+}
+
+// SetColumn020 sets the "column020" field.
+func (wuo *WideUpdateOne) SetColumn020(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn020(t)
+	return wuo
+}
+
+// SetNillableColumn020 sets the "column020" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn020(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn020(*t)
+	}
+	return wuo
+}
+
+// SetColumn021 sets the "column021" field.
+func (wuo *WideUpdateOne) SetColumn021(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn021(s)
+	return wuo
+}
+
+// SetNillableColumn021 sets the "column021" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn021(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn021(*s)
+	}
+	return wuo
+}
+
+// SetColumn022 sets the "column022" field.
+func (wuo *WideUpdateOne) SetColumn022(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn022()
+	wuo.mutation.SetColumn022(i)
+	return wuo
+}
+
+// SetNillableColumn022 sets the "column022" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn022(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn022(*i)
+	}
+	return wuo
+}
+
+// AddColumn022 adds i to the "column022" field.
+func (wuo *WideUpdateOne) AddColumn022(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn022(i)
+	return wuo
+}
+
+// SetColumn023 sets the "column023" field.
+func (wuo *WideUpdateOne) SetColumn023(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn023()
+	wuo.mutation.SetColumn023(f)
+	return wuo
+}
+
+// SetNillableColumn023 sets the "column023" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn023(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn023(*f)
+	}
+	return wuo
+}
+
+// AddColumn023 adds f to the "column023" field.
+func (wuo *WideUpdateOne) AddColumn023(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn023(f)
+	return wuo
+}
+
+// SetColumn024 sets the "column024" field.
+func (wuo *WideUpdateOne) SetColumn024(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn024(t)
+	return wuo
+}
+
+// SetNillableColumn024 sets the "column024" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn024(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn024(*t)
+	}
+	return wuo
+}
+
+// SetColumn025 sets the "column025" field.
+func (wuo *WideUpdateOne) SetColumn025(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn025(s)
+	return wuo
+}
+
+// SetNillableColumn025 sets the "column025" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn025(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn025(*s)
+	}
+	return wuo
+}
+
+// SetColumn026 sets the "column026" field.
+func (wuo *WideUpdateOne) SetColumn026(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn026()
+	wuo.mutation.SetColumn026(i)
+	return wuo
+}
+
+// SetNillableColumn026 sets the "column026" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn026(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn026(*i)
+	}
+	return wuo
+}
+
+// AddColumn026 adds i to the "column026" field.
+func (wuo *WideUpdateOne) AddColumn026(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn026(i)
+	return wuo
+}
+
+// SetColumn027 sets the "column027" field.
+func (wuo *WideUpdateOne) SetColumn027(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn027()
+	wuo.mutation.SetColumn027(f)
+	return wuo
+}
+
+// SetNillableColumn027 sets the "column027" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn027(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn027(*f)
+	}
+	return wuo
+}
+
+// AddColumn027 adds f to the "column027" field.
+func (wuo *WideUpdateOne) AddColumn027(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn027(f)
+	return wuo
+}
+
+// SetColumn028 sets the "column028" field.
+func (wuo *WideUpdateOne) SetColumn028(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn028(t)
+	return wuo
+}
+
+// SetNillableColumn028 sets the "column028" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn028(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn028(*t)
+	}
+	return wuo
+}
+
+// SetColumn029 sets the "column029" field.
+func (wuo *WideUpdateOne) SetColumn029(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn029(s)
+	return wuo
+}
+
+// SetNillableColumn029 sets the "column029" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn029(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn029(*s)
+	}
+	return wuo
+}
+
+// SetColumn030 sets the "column030" field.
+func (wuo *WideUpdateOne) SetColumn030(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn030()
+	wuo.mutation.SetColumn030(i)
+	return wuo
+}
+
+// SetNillableColumn030 sets the "column030" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn030(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn030(*i)
+	}
+	return wuo
+}
+
+// AddColumn030 adds i to the "column030" field.
+func (wuo *WideUpdateOne) AddColumn030(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn030(i)
+	return wuo
+}
+
+// SetColumn031 sets the "column031" field.
+func (wuo *WideUpdateOne) SetColumn031(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn031()
+	wuo.mutation.SetColumn031(f)
+	return wuo
+}
+
+// SetNillableColumn031 sets the "column031" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn031(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn031(*f)
+	}
+	return wuo
+}
+
+// AddColumn031 adds f to the "column031" field.
+func (wuo *WideUpdateOne) AddColumn031(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn031(f)
+	return wuo
+}
+
+// SetColumn032 sets the "column032" field.
+func (wuo *WideUpdateOne) SetColumn032(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn032(t)
+	return wuo
+}
+
+// SetNillableColumn032 sets the "column032" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn032(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn032(*t)
+	}
+	return wuo
+}
+
+// SetColumn033 sets the "column033" field.
+func (wuo *WideUpdateOne) SetColumn033(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn033(s)
+	return wuo
+}
+
+// SetNillableColumn033 sets the "column033" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn033(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn033(*s)
+	}
+	return wuo
+}
+
+// SetColumn034 sets the "column034" field.
+func (wuo *WideUpdateOne) SetColumn034(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn034()
+	wuo.mutation.SetColumn034(i)
+	return wuo
+}
+
+// SetNillableColumn034 sets the "column034" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn034(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn034(*i)
+	}
+	return wuo
+}
+
+// AddColumn034 adds i to the "column034" field.
+func (wuo *WideUpdateOne) AddColumn034(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn034(i)
+	return wuo
+}
+
+// SetColumn035 sets the "column035" field.
+func (wuo *WideUpdateOne) SetColumn035(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn035()
+	wuo.mutation.SetColumn035(f)
+	return wuo
+}
+
+// SetNillableColumn035 sets the "column035" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn035(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn035(*f)
+	}
+	return wuo
+}
+
+// AddColumn035 adds f to the "column035" field.
+func (wuo *WideUpdateOne) AddColumn035(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn035(f)
+	return wuo
+}
+
+// SetColumn036 sets the "column036" field.
+func (wuo *WideUpdateOne) SetColumn036(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn036(t)
+	return wuo
+}
+
+// SetNillableColumn036 sets the "column036" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn036(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn036(*t)
+	}
+	return wuo
+}
+
+// SetColumn037 sets the "column037" field.
+func (wuo *WideUpdateOne) SetColumn037(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn037(s)
+	return wuo
+}
+
+// SetNillableColumn037 sets the "column037" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn037(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn037(*s)
+	}
+	return wuo
+}
+
+// SetColumn038 sets the "column038" field.
+func (wuo *WideUpdateOne) SetColumn038(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn038()
+	wuo.mutation.SetColumn038(i)
+	return wuo
+}
+
+// SetNillableColumn038 sets the "column038" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn038(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn038(*i)
+	}
+	return wuo
+}
+
+// AddColumn038 adds i to the "column038" field.
+func (wuo *WideUpdateOne) AddColumn038(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn038(i)
+	return wuo
+}
+
+// SetColumn039 sets the "column039" field.
+func (wuo *WideUpdateOne) SetColumn039(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn039()
+	wuo.mutation.SetColumn039(f)
+	return wuo
+}
+
+// SetNillableColumn039 sets the "column039" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn039(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn039(*f)
+	}
+	return wuo
+}
+
+// AddColumn039 adds f to the "column039" field.
+func (wuo *WideUpdateOne) AddColumn039(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn039(f)
+	return wuo
+}
+
+// SetColumn040 sets the "column040" field.
+func (wuo *WideUpdateOne) SetColumn040(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn040(t)
+	return wuo
+}
+
+// SetNillableColumn040 sets the "column040" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn040(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn040(*t)
+	}
+	return wuo
+}
+
+// SetColumn041 sets the "column041" field.
+func (wuo *WideUpdateOne) SetColumn041(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn041(s)
+	return wuo
+}
+
+// SetNillableColumn041 sets the "column041" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn041(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn041(*s)
+	}
+	return wuo
+}
+
+// SetColumn042 sets the "column042" field.
+func (wuo *WideUpdateOne) SetColumn042(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn042()
+	wuo.mutation.SetColumn042(i)
+	return wuo
+}
+
+// SetNillableColumn042 sets the "column042" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn042(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn042(*i)
+	}
+	return wuo
+}
+
+// AddColumn042 adds i to the "column042" field.
+func (wuo *WideUpdateOne) AddColumn042(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn042(i)
+	return wuo
+}
+
+// SetColumn043 sets the "column043" field.
+func (wuo *WideUpdateOne) SetColumn043(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn043()
+	wuo.mutation.SetColumn043(f)
+	return wuo
+}
+
+// SetNillableColumn043 sets the "column043" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn043(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn043(*f)
+	}
+	return wuo
+}
+
+// AddColumn043 adds f to the "column043" field.
+func (wuo *WideUpdateOne) AddColumn043(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn043(f)
+	return wuo
+}
+
+// SetColumn044 sets the "column044" field.
+func (wuo *WideUpdateOne) SetColumn044(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn044(t)
+	return wuo
+}
+
+// SetNillableColumn044 sets the "column044" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn044(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn044(*t)
+	}
+	return wuo
+}
+
+// SetColumn045 sets the "column045" field.
+func (wuo *WideUpdateOne) SetColumn045(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn045(s)
+	return wuo
+}
+
+// SetNillableColumn045 sets the "column045" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn045(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn045(*s)
+	}
+	return wuo
+}
+
+// SetColumn046 sets the "column046" field.
+func (wuo *WideUpdateOne) SetColumn046(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn046()
+	wuo.mutation.SetColumn046(i)
+	return wuo
+}
+
+// SetNillableColumn046 sets the "column046" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn046(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn046(*i)
+	}
+	return wuo
+}
+
+// AddColumn046 adds i to the "column046" field.
+func (wuo *WideUpdateOne) AddColumn046(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn046(i)
+	return wuo
+}
+
+// SetColumn047 sets the "column047" field.
+func (wuo *WideUpdateOne) SetColumn047(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn047()
+	wuo.mutation.SetColumn047(f)
+	return wuo
+}
+
+// SetNillableColumn047 sets the "column047" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn047(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn047(*f)
+	}
+	return wuo
+}
+
+// AddColumn047 adds f to the "column047" field.
+func (wuo *WideUpdateOne) AddColumn047(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn047(f)
+	return wuo
+}
+
+// SetColumn048 sets the "column048" field.
+func (wuo *WideUpdateOne) SetColumn048(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn048(t)
+	return wuo
+}
+
+// SetNillableColumn048 sets the "column048" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn048(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn048(*t)
+	}
+	return wuo
+}
+
+// SetColumn049 sets the "column049" field.
+func (wuo *WideUpdateOne) SetColumn049(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn049(s)
+	return wuo
+}
+
+// SetNillableColumn049 sets the "column049" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn049(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn049(*s)
+	}
+	return wuo
+}
+
+// SetColumn050 sets the "column050" field.
+func (wuo *WideUpdateOne) SetColumn050(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn050()
+	wuo.mutation.SetColumn050(i)
+	return wuo
+}
+
+// SetNillableColumn050 sets the "column050" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn050(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn050(*i)
+	}
+	return wuo
+}
+
+// AddColumn050 adds i to the "column050" field.
+func (wuo *WideUpdateOne) AddColumn050(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn050(i)
+	return wuo
+}
+
+// SetColumn051 sets the "column051" field.
+func (wuo *WideUpdateOne) SetColumn051(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn051()
+	wuo.mutation.SetColumn051(f)
+	return wuo
+}
+
+// SetNillableColumn051 sets the "column051" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn051(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn051(*f)
+	}
+	return wuo
+}
+
+// AddColumn051 adds f to the "column051" field.
+func (wuo *WideUpdateOne) AddColumn051(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn051(f)
+	return wuo
+}
+
+// SetColumn052 sets the "column052" field.
+func (wuo *WideUpdateOne) SetColumn052(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn052(t)
+	return wuo
+}
+
+// SetNillableColumn052 sets the "column052" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn052(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn052(*t)
+	}
+	return wuo
+}
+
+// SetColumn053 sets the "column053" field.
+func (wuo *WideUpdateOne) SetColumn053(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn053(s)
+	return wuo
+}
+
+// SetNillableColumn053 sets the "column053" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn053(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn053(*s)
+	}
+	return wuo
+}
+
+// SetColumn054 sets the "column054" field.
+func (wuo *WideUpdateOne) SetColumn054(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn054()
+	wuo.mutation.SetColumn054(i)
+	return wuo
+}
+
+// SetNillableColumn054 sets the "column054" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn054(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn054(*i)
+	}
+	return wuo
+}
+
+// AddColumn054 adds i to the "column054" field.
+func (wuo *WideUpdateOne) AddColumn054(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn054(i)
+	return wuo
+}
+
+// SetColumn055 sets the "column055" field.
+func (wuo *WideUpdateOne) SetColumn055(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn055()
+	wuo.mutation.SetColumn055(f)
+	return wuo
+}
+
+// SetNillableColumn055 sets the "column055" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn055(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn055(*f)
+	}
+	return wuo
+}
+
+// AddColumn055 adds f to the "column055" field.
+func (wuo *WideUpdateOne) AddColumn055(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn055(f)
+	return wuo
+}
+
+// SetColumn056 sets the "column056" field.
+func (wuo *WideUpdateOne) SetColumn056(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn056(t)
+	return wuo
+}
+
+// SetNillableColumn056 sets the "column056" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn056(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn056(*t)
+	}
+	return wuo
+}
+
+// SetColumn057 sets the "column057" field.
+func (wuo *WideUpdateOne) SetColumn057(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn057(s)
+	return wuo
+}
+
+// SetNillableColumn057 sets the "column057" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn057(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn057(*s)
+	}
+	return wuo
+}
+
+// SetColumn058 sets the "column058" field.
+func (wuo *WideUpdateOne) SetColumn058(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn058()
+	wuo.mutation.SetColumn058(i)
+	return wuo
+}
+
+// SetNillableColumn058 sets the "column058" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn058(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn058(*i)
+	}
+	return wuo
+}
+
+// AddColumn058 adds i to the "column058" field.
+func (wuo *WideUpdateOne) AddColumn058(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn058(i)
+	return wuo
+}
+
+// SetColumn059 sets the "column059" field.
+func (wuo *WideUpdateOne) SetColumn059(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn059()
+	wuo.mutation.SetColumn059(f)
+	return wuo
+}
+
+// SetNillableColumn059 sets the "column059" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn059(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn059(*f)
+	}
+	return wuo
+}
+
+// AddColumn059 adds f to the "column059" field.
+func (wuo *WideUpdateOne) AddColumn059(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn059(f)
+	return wuo
+}
+
+// SetColumn060 sets the "column060" field.
+func (wuo *WideUpdateOne) SetColumn060(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn060(t)
+	return wuo
+}
+
+// SetNillableColumn060 sets the "column060" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn060(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn060(*t)
+	}
+	return wuo
+}
+
+// SetColumn061 sets the "column061" field.
+func (wuo *WideUpdateOne) SetColumn061(s string) *WideUpdateOne {
+	wuo.mutation.SetColumn061(s)
+	return wuo
+}
+
+// SetNillableColumn061 sets the "column061" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn061(s *string) *WideUpdateOne {
+	if s != nil {
+		wuo.SetColumn061(*s)
+	}
+	return wuo
+}
+
+// SetColumn062 sets the "column062" field.
+func (wuo *WideUpdateOne) SetColumn062(i int) *WideUpdateOne {
+	wuo.mutation.ResetColumn062()
+	wuo.mutation.SetColumn062(i)
+	return wuo
+}
+
+// SetNillableColumn062 sets the "column062" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn062(i *int) *WideUpdateOne {
+	if i != nil {
+		wuo.SetColumn062(*i)
+	}
+	return wuo
+}
+
+// AddColumn062 adds i to the "column062" field.
+func (wuo *WideUpdateOne) AddColumn062(i int) *WideUpdateOne {
+	wuo.mutation.AddColumn062(i)
+	return wuo
+}
+
+// SetColumn063 sets the "column063" field.
+func (wuo *WideUpdateOne) SetColumn063(f float64) *WideUpdateOne {
+	wuo.mutation.ResetColumn063()
+	wuo.mutation.SetColumn063(f)
+	return wuo
+}
+
+// SetNillableColumn063 sets the "column063" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn063(f *float64) *WideUpdateOne {
+	if f != nil {
+		wuo.SetColumn063(*f)
+	}
+	return wuo
+}
+
+// AddColumn063 adds f to the "column063" field.
+func (wuo *WideUpdateOne) AddColumn063(f float64) *WideUpdateOne {
+	wuo.mutation.AddColumn063(f)
+	return wuo
+}
+
+// SetColumn064 sets the "column064" field.
+func (wuo *WideUpdateOne) SetColumn064(t time.Time) *WideUpdateOne {
+	wuo.mutation.SetColumn064(t)
+	return wuo
+}
+
+// SetNillableColumn064 sets the "column064" field if the given value is not nil.
+func (wuo *WideUpdateOne) SetNillableColumn064(t *time.Time) *WideUpdateOne {
+	if t != nil {
+		wuo.SetColumn064(*t)
+	}
+	return wuo
+}
+
+// Mutation returns the WideMutation object of the builder.
+func (wuo *WideUpdateOne) Mutation() *WideMutation {
+	return wuo.mutation
+}
+
+// Where appends a list predicates to the WideUpdate builder.
+func (wuo *WideUpdateOne) Where(ps ...predicate.Wide) *WideUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WideUpdateOne) Select(field string, fields ...string) *WideUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Wide entity.
+func (wuo *WideUpdateOne) Save(ctx context.Context) (*Wide, error) {
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WideUpdateOne) SaveX(ctx context.Context) *Wide {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WideUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WideUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wuo *WideUpdateOne) sqlSave(ctx context.Context) (_node *Wide, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wide.Table, wide.Columns, sqlgraph.NewFieldSpec(wide.FieldID, field.TypeInt))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Wide.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wide.FieldID)
+		for _, f := range fields {
+			if !wide.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wide.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.Column001(); ok {
+		_spec.SetField(wide.FieldColumn001, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column002(); ok {
+		_spec.SetField(wide.FieldColumn002, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn002(); ok {
+		_spec.AddField(wide.FieldColumn002, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column003(); ok {
+		_spec.SetField(wide.FieldColumn003, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn003(); ok {
+		_spec.AddField(wide.FieldColumn003, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column004(); ok {
+		_spec.SetField(wide.FieldColumn004, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column005(); ok {
+		_spec.SetField(wide.FieldColumn005, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column006(); ok {
+		_spec.SetField(wide.FieldColumn006, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn006(); ok {
+		_spec.AddField(wide.FieldColumn006, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column007(); ok {
+		_spec.SetField(wide.FieldColumn007, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn007(); ok {
+		_spec.AddField(wide.FieldColumn007, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column008(); ok {
+		_spec.SetField(wide.FieldColumn008, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column009(); ok {
+		_spec.SetField(wide.FieldColumn009, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column010(); ok {
+		_spec.SetField(wide.FieldColumn010, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn010(); ok {
+		_spec.AddField(wide.FieldColumn010, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column011(); ok {
+		_spec.SetField(wide.FieldColumn011, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn011(); ok {
+		_spec.AddField(wide.FieldColumn011, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column012(); ok {
+		_spec.SetField(wide.FieldColumn012, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column013(); ok {
+		_spec.SetField(wide.FieldColumn013, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column014(); ok {
+		_spec.SetField(wide.FieldColumn014, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn014(); ok {
+		_spec.AddField(wide.FieldColumn014, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column015(); ok {
+		_spec.SetField(wide.FieldColumn015, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn015(); ok {
+		_spec.AddField(wide.FieldColumn015, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column016(); ok {
+		_spec.SetField(wide.FieldColumn016, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column017(); ok {
+		_spec.SetField(wide.FieldColumn017, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column018(); ok {
+		_spec.SetField(wide.FieldColumn018, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn018(); ok {
+		_spec.AddField(wide.FieldColumn018, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column019(); ok {
+		_spec.SetField(wide.FieldColumn019, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn019(); ok {
+		_spec.AddField(wide.FieldColumn019, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column020(); ok {
+		_spec.SetField(wide.FieldColumn020, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column021(); ok {
+		_spec.SetField(wide.FieldColumn021, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column022(); ok {
+		_spec.SetField(wide.FieldColumn022, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn022(); ok {
+		_spec.AddField(wide.FieldColumn022, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column023(); ok {
+		_spec.SetField(wide.FieldColumn023, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn023(); ok {
+		_spec.AddField(wide.FieldColumn023, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column024(); ok {
+		_spec.SetField(wide.FieldColumn024, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column025(); ok {
+		_spec.SetField(wide.FieldColumn025, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column026(); ok {
+		_spec.SetField(wide.FieldColumn026, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn026(); ok {
+		_spec.AddField(wide.FieldColumn026, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column027(); ok {
+		_spec.SetField(wide.FieldColumn027, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn027(); ok {
+		_spec.AddField(wide.FieldColumn027, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column028(); ok {
+		_spec.SetField(wide.FieldColumn028, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column029(); ok {
+		_spec.SetField(wide.FieldColumn029, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column030(); ok {
+		_spec.SetField(wide.FieldColumn030, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn030(); ok {
+		_spec.AddField(wide.FieldColumn030, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column031(); ok {
+		_spec.SetField(wide.FieldColumn031, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn031(); ok {
+		_spec.AddField(wide.FieldColumn031, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column032(); ok {
+		_spec.SetField(wide.FieldColumn032, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column033(); ok {
+		_spec.SetField(wide.FieldColumn033, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column034(); ok {
+		_spec.SetField(wide.FieldColumn034, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn034(); ok {
+		_spec.AddField(wide.FieldColumn034, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column035(); ok {
+		_spec.SetField(wide.FieldColumn035, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn035(); ok {
+		_spec.AddField(wide.FieldColumn035, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column036(); ok {
+		_spec.SetField(wide.FieldColumn036, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column037(); ok {
+		_spec.SetField(wide.FieldColumn037, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column038(); ok {
+		_spec.SetField(wide.FieldColumn038, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn038(); ok {
+		_spec.AddField(wide.FieldColumn038, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column039(); ok {
+		_spec.SetField(wide.FieldColumn039, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn039(); ok {
+		_spec.AddField(wide.FieldColumn039, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column040(); ok {
+		_spec.SetField(wide.FieldColumn040, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column041(); ok {
+		_spec.SetField(wide.FieldColumn041, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column042(); ok {
+		_spec.SetField(wide.FieldColumn042, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn042(); ok {
+		_spec.AddField(wide.FieldColumn042, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column043(); ok {
+		_spec.SetField(wide.FieldColumn043, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn043(); ok {
+		_spec.AddField(wide.FieldColumn043, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column044(); ok {
+		_spec.SetField(wide.FieldColumn044, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column045(); ok {
+		_spec.SetField(wide.FieldColumn045, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column046(); ok {
+		_spec.SetField(wide.FieldColumn046, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn046(); ok {
+		_spec.AddField(wide.FieldColumn046, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column047(); ok {
+		_spec.SetField(wide.FieldColumn047, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn047(); ok {
+		_spec.AddField(wide.FieldColumn047, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column048(); ok {
+		_spec.SetField(wide.FieldColumn048, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column049(); ok {
+		_spec.SetField(wide.FieldColumn049, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column050(); ok {
+		_spec.SetField(wide.FieldColumn050, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn050(); ok {
+		_spec.AddField(wide.FieldColumn050, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column051(); ok {
+		_spec.SetField(wide.FieldColumn051, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn051(); ok {
+		_spec.AddField(wide.FieldColumn051, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column052(); ok {
+		_spec.SetField(wide.FieldColumn052, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column053(); ok {
+		_spec.SetField(wide.FieldColumn053, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column054(); ok {
+		_spec.SetField(wide.FieldColumn054, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn054(); ok {
+		_spec.AddField(wide.FieldColumn054, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column055(); ok {
+		_spec.SetField(wide.FieldColumn055, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn055(); ok {
+		_spec.AddField(wide.FieldColumn055, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column056(); ok {
+		_spec.SetField(wide.FieldColumn056, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column057(); ok {
+		_spec.SetField(wide.FieldColumn057, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column058(); ok {
+		_spec.SetField(wide.FieldColumn058, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn058(); ok {
+		_spec.AddField(wide.FieldColumn058, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column059(); ok {
+		_spec.SetField(wide.FieldColumn059, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn059(); ok {
+		_spec.AddField(wide.FieldColumn059, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column060(); ok {
+		_spec.SetField(wide.FieldColumn060, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Column061(); ok {
+		_spec.SetField(wide.FieldColumn061, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Column062(); ok {
+		_spec.SetField(wide.FieldColumn062, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn062(); ok {
+		_spec.AddField(wide.FieldColumn062, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Column063(); ok {
+		_spec.SetField(wide.FieldColumn063, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.AddedColumn063(); ok {
+		_spec.AddField(wide.FieldColumn063, field.TypeFloat64, value)
+	}
+	if value, ok := wuo.mutation.Column064(); ok {
+		_spec.SetField(wide.FieldColumn064, field.TypeTime, value)
+	}
+	_node = &Wide{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wide.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
@@ -1,17 +1,53 @@
 package benchmark
 
 import (
+	"context"
+	"database/sql"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-goe/goe"
+	goemodel "github.com/go-goe/goe/model"
+	"github.com/go-goe/goe/query"
+	"github.com/go-goe/goe/query/aggregate"
+	"github.com/go-goe/goe/query/function"
+	"github.com/go-goe/goe/query/update"
 	"github.com/go-goe/goe/query/where"
 	"github.com/go-goe/postgres"
+	"github.com/google/uuid"
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
 	"github.com/lauro-santana/golang-orm-benchmarks/model"
 )
 
+// PricePolicies maps the price_policies table for goe, which derives the names from the struct:
+// the type name must be plural and the foreign key spelled BookId to match book_id.
+type PricePolicies struct {
+	ID        int64
+	BookId    int64
+	Price     float64
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// UuidBook maps the uuid_books table for goe, which derives the table name from the type name and would read
+// UUIDBook as u_u_i_d_books. goe inserts the keys that are not integers, so they are generated by the application.
+type UuidBook struct {
+	ID           uuid.UUID
+	ISBN         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int
+	PublicizedAt time.Time
+}
+
 type Database struct {
-	Book *model.Book
+	Book          *model.Book
+	PricePolicies *PricePolicies
+	Draft         *model.Draft
+	Wide          *model.Wide
+	UuidBook      *UuidBook
 	*goe.DB
 }
 
@@ -19,6 +55,10 @@ type GoeBenchmark struct {
 	db *Database
 }
 
+func init() {
+	Register(Adapter{Name: "goe", Category: CategoryORM, New: NewGoeBenchmark})
+}
+
 func NewGoeBenchmark() Benchmark {
 	return &GoeBenchmark{}
 }
@@ -32,6 +72,15 @@ func (o *GoeBenchmark) Close() error {
 	return goe.Close(o.db)
 }
 
+func (o *GoeBenchmark) Capabilities() Capabilities {
+	return Capabilities{
+		SelectRelationsOp:          Emulated,
+		SelectPageRelationsEagerOp: Emulated,
+		UpdateBulkValuesOp:         Emulated,
+		SelectExistsOp:             Emulated,
+	}
+}
+
 func (o *GoeBenchmark) Insert(b *testing.B) {
 	book := model.NewBook()
 
@@ -41,6 +90,7 @@ func (o *GoeBenchmark) Insert(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
 		book.ID = 0
+		book.ISBN = model.NewISBN()
 		b.StartTimer()
 
 		err := goe.Insert(o.db.Book).One(book)
@@ -63,6 +113,7 @@ func (o *GoeBenchmark) InsertBulk(b *testing.B) {
 		b.StopTimer()
 		for i := range books {
 			books[i].ID = 0
+			books[i].ISBN = model.NewISBN()
 		}
 		b.StartTimer()
 
@@ -173,3 +224,437 @@ func (o *GoeBenchmark) FindPage(b *testing.B) {
 		}
 	}
 }
+
+func (o *GoeBenchmark) FindWithPricePolicies(b *testing.B) {
+	book, err := seedBookWithPricePolicies()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		// goe has no eager loading, the policies are loaded by a second query.
+		_, err = goe.Find(o.db.Book).ById(model.Book{ID: book.ID})
+		if err == nil {
+			_, err = goe.Select(o.db.PricePolicies).
+				From(o.db.PricePolicies).
+				Where(where.Equals(&o.db.PricePolicies.BookId, book.ID)).
+				AsSlice()
+		}
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+
+	report()
+}
+
+func (o *GoeBenchmark) FindPageWithPricePoliciesNaive(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			var booksPage []model.Book
+			booksPage, err = goe.Select(o.db.Book).
+				From(o.db.Book).
+				Take(utils.PageSize).
+				Where(where.Greater(&o.db.Book.ID, pageCursor(books, s))).
+				AsSlice()
+			for j := 0; err == nil && j < len(booksPage); j++ {
+				_, err = goe.Select(o.db.PricePolicies).
+					From(o.db.PricePolicies).
+					Where(where.Equals(&o.db.PricePolicies.BookId, booksPage[j].ID)).
+					AsSlice()
+			}
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *GoeBenchmark) FindPageWithPricePoliciesEager(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			// goe has no eager loading, the policies of the whole page are loaded by a second query.
+			var booksPage []model.Book
+			booksPage, err = goe.Select(o.db.Book).
+				From(o.db.Book).
+				Take(utils.PageSize).
+				Where(where.Greater(&o.db.Book.ID, pageCursor(books, s))).
+				AsSlice()
+			if err == nil && len(booksPage) > 0 {
+				bookIDs := make([]int64, len(booksPage))
+				for j := range booksPage {
+					bookIDs[j] = booksPage[j].ID
+				}
+				_, err = goe.Select(o.db.PricePolicies).
+					From(o.db.PricePolicies).
+					Where(where.In(&o.db.PricePolicies.BookId, bookIDs)).
+					AsSlice()
+			}
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *GoeBenchmark) TransactionCommit(b *testing.B) {
+	benchmarkTransaction(b, true, o.doTransaction)
+}
+
+func (o *GoeBenchmark) TransactionRollback(b *testing.B) {
+	benchmarkTransaction(b, false, o.doTransaction)
+}
+
+func (o *GoeBenchmark) doTransaction(book *model.Book, commit bool) error {
+	// goe defaults to serializable transactions, the other adapters run on the database default.
+	tx, err := o.db.NewTransactionContext(context.Background(), sql.LevelDefault)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// it is a no-op once the transaction is committed
+		_ = tx.Rollback()
+	}()
+
+	if err = goe.Insert(o.db.Book).OnTransaction(tx).One(book); err != nil {
+		return err
+	}
+	policies := make([]PricePolicies, utils.TransactionPricePoliciesNumber)
+	for i, policy := range model.NewPricePolicies(book.ID, utils.TransactionPricePoliciesNumber) {
+		policies[i] = PricePolicies{
+			BookId:    policy.BookID,
+			Price:     policy.Price,
+			StartDate: policy.StartDate,
+			EndDate:   policy.EndDate,
+		}
+	}
+	if err = goe.Insert(o.db.PricePolicies).OnTransaction(tx).All(policies); err != nil {
+		return err
+	}
+	err = goe.Update(o.db.Book).
+		OnTransaction(tx).
+		Sets(update.Set(&o.db.Book.Quantity, updatedQuantity(book))).
+		Where(where.Equals(&o.db.Book.ID, book.ID))
+	if err != nil {
+		return err
+	}
+
+	if !commit {
+		return tx.Rollback()
+	}
+	return tx.Commit()
+}
+
+func (o *GoeBenchmark) UpdateBulk(b *testing.B) {
+	benchmarkUpdateBulk(b, func(ids []int64, quantity int) error {
+		return goe.Update(o.db.Book).
+			Sets(update.Set(&o.db.Book.Quantity, quantity)).
+			Where(where.In(&o.db.Book.ID, ids))
+	})
+}
+
+func (o *GoeBenchmark) UpdateBulkValues(b *testing.B) {
+	benchmarkUpdateBulkValues(b, func(ids []int64, quantities []int) error {
+		// goe has no bulk update of different values, the books are updated one by one in a transaction.
+		tx, err := o.db.NewTransactionContext(context.Background(), sql.LevelDefault)
+		if err != nil {
+			return err
+		}
+		for i := range ids {
+			err = goe.Update(o.db.Book).
+				OnTransaction(tx).
+				Sets(update.Set(&o.db.Book.Quantity, quantities[i])).
+				Where(where.Equals(&o.db.Book.ID, ids[i]))
+			if err != nil {
+				_ = tx.Rollback()
+				return err
+			}
+		}
+		return tx.Commit()
+	})
+}
+
+func (o *GoeBenchmark) DeleteBulk(b *testing.B) {
+	benchmarkDeleteBulk(b, func(ids []int64) error {
+		return goe.Delete(o.db.Book).Where(where.In(&o.db.Book.ID, ids))
+	})
+}
+
+func (o *GoeBenchmark) DeleteBulkWhere(b *testing.B) {
+	benchmarkDeleteBulkWhere(b, func(genre string) error {
+		return goe.Delete(o.db.Book).Where(where.Equals(&o.db.Book.Genre, genre))
+	})
+}
+
+func (o *GoeBenchmark) FindPageOffset(b *testing.B) {
+	benchmarkPageDepths(b, func(offset int, _ int64) error {
+		// goe pages are numbered from one and count the rows along with the page.
+		_, err := goe.Select(o.db.Book).
+			From(o.db.Book).
+			OrderByAsc(&o.db.Book.ID).
+			AsPagination(offset/utils.PageSize+1, utils.PageSize)
+		return err
+	})
+}
+
+func (o *GoeBenchmark) FindPageKeyset(b *testing.B) {
+	benchmarkPageDepths(b, func(_ int, cursor int64) error {
+		_, err := goe.Select(o.db.Book).
+			From(o.db.Book).
+			Where(where.Greater(&o.db.Book.ID, cursor)).
+			OrderByAsc(&o.db.Book.ID).
+			Take(utils.PageSize).
+			AsSlice()
+		return err
+	})
+}
+
+func (o *GoeBenchmark) Search(b *testing.B) {
+	benchmarkSearch(b, func(search bookSearch) error {
+		// goe takes a single where operation, the filters are chained with where.And.
+		var filters []goemodel.Operation
+		if search.author != "" {
+			filters = append(filters, where.Equals(&o.db.Book.Author, search.author))
+		}
+		if search.genres != nil {
+			filters = append(filters, where.In(&o.db.Book.Genre, search.genres))
+		}
+		if search.minQuantity != nil {
+			filters = append(filters, where.GreaterEquals(&o.db.Book.Quantity, *search.minQuantity))
+		}
+		if search.maxQuantity != nil {
+			filters = append(filters, where.LessEquals(&o.db.Book.Quantity, *search.maxQuantity))
+		}
+		if !search.publishedFrom.IsZero() {
+			filters = append(filters, where.GreaterEquals(&o.db.Book.PublicizedAt, search.publishedFrom))
+		}
+		if !search.publishedTo.IsZero() {
+			filters = append(filters, where.Less(&o.db.Book.PublicizedAt, search.publishedTo))
+		}
+		if search.title != "" {
+			// goe has no ILIKE, both the title and the pattern are lower cased instead.
+			filters = append(filters, where.Like(function.ToLower(&o.db.Book.Title), strings.ToLower(search.titlePattern())))
+		}
+
+		query := goe.Select(o.db.Book).From(o.db.Book)
+		if len(filters) > 0 {
+			filter := filters[0]
+			for _, next := range filters[1:] {
+				filter = where.And(filter, next)
+			}
+			query = query.Where(filter)
+		}
+		_, err := query.OrderByDesc(&o.db.Book.PublicizedAt).Take(utils.PageSize).AsSlice()
+		return err
+	})
+}
+
+func (o *GoeBenchmark) FindTitleByID(b *testing.B) {
+	benchmarkFindTitleByID(b, func(id int64) error {
+		_, err := goe.Select(&struct {
+			ID    *int64
+			Title *string
+		}{
+			ID:    &o.db.Book.ID,
+			Title: &o.db.Book.Title,
+		}).
+			From(o.db.Book).
+			Where(where.Equals(&o.db.Book.ID, id)).
+			AsSlice()
+		return err
+	})
+}
+
+func (o *GoeBenchmark) FindTitlePage(b *testing.B) {
+	benchmarkFindTitlePage(b, func(cursor int64) error {
+		_, err := goe.Select(&struct {
+			ID    *int64
+			Title *string
+		}{
+			ID:    &o.db.Book.ID,
+			Title: &o.db.Book.Title,
+		}).
+			From(o.db.Book).
+			Where(where.Greater(&o.db.Book.ID, cursor)).
+			Take(utils.PageSize).
+			AsSlice()
+		return err
+	})
+}
+
+func (o *GoeBenchmark) Stream(b *testing.B) {
+	benchmarkStream(b, func(limit int) (int, error) {
+		read := 0
+		for _, err := range goe.Select(o.db.Book).From(o.db.Book).OrderByAsc(&o.db.Book.ID).Take(limit).Rows() {
+			if err != nil {
+				return read, err
+			}
+			read++
+		}
+		return read, nil
+	})
+}
+
+func (o *GoeBenchmark) UpdatePartial(b *testing.B) {
+	benchmarkUpdatePartial(b, func(book *model.Book) error {
+		return goe.Update(o.db.Book).
+			Sets(update.Set(&o.db.Book.Quantity, book.Quantity)).
+			Where(where.Equals(&o.db.Book.ID, book.ID))
+	})
+}
+
+func (o *GoeBenchmark) SoftDelete(b *testing.B) {
+	benchmarkSoftDelete(b, func(id int64) error {
+		deletedAt := time.Now()
+		return goe.Update(o.db.Book).
+			Sets(update.Set(&o.db.Book.DeletedAt, &deletedAt)).
+			Where(where.And(where.Equals(&o.db.Book.ID, id), where.Equals(&o.db.Book.DeletedAt, (*time.Time)(nil))))
+	})
+}
+
+func (o *GoeBenchmark) RestoreSoftDeleted(b *testing.B) {
+	benchmarkRestoreSoftDeleted(b, func(id int64) error {
+		return goe.Update(o.db.Book).
+			Sets(update.Set(&o.db.Book.DeletedAt, (*time.Time)(nil))).
+			Where(where.Equals(&o.db.Book.ID, id))
+	})
+}
+
+func (o *GoeBenchmark) FindNotDeletedByID(b *testing.B) {
+	benchmarkFindNotDeletedByID(b, o.findNotDeleted)
+}
+
+func (o *GoeBenchmark) findNotDeleted(id int64) (bool, error) {
+	books, err := goe.Select(o.db.Book).
+		From(o.db.Book).
+		Where(where.And(where.Equals(&o.db.Book.ID, id), where.Equals(&o.db.Book.DeletedAt, (*time.Time)(nil)))).
+		AsSlice()
+	return len(books) > 0, err
+}
+
+func (o *GoeBenchmark) Exists(b *testing.B) {
+	benchmarkExists(b, func(isbn string) (bool, error) {
+		// goe has no exists query, the count of the unique isbn is compared instead.
+		count, err := o.count(where.Equals(&o.db.Book.ISBN, isbn))
+		return count > 0, err
+	})
+}
+
+func (o *GoeBenchmark) Count(b *testing.B) {
+	benchmarkCount(b, func(genre string) (int, error) {
+		return o.count(where.Equals(&o.db.Book.Genre, genre))
+	})
+}
+
+// count counts the books matching the operation.
+func (o *GoeBenchmark) count(operation goemodel.Operation) (int, error) {
+	result, err := goe.Select(&struct{ *query.Count }{aggregate.Count(&o.db.Book.ID)}).
+		From(o.db.Book).
+		Where(operation).
+		AsSlice()
+	if err != nil || len(result) == 0 {
+		return 0, err
+	}
+	return int(result[0].Value), nil
+}
+
+func (o *GoeBenchmark) FindByIDs(b *testing.B) {
+	benchmarkFindByIDs(b, func(ids []int64) (int, error) {
+		books, err := goe.Select(o.db.Book).From(o.db.Book).Where(where.In(&o.db.Book.ID, ids)).AsSlice()
+		return len(books), err
+	})
+}
+
+func (o *GoeBenchmark) InsertDraft(b *testing.B) {
+	benchmarkInsertDraft(b, func(draft *model.Draft) error {
+		return goe.Insert(o.db.Draft).One(draft)
+	})
+}
+
+func (o *GoeBenchmark) FindDraftByID(b *testing.B) {
+	benchmarkFindDraftByID(b, func(id int64) error {
+		_, err := goe.Find(o.db.Draft).ById(model.Draft{ID: id})
+		return err
+	})
+}
+
+func (o *GoeBenchmark) FindDrafts(b *testing.B) {
+	benchmarkFindDrafts(b, func(limit int) (int, error) {
+		drafts, err := goe.Select(o.db.Draft).From(o.db.Draft).OrderByAsc(&o.db.Draft.ID).Take(limit).AsSlice()
+		return len(drafts), err
+	})
+}
+
+func (o *GoeBenchmark) InsertWide(b *testing.B) {
+	benchmarkInsertWide(b, func(wide *model.Wide) error {
+		return goe.Insert(o.db.Wide).One(wide)
+	})
+}
+
+func (o *GoeBenchmark) FindWideByID(b *testing.B) {
+	benchmarkFindWideByID(b, func(id int64) error {
+		_, err := goe.Find(o.db.Wide).ById(model.Wide{ID: id})
+		return err
+	})
+}
+
+func (o *GoeBenchmark) FindWides(b *testing.B) {
+	benchmarkFindWides(b, func(limit int) (int, error) {
+		wides, err := goe.Select(o.db.Wide).From(o.db.Wide).OrderByAsc(&o.db.Wide.ID).Take(limit).AsSlice()
+		return len(wides), err
+	})
+}
+
+func (o *GoeBenchmark) InsertUUIDClient(b *testing.B) {
+	benchmarkInsertUUIDClient(b, func(book *model.UUIDBook) error {
+		return goe.Insert(o.db.UuidBook).One((*UuidBook)(book))
+	})
+}
+
+func (o *GoeBenchmark) FindUUIDByID(b *testing.B) {
+	benchmarkFindUUIDByID(b, func(id uuid.UUID) error {
+		_, err := goe.Find(o.db.UuidBook).ById(UuidBook{ID: id})
+		return err
+	})
+}
+
+func (o *GoeBenchmark) DeleteUUID(b *testing.B) {
+	benchmarkDeleteUUID(b, func(id uuid.UUID) error {
+		return goe.Delete(o.db.UuidBook).Where(where.Equals(&o.db.UuidBook.ID, id))
+	})
+}
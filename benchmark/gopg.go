@@ -0,0 +1,684 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+)
+
+type GoPgBenchmark struct {
+	db  *pg.DB
+	ctx context.Context
+}
+
+func init() {
+	Register(Adapter{Name: "go-pg", Category: CategoryORM, New: NewGoPgBenchmark})
+}
+
+func NewGoPgBenchmark() Benchmark {
+	return &GoPgBenchmark{ctx: context.Background()}
+}
+
+func (o *GoPgBenchmark) Init() error {
+	opt, err := pg.ParseURL(utils.PostgresDSN)
+	if err != nil {
+		return err
+	}
+	o.db = pg.Connect(opt)
+	return o.db.Ping(o.ctx)
+}
+
+func (o *GoPgBenchmark) Close() error {
+	return o.db.Close()
+}
+
+func (o *GoPgBenchmark) Capabilities() Capabilities {
+	return nil
+}
+
+func (o *GoPgBenchmark) Insert(b *testing.B) {
+	book := model.NewBook()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		book.ID = 0
+		book.ISBN = model.NewISBN()
+		b.StartTimer()
+
+		_, err := o.db.ModelContext(o.ctx, book).Insert()
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (o *GoPgBenchmark) InsertBulk(b *testing.B) {
+	books := model.NewBooks(utils.BulkInsertNumber)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for _, book := range books {
+			book.ID = 0
+			book.ISBN = model.NewISBN()
+		}
+		b.StartTimer()
+
+		_, err := o.db.ModelContext(o.ctx, &books).Insert()
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (o *GoPgBenchmark) Update(b *testing.B) {
+	book := model.NewBook()
+
+	_, err := o.db.ModelContext(o.ctx, book).Insert()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = o.db.ModelContext(o.ctx, book).WherePK().Update()
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (o *GoPgBenchmark) Delete(b *testing.B) {
+	n := b.N
+	books := model.NewBooks(n)
+
+	_, err := o.db.ModelContext(o.ctx, &books).Insert()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var book *model.Book
+	for i := 0; i < n; i++ {
+		b.StopTimer()
+		book = new(model.Book)
+		book.ID = books[i].ID
+		b.StartTimer()
+
+		_, err = o.db.ModelContext(o.ctx, book).WherePK().Delete()
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (o *GoPgBenchmark) FindByID(b *testing.B) {
+	book := model.NewBook()
+	_, err := o.db.ModelContext(o.ctx, book).Insert()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for range utils.FindOneLoop {
+			err = o.db.ModelContext(o.ctx, book).Where("id = ?", book.ID).Select()
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+}
+
+func (o *GoPgBenchmark) FindPage(b *testing.B) {
+	books := model.NewBooks(utils.BulkInsertPageNumber)
+	_, err := o.db.ModelContext(o.ctx, &books).Insert()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	booksPage := make([]model.Book, 0, utils.PageSize)
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			// ent, sqlc and goe generates the slice inside, so all makes counts
+			booksPage = make([]model.Book, 0, utils.PageSize)
+
+			err = o.db.ModelContext(o.ctx, &booksPage).Where("id > ?", s).Limit(utils.PageSize).Select()
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+}
+
+func (o *GoPgBenchmark) FindWithPricePolicies(b *testing.B) {
+	book, err := seedBookWithPricePolicies()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		foundBook := new(model.Book)
+		err = o.db.ModelContext(o.ctx, foundBook).Relation("PricePolicies").Where("book.id = ?", book.ID).Select()
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+
+	report()
+}
+
+func (o *GoPgBenchmark) FindPageWithPricePoliciesNaive(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			var booksPage []model.Book
+			err = o.db.ModelContext(o.ctx, &booksPage).Where("id > ?", pageCursor(books, s)).Limit(utils.PageSize).Select()
+			for j := 0; err == nil && j < len(booksPage); j++ {
+				err = o.db.ModelContext(o.ctx, &booksPage[j].PricePolicies).
+					Where("book_id = ?", booksPage[j].ID).
+					Select()
+			}
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *GoPgBenchmark) FindPageWithPricePoliciesEager(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			var booksPage []model.Book
+			err = o.db.ModelContext(o.ctx, &booksPage).
+				Relation("PricePolicies").
+				Where("book.id > ?", pageCursor(books, s)).
+				Limit(utils.PageSize).
+				Select()
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *GoPgBenchmark) TransactionCommit(b *testing.B) {
+	benchmarkTransaction(b, true, o.doTransaction)
+}
+
+func (o *GoPgBenchmark) TransactionRollback(b *testing.B) {
+	benchmarkTransaction(b, false, o.doTransaction)
+}
+
+func (o *GoPgBenchmark) doTransaction(book *model.Book, commit bool) error {
+	return o.db.RunInTransaction(o.ctx, func(tx *pg.Tx) error {
+		_, err := tx.ModelContext(o.ctx, book).Insert()
+		if err != nil {
+			return err
+		}
+		policies := model.NewPricePolicies(book.ID, utils.TransactionPricePoliciesNumber)
+		_, err = tx.ModelContext(o.ctx, &policies).Insert()
+		if err != nil {
+			return err
+		}
+		_, err = tx.ModelContext(o.ctx, book).
+			Set("quantity = ?", updatedQuantity(book)).
+			WherePK().
+			Update()
+		if err != nil {
+			return err
+		}
+
+		if !commit {
+			return errRollback
+		}
+		return nil
+	})
+}
+
+func (o *GoPgBenchmark) Upsert(b *testing.B) {
+	benchmarkUpsert(b, func(book *model.Book) error {
+		_, err := o.db.ModelContext(o.ctx, book).
+			OnConflict("(isbn) DO UPDATE").
+			Set(upsertSet).
+			Insert()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) UpsertBulk(b *testing.B) {
+	benchmarkUpsertBulk(b, func(books []*model.Book) error {
+		_, err := o.db.ModelContext(o.ctx, &books).
+			OnConflict("(isbn) DO UPDATE").
+			Set(upsertSet).
+			Insert()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) UpdateBulk(b *testing.B) {
+	benchmarkUpdateBulk(b, func(ids []int64, quantity int) error {
+		_, err := o.db.ModelContext(o.ctx, (*model.Book)(nil)).
+			Set("quantity = ?", quantity).
+			Where("id = ANY(?)", pg.Array(ids)).
+			Update()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) UpdateBulkValues(b *testing.B) {
+	benchmarkUpdateBulkValues(b, func(ids []int64, quantities []int) error {
+		books := make([]model.Book, len(ids))
+		for i := range ids {
+			books[i].ID = ids[i]
+			books[i].Quantity = quantities[i]
+		}
+
+		_, err := o.db.ModelContext(o.ctx, &books).Column("quantity").Update()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) DeleteBulk(b *testing.B) {
+	benchmarkDeleteBulk(b, func(ids []int64) error {
+		_, err := o.db.ModelContext(o.ctx, (*model.Book)(nil)).
+			Where("id = ANY(?)", pg.Array(ids)).
+			Delete()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) DeleteBulkWhere(b *testing.B) {
+	benchmarkDeleteBulkWhere(b, func(genre string) error {
+		_, err := o.db.ModelContext(o.ctx, (*model.Book)(nil)).
+			Where("genre = ?", genre).
+			Delete()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) FindPageOffset(b *testing.B) {
+	benchmarkPageDepths(b, func(offset int, _ int64) error {
+		var booksPage []model.Book
+		_, err := o.db.ModelContext(o.ctx, &booksPage).
+			Order("id").
+			Limit(utils.PageSize).
+			Offset(offset).
+			SelectAndCount()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) FindPageKeyset(b *testing.B) {
+	benchmarkPageDepths(b, func(_ int, cursor int64) error {
+		var booksPage []model.Book
+		return o.db.ModelContext(o.ctx, &booksPage).
+			Where("id > ?", cursor).
+			Order("id").
+			Limit(utils.PageSize).
+			Select()
+	})
+}
+
+func (o *GoPgBenchmark) SummarizeGenres(b *testing.B) {
+	benchmarkSummarizeGenres(b, func() error {
+		var summaries []model.GenreSummary
+		return o.db.ModelContext(o.ctx, (*model.Book)(nil)).
+			ColumnExpr(genreSummaryColumns).
+			Group("genre").
+			Order("genre").
+			Select(&summaries)
+	})
+}
+
+func (o *GoPgBenchmark) Search(b *testing.B) {
+	benchmarkSearch(b, func(search bookSearch) error {
+		var books []model.Book
+		query := o.db.ModelContext(o.ctx, &books)
+		if search.author != "" {
+			query = query.Where("author = ?", search.author)
+		}
+		if search.genres != nil {
+			query = query.WhereIn("genre IN (?)", search.genres)
+		}
+		if search.minQuantity != nil {
+			query = query.Where("quantity >= ?", *search.minQuantity)
+		}
+		if search.maxQuantity != nil {
+			query = query.Where("quantity <= ?", *search.maxQuantity)
+		}
+		if !search.publishedFrom.IsZero() {
+			query = query.Where("publicized_at >= ?", search.publishedFrom)
+		}
+		if !search.publishedTo.IsZero() {
+			query = query.Where("publicized_at < ?", search.publishedTo)
+		}
+		if search.title != "" {
+			query = query.Where("title ILIKE ?", search.titlePattern())
+		}
+
+		return query.Order("publicized_at DESC").Limit(utils.PageSize).Select()
+	})
+}
+
+func (o *GoPgBenchmark) FindTitleByID(b *testing.B) {
+	benchmarkFindTitleByID(b, func(id int64) error {
+		var title model.BookTitle
+		return o.db.ModelContext(o.ctx, (*model.Book)(nil)).
+			Column("id", "title").
+			Where("id = ?", id).
+			Select(&title)
+	})
+}
+
+func (o *GoPgBenchmark) FindTitlePage(b *testing.B) {
+	benchmarkFindTitlePage(b, func(cursor int64) error {
+		var titles []model.BookTitle
+		return o.db.ModelContext(o.ctx, (*model.Book)(nil)).
+			Column("id", "title").
+			Where("id > ?", cursor).
+			Limit(utils.PageSize).
+			Select(&titles)
+	})
+}
+
+func (o *GoPgBenchmark) Stream(b *testing.B) {
+	benchmarkStream(b, func(limit int) (int, error) {
+		read := 0
+		err := o.db.ModelContext(o.ctx, (*model.Book)(nil)).
+			Order("id").
+			Limit(limit).
+			ForEach(func(book *model.Book) error {
+				read++
+				return nil
+			})
+		return read, err
+	})
+}
+
+func (o *GoPgBenchmark) UpdatePartial(b *testing.B) {
+	benchmarkUpdatePartial(b, func(book *model.Book) error {
+		_, err := o.db.ModelContext(o.ctx, book).Column("quantity").WherePK().Update()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) Decrement(b *testing.B) {
+	benchmarkDecrement(b, o.decrement)
+}
+
+func (o *GoPgBenchmark) DecrementConcurrent(b *testing.B) {
+	benchmarkDecrementConcurrent(b, o.decrement)
+}
+
+func (o *GoPgBenchmark) decrement(id int64) (int, error) {
+	book := model.Book{ID: id}
+	result, err := o.db.ModelContext(o.ctx, &book).
+		Set("quantity = quantity - 1").
+		WherePK().
+		Where("quantity > 0").
+		Returning("quantity").
+		Update()
+	if err == nil && result.RowsAffected() == 0 {
+		return 0, errOutOfStock
+	}
+	return book.Quantity, err
+}
+
+func (o *GoPgBenchmark) UpdateOptimistic(b *testing.B) {
+	benchmarkUpdateOptimistic(b, o.findBook, o.saveVersioned)
+}
+
+func (o *GoPgBenchmark) findBook(id int64) (*model.Book, error) {
+	book := new(model.Book)
+	err := o.db.ModelContext(o.ctx, book).Where("id = ?", id).Select()
+	return book, err
+}
+
+func (o *GoPgBenchmark) saveVersioned(book *model.Book) error {
+	result, err := o.db.ModelContext(o.ctx, book).
+		Set("quantity = ?quantity").
+		Set("version = version + 1").
+		WherePK().
+		Where("version = ?version").
+		Update()
+	if err == nil && result.RowsAffected() == 0 {
+		return errVersionConflict
+	}
+	return err
+}
+
+// goPgSoftDeletableBook maps the books table with the soft_delete option on deleted_at, which turns the deletes into
+// updates of the column and leaves the deleted books out of the queries. It is kept apart from model.Book, so the
+// other operations are not scoped.
+type goPgSoftDeletableBook struct {
+	tableName struct{} `pg:"books"`
+
+	ID           int64 `pg:"id,pk"`
+	ISBN         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int `pg:",use_zero"`
+	PublicizedAt time.Time
+	Version      int       `pg:",use_zero"`
+	DeletedAt    time.Time `pg:",soft_delete"`
+}
+
+func (o *GoPgBenchmark) SoftDelete(b *testing.B) {
+	benchmarkSoftDelete(b, func(id int64) error {
+		_, err := o.db.ModelContext(o.ctx, &goPgSoftDeletableBook{ID: id}).WherePK().Delete()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) RestoreSoftDeleted(b *testing.B) {
+	benchmarkRestoreSoftDeleted(b, func(id int64) error {
+		_, err := o.db.ModelContext(o.ctx, &goPgSoftDeletableBook{ID: id}).
+			Set("deleted_at = NULL").
+			WherePK().
+			AllWithDeleted().
+			Update()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) FindNotDeletedByID(b *testing.B) {
+	benchmarkFindNotDeletedByID(b, o.findNotDeleted)
+}
+
+func (o *GoPgBenchmark) findNotDeleted(id int64) (bool, error) {
+	book := new(goPgSoftDeletableBook)
+	err := o.db.ModelContext(o.ctx, book).Where("id = ?", id).Select()
+	if errors.Is(err, pg.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (o *GoPgBenchmark) Exists(b *testing.B) {
+	benchmarkExists(b, func(isbn string) (bool, error) {
+		return o.db.ModelContext(o.ctx, (*model.Book)(nil)).Where("isbn = ?", isbn).Exists()
+	})
+}
+
+func (o *GoPgBenchmark) Count(b *testing.B) {
+	benchmarkCount(b, func(genre string) (int, error) {
+		return o.db.ModelContext(o.ctx, (*model.Book)(nil)).Where("genre = ?", genre).Count()
+	})
+}
+
+func (o *GoPgBenchmark) FindByIDs(b *testing.B) {
+	benchmarkFindByIDs(b, func(ids []int64) (int, error) {
+		var books []model.Book
+		err := o.db.ModelContext(o.ctx, &books).Where("id IN (?)", pg.In(ids)).Select()
+		return len(books), err
+	})
+}
+
+func (o *GoPgBenchmark) InsertListing(b *testing.B) {
+	benchmarkInsertListing(b, func(listing *model.Listing) error {
+		_, err := o.db.ModelContext(o.ctx, listing).Insert()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) FindListingByID(b *testing.B) {
+	benchmarkFindListingByID(b, func(id int64) error {
+		listing := new(model.Listing)
+		return o.db.ModelContext(o.ctx, listing).Where("id = ?", id).Select()
+	})
+}
+
+func (o *GoPgBenchmark) UpdateListing(b *testing.B) {
+	benchmarkUpdateListing(b, func(listing *model.Listing) error {
+		_, err := o.db.ModelContext(o.ctx, listing).WherePK().Update()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) InsertDraft(b *testing.B) {
+	benchmarkInsertDraft(b, func(draft *model.Draft) error {
+		_, err := o.db.ModelContext(o.ctx, draft).Insert()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) FindDraftByID(b *testing.B) {
+	benchmarkFindDraftByID(b, func(id int64) error {
+		draft := new(model.Draft)
+		return o.db.ModelContext(o.ctx, draft).Where("id = ?", id).Select()
+	})
+}
+
+func (o *GoPgBenchmark) FindDrafts(b *testing.B) {
+	benchmarkFindDrafts(b, func(limit int) (int, error) {
+		var drafts []model.Draft
+		err := o.db.ModelContext(o.ctx, &drafts).Order("id").Limit(limit).Select()
+		return len(drafts), err
+	})
+}
+
+func (o *GoPgBenchmark) InsertWide(b *testing.B) {
+	benchmarkInsertWide(b, func(wide *model.Wide) error {
+		_, err := o.db.ModelContext(o.ctx, wide).Insert()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) FindWideByID(b *testing.B) {
+	benchmarkFindWideByID(b, func(id int64) error {
+		wide := new(model.Wide)
+		return o.db.ModelContext(o.ctx, wide).Where("id = ?", id).Select()
+	})
+}
+
+func (o *GoPgBenchmark) FindWides(b *testing.B) {
+	benchmarkFindWides(b, func(limit int) (int, error) {
+		var wides []model.Wide
+		err := o.db.ModelContext(o.ctx, &wides).Order("id").Limit(limit).Select()
+		return len(wides), err
+	})
+}
+
+func (o *GoPgBenchmark) InsertUUIDClient(b *testing.B) {
+	benchmarkInsertUUIDClient(b, func(book *model.UUIDBook) error {
+		_, err := o.db.ModelContext(o.ctx, book).Insert()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) InsertUUIDServer(b *testing.B) {
+	benchmarkInsertUUIDServer(b, func(book *model.UUIDBook) error {
+		// The key is zero, so go-pg leaves it to the default of the column and reads it back.
+		_, err := o.db.ModelContext(o.ctx, book).Insert()
+		return err
+	})
+}
+
+func (o *GoPgBenchmark) FindUUIDByID(b *testing.B) {
+	benchmarkFindUUIDByID(b, func(id uuid.UUID) error {
+		book := new(model.UUIDBook)
+		return o.db.ModelContext(o.ctx, book).Where("id = ?", id).Select()
+	})
+}
+
+func (o *GoPgBenchmark) DeleteUUID(b *testing.B) {
+	benchmarkDeleteUUID(b, func(id uuid.UUID) error {
+		_, err := o.db.ModelContext(o.ctx, &model.UUIDBook{ID: id}).WherePK().Delete()
+		return err
+	})
+}
@@ -0,0 +1,1245 @@
+package benchmark
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	// Postgres dialect.
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+)
+
+// GoquBenchmark builds every statement with goqu at runtime and executes it through pgx,
+// so the difference against PgxBenchmark is the query building overhead.
+type GoquBenchmark struct {
+	db      *pgxpool.Pool
+	dialect goqu.DialectWrapper
+	ctx     context.Context
+}
+
+func init() {
+	Register(Adapter{Name: "goqu", Category: CategoryBuilder, New: NewGoquBenchmark})
+}
+
+func NewGoquBenchmark() Benchmark {
+	return &GoquBenchmark{
+		dialect: goqu.Dialect("postgres"),
+		ctx:     context.Background(),
+	}
+}
+
+func (g *GoquBenchmark) Init() error {
+	var err error
+	g.db, err = pgxpool.New(g.ctx, utils.PostgresDSN)
+	return err
+}
+
+func (g *GoquBenchmark) Close() error {
+	g.db.Close()
+	return nil
+}
+
+func (g *GoquBenchmark) Capabilities() Capabilities {
+	return nil
+}
+
+func (g *GoquBenchmark) Insert(b *testing.B) {
+	book := model.NewBook()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		book.ISBN = model.NewISBN()
+		b.StartTimer()
+
+		query, args, err := g.dialect.
+			Insert("books").
+			Prepared(true).
+			Rows(goquRecord(book)).
+			ToSQL()
+		if err != nil {
+			b.Error(err)
+		}
+
+		_, err = g.db.Exec(g.ctx, query, args...)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (g *GoquBenchmark) InsertBulk(b *testing.B) {
+	books := model.NewBooks(utils.BulkInsertNumber)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for _, book := range books {
+			book.ISBN = model.NewISBN()
+		}
+		b.StartTimer()
+
+		err := g.doInsertBulk(books)
+
+		if err != nil {
+			b.Error(err)
+		}
+	}
+}
+
+func (g *GoquBenchmark) Update(b *testing.B) {
+	book := model.NewBook()
+	id, err := g.insertReturningID(book)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		query, args, err := g.dialect.
+			Update("books").
+			Prepared(true).
+			Set(goquRecord(book)).
+			Where(goqu.C("id").Eq(id)).
+			ToSQL()
+		if err != nil {
+			b.Error(err)
+		}
+
+		_, err = g.db.Exec(g.ctx, query, args...)
+
+		if err != nil {
+			b.Error(err)
+		}
+	}
+}
+
+func (g *GoquBenchmark) Delete(b *testing.B) {
+	book := model.NewBook()
+	savedIDs := make([]int64, b.N)
+	for i := 0; i < b.N; i++ {
+		book.ISBN = model.NewISBN()
+		id, err := g.insertReturningID(book)
+		if err != nil {
+			b.Error(err)
+		}
+		savedIDs[i] = id
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var bookID int64
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		bookID = savedIDs[i]
+		b.StartTimer()
+
+		query, args, err := g.dialect.Delete("books").Prepared(true).Where(goqu.C("id").Eq(bookID)).ToSQL()
+		if err != nil {
+			b.Error(err)
+		}
+
+		_, err = g.db.Exec(g.ctx, query, args...)
+
+		if err != nil {
+			b.Error(err)
+		}
+	}
+}
+
+func (g *GoquBenchmark) FindByID(b *testing.B) {
+	book := model.NewBook()
+	id, err := g.insertReturningID(book)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for range utils.FindOneLoop {
+			query, args, err := g.dialect.From("books").Prepared(true).Where(goqu.C("id").Eq(id)).ToSQL()
+			if err != nil {
+				b.Error(err)
+			}
+
+			var foundBook model.Book
+			err = g.db.QueryRow(g.ctx, query, args...).Scan(
+				&foundBook.ID,
+				&foundBook.ISBN,
+				&foundBook.Title,
+				&foundBook.Author,
+				&foundBook.Genre,
+				&foundBook.Quantity,
+				&foundBook.PublicizedAt,
+				&foundBook.Version,
+				&foundBook.DeletedAt,
+			)
+
+			if err != nil {
+				b.Error(err)
+			}
+		}
+	}
+}
+
+func (g *GoquBenchmark) FindPage(b *testing.B) {
+	books := model.NewBooks(utils.BulkInsertPageNumber)
+	if err := g.doInsertBulk(books); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			booksPage = make([]model.Book, 0, utils.PageSize)
+
+			query, args, err := g.dialect.
+				From("books").
+				Prepared(true).
+				Where(goqu.C("id").Gt(s)).
+				Limit(utils.PageSize).
+				ToSQL()
+			if err != nil {
+				b.Error(err)
+			}
+
+			result, err := g.db.Query(g.ctx, query, args...)
+			if err != nil {
+				b.Error(err)
+			}
+
+			for result.Next() {
+				var book model.Book
+				if err = result.Scan(
+					&book.ID,
+					&book.ISBN,
+					&book.Title,
+					&book.Author,
+					&book.Genre,
+					&book.Quantity,
+					&book.PublicizedAt,
+					&book.Version,
+					&book.DeletedAt,
+				); err != nil {
+					b.Error(err)
+				}
+				booksPage = append(booksPage, book)
+			}
+		}
+	}
+}
+
+func (g *GoquBenchmark) insertReturningID(book *model.Book) (int64, error) {
+	query, args, err := g.dialect.
+		Insert("books").
+		Prepared(true).
+		Rows(goquRecord(book)).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = g.db.QueryRow(g.ctx, query, args...).Scan(&id)
+	return id, err
+}
+
+func (g *GoquBenchmark) doInsertBulk(books []*model.Book) error {
+	rows := make([]interface{}, len(books))
+	for i, book := range books {
+		rows[i] = goquRecord(book)
+	}
+
+	query, args, err := g.dialect.Insert("books").Prepared(true).Rows(rows...).ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = g.db.Exec(g.ctx, query, args...)
+	return err
+}
+
+func goquRecord(book *model.Book) goqu.Record {
+	return goqu.Record{
+		"isbn":          book.ISBN,
+		"title":         book.Title,
+		"author":        book.Author,
+		"genre":         book.Genre,
+		"quantity":      book.Quantity,
+		"publicized_at": book.PublicizedAt,
+	}
+}
+
+func (g *GoquBenchmark) FindWithPricePolicies(b *testing.B) {
+	book, err := seedBookWithPricePolicies()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		query, args, err := g.dialect.
+			From(goqu.T("books").As("b")).
+			Prepared(true).
+			Select(
+				"b.id", "b.isbn", "b.title", "b.author", "b.genre", "b.quantity", "b.publicized_at",
+				"p.id", "p.book_id", "p.price", "p.start_date", "p.end_date",
+			).
+			Join(goqu.T("price_policies").As("p"), goqu.On(goqu.I("p.book_id").Eq(goqu.I("b.id")))).
+			Where(goqu.I("b.id").Eq(book.ID)).
+			ToSQL()
+		if err != nil {
+			b.Error(err)
+		}
+
+		result, err := g.db.Query(g.ctx, query, args...)
+		if err != nil {
+			b.Error(err)
+		}
+
+		_, err = scanBooksWithPricePolicies(result)
+		if err != nil {
+			b.Error(err)
+		}
+	}
+
+	report()
+}
+
+func (g *GoquBenchmark) FindPageWithPricePoliciesNaive(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			query, args, err := g.dialect.
+				From("books").
+				Prepared(true).
+				Where(goqu.C("id").Gt(pageCursor(books, s))).
+				Limit(utils.PageSize).
+				ToSQL()
+			if err != nil {
+				b.Error(err)
+			}
+
+			result, err := g.db.Query(g.ctx, query, args...)
+			if err != nil {
+				b.Error(err)
+			}
+
+			page, err := scanBooks(result)
+			if err != nil {
+				b.Error(err)
+			}
+
+			for j := range page {
+				query, args, err = g.dialect.
+					From("price_policies").
+					Prepared(true).
+					Where(goqu.C("book_id").Eq(page[j].ID)).
+					ToSQL()
+				if err != nil {
+					b.Error(err)
+				}
+
+				result, err = g.db.Query(g.ctx, query, args...)
+				if err != nil {
+					b.Error(err)
+				}
+
+				page[j].PricePolicies, err = scanPricePolicies(result)
+				if err != nil {
+					b.Error(err)
+				}
+			}
+		}
+	}
+
+	report()
+}
+
+func (g *GoquBenchmark) FindPageWithPricePoliciesEager(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			page := g.dialect.
+				From("books").
+				Where(goqu.C("id").Gt(pageCursor(books, s))).
+				Order(goqu.C("id").Asc()).
+				Limit(utils.PageSize)
+
+			query, args, err := g.dialect.
+				From(page.As("b")).
+				Prepared(true).
+				Select(
+					"b.id", "b.isbn", "b.title", "b.author", "b.genre", "b.quantity", "b.publicized_at",
+					"p.id", "p.book_id", "p.price", "p.start_date", "p.end_date",
+				).
+				Join(goqu.T("price_policies").As("p"), goqu.On(goqu.I("p.book_id").Eq(goqu.I("b.id")))).
+				Order(goqu.I("b.id").Asc()).
+				ToSQL()
+			if err != nil {
+				b.Error(err)
+			}
+
+			result, err := g.db.Query(g.ctx, query, args...)
+			if err != nil {
+				b.Error(err)
+			}
+
+			_, err = scanBooksWithPricePolicies(result)
+			if err != nil {
+				b.Error(err)
+			}
+		}
+	}
+
+	report()
+}
+
+func (g *GoquBenchmark) TransactionCommit(b *testing.B) {
+	benchmarkTransaction(b, true, g.doTransaction)
+}
+
+func (g *GoquBenchmark) TransactionRollback(b *testing.B) {
+	benchmarkTransaction(b, false, g.doTransaction)
+}
+
+func (g *GoquBenchmark) doTransaction(book *model.Book, commit bool) error {
+	tx, err := g.db.Begin(g.ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// it is a no-op once the transaction is committed
+		_ = tx.Rollback(g.ctx)
+	}()
+
+	query, args, err := g.dialect.
+		Insert("books").
+		Prepared(true).
+		Rows(goquRecord(book)).
+		Returning("id").
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	if err = tx.QueryRow(g.ctx, query, args...).Scan(&book.ID); err != nil {
+		return err
+	}
+
+	policies := model.NewPricePolicies(book.ID, utils.TransactionPricePoliciesNumber)
+	rows := make([]interface{}, len(policies))
+	for i, policy := range policies {
+		rows[i] = goqu.Record{
+			"book_id":    policy.BookID,
+			"price":      policy.Price,
+			"start_date": policy.StartDate,
+			"end_date":   policy.EndDate,
+		}
+	}
+	query, args, err = g.dialect.Insert("price_policies").Prepared(true).Rows(rows...).ToSQL()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(g.ctx, query, args...); err != nil {
+		return err
+	}
+
+	query, args, err = g.dialect.
+		Update("books").
+		Prepared(true).
+		Set(goqu.Record{"quantity": updatedQuantity(book)}).
+		Where(goqu.C("id").Eq(book.ID)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(g.ctx, query, args...); err != nil {
+		return err
+	}
+
+	if !commit {
+		return tx.Rollback(g.ctx)
+	}
+	return tx.Commit(g.ctx)
+}
+
+func (g *GoquBenchmark) Upsert(b *testing.B) {
+	benchmarkUpsert(b, func(book *model.Book) error {
+		query, args, err := g.dialect.
+			Insert("books").
+			Prepared(true).
+			Rows(goquRecord(book)).
+			OnConflict(goquUpsert()).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+
+		_, err = g.db.Exec(g.ctx, query, args...)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) UpsertBulk(b *testing.B) {
+	benchmarkUpsertBulk(b, func(books []*model.Book) error {
+		rows := make([]interface{}, len(books))
+		for i, book := range books {
+			rows[i] = goquRecord(book)
+		}
+
+		query, args, err := g.dialect.
+			Insert("books").
+			Prepared(true).
+			Rows(rows...).
+			OnConflict(goquUpsert()).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+
+		_, err = g.db.Exec(g.ctx, query, args...)
+		return err
+	})
+}
+
+// goquUpsert updates the columns of a book whose ISBN already exists.
+func goquUpsert() exp.ConflictExpression {
+	record := make(goqu.Record, len(upsertColumns))
+	for _, column := range upsertColumns {
+		record[column] = goqu.I("excluded." + column)
+	}
+	return goqu.DoUpdate("isbn", record)
+}
+
+func (g *GoquBenchmark) UpdateBulk(b *testing.B) {
+	benchmarkUpdateBulk(b, func(ids []int64, quantity int) error {
+		// goqu expands slices into lists, so the ids are selected with IN instead of ANY.
+		query, args, err := g.dialect.
+			Update("books").
+			Prepared(true).
+			Set(goqu.Record{"quantity": quantity}).
+			Where(goqu.C("id").In(ids)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+
+		_, err = g.db.Exec(g.ctx, query, args...)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) UpdateBulkValues(b *testing.B) {
+	benchmarkUpdateBulkValues(b, func(ids []int64, quantities []int) error {
+		quantity := goqu.Case().Value(goqu.C("id"))
+		for i := range ids {
+			quantity = quantity.When(goqu.Cast(goqu.V(ids[i]), "INTEGER"), goqu.Cast(goqu.V(quantities[i]), "INTEGER"))
+		}
+
+		query, args, err := g.dialect.
+			Update("books").
+			Prepared(true).
+			Set(goqu.Record{"quantity": quantity}).
+			Where(goqu.C("id").In(ids)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+
+		_, err = g.db.Exec(g.ctx, query, args...)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) DeleteBulk(b *testing.B) {
+	benchmarkDeleteBulk(b, func(ids []int64) error {
+		query, args, err := g.dialect.
+			Delete("books").
+			Prepared(true).
+			Where(goqu.C("id").In(ids)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+
+		_, err = g.db.Exec(g.ctx, query, args...)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) DeleteBulkWhere(b *testing.B) {
+	benchmarkDeleteBulkWhere(b, func(genre string) error {
+		query, args, err := g.dialect.
+			Delete("books").
+			Prepared(true).
+			Where(goqu.C("genre").Eq(genre)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+
+		_, err = g.db.Exec(g.ctx, query, args...)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) FindPageOffset(b *testing.B) {
+	benchmarkPageDepths(b, func(offset int, _ int64) error {
+		query, args, err := g.dialect.
+			From("books").
+			Prepared(true).
+			Order(goqu.C("id").Asc()).
+			Limit(utils.PageSize).
+			Offset(uint(offset)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		rows, err := g.db.Query(g.ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		if _, err = scanBooks(rows); err != nil {
+			return err
+		}
+
+		query, args, err = g.dialect.From("books").Prepared(true).Select(goqu.COUNT("*")).ToSQL()
+		if err != nil {
+			return err
+		}
+		var total int64
+		return g.db.QueryRow(g.ctx, query, args...).Scan(&total)
+	})
+}
+
+func (g *GoquBenchmark) FindPageKeyset(b *testing.B) {
+	benchmarkPageDepths(b, func(_ int, cursor int64) error {
+		query, args, err := g.dialect.
+			From("books").
+			Prepared(true).
+			Where(goqu.C("id").Gt(cursor)).
+			Order(goqu.C("id").Asc()).
+			Limit(utils.PageSize).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		rows, err := g.db.Query(g.ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		_, err = scanBooks(rows)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) SummarizeGenres(b *testing.B) {
+	benchmarkSummarizeGenres(b, func() error {
+		query, args, err := g.dialect.
+			From("books").
+			Prepared(true).
+			Select(
+				goqu.C("genre"),
+				goqu.COUNT("*").As("books"),
+				goqu.SUM("quantity").As("quantity"),
+				goqu.MIN("publicized_at").As("first_publicized_at"),
+				goqu.MAX("publicized_at").As("last_publicized_at"),
+			).
+			GroupBy("genre").
+			Order(goqu.C("genre").Asc()).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		rows, err := g.db.Query(g.ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		_, err = scanGenreSummaries(rows)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) Search(b *testing.B) {
+	benchmarkSearch(b, func(search bookSearch) error {
+		var filters []exp.Expression
+		if search.author != "" {
+			filters = append(filters, goqu.C("author").Eq(search.author))
+		}
+		if search.genres != nil {
+			filters = append(filters, goqu.C("genre").In(search.genres))
+		}
+		if search.minQuantity != nil {
+			filters = append(filters, goqu.C("quantity").Gte(*search.minQuantity))
+		}
+		if search.maxQuantity != nil {
+			filters = append(filters, goqu.C("quantity").Lte(*search.maxQuantity))
+		}
+		if !search.publishedFrom.IsZero() {
+			filters = append(filters, goqu.C("publicized_at").Gte(search.publishedFrom))
+		}
+		if !search.publishedTo.IsZero() {
+			filters = append(filters, goqu.C("publicized_at").Lt(search.publishedTo))
+		}
+		if search.title != "" {
+			filters = append(filters, goqu.C("title").ILike(search.titlePattern()))
+		}
+
+		query, args, err := g.dialect.
+			From("books").
+			Prepared(true).
+			Where(filters...).
+			Order(goqu.C("publicized_at").Desc()).
+			Limit(utils.PageSize).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		rows, err := g.db.Query(g.ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		_, err = scanBooks(rows)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) FindTitleByID(b *testing.B) {
+	benchmarkFindTitleByID(b, func(id int64) error {
+		query, args, err := g.dialect.
+			From("books").
+			Prepared(true).
+			Select("id", "title").
+			Where(goqu.C("id").Eq(id)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		var title model.BookTitle
+		return g.db.QueryRow(g.ctx, query, args...).Scan(&title.ID, &title.Title)
+	})
+}
+
+func (g *GoquBenchmark) FindTitlePage(b *testing.B) {
+	benchmarkFindTitlePage(b, func(cursor int64) error {
+		query, args, err := g.dialect.
+			From("books").
+			Prepared(true).
+			Select("id", "title").
+			Where(goqu.C("id").Gt(cursor)).
+			Limit(utils.PageSize).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		rows, err := g.db.Query(g.ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		_, err = scanBookTitles(rows)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) Stream(b *testing.B) {
+	benchmarkStream(b, func(limit int) (int, error) {
+		query, args, err := g.dialect.
+			From("books").
+			Prepared(true).
+			Order(goqu.C("id").Asc()).
+			Limit(uint(limit)).
+			ToSQL()
+		if err != nil {
+			return 0, err
+		}
+		rows, err := g.db.Query(g.ctx, query, args...)
+		if err != nil {
+			return 0, err
+		}
+		return streamBooks(rows)
+	})
+}
+
+func (g *GoquBenchmark) UpdatePartial(b *testing.B) {
+	benchmarkUpdatePartial(b, func(book *model.Book) error {
+		query, args, err := g.dialect.
+			Update("books").
+			Prepared(true).
+			Set(goqu.Record{"quantity": book.Quantity}).
+			Where(goqu.C("id").Eq(book.ID)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		_, err = g.db.Exec(g.ctx, query, args...)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) Decrement(b *testing.B) {
+	benchmarkDecrement(b, g.decrement)
+}
+
+func (g *GoquBenchmark) DecrementConcurrent(b *testing.B) {
+	benchmarkDecrementConcurrent(b, g.decrement)
+}
+
+func (g *GoquBenchmark) decrement(id int64) (int, error) {
+	query, args, err := g.dialect.
+		Update("books").
+		Prepared(true).
+		Set(goqu.Record{"quantity": goqu.L("quantity - 1")}).
+		Where(goqu.C("id").Eq(id), goqu.C("quantity").Gt(0)).
+		Returning("quantity").
+		ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	var quantity int
+	err = g.db.QueryRow(g.ctx, query, args...).Scan(&quantity)
+	return quantity, err
+}
+
+func (g *GoquBenchmark) UpdateOptimistic(b *testing.B) {
+	benchmarkUpdateOptimistic(b, g.findBook, g.saveVersioned)
+}
+
+func (g *GoquBenchmark) findBook(id int64) (*model.Book, error) {
+	query, args, err := g.dialect.
+		From("books").
+		Prepared(true).
+		Where(goqu.C("id").Eq(id)).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	return scanBook(g.db.QueryRow(g.ctx, query, args...))
+}
+
+func (g *GoquBenchmark) saveVersioned(book *model.Book) error {
+	query, args, err := g.dialect.
+		Update("books").
+		Prepared(true).
+		Set(goqu.Record{"quantity": book.Quantity, "version": goqu.L("version + 1")}).
+		Where(goqu.C("id").Eq(book.ID), goqu.C("version").Eq(book.Version)).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+	tag, err := g.db.Exec(g.ctx, query, args...)
+	if err == nil && tag.RowsAffected() == 0 {
+		return errVersionConflict
+	}
+	return err
+}
+
+func (g *GoquBenchmark) SoftDelete(b *testing.B) {
+	benchmarkSoftDelete(b, func(id int64) error {
+		query, args, err := g.dialect.
+			Update("books").
+			Prepared(true).
+			Set(goqu.Record{"deleted_at": time.Now()}).
+			Where(goqu.C("id").Eq(id), goqu.C("deleted_at").IsNull()).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		_, err = g.db.Exec(g.ctx, query, args...)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) RestoreSoftDeleted(b *testing.B) {
+	benchmarkRestoreSoftDeleted(b, func(id int64) error {
+		query, args, err := g.dialect.
+			Update("books").
+			Prepared(true).
+			Set(goqu.Record{"deleted_at": nil}).
+			Where(goqu.C("id").Eq(id)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		_, err = g.db.Exec(g.ctx, query, args...)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) FindNotDeletedByID(b *testing.B) {
+	benchmarkFindNotDeletedByID(b, g.findNotDeleted)
+}
+
+func (g *GoquBenchmark) findNotDeleted(id int64) (bool, error) {
+	query, args, err := g.dialect.
+		From("books").
+		Prepared(true).
+		Where(goqu.C("id").Eq(id), goqu.C("deleted_at").IsNull()).
+		ToSQL()
+	if err != nil {
+		return false, err
+	}
+	_, err = scanBook(g.db.QueryRow(g.ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (g *GoquBenchmark) Exists(b *testing.B) {
+	benchmarkExists(b, func(isbn string) (bool, error) {
+		books := g.dialect.
+			From("books").
+			Select(goqu.L("1")).
+			Where(goqu.C("isbn").Eq(isbn))
+		query, args, err := g.dialect.
+			Select(goqu.L("EXISTS ?", books)).
+			Prepared(true).
+			ToSQL()
+		if err != nil {
+			return false, err
+		}
+		var exists bool
+		err = g.db.QueryRow(g.ctx, query, args...).Scan(&exists)
+		return exists, err
+	})
+}
+
+func (g *GoquBenchmark) Count(b *testing.B) {
+	benchmarkCount(b, func(genre string) (int, error) {
+		query, args, err := g.dialect.
+			From("books").
+			Prepared(true).
+			Select(goqu.COUNT(goqu.Star())).
+			Where(goqu.C("genre").Eq(genre)).
+			ToSQL()
+		if err != nil {
+			return 0, err
+		}
+		var count int
+		err = g.db.QueryRow(g.ctx, query, args...).Scan(&count)
+		return count, err
+	})
+}
+
+func (g *GoquBenchmark) FindByIDs(b *testing.B) {
+	benchmarkFindByIDs(b, func(ids []int64) (int, error) {
+		query, args, err := g.dialect.
+			From("books").
+			Prepared(true).
+			Where(goqu.C("id").In(ids)).
+			ToSQL()
+		if err != nil {
+			return 0, err
+		}
+		rows, err := g.db.Query(g.ctx, query, args...)
+		if err != nil {
+			return 0, err
+		}
+		books, err := scanBooks(rows)
+		return len(books), err
+	})
+}
+
+func (g *GoquBenchmark) InsertListing(b *testing.B) {
+	benchmarkInsertListing(b, func(listing *model.Listing) error {
+		record, err := newListingRecord(listing)
+		if err != nil {
+			return err
+		}
+		query, args, err := g.dialect.
+			Insert("listings").
+			Prepared(true).
+			Rows(record).
+			Returning("id").
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		return g.db.QueryRow(g.ctx, query, args...).Scan(&listing.ID)
+	})
+}
+
+func (g *GoquBenchmark) FindListingByID(b *testing.B) {
+	benchmarkFindListingByID(b, func(id int64) error {
+		query, args, err := g.dialect.
+			From("listings").
+			Prepared(true).
+			Where(goqu.C("id").Eq(id)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		_, err = scanListing(g.db.QueryRow(g.ctx, query, args...))
+		return err
+	})
+}
+
+func (g *GoquBenchmark) UpdateListing(b *testing.B) {
+	benchmarkUpdateListing(b, func(listing *model.Listing) error {
+		record, err := newListingRecord(listing)
+		if err != nil {
+			return err
+		}
+		query, args, err := g.dialect.
+			Update("listings").
+			Prepared(true).
+			Set(record).
+			Where(goqu.C("id").Eq(listing.ID)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		_, err = g.db.Exec(g.ctx, query, args...)
+		return err
+	})
+}
+
+// newListingRecord returns the columns of a listing for goqu, which renders structs and slices other than bytes as
+// SQL rather than passing them to the driver: the attributes are encoded to JSON beforehand, and the tags are
+// passed as a model.TextArray for goqu to call its driver.Valuer.
+func newListingRecord(listing *model.Listing) (goqu.Record, error) {
+	attributes, err := json.Marshal(listing.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	return goqu.Record{
+		"reference":       listing.Reference,
+		"attributes":      attributes,
+		"tags":            listing.Tags,
+		"price":           listing.Price,
+		"cover":           listing.Cover,
+		"subtitle":        listing.Subtitle,
+		"discontinued_at": listing.DiscontinuedAt,
+		"listed_at":       listing.ListedAt,
+	}, nil
+}
+
+func (g *GoquBenchmark) InsertDraft(b *testing.B) {
+	benchmarkInsertDraft(b, func(draft *model.Draft) error {
+		query, args, err := g.dialect.
+			Insert("drafts").
+			Prepared(true).
+			Rows(goqu.Record{
+				"title":        draft.Title,
+				"subtitle":     draft.Subtitle,
+				"author":       draft.Author,
+				"translator":   draft.Translator,
+				"pages":        draft.Pages,
+				"rating":       draft.Rating,
+				"illustrated":  draft.Illustrated,
+				"submitted_at": draft.SubmittedAt,
+			}).
+			Returning("id").
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		return g.db.QueryRow(g.ctx, query, args...).Scan(&draft.ID)
+	})
+}
+
+func (g *GoquBenchmark) FindDraftByID(b *testing.B) {
+	benchmarkFindDraftByID(b, func(id int64) error {
+		query, args, err := g.dialect.
+			From("drafts").
+			Prepared(true).
+			Where(goqu.C("id").Eq(id)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		_, err = scanDraft(g.db.QueryRow(g.ctx, query, args...))
+		return err
+	})
+}
+
+func (g *GoquBenchmark) FindDrafts(b *testing.B) {
+	benchmarkFindDrafts(b, func(limit int) (int, error) {
+		query, args, err := g.dialect.
+			From("drafts").
+			Prepared(true).
+			Order(goqu.C("id").Asc()).
+			Limit(uint(limit)).
+			ToSQL()
+		if err != nil {
+			return 0, err
+		}
+		rows, err := g.db.Query(g.ctx, query, args...)
+		if err != nil {
+			return 0, err
+		}
+		return collectDrafts(rows, limit)
+	})
+}
+
+func (g *GoquBenchmark) InsertWide(b *testing.B) {
+	benchmarkInsertWide(b, func(wide *model.Wide) error {
+		record := make(goqu.Record, len(model.WideColumns))
+		for i, value := range wide.Values() {
+			record[model.WideColumns[i]] = value
+		}
+		query, args, err := g.dialect.
+			Insert("wides").
+			Prepared(true).
+			Rows(record).
+			Returning("id").
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		return g.db.QueryRow(g.ctx, query, args...).Scan(&wide.ID)
+	})
+}
+
+func (g *GoquBenchmark) FindWideByID(b *testing.B) {
+	benchmarkFindWideByID(b, func(id int64) error {
+		query, args, err := g.dialect.
+			From("wides").
+			Prepared(true).
+			Where(goqu.C("id").Eq(id)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		wide := new(model.Wide)
+		return g.db.QueryRow(g.ctx, query, args...).Scan(wide.Fields()...)
+	})
+}
+
+func (g *GoquBenchmark) FindWides(b *testing.B) {
+	benchmarkFindWides(b, func(limit int) (int, error) {
+		query, args, err := g.dialect.
+			From("wides").
+			Prepared(true).
+			Order(goqu.C("id").Asc()).
+			Limit(uint(limit)).
+			ToSQL()
+		if err != nil {
+			return 0, err
+		}
+		rows, err := g.db.Query(g.ctx, query, args...)
+		if err != nil {
+			return 0, err
+		}
+		return collectWides(rows, limit)
+	})
+}
+
+func (g *GoquBenchmark) InsertUUIDClient(b *testing.B) {
+	benchmarkInsertUUIDClient(b, func(book *model.UUIDBook) error {
+		record := goquUUIDRecord(book)
+		record["id"] = book.ID
+		query, args, err := g.dialect.
+			Insert("uuid_books").
+			Prepared(true).
+			Rows(record).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		_, err = g.db.Exec(g.ctx, query, args...)
+		return err
+	})
+}
+
+func (g *GoquBenchmark) InsertUUIDServer(b *testing.B) {
+	benchmarkInsertUUIDServer(b, func(book *model.UUIDBook) error {
+		query, args, err := g.dialect.
+			Insert("uuid_books").
+			Prepared(true).
+			Rows(goquUUIDRecord(book)).
+			Returning("id").
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		return g.db.QueryRow(g.ctx, query, args...).Scan(&book.ID)
+	})
+}
+
+func (g *GoquBenchmark) FindUUIDByID(b *testing.B) {
+	benchmarkFindUUIDByID(b, func(id uuid.UUID) error {
+		query, args, err := g.dialect.
+			From("uuid_books").
+			Prepared(true).
+			Where(goqu.C("id").Eq(id)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		_, err = scanUUIDBook(g.db.QueryRow(g.ctx, query, args...))
+		return err
+	})
+}
+
+func (g *GoquBenchmark) DeleteUUID(b *testing.B) {
+	benchmarkDeleteUUID(b, func(id uuid.UUID) error {
+		query, args, err := g.dialect.
+			Delete("uuid_books").
+			Prepared(true).
+			Where(goqu.C("id").Eq(id)).
+			ToSQL()
+		if err != nil {
+			return err
+		}
+		_, err = g.db.Exec(g.ctx, query, args...)
+		return err
+	})
+}
+
+// goquUUIDRecord returns the columns of a book keyed by a UUID, but the key.
+func goquUUIDRecord(book *model.UUIDBook) goqu.Record {
+	return goqu.Record{
+		"isbn":          book.ISBN,
+		"title":         book.Title,
+		"author":        book.Author,
+		"genre":         book.Genre,
+		"quantity":      book.Quantity,
+		"publicized_at": book.PublicizedAt,
+	}
+}
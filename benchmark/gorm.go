@@ -1,13 +1,18 @@
 package benchmark
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
 	"github.com/lauro-santana/golang-orm-benchmarks/model"
 
+	"github.com/google/uuid"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 )
 
@@ -15,6 +20,10 @@ type GormBenchmark struct {
 	db *gorm.DB
 }
 
+func init() {
+	Register(Adapter{Name: "gorm", Category: CategoryORM, New: NewGormBenchmark})
+}
+
 func NewGormBenchmark() Benchmark {
 	return &GormBenchmark{}
 }
@@ -42,6 +51,12 @@ func (o *GormBenchmark) Close() error {
 	return sqlDB.Close()
 }
 
+func (o *GormBenchmark) Capabilities() Capabilities {
+	return Capabilities{
+		SelectExistsOp: Emulated,
+	}
+}
+
 func (o *GormBenchmark) Insert(b *testing.B) {
 	book := model.NewBook()
 
@@ -51,6 +66,7 @@ func (o *GormBenchmark) Insert(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
 		book.ID = 0
+		book.ISBN = model.NewISBN()
 		b.StartTimer()
 
 		err := o.db.Create(book).Error
@@ -73,6 +89,7 @@ func (o *GormBenchmark) InsertBulk(b *testing.B) {
 		b.StopTimer()
 		for _, book := range books {
 			book.ID = 0
+			book.ISBN = model.NewISBN()
 		}
 		b.StartTimer()
 
@@ -192,3 +209,475 @@ func (o *GormBenchmark) FindPage(b *testing.B) {
 		}
 	}
 }
+
+func (o *GormBenchmark) FindWithPricePolicies(b *testing.B) {
+	book, err := seedBookWithPricePolicies()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var foundBook model.Book
+		err = o.db.Preload("PricePolicies").First(&foundBook, book.ID).Error
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+
+	report()
+}
+
+func (o *GormBenchmark) FindPageWithPricePoliciesNaive(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			var booksPage []model.Book
+			err = o.db.Limit(utils.PageSize).Where("id > ?", pageCursor(books, s)).Find(&booksPage).Error
+			for j := 0; err == nil && j < len(booksPage); j++ {
+				err = o.db.Model(&booksPage[j]).Association("PricePolicies").Find(&booksPage[j].PricePolicies)
+			}
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *GormBenchmark) FindPageWithPricePoliciesEager(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			var booksPage []model.Book
+			err = o.db.Preload("PricePolicies").Limit(utils.PageSize).Where("id > ?", pageCursor(books, s)).Find(&booksPage).Error
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *GormBenchmark) TransactionCommit(b *testing.B) {
+	benchmarkTransaction(b, true, o.doTransaction)
+}
+
+func (o *GormBenchmark) TransactionRollback(b *testing.B) {
+	benchmarkTransaction(b, false, o.doTransaction)
+}
+
+func (o *GormBenchmark) doTransaction(book *model.Book, commit bool) error {
+	return o.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(book).Error
+		if err != nil {
+			return err
+		}
+		policies := model.NewPricePolicies(book.ID, utils.TransactionPricePoliciesNumber)
+		err = tx.Create(&policies).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(book).Update("quantity", updatedQuantity(book)).Error
+		if err != nil {
+			return err
+		}
+
+		if !commit {
+			return errRollback
+		}
+		return nil
+	})
+}
+
+func (o *GormBenchmark) Upsert(b *testing.B) {
+	benchmarkUpsert(b, func(book *model.Book) error {
+		return o.db.Clauses(gormUpsert).Create(book).Error
+	})
+}
+
+func (o *GormBenchmark) UpsertBulk(b *testing.B) {
+	benchmarkUpsertBulk(b, func(books []*model.Book) error {
+		return o.db.Clauses(gormUpsert).Create(books).Error
+	})
+}
+
+// gormUpsert updates the columns of a book whose ISBN already exists.
+var gormUpsert = clause.OnConflict{
+	Columns:   []clause.Column{{Name: "isbn"}},
+	DoUpdates: clause.AssignmentColumns(upsertColumns),
+}
+
+func (o *GormBenchmark) UpdateBulk(b *testing.B) {
+	benchmarkUpdateBulk(b, func(ids []int64, quantity int) error {
+		// gorm expands slices into lists, so the ids are selected with IN instead of ANY.
+		return o.db.Model(&model.Book{}).Where("id IN ?", ids).Update("quantity", quantity).Error
+	})
+}
+
+func (o *GormBenchmark) UpdateBulkValues(b *testing.B) {
+	benchmarkUpdateBulkValues(b, func(ids []int64, quantities []int) error {
+		// gorm has no bulk update of different values, the CASE expression is written by hand.
+		var quantity strings.Builder
+		args := make([]interface{}, 0, len(ids)*2)
+		quantity.WriteString("CASE id")
+		for i := range ids {
+			quantity.WriteString(" WHEN ? THEN ?::INTEGER")
+			args = append(args, ids[i], quantities[i])
+		}
+		quantity.WriteString(" END")
+
+		return o.db.Model(&model.Book{}).
+			Where("id IN ?", ids).
+			Update("quantity", gorm.Expr(quantity.String(), args...)).
+			Error
+	})
+}
+
+func (o *GormBenchmark) DeleteBulk(b *testing.B) {
+	benchmarkDeleteBulk(b, func(ids []int64) error {
+		return o.db.Delete(&model.Book{}, ids).Error
+	})
+}
+
+func (o *GormBenchmark) DeleteBulkWhere(b *testing.B) {
+	benchmarkDeleteBulkWhere(b, func(genre string) error {
+		return o.db.Where("genre = ?", genre).Delete(&model.Book{}).Error
+	})
+}
+
+func (o *GormBenchmark) FindPageOffset(b *testing.B) {
+	benchmarkPageDepths(b, func(offset int, _ int64) error {
+		var booksPage []model.Book
+		err := o.db.Order("id").Limit(utils.PageSize).Offset(offset).Find(&booksPage).Error
+		if err != nil {
+			return err
+		}
+
+		var total int64
+		return o.db.Model(&model.Book{}).Count(&total).Error
+	})
+}
+
+func (o *GormBenchmark) FindPageKeyset(b *testing.B) {
+	benchmarkPageDepths(b, func(_ int, cursor int64) error {
+		var booksPage []model.Book
+		return o.db.Where("id > ?", cursor).Order("id").Limit(utils.PageSize).Find(&booksPage).Error
+	})
+}
+
+func (o *GormBenchmark) SummarizeGenres(b *testing.B) {
+	benchmarkSummarizeGenres(b, func() error {
+		var summaries []model.GenreSummary
+		return o.db.Model(&model.Book{}).
+			Select(genreSummaryColumns).
+			Group("genre").
+			Order("genre").
+			Scan(&summaries).
+			Error
+	})
+}
+
+func (o *GormBenchmark) Search(b *testing.B) {
+	benchmarkSearch(b, func(search bookSearch) error {
+		query := o.db.Model(&model.Book{})
+		if search.author != "" {
+			query = query.Where("author = ?", search.author)
+		}
+		if search.genres != nil {
+			query = query.Where("genre IN ?", search.genres)
+		}
+		if search.minQuantity != nil {
+			query = query.Where("quantity >= ?", *search.minQuantity)
+		}
+		if search.maxQuantity != nil {
+			query = query.Where("quantity <= ?", *search.maxQuantity)
+		}
+		if !search.publishedFrom.IsZero() {
+			query = query.Where("publicized_at >= ?", search.publishedFrom)
+		}
+		if !search.publishedTo.IsZero() {
+			query = query.Where("publicized_at < ?", search.publishedTo)
+		}
+		if search.title != "" {
+			query = query.Where("title ILIKE ?", search.titlePattern())
+		}
+
+		var books []model.Book
+		return query.Order("publicized_at DESC").Limit(utils.PageSize).Find(&books).Error
+	})
+}
+
+func (o *GormBenchmark) FindTitleByID(b *testing.B) {
+	benchmarkFindTitleByID(b, func(id int64) error {
+		var title model.BookTitle
+		return o.db.Model(&model.Book{}).Select("id", "title").Where("id = ?", id).Scan(&title).Error
+	})
+}
+
+func (o *GormBenchmark) FindTitlePage(b *testing.B) {
+	benchmarkFindTitlePage(b, func(cursor int64) error {
+		var titles []model.BookTitle
+		return o.db.Model(&model.Book{}).
+			Select("id", "title").
+			Where("id > ?", cursor).
+			Limit(utils.PageSize).
+			Scan(&titles).
+			Error
+	})
+}
+
+func (o *GormBenchmark) Stream(b *testing.B) {
+	benchmarkStream(b, func(limit int) (int, error) {
+		rows, err := o.db.Model(&model.Book{}).Order("id").Limit(limit).Rows()
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+
+		read := 0
+		for rows.Next() {
+			var book model.Book
+			if err = o.db.ScanRows(rows, &book); err != nil {
+				return read, err
+			}
+			read++
+		}
+		return read, rows.Err()
+	})
+}
+
+func (o *GormBenchmark) UpdatePartial(b *testing.B) {
+	benchmarkUpdatePartial(b, func(book *model.Book) error {
+		return o.db.Model(book).UpdateColumn("quantity", book.Quantity).Error
+	})
+}
+
+func (o *GormBenchmark) Decrement(b *testing.B) {
+	benchmarkDecrement(b, o.decrement)
+}
+
+func (o *GormBenchmark) DecrementConcurrent(b *testing.B) {
+	benchmarkDecrementConcurrent(b, o.decrement)
+}
+
+func (o *GormBenchmark) decrement(id int64) (int, error) {
+	book := model.Book{ID: id}
+	result := o.db.Model(&book).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "quantity"}}}).
+		Where("quantity > 0").
+		UpdateColumn("quantity", gorm.Expr("quantity - 1"))
+	if result.Error == nil && result.RowsAffected == 0 {
+		return 0, errOutOfStock
+	}
+	return book.Quantity, result.Error
+}
+
+func (o *GormBenchmark) UpdateOptimistic(b *testing.B) {
+	benchmarkUpdateOptimistic(b, o.findBook, o.saveVersioned)
+}
+
+func (o *GormBenchmark) findBook(id int64) (*model.Book, error) {
+	book := new(model.Book)
+	err := o.db.First(book, id).Error
+	return book, err
+}
+
+func (o *GormBenchmark) saveVersioned(book *model.Book) error {
+	result := o.db.Model(book).
+		Where("version = ?", book.Version).
+		UpdateColumns(map[string]interface{}{
+			"quantity": book.Quantity,
+			"version":  gorm.Expr("version + 1"),
+		})
+	if result.Error == nil && result.RowsAffected == 0 {
+		return errVersionConflict
+	}
+	return result.Error
+}
+
+// gormSoftDeletableBook maps the books table with a gorm.DeletedAt, which turns the deletes into updates of
+// deleted_at and leaves the deleted books out of the queries. It is kept apart from model.Book, so the other
+// operations are not scoped.
+type gormSoftDeletableBook struct {
+	ID           int64 `gorm:"primary_key"`
+	ISBN         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int
+	PublicizedAt time.Time
+	Version      int
+	DeletedAt    gorm.DeletedAt
+}
+
+func (gormSoftDeletableBook) TableName() string {
+	return "books"
+}
+
+func (o *GormBenchmark) SoftDelete(b *testing.B) {
+	benchmarkSoftDelete(b, func(id int64) error {
+		return o.db.Delete(&gormSoftDeletableBook{}, id).Error
+	})
+}
+
+func (o *GormBenchmark) RestoreSoftDeleted(b *testing.B) {
+	benchmarkRestoreSoftDeleted(b, func(id int64) error {
+		return o.db.Unscoped().Model(&gormSoftDeletableBook{ID: id}).Update("deleted_at", nil).Error
+	})
+}
+
+func (o *GormBenchmark) FindNotDeletedByID(b *testing.B) {
+	benchmarkFindNotDeletedByID(b, o.findNotDeleted)
+}
+
+func (o *GormBenchmark) findNotDeleted(id int64) (bool, error) {
+	var book gormSoftDeletableBook
+	err := o.db.First(&book, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (o *GormBenchmark) Exists(b *testing.B) {
+	benchmarkExists(b, func(isbn string) (bool, error) {
+		// gorm has no exists query, the count of the unique isbn is compared instead.
+		var exists bool
+		err := o.db.Model(&model.Book{}).Select("count(*) > 0").Where("isbn = ?", isbn).Find(&exists).Error
+		return exists, err
+	})
+}
+
+func (o *GormBenchmark) Count(b *testing.B) {
+	benchmarkCount(b, func(genre string) (int, error) {
+		var count int64
+		err := o.db.Model(&model.Book{}).Where("genre = ?", genre).Count(&count).Error
+		return int(count), err
+	})
+}
+
+func (o *GormBenchmark) FindByIDs(b *testing.B) {
+	benchmarkFindByIDs(b, func(ids []int64) (int, error) {
+		var books []model.Book
+		err := o.db.Find(&books, ids).Error
+		return len(books), err
+	})
+}
+
+func (o *GormBenchmark) InsertListing(b *testing.B) {
+	benchmarkInsertListing(b, func(listing *model.Listing) error {
+		return o.db.Create(listing).Error
+	})
+}
+
+func (o *GormBenchmark) FindListingByID(b *testing.B) {
+	benchmarkFindListingByID(b, func(id int64) error {
+		var listing model.Listing
+		return o.db.First(&listing, id).Error
+	})
+}
+
+func (o *GormBenchmark) UpdateListing(b *testing.B) {
+	benchmarkUpdateListing(b, func(listing *model.Listing) error {
+		return o.db.Save(listing).Error
+	})
+}
+
+func (o *GormBenchmark) InsertDraft(b *testing.B) {
+	benchmarkInsertDraft(b, func(draft *model.Draft) error {
+		return o.db.Create(draft).Error
+	})
+}
+
+func (o *GormBenchmark) FindDraftByID(b *testing.B) {
+	benchmarkFindDraftByID(b, func(id int64) error {
+		var draft model.Draft
+		return o.db.First(&draft, id).Error
+	})
+}
+
+func (o *GormBenchmark) FindDrafts(b *testing.B) {
+	benchmarkFindDrafts(b, func(limit int) (int, error) {
+		var drafts []model.Draft
+		err := o.db.Order("id").Limit(limit).Find(&drafts).Error
+		return len(drafts), err
+	})
+}
+
+func (o *GormBenchmark) InsertWide(b *testing.B) {
+	benchmarkInsertWide(b, func(wide *model.Wide) error {
+		return o.db.Create(wide).Error
+	})
+}
+
+func (o *GormBenchmark) FindWideByID(b *testing.B) {
+	benchmarkFindWideByID(b, func(id int64) error {
+		var wide model.Wide
+		return o.db.First(&wide, id).Error
+	})
+}
+
+func (o *GormBenchmark) FindWides(b *testing.B) {
+	benchmarkFindWides(b, func(limit int) (int, error) {
+		var wides []model.Wide
+		err := o.db.Order("id").Limit(limit).Find(&wides).Error
+		return len(wides), err
+	})
+}
+
+func (o *GormBenchmark) InsertUUIDClient(b *testing.B) {
+	benchmarkInsertUUIDClient(b, func(book *model.UUIDBook) error {
+		return o.db.Create(book).Error
+	})
+}
+
+func (o *GormBenchmark) InsertUUIDServer(b *testing.B) {
+	benchmarkInsertUUIDServer(b, func(book *model.UUIDBook) error {
+		// The key is zero, so gorm leaves it to the default of the column and reads it back.
+		return o.db.Create(book).Error
+	})
+}
+
+func (o *GormBenchmark) FindUUIDByID(b *testing.B) {
+	benchmarkFindUUIDByID(b, func(id uuid.UUID) error {
+		var book model.UUIDBook
+		return o.db.First(&book, "id = ?", id).Error
+	})
+}
+
+func (o *GormBenchmark) DeleteUUID(b *testing.B) {
+	benchmarkDeleteUUID(b, func(id uuid.UUID) error {
+		return o.db.Delete(&model.UUIDBook{ID: id}).Error
+	})
+}
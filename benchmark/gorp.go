@@ -0,0 +1,650 @@
+package benchmark
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+
+	"github.com/go-gorp/gorp/v3"
+	"github.com/google/uuid"
+)
+
+type GorpBenchmark struct {
+	db *gorp.DbMap
+}
+
+func init() {
+	Register(Adapter{Name: "gorp", Category: CategoryORM, New: NewGorpBenchmark})
+}
+
+func NewGorpBenchmark() Benchmark {
+	return &GorpBenchmark{}
+}
+
+func (o *GorpBenchmark) Init() error {
+	db, err := sql.Open("pgx", utils.PostgresDSN)
+	if err != nil {
+		return err
+	}
+	o.db = &gorp.DbMap{Db: db, Dialect: gorp.PostgresDialect{}}
+	// gorp checks and increments the version column on every update and delete of a book.
+	o.db.AddTableWithName(model.Book{}, "books").SetKeys(true, "ID").SetVersionCol("Version")
+	o.db.AddTableWithName(model.PricePolicy{}, "price_policies").SetKeys(true, "ID")
+	o.db.AddTableWithName(model.Listing{}, "listings").SetKeys(true, "ID")
+	o.db.AddTableWithName(model.Draft{}, "drafts").SetKeys(true, "ID")
+	o.db.AddTableWithName(model.Wide{}, "wides").SetKeys(true, "ID")
+	// gorp only reads back integer keys, so the UUIDs are always generated by the application.
+	o.db.AddTableWithName(model.UUIDBook{}, "uuid_books").SetKeys(false, "ID")
+	o.db.TypeConverter = gorpTypeConverter{}
+	return nil
+}
+
+func (o *GorpBenchmark) Close() error {
+	return o.db.Db.Close()
+}
+
+func (o *GorpBenchmark) Capabilities() Capabilities {
+	return Capabilities{
+		InsertBulkOp:               Emulated,
+		SelectRelationsOp:          Emulated,
+		SelectPageRelationsEagerOp: Emulated,
+		UpsertBulkOp:               Emulated,
+	}
+}
+
+func (o *GorpBenchmark) Insert(b *testing.B) {
+	book := model.NewBook()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		book.ID = 0
+		book.ISBN = model.NewISBN()
+		b.StartTimer()
+
+		err := o.db.Insert(book)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (o *GorpBenchmark) InsertBulk(b *testing.B) {
+	books := model.NewBooks(utils.BulkInsertNumber)
+	list := make([]interface{}, len(books))
+	for i, book := range books {
+		list[i] = book
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for _, book := range books {
+			book.ID = 0
+			book.ISBN = model.NewISBN()
+		}
+		b.StartTimer()
+
+		// gorp has no multi-row insert, it executes one statement per book.
+		err := o.db.Insert(list...)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (o *GorpBenchmark) Update(b *testing.B) {
+	book := model.NewBook()
+
+	err := o.db.Insert(book)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = o.db.Update(book)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (o *GorpBenchmark) Delete(b *testing.B) {
+	n := b.N
+	books := model.NewBooks(n)
+	for _, book := range books {
+		if err := o.db.Insert(book); err != nil {
+			b.Error(err)
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var book *model.Book
+	for i := 0; i < n; i++ {
+		b.StopTimer()
+		book = new(model.Book)
+		book.ID = books[i].ID
+		b.StartTimer()
+
+		_, err := o.db.Delete(book)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (o *GorpBenchmark) FindByID(b *testing.B) {
+	book := model.NewBook()
+
+	err := o.db.Insert(book)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for range utils.FindOneLoop {
+			_, err = o.db.Get(model.Book{}, book.ID)
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+}
+
+func (o *GorpBenchmark) FindPage(b *testing.B) {
+	books := model.NewBooks(utils.BulkInsertPageNumber)
+	for _, book := range books {
+		if err := o.db.Insert(book); err != nil {
+			b.Error(err)
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	booksPage := make([]model.Book, 0, utils.PageSize)
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			// ent, sqlc and goe generates the slice inside, so all makes counts
+			booksPage = make([]model.Book, 0, utils.PageSize)
+
+			_, err := o.db.Select(&booksPage, utils.SelectPaginatingQuery, s, utils.PageSize)
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+}
+
+func (o *GorpBenchmark) FindWithPricePolicies(b *testing.B) {
+	book, err := seedBookWithPricePolicies()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		// gorp does not map relationships, the policies are loaded by a second query.
+		var foundBook model.Book
+		err = o.db.SelectOne(&foundBook, utils.SelectByIDQuery, book.ID)
+		if err == nil {
+			_, err = o.db.Select(&foundBook.PricePolicies, utils.SelectPricePoliciesByBookQuery, book.ID)
+		}
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+
+	report()
+}
+
+func (o *GorpBenchmark) FindPageWithPricePoliciesNaive(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			var booksPage []model.Book
+			_, err = o.db.Select(&booksPage, utils.SelectPaginatingQuery, pageCursor(books, s), utils.PageSize)
+			for j := 0; err == nil && j < len(booksPage); j++ {
+				_, err = o.db.Select(&booksPage[j].PricePolicies, utils.SelectPricePoliciesByBookQuery, booksPage[j].ID)
+			}
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *GorpBenchmark) FindPageWithPricePoliciesEager(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			// gorp does not map relationships, the policies of the whole page are loaded by a second query.
+			var booksPage []model.Book
+			var policies []model.PricePolicy
+			_, err = o.db.Select(&booksPage, utils.SelectPaginatingQuery, pageCursor(books, s), utils.PageSize)
+			if err == nil {
+				bookIDs := make([]int64, len(booksPage))
+				for j := range booksPage {
+					bookIDs[j] = booksPage[j].ID
+				}
+				_, err = o.db.Select(&policies, utils.SelectPricePoliciesByBooksQuery, bookIDs)
+			}
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *GorpBenchmark) TransactionCommit(b *testing.B) {
+	benchmarkTransaction(b, true, o.doTransaction)
+}
+
+func (o *GorpBenchmark) TransactionRollback(b *testing.B) {
+	benchmarkTransaction(b, false, o.doTransaction)
+}
+
+func (o *GorpBenchmark) doTransaction(book *model.Book, commit bool) error {
+	tx, err := o.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// it is a no-op once the transaction is committed
+		_ = tx.Rollback()
+	}()
+
+	if err = tx.Insert(book); err != nil {
+		return err
+	}
+	policies := model.NewPricePolicies(book.ID, utils.TransactionPricePoliciesNumber)
+	list := make([]interface{}, len(policies))
+	for i, policy := range policies {
+		list[i] = policy
+	}
+	if err = tx.Insert(list...); err != nil {
+		return err
+	}
+	if _, err = tx.Exec(utils.UpdateQuantityQuery, updatedQuantity(book), book.ID); err != nil {
+		return err
+	}
+
+	if !commit {
+		return tx.Rollback()
+	}
+	return tx.Commit()
+}
+
+func (o *GorpBenchmark) Upsert(b *testing.B) {
+	benchmarkUpsert(b, func(book *model.Book) error {
+		// gorp has no upsert API, the statement is written by hand.
+		_, err := o.db.Exec(utils.UpsertQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) UpsertBulk(b *testing.B) {
+	benchmarkUpsertBulk(b, func(books []*model.Book) error {
+		// gorp has neither upsert nor multi-row insert, it executes one statement per book.
+		for _, book := range books {
+			_, err := o.db.Exec(utils.UpsertQuery,
+				book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (o *GorpBenchmark) UpdateBulk(b *testing.B) {
+	benchmarkUpdateBulk(b, func(ids []int64, quantity int) error {
+		_, err := o.db.Exec(utils.UpdateQuantityByIDsQuery, quantity, ids)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) UpdateBulkValues(b *testing.B) {
+	benchmarkUpdateBulkValues(b, func(ids []int64, quantities []int) error {
+		_, err := o.db.Exec(utils.UpdateQuantitiesUnnestQuery, ids, quantities)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) DeleteBulk(b *testing.B) {
+	benchmarkDeleteBulk(b, func(ids []int64) error {
+		_, err := o.db.Exec(utils.DeleteByIDsQuery, ids)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) DeleteBulkWhere(b *testing.B) {
+	benchmarkDeleteBulkWhere(b, func(genre string) error {
+		_, err := o.db.Exec(utils.DeleteByGenreQuery, genre)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) FindPageOffset(b *testing.B) {
+	benchmarkPageDepths(b, func(offset int, _ int64) error {
+		var booksPage []model.Book
+		_, err := o.db.Select(&booksPage, utils.SelectPaginatingOffsetQuery, utils.PageSize, offset)
+		if err != nil {
+			return err
+		}
+
+		_, err = o.db.SelectInt(utils.CountBooksQuery)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) FindPageKeyset(b *testing.B) {
+	benchmarkPageDepths(b, func(_ int, cursor int64) error {
+		var booksPage []model.Book
+		_, err := o.db.Select(&booksPage, utils.SelectPaginatingKeysetQuery, cursor, utils.PageSize)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) SummarizeGenres(b *testing.B) {
+	benchmarkSummarizeGenres(b, func() error {
+		var summaries []model.GenreSummary
+		_, err := o.db.Select(&summaries, utils.SelectGenreSummariesQuery)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) Search(b *testing.B) {
+	benchmarkSearch(b, func(search bookSearch) error {
+		var books []model.Book
+		query, args := searchSQL(search)
+		_, err := o.db.Select(&books, query, args...)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) FindTitleByID(b *testing.B) {
+	benchmarkFindTitleByID(b, func(id int64) error {
+		var title model.BookTitle
+		return o.db.SelectOne(&title, utils.SelectTitleByIDQuery, id)
+	})
+}
+
+func (o *GorpBenchmark) FindTitlePage(b *testing.B) {
+	benchmarkFindTitlePage(b, func(cursor int64) error {
+		var titles []model.BookTitle
+		_, err := o.db.Select(&titles, utils.SelectTitlesPaginatingQuery, cursor, utils.PageSize)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) Stream(b *testing.B) {
+	benchmarkStream(b, func(limit int) (int, error) {
+		// gorp has no iterator, the whole result is loaded at once.
+		var books []model.Book
+		_, err := o.db.Select(&books, utils.SelectPaginatingKeysetQuery, 0, limit)
+		return len(books), err
+	})
+}
+
+func (o *GorpBenchmark) UpdatePartial(b *testing.B) {
+	benchmarkUpdatePartial(b, func(book *model.Book) error {
+		// gorp updates whole rows only, the statement is written by hand.
+		_, err := o.db.Exec(utils.UpdateQuantityQuery, book.Quantity, book.ID)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) Decrement(b *testing.B) {
+	benchmarkDecrement(b, o.decrement)
+}
+
+func (o *GorpBenchmark) DecrementConcurrent(b *testing.B) {
+	benchmarkDecrementConcurrent(b, o.decrement)
+}
+
+func (o *GorpBenchmark) decrement(id int64) (int, error) {
+	quantity, err := o.db.SelectInt(utils.DecrementQuantityQuery, id)
+	return int(quantity), err
+}
+
+func (o *GorpBenchmark) UpdateOptimistic(b *testing.B) {
+	benchmarkUpdateOptimistic(b, o.findBook, o.saveVersioned)
+}
+
+func (o *GorpBenchmark) findBook(id int64) (*model.Book, error) {
+	book := new(model.Book)
+	err := o.db.SelectOne(book, utils.SelectByIDQuery, id)
+	return book, err
+}
+
+func (o *GorpBenchmark) saveVersioned(book *model.Book) error {
+	// gorp has built-in optimistic locking, the whole row is written when the version matches.
+	_, err := o.db.Update(book)
+	var conflict gorp.OptimisticLockError
+	if errors.As(err, &conflict) {
+		return errVersionConflict
+	}
+	return err
+}
+
+func (o *GorpBenchmark) SoftDelete(b *testing.B) {
+	benchmarkSoftDelete(b, func(id int64) error {
+		_, err := o.db.Exec(utils.SoftDeleteQuery, time.Now(), id)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) RestoreSoftDeleted(b *testing.B) {
+	benchmarkRestoreSoftDeleted(b, func(id int64) error {
+		_, err := o.db.Exec(utils.RestoreQuery, id)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) FindNotDeletedByID(b *testing.B) {
+	benchmarkFindNotDeletedByID(b, o.findNotDeleted)
+}
+
+func (o *GorpBenchmark) findNotDeleted(id int64) (bool, error) {
+	var book model.Book
+	err := o.db.SelectOne(&book, utils.SelectNotDeletedByIDQuery, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (o *GorpBenchmark) Exists(b *testing.B) {
+	benchmarkExists(b, func(isbn string) (bool, error) {
+		var exists bool
+		err := o.db.SelectOne(&exists, utils.ExistsByISBNQuery, isbn)
+		return exists, err
+	})
+}
+
+func (o *GorpBenchmark) Count(b *testing.B) {
+	benchmarkCount(b, func(genre string) (int, error) {
+		count, err := o.db.SelectInt(utils.CountByGenreQuery, genre)
+		return int(count), err
+	})
+}
+
+func (o *GorpBenchmark) FindByIDs(b *testing.B) {
+	benchmarkFindByIDs(b, func(ids []int64) (int, error) {
+		var books []model.Book
+		_, err := o.db.Select(&books, utils.SelectByIDsQuery, ids)
+		return len(books), err
+	})
+}
+
+func (o *GorpBenchmark) InsertListing(b *testing.B) {
+	benchmarkInsertListing(b, func(listing *model.Listing) error {
+		return o.db.Insert(listing)
+	})
+}
+
+func (o *GorpBenchmark) FindListingByID(b *testing.B) {
+	benchmarkFindListingByID(b, func(id int64) error {
+		_, err := o.db.Get(model.Listing{}, id)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) UpdateListing(b *testing.B) {
+	benchmarkUpdateListing(b, func(listing *model.Listing) error {
+		_, err := o.db.Update(listing)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) InsertDraft(b *testing.B) {
+	benchmarkInsertDraft(b, func(draft *model.Draft) error {
+		return o.db.Insert(draft)
+	})
+}
+
+func (o *GorpBenchmark) FindDraftByID(b *testing.B) {
+	benchmarkFindDraftByID(b, func(id int64) error {
+		_, err := o.db.Get(model.Draft{}, id)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) FindDrafts(b *testing.B) {
+	benchmarkFindDrafts(b, func(limit int) (int, error) {
+		var drafts []model.Draft
+		_, err := o.db.Select(&drafts, utils.SelectDraftsQuery, limit)
+		return len(drafts), err
+	})
+}
+
+func (o *GorpBenchmark) InsertWide(b *testing.B) {
+	benchmarkInsertWide(b, func(wide *model.Wide) error {
+		return o.db.Insert(wide)
+	})
+}
+
+func (o *GorpBenchmark) FindWideByID(b *testing.B) {
+	benchmarkFindWideByID(b, func(id int64) error {
+		_, err := o.db.Get(model.Wide{}, id)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) FindWides(b *testing.B) {
+	benchmarkFindWides(b, func(limit int) (int, error) {
+		var wides []model.Wide
+		_, err := o.db.Select(&wides, utils.SelectWidesQuery, limit)
+		return len(wides), err
+	})
+}
+
+func (o *GorpBenchmark) InsertUUIDClient(b *testing.B) {
+	benchmarkInsertUUIDClient(b, func(book *model.UUIDBook) error {
+		return o.db.Insert(book)
+	})
+}
+
+func (o *GorpBenchmark) FindUUIDByID(b *testing.B) {
+	benchmarkFindUUIDByID(b, func(id uuid.UUID) error {
+		_, err := o.db.Get(model.UUIDBook{}, id)
+		return err
+	})
+}
+
+func (o *GorpBenchmark) DeleteUUID(b *testing.B) {
+	benchmarkDeleteUUID(b, func(id uuid.UUID) error {
+		_, err := o.db.Delete(&model.UUIDBook{ID: id})
+		return err
+	})
+}
+
+// gorpTypeConverter stores the attributes of the listings as JSON, the TypeConverter being how gorp maps the types
+// database/sql does not know. The other values are passed through.
+type gorpTypeConverter struct{}
+
+func (gorpTypeConverter) ToDb(val interface{}) (interface{}, error) {
+	if attributes, ok := val.(model.ListingAttributes); ok {
+		return json.Marshal(attributes)
+	}
+	return val, nil
+}
+
+func (gorpTypeConverter) FromDb(target interface{}) (gorp.CustomScanner, bool) {
+	if _, ok := target.(*model.ListingAttributes); !ok {
+		return gorp.CustomScanner{}, false
+	}
+	binder := func(holder, target interface{}) error {
+		return json.Unmarshal(*holder.(*[]byte), target)
+	}
+	return gorp.CustomScanner{Holder: new([]byte), Target: target, Binder: binder}, true
+}
@@ -0,0 +1,62 @@
+package benchmark
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// IDListBenchmark is implemented by the adapters able to select books by a list of ids. Some libraries bind the list
+// as a single array parameter to `id = ANY($1)`, others expand it into one parameter per id in `id IN (...)`.
+type IDListBenchmark interface {
+	// FindByIDs selects the books of lists of utils.IDListSizes ids.
+	FindByIDs(b *testing.B)
+}
+
+// idListMetric is the unit of the metric reporting the time spent selecting a list of the given size.
+func idListMetric(size int) string {
+	return fmt.Sprintf("ns/ids-%d", size)
+}
+
+// benchmarkFindByIDs measures fn selecting the books of every list size in utils.IDListSizes, and checks it finds
+// one book per id. The time spent on each size is reported apart, so the report shows how the cost grows.
+func benchmarkFindByIDs(b *testing.B, fn func(ids []int64) (int, error)) {
+	books := model.NewBooks(utils.IDListSizes[len(utils.IDListSizes)-1])
+	if err := utils.SeedBooks(books...); err != nil {
+		b.Error(err)
+		return
+	}
+	ids := make([]int64, len(books))
+	for i, book := range books {
+		ids[i] = book.ID
+	}
+	elapsed := make([]time.Duration, len(utils.IDListSizes))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j, size := range utils.IDListSizes {
+			start := time.Now()
+			found, err := fn(ids[:size])
+			elapsed[j] += time.Since(start)
+
+			b.StopTimer()
+			if err == nil && found != size {
+				err = fmt.Errorf("found %d books for %d ids", found, size)
+			}
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	b.StopTimer()
+	for j, size := range utils.IDListSizes {
+		b.ReportMetric(float64(elapsed[j].Nanoseconds())/float64(b.N), idListMetric(size))
+	}
+}
@@ -0,0 +1,87 @@
+package benchmark
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// NullableBenchmark is implemented by the adapters able to map the drafts table, whose columns are all nullable
+// and half NULL. Each library maps them its own way: pointers, sql.Null*, pgtype wrappers or optional fields.
+type NullableBenchmark interface {
+	// InsertDraft inserts one draft and reads back its id.
+	InsertDraft(b *testing.B)
+	// FindDraftByID selects one draft by its id.
+	FindDraftByID(b *testing.B)
+	// FindDrafts selects utils.DraftsNumber drafts in one query.
+	FindDrafts(b *testing.B)
+}
+
+// benchmarkInsertDraft measures fn inserting a draft, alternating between the two halves of the columns left NULL.
+func benchmarkInsertDraft(b *testing.B, fn func(draft *model.Draft) error) {
+	drafts := model.NewDrafts(2)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		draft := drafts[i%len(drafts)]
+		draft.ID = 0
+		b.StartTimer()
+
+		err := fn(draft)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// benchmarkFindDraftByID measures fn selecting two seeded drafts in turn, each NULL where the other is not.
+func benchmarkFindDraftByID(b *testing.B, fn func(id int64) error) {
+	drafts := model.NewDrafts(2)
+	if err := utils.SeedDrafts(drafts...); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err := fn(drafts[i%len(drafts)].ID)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// benchmarkFindDrafts measures fn selecting utils.DraftsNumber seeded drafts, and checks it reads all of them.
+func benchmarkFindDrafts(b *testing.B, fn func(limit int) (int, error)) {
+	if err := utils.SeedDrafts(model.NewDrafts(utils.DraftsNumber)...); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		found, err := fn(utils.DraftsNumber)
+
+		b.StopTimer()
+		if err == nil && found != utils.DraftsNumber {
+			err = fmt.Errorf("found %d drafts, want %d", found, utils.DraftsNumber)
+		}
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
@@ -0,0 +1,75 @@
+package benchmark
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// OptimisticLockBenchmark is implemented by the adapters able to tell how many rows an update changed, which
+// optimistic locking relies on to detect conflicts.
+type OptimisticLockBenchmark interface {
+	// UpdateOptimistic reads a book, changes its quantity and writes it back with WHERE id = ? AND version = ?,
+	// incrementing the version.
+	UpdateOptimistic(b *testing.B)
+}
+
+// errVersionConflict is returned by a versioned update when the book was updated since it was read.
+var errVersionConflict = errors.New("the book was updated since it was read")
+
+// findBookFunc reads the book with the given id.
+type findBookFunc func(id int64) (*model.Book, error)
+
+// saveVersionedFunc writes the quantity of a book if its version is still the one read, and returns
+// errVersionConflict otherwise.
+type saveVersionedFunc func(book *model.Book) error
+
+// benchmarkUpdateOptimistic measures the read-modify-write of a seeded book with find and save. Before measuring, it
+// checks that save detects a conflict, writing the same version of the book twice.
+func benchmarkUpdateOptimistic(b *testing.B, find findBookFunc, save saveVersionedFunc) {
+	book := model.NewBook()
+	if err := utils.SeedBooks(book); err != nil {
+		b.Error(err)
+	}
+	if err := checkVersionConflict(book.ID, find, save); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		found, err := find(book.ID)
+		if err == nil {
+			found.Quantity = updatedQuantity(found)
+			err = save(found)
+		}
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// checkVersionConflict reads a book twice and saves both copies, expecting the second save to conflict.
+func checkVersionConflict(id int64, find findBookFunc, save saveVersionedFunc) error {
+	first, err := find(id)
+	if err != nil {
+		return err
+	}
+	second, err := find(id)
+	if err != nil {
+		return err
+	}
+	if err = save(first); err != nil {
+		return err
+	}
+	if err = save(second); !errors.Is(err, errVersionConflict) {
+		return errors.Join(errors.New("a stale version was saved without conflict"), err)
+	}
+	return nil
+}
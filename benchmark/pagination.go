@@ -0,0 +1,60 @@
+package benchmark
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+)
+
+// PaginationBenchmark is implemented by the adapters able to compare offset and keyset pagination. Both operations
+// read the pages in utils.PageDepths from a table of utils.PaginationBooksNumber books, ordered by id.
+type PaginationBenchmark interface {
+	// FindPageOffset selects the pages with LIMIT and OFFSET, along with the total number of books.
+	FindPageOffset(b *testing.B)
+	// FindPageKeyset selects the pages filtering the ids greater than the last id of the previous page.
+	FindPageKeyset(b *testing.B)
+}
+
+// pageDepthMetric is the unit of the metric reporting the time spent reading the page at the given depth.
+func pageDepthMetric(depth int) string {
+	return fmt.Sprintf("ns/page-%d", depth)
+}
+
+// benchmarkPageDepths measures fn reading every page in utils.PageDepths. fn receives the offset of the page and its
+// keyset cursor, and the time spent on each depth is reported apart so the report shows how the cost grows.
+func benchmarkPageDepths(b *testing.B, fn func(offset int, cursor int64) error) {
+	offsets := make([]int, len(utils.PageDepths))
+	for i, depth := range utils.PageDepths {
+		offsets[i] = depth * utils.PageSize
+	}
+	ids, err := utils.SeedBooksUpTo(utils.PaginationBooksNumber, offsets...)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+	elapsed := make([]time.Duration, len(offsets))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j, offset := range offsets {
+			start := time.Now()
+			err = fn(offset, ids[j]-1)
+			elapsed[j] += time.Since(start)
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	b.StopTimer()
+	for j, depth := range utils.PageDepths {
+		b.ReportMetric(float64(elapsed[j].Nanoseconds())/float64(b.N), pageDepthMetric(depth))
+	}
+}
@@ -0,0 +1,37 @@
+package benchmark
+
+import (
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// PartialUpdateBenchmark is implemented by the adapters able to update some columns of a row. The operation is
+// reported next to update, which rewrites every column of the book.
+type PartialUpdateBenchmark interface {
+	// UpdatePartial sets the quantity of a book, leaving the other columns out of the statement.
+	UpdatePartial(b *testing.B)
+}
+
+// benchmarkUpdatePartial measures fn writing the quantity of a seeded book. The book holds the quantity to write.
+func benchmarkUpdatePartial(b *testing.B, fn func(book *model.Book) error) {
+	book := model.NewBook()
+	if err := utils.SeedBooks(book); err != nil {
+		b.Error(err)
+	}
+	book.Quantity = updatedQuantity(book)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err := fn(book)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
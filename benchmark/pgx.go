@@ -2,22 +2,37 @@ package benchmark
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
 	"github.com/lauro-santana/golang-orm-benchmarks/model"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var columns = []string{"isbn", "title", "author", "genre", "quantity", "publicized_at"}
 
+var draftColumns = []string{
+	"title", "subtitle", "author", "translator", "pages", "rating", "illustrated", "submitted_at",
+}
+
+var listingColumns = []string{
+	"reference", "attributes", "tags", "price", "cover", "subtitle", "discontinued_at", "listed_at",
+}
+
 type PgxBenchmark struct {
 	db  *pgxpool.Pool
 	ctx context.Context
 }
 
+func init() {
+	Register(Adapter{Name: "pgx", Category: CategoryRaw, New: NewPgxBenchmark})
+}
+
 func NewPgxBenchmark() Benchmark {
 	return &PgxBenchmark{
 		ctx: context.Background(),
@@ -35,6 +50,10 @@ func (p *PgxBenchmark) Close() error {
 	return nil
 }
 
+func (p *PgxBenchmark) Capabilities() Capabilities {
+	return nil
+}
+
 func (p *PgxBenchmark) Insert(b *testing.B) {
 	book := model.NewBook()
 
@@ -42,6 +61,10 @@ func (p *PgxBenchmark) Insert(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		book.ISBN = model.NewISBN()
+		b.StartTimer()
+
 		_, err := p.db.Exec(p.ctx, utils.InsertQuery,
 			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
 
@@ -63,6 +86,12 @@ func (p *PgxBenchmark) InsertBulk(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for _, row := range rows {
+			row[0] = model.NewISBN()
+		}
+		b.StartTimer()
+
 		_, err := p.db.CopyFrom(p.ctx, pgx.Identifier{"books"}, columns, pgx.CopyFromRows(rows))
 
 		if err != nil {
@@ -98,6 +127,7 @@ func (p *PgxBenchmark) Delete(b *testing.B) {
 	savedIDs := make([]int64, b.N)
 	for i := 0; i < b.N; i++ {
 		var id int64
+		book.ISBN = model.NewISBN()
 		err := p.db.QueryRow(p.ctx, utils.InsertReturningIDQuery,
 			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
 		if err != nil {
@@ -146,6 +176,8 @@ func (p *PgxBenchmark) FindByID(b *testing.B) {
 				&foundBook.Genre,
 				&foundBook.Quantity,
 				&foundBook.PublicizedAt,
+				&foundBook.Version,
+				&foundBook.DeletedAt,
 			)
 
 			// checking the error will count on raw benchmarks
@@ -192,6 +224,8 @@ func (p *PgxBenchmark) FindPage(b *testing.B) {
 					&book.Genre,
 					&book.Quantity,
 					&book.PublicizedAt,
+					&book.Version,
+					&book.DeletedAt,
 				); err != nil {
 					b.Error(err)
 				}
@@ -200,3 +234,741 @@ func (p *PgxBenchmark) FindPage(b *testing.B) {
 		}
 	}
 }
+
+func (p *PgxBenchmark) FindWithPricePolicies(b *testing.B) {
+	book, err := seedBookWithPricePolicies()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		result, err := p.db.Query(p.ctx, utils.SelectWithPricePoliciesQuery, book.ID)
+
+		// checking the error will count on raw benchmarks
+		if err != nil {
+			b.Error(err)
+		}
+
+		_, err = scanBooksWithPricePolicies(result)
+		if err != nil {
+			b.Error(err)
+		}
+	}
+
+	report()
+}
+
+// scanBooksWithPricePolicies reads the rows of a books and price_policies join, ordered by book, into books.
+func scanBooksWithPricePolicies(rows pgx.Rows) ([]*model.Book, error) {
+	defer rows.Close()
+
+	var books []*model.Book
+	for rows.Next() {
+		var current model.Book
+		var policy model.PricePolicy
+		if err := rows.Scan(
+			&current.ID,
+			&current.ISBN,
+			&current.Title,
+			&current.Author,
+			&current.Genre,
+			&current.Quantity,
+			&current.PublicizedAt,
+			&policy.ID,
+			&policy.BookID,
+			&policy.Price,
+			&policy.StartDate,
+			&policy.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		if len(books) == 0 || books[len(books)-1].ID != current.ID {
+			books = append(books, &current)
+		}
+		book := books[len(books)-1]
+		book.PricePolicies = append(book.PricePolicies, policy)
+	}
+	return books, rows.Err()
+}
+
+func (p *PgxBenchmark) FindPageWithPricePoliciesNaive(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			result, err := p.db.Query(p.ctx, utils.SelectPaginatingQuery, pageCursor(books, s), utils.PageSize)
+
+			// checking the error will count on raw benchmarks
+			if err != nil {
+				b.Error(err)
+			}
+
+			page, err := scanBooks(result)
+			if err != nil {
+				b.Error(err)
+			}
+
+			for j := range page {
+				result, err = p.db.Query(p.ctx, utils.SelectPricePoliciesByBookQuery, page[j].ID)
+				if err != nil {
+					b.Error(err)
+				}
+
+				page[j].PricePolicies, err = scanPricePolicies(result)
+				if err != nil {
+					b.Error(err)
+				}
+			}
+		}
+	}
+
+	report()
+}
+
+func (p *PgxBenchmark) FindPageWithPricePoliciesEager(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			result, err := p.db.Query(p.ctx, utils.SelectPaginatingWithPricePoliciesQuery, pageCursor(books, s), utils.PageSize)
+
+			// checking the error will count on raw benchmarks
+			if err != nil {
+				b.Error(err)
+			}
+
+			_, err = scanBooksWithPricePolicies(result)
+			if err != nil {
+				b.Error(err)
+			}
+		}
+	}
+
+	report()
+}
+
+// scanBooks reads the rows of a books query.
+func scanBooks(rows pgx.Rows) ([]model.Book, error) {
+	defer rows.Close()
+
+	books := make([]model.Book, 0, utils.PageSize)
+	for rows.Next() {
+		var book model.Book
+		if err := rows.Scan(
+			&book.ID,
+			&book.ISBN,
+			&book.Title,
+			&book.Author,
+			&book.Genre,
+			&book.Quantity,
+			&book.PublicizedAt,
+			&book.Version,
+			&book.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		books = append(books, book)
+	}
+	return books, rows.Err()
+}
+
+// scanPricePolicies reads the rows of a price_policies query.
+func scanPricePolicies(rows pgx.Rows) ([]model.PricePolicy, error) {
+	defer rows.Close()
+
+	var policies []model.PricePolicy
+	for rows.Next() {
+		var policy model.PricePolicy
+		if err := rows.Scan(
+			&policy.ID,
+			&policy.BookID,
+			&policy.Price,
+			&policy.StartDate,
+			&policy.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, rows.Err()
+}
+
+func (p *PgxBenchmark) TransactionCommit(b *testing.B) {
+	benchmarkTransaction(b, true, p.doTransaction)
+}
+
+func (p *PgxBenchmark) TransactionRollback(b *testing.B) {
+	benchmarkTransaction(b, false, p.doTransaction)
+}
+
+func (p *PgxBenchmark) doTransaction(book *model.Book, commit bool) error {
+	tx, err := p.db.Begin(p.ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// it is a no-op once the transaction is committed
+		_ = tx.Rollback(p.ctx)
+	}()
+
+	err = tx.QueryRow(p.ctx, utils.InsertReturningIDQuery,
+		book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&book.ID)
+	if err != nil {
+		return err
+	}
+	bookIDs, prices, startDates, endDates := pricePolicyArrays(
+		model.NewPricePolicies(book.ID, utils.TransactionPricePoliciesNumber))
+	_, err = tx.Exec(p.ctx, utils.InsertPricePoliciesQuery, bookIDs, prices, startDates, endDates)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(p.ctx, utils.UpdateQuantityQuery, updatedQuantity(book), book.ID)
+	if err != nil {
+		return err
+	}
+
+	if !commit {
+		return tx.Rollback(p.ctx)
+	}
+	return tx.Commit(p.ctx)
+}
+
+// pricePolicyArrays splits the price policies into one array per column, to insert them all with a single unnest.
+func pricePolicyArrays(policies []*model.PricePolicy) ([]int64, []float64, []time.Time, []time.Time) {
+	bookIDs := make([]int64, len(policies))
+	prices := make([]float64, len(policies))
+	startDates := make([]time.Time, len(policies))
+	endDates := make([]time.Time, len(policies))
+	for i, policy := range policies {
+		bookIDs[i] = policy.BookID
+		prices[i] = policy.Price
+		startDates[i] = policy.StartDate
+		endDates[i] = policy.EndDate
+	}
+	return bookIDs, prices, startDates, endDates
+}
+
+func (p *PgxBenchmark) Upsert(b *testing.B) {
+	benchmarkUpsert(b, func(book *model.Book) error {
+		_, err := p.db.Exec(p.ctx, utils.UpsertQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+		return err
+	})
+}
+
+func (p *PgxBenchmark) UpsertBulk(b *testing.B) {
+	benchmarkUpsertBulk(b, func(books []*model.Book) error {
+		// copy cannot handle conflicts, the books are sent as arrays instead.
+		isbns := make([]string, len(books))
+		titles := make([]string, len(books))
+		authors := make([]string, len(books))
+		genres := make([]string, len(books))
+		quantities := make([]int, len(books))
+		publicizedAt := make([]time.Time, len(books))
+		for i, book := range books {
+			isbns[i] = book.ISBN
+			titles[i] = book.Title
+			authors[i] = book.Author
+			genres[i] = book.Genre
+			quantities[i] = book.Quantity
+			publicizedAt[i] = book.PublicizedAt
+		}
+
+		_, err := p.db.Exec(p.ctx, utils.UpsertUnnestQuery, isbns, titles, authors, genres, quantities, publicizedAt)
+		return err
+	})
+}
+
+func (p *PgxBenchmark) UpdateBulk(b *testing.B) {
+	benchmarkUpdateBulk(b, func(ids []int64, quantity int) error {
+		_, err := p.db.Exec(p.ctx, utils.UpdateQuantityByIDsQuery, quantity, ids)
+		return err
+	})
+}
+
+func (p *PgxBenchmark) UpdateBulkValues(b *testing.B) {
+	benchmarkUpdateBulkValues(b, func(ids []int64, quantities []int) error {
+		_, err := p.db.Exec(p.ctx, utils.UpdateQuantitiesUnnestQuery, ids, quantities)
+		return err
+	})
+}
+
+func (p *PgxBenchmark) DeleteBulk(b *testing.B) {
+	benchmarkDeleteBulk(b, func(ids []int64) error {
+		_, err := p.db.Exec(p.ctx, utils.DeleteByIDsQuery, ids)
+		return err
+	})
+}
+
+func (p *PgxBenchmark) DeleteBulkWhere(b *testing.B) {
+	benchmarkDeleteBulkWhere(b, func(genre string) error {
+		_, err := p.db.Exec(p.ctx, utils.DeleteByGenreQuery, genre)
+		return err
+	})
+}
+
+func (p *PgxBenchmark) FindPageOffset(b *testing.B) {
+	benchmarkPageDepths(b, func(offset int, _ int64) error {
+		rows, err := p.db.Query(p.ctx, utils.SelectPaginatingOffsetQuery, utils.PageSize, offset)
+		if err != nil {
+			return err
+		}
+		if _, err = scanBooks(rows); err != nil {
+			return err
+		}
+
+		var total int64
+		return p.db.QueryRow(p.ctx, utils.CountBooksQuery).Scan(&total)
+	})
+}
+
+func (p *PgxBenchmark) FindPageKeyset(b *testing.B) {
+	benchmarkPageDepths(b, func(_ int, cursor int64) error {
+		rows, err := p.db.Query(p.ctx, utils.SelectPaginatingKeysetQuery, cursor, utils.PageSize)
+		if err != nil {
+			return err
+		}
+		_, err = scanBooks(rows)
+		return err
+	})
+}
+
+func (p *PgxBenchmark) SummarizeGenres(b *testing.B) {
+	benchmarkSummarizeGenres(b, func() error {
+		rows, err := p.db.Query(p.ctx, utils.SelectGenreSummariesQuery)
+		if err != nil {
+			return err
+		}
+		_, err = scanGenreSummaries(rows)
+		return err
+	})
+}
+
+// scanGenreSummaries reads the rows of a query summarizing the genres.
+func scanGenreSummaries(rows pgx.Rows) ([]model.GenreSummary, error) {
+	defer rows.Close()
+
+	var summaries []model.GenreSummary
+	for rows.Next() {
+		var summary model.GenreSummary
+		if err := rows.Scan(
+			&summary.Genre,
+			&summary.Books,
+			&summary.Quantity,
+			&summary.FirstPublicizedAt,
+			&summary.LastPublicizedAt,
+		); err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, rows.Err()
+}
+
+func (p *PgxBenchmark) Search(b *testing.B) {
+	benchmarkSearch(b, func(search bookSearch) error {
+		query, args := searchSQL(search)
+		rows, err := p.db.Query(p.ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		_, err = scanBooks(rows)
+		return err
+	})
+}
+
+func (p *PgxBenchmark) FindTitleByID(b *testing.B) {
+	benchmarkFindTitleByID(b, func(id int64) error {
+		var title model.BookTitle
+		return p.db.QueryRow(p.ctx, utils.SelectTitleByIDQuery, id).Scan(&title.ID, &title.Title)
+	})
+}
+
+func (p *PgxBenchmark) FindTitlePage(b *testing.B) {
+	benchmarkFindTitlePage(b, func(cursor int64) error {
+		rows, err := p.db.Query(p.ctx, utils.SelectTitlesPaginatingQuery, cursor, utils.PageSize)
+		if err != nil {
+			return err
+		}
+		_, err = scanBookTitles(rows)
+		return err
+	})
+}
+
+// scanBookTitles reads the rows of a query selecting the ids and titles of books.
+func scanBookTitles(rows pgx.Rows) ([]model.BookTitle, error) {
+	defer rows.Close()
+
+	titles := make([]model.BookTitle, 0, utils.PageSize)
+	for rows.Next() {
+		var title model.BookTitle
+		if err := rows.Scan(&title.ID, &title.Title); err != nil {
+			return nil, err
+		}
+		titles = append(titles, title)
+	}
+	return titles, rows.Err()
+}
+
+func (p *PgxBenchmark) Stream(b *testing.B) {
+	benchmarkStream(b, func(limit int) (int, error) {
+		rows, err := p.db.Query(p.ctx, utils.SelectPaginatingKeysetQuery, 0, limit)
+		if err != nil {
+			return 0, err
+		}
+		return streamBooks(rows)
+	})
+}
+
+// streamBooks reads the rows of a books query one by one, without keeping them.
+func streamBooks(rows pgx.Rows) (int, error) {
+	defer rows.Close()
+
+	read := 0
+	var book model.Book
+	for rows.Next() {
+		if err := rows.Scan(
+			&book.ID,
+			&book.ISBN,
+			&book.Title,
+			&book.Author,
+			&book.Genre,
+			&book.Quantity,
+			&book.PublicizedAt,
+			&book.Version,
+			&book.DeletedAt,
+		); err != nil {
+			return read, err
+		}
+		read++
+	}
+	return read, rows.Err()
+}
+
+func (p *PgxBenchmark) UpdatePartial(b *testing.B) {
+	benchmarkUpdatePartial(b, func(book *model.Book) error {
+		_, err := p.db.Exec(p.ctx, utils.UpdateQuantityQuery, book.Quantity, book.ID)
+		return err
+	})
+}
+
+func (p *PgxBenchmark) Decrement(b *testing.B) {
+	benchmarkDecrement(b, p.decrement)
+}
+
+func (p *PgxBenchmark) DecrementConcurrent(b *testing.B) {
+	benchmarkDecrementConcurrent(b, p.decrement)
+}
+
+func (p *PgxBenchmark) decrement(id int64) (int, error) {
+	var quantity int
+	err := p.db.QueryRow(p.ctx, utils.DecrementQuantityQuery, id).Scan(&quantity)
+	return quantity, err
+}
+
+func (p *PgxBenchmark) UpdateOptimistic(b *testing.B) {
+	benchmarkUpdateOptimistic(b, p.findBook, p.saveVersioned)
+}
+
+func (p *PgxBenchmark) findBook(id int64) (*model.Book, error) {
+	return scanBook(p.db.QueryRow(p.ctx, utils.SelectByIDQuery, id))
+}
+
+func (p *PgxBenchmark) saveVersioned(book *model.Book) error {
+	tag, err := p.db.Exec(p.ctx, utils.UpdateVersionedQuery, book.Quantity, book.ID, book.Version)
+	if err == nil && tag.RowsAffected() == 0 {
+		return errVersionConflict
+	}
+	return err
+}
+
+// scanBook reads the row of a query selecting one book.
+func scanBook(row pgx.Row) (*model.Book, error) {
+	book := new(model.Book)
+	err := row.Scan(
+		&book.ID,
+		&book.ISBN,
+		&book.Title,
+		&book.Author,
+		&book.Genre,
+		&book.Quantity,
+		&book.PublicizedAt,
+		&book.Version,
+		&book.DeletedAt,
+	)
+	return book, err
+}
+
+func (p *PgxBenchmark) SoftDelete(b *testing.B) {
+	benchmarkSoftDelete(b, func(id int64) error {
+		_, err := p.db.Exec(p.ctx, utils.SoftDeleteQuery, time.Now(), id)
+		return err
+	})
+}
+
+func (p *PgxBenchmark) RestoreSoftDeleted(b *testing.B) {
+	benchmarkRestoreSoftDeleted(b, func(id int64) error {
+		_, err := p.db.Exec(p.ctx, utils.RestoreQuery, id)
+		return err
+	})
+}
+
+func (p *PgxBenchmark) FindNotDeletedByID(b *testing.B) {
+	benchmarkFindNotDeletedByID(b, p.findNotDeleted)
+}
+
+func (p *PgxBenchmark) findNotDeleted(id int64) (bool, error) {
+	_, err := scanBook(p.db.QueryRow(p.ctx, utils.SelectNotDeletedByIDQuery, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (p *PgxBenchmark) Exists(b *testing.B) {
+	benchmarkExists(b, func(isbn string) (bool, error) {
+		var exists bool
+		err := p.db.QueryRow(p.ctx, utils.ExistsByISBNQuery, isbn).Scan(&exists)
+		return exists, err
+	})
+}
+
+func (p *PgxBenchmark) Count(b *testing.B) {
+	benchmarkCount(b, func(genre string) (int, error) {
+		var count int
+		err := p.db.QueryRow(p.ctx, utils.CountByGenreQuery, genre).Scan(&count)
+		return count, err
+	})
+}
+
+func (p *PgxBenchmark) FindByIDs(b *testing.B) {
+	benchmarkFindByIDs(b, func(ids []int64) (int, error) {
+		rows, err := p.db.Query(p.ctx, utils.SelectByIDsQuery, ids)
+		if err != nil {
+			return 0, err
+		}
+		books, err := scanBooks(rows)
+		return len(books), err
+	})
+}
+
+func (p *PgxBenchmark) InsertListing(b *testing.B) {
+	benchmarkInsertListing(b, func(listing *model.Listing) error {
+		return p.db.QueryRow(p.ctx, utils.InsertListingQuery,
+			listing.Reference,
+			listing.Attributes,
+			[]string(listing.Tags),
+			listing.Price,
+			listing.Cover,
+			listing.Subtitle,
+			listing.DiscontinuedAt,
+			listing.ListedAt,
+		).Scan(&listing.ID)
+	})
+}
+
+func (p *PgxBenchmark) FindListingByID(b *testing.B) {
+	benchmarkFindListingByID(b, func(id int64) error {
+		_, err := scanListing(p.db.QueryRow(p.ctx, utils.SelectListingByIDQuery, id))
+		return err
+	})
+}
+
+func (p *PgxBenchmark) UpdateListing(b *testing.B) {
+	benchmarkUpdateListing(b, func(listing *model.Listing) error {
+		_, err := p.db.Exec(p.ctx, utils.UpdateListingQuery,
+			listing.Reference,
+			listing.Attributes,
+			[]string(listing.Tags),
+			listing.Price,
+			listing.Cover,
+			listing.Subtitle,
+			listing.DiscontinuedAt,
+			listing.ListedAt,
+			listing.ID,
+		)
+		return err
+	})
+}
+
+// scanListing reads the row of a query selecting one listing. pgx decodes the JSON and the array itself, the tags
+// being scanned as a []string rather than through the sql.Scanner of model.TextArray, which expects text.
+func scanListing(row pgx.Row) (*model.Listing, error) {
+	listing := new(model.Listing)
+	err := row.Scan(
+		&listing.ID,
+		&listing.Reference,
+		&listing.Attributes,
+		(*[]string)(&listing.Tags),
+		&listing.Price,
+		&listing.Cover,
+		&listing.Subtitle,
+		&listing.DiscontinuedAt,
+		&listing.ListedAt,
+	)
+	return listing, err
+}
+
+func (p *PgxBenchmark) InsertDraft(b *testing.B) {
+	benchmarkInsertDraft(b, func(draft *model.Draft) error {
+		return p.db.QueryRow(p.ctx, utils.InsertDraftQuery,
+			draft.Title,
+			draft.Subtitle,
+			draft.Author,
+			draft.Translator,
+			draft.Pages,
+			draft.Rating,
+			draft.Illustrated,
+			draft.SubmittedAt,
+		).Scan(&draft.ID)
+	})
+}
+
+func (p *PgxBenchmark) FindDraftByID(b *testing.B) {
+	benchmarkFindDraftByID(b, func(id int64) error {
+		_, err := scanDraft(p.db.QueryRow(p.ctx, utils.SelectDraftByIDQuery, id))
+		return err
+	})
+}
+
+func (p *PgxBenchmark) FindDrafts(b *testing.B) {
+	benchmarkFindDrafts(b, func(limit int) (int, error) {
+		rows, err := p.db.Query(p.ctx, utils.SelectDraftsQuery, limit)
+		if err != nil {
+			return 0, err
+		}
+		return collectDrafts(rows, limit)
+	})
+}
+
+// scanDraft reads a draft from a row or rows, pgx setting the pointers of the NULL columns to nil.
+func scanDraft(row pgx.Row) (*model.Draft, error) {
+	draft := new(model.Draft)
+	err := row.Scan(
+		&draft.ID,
+		&draft.Title,
+		&draft.Subtitle,
+		&draft.Author,
+		&draft.Translator,
+		&draft.Pages,
+		&draft.Rating,
+		&draft.Illustrated,
+		&draft.SubmittedAt,
+	)
+	return draft, err
+}
+
+// collectDrafts reads and closes rows of at most limit drafts, returning how many were read.
+func collectDrafts(rows pgx.Rows, limit int) (int, error) {
+	defer rows.Close()
+
+	drafts := make([]*model.Draft, 0, limit)
+	for rows.Next() {
+		draft, err := scanDraft(rows)
+		if err != nil {
+			return len(drafts), err
+		}
+		drafts = append(drafts, draft)
+	}
+	return len(drafts), rows.Err()
+}
+
+func (p *PgxBenchmark) InsertWide(b *testing.B) {
+	benchmarkInsertWide(b, func(wide *model.Wide) error {
+		return p.db.QueryRow(p.ctx, utils.InsertWideQuery, wide.Values()...).Scan(&wide.ID)
+	})
+}
+
+func (p *PgxBenchmark) FindWideByID(b *testing.B) {
+	benchmarkFindWideByID(b, func(id int64) error {
+		wide := new(model.Wide)
+		return p.db.QueryRow(p.ctx, utils.SelectWideByIDQuery, id).Scan(wide.Fields()...)
+	})
+}
+
+func (p *PgxBenchmark) FindWides(b *testing.B) {
+	benchmarkFindWides(b, func(limit int) (int, error) {
+		rows, err := p.db.Query(p.ctx, utils.SelectWidesQuery, limit)
+		if err != nil {
+			return 0, err
+		}
+		return collectWides(rows, limit)
+	})
+}
+
+// collectWides reads and closes rows of at most limit rows of the wides table, returning how many were read.
+func collectWides(rows pgx.Rows, limit int) (int, error) {
+	defer rows.Close()
+
+	wides := make([]*model.Wide, 0, limit)
+	for rows.Next() {
+		wide := new(model.Wide)
+		if err := rows.Scan(wide.Fields()...); err != nil {
+			return len(wides), err
+		}
+		wides = append(wides, wide)
+	}
+	return len(wides), rows.Err()
+}
+
+func (p *PgxBenchmark) InsertUUIDClient(b *testing.B) {
+	benchmarkInsertUUIDClient(b, func(book *model.UUIDBook) error {
+		_, err := p.db.Exec(p.ctx, utils.InsertUUIDBookQuery,
+			book.ID, book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+		return err
+	})
+}
+
+func (p *PgxBenchmark) InsertUUIDServer(b *testing.B) {
+	benchmarkInsertUUIDServer(b, func(book *model.UUIDBook) error {
+		return p.db.QueryRow(p.ctx, utils.InsertUUIDBookReturningIDQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&book.ID)
+	})
+}
+
+func (p *PgxBenchmark) FindUUIDByID(b *testing.B) {
+	benchmarkFindUUIDByID(b, func(id uuid.UUID) error {
+		_, err := scanUUIDBook(p.db.QueryRow(p.ctx, utils.SelectUUIDBookByIDQuery, id))
+		return err
+	})
+}
+
+func (p *PgxBenchmark) DeleteUUID(b *testing.B) {
+	benchmarkDeleteUUID(b, func(id uuid.UUID) error {
+		_, err := p.db.Exec(p.ctx, utils.DeleteUUIDBookQuery, id)
+		return err
+	})
+}
+
+// scanUUIDBook reads the row of a query selecting one book keyed by a UUID.
+func scanUUIDBook(row pgx.Row) (*model.UUIDBook, error) {
+	book := new(model.UUIDBook)
+	err := row.Scan(
+		&book.ID,
+		&book.ISBN,
+		&book.Title,
+		&book.Author,
+		&book.Genre,
+		&book.Quantity,
+		&book.PublicizedAt,
+	)
+	return book, err
+}
@@ -0,0 +1,65 @@
+package benchmark
+
+import (
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// ProjectionBenchmark is implemented by the adapters able to select some columns of a table. The operations mirror
+// select-one and select-page but read only the id and title of the books, into a model.BookTitle or scalars.
+type ProjectionBenchmark interface {
+	// FindTitleByID selects the id and title of a book by its id.
+	FindTitleByID(b *testing.B)
+	// FindTitlePage selects the ids and titles of a page of books.
+	FindTitlePage(b *testing.B)
+}
+
+// benchmarkFindTitleByID measures fn selecting the id and title of a seeded book, utils.FindOneLoop times per
+// iteration as select-one does.
+func benchmarkFindTitleByID(b *testing.B, fn func(id int64) error) {
+	book := model.NewBook()
+	if err := utils.SeedBooks(book); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for range utils.FindOneLoop {
+			err := fn(book.ID)
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+}
+
+// benchmarkFindTitlePage measures fn selecting every page of utils.BulkInsertPageNumber seeded books, as
+// select-page does. fn receives the keyset cursor of the page.
+func benchmarkFindTitlePage(b *testing.B, fn func(cursor int64) error) {
+	books := model.NewBooks(utils.BulkInsertPageNumber)
+	if err := utils.SeedBooks(books...); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			err := fn(pageCursor(books, s))
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+}
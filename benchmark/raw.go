@@ -2,18 +2,27 @@ package benchmark
 
 import (
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
 	"github.com/lauro-santana/golang-orm-benchmarks/model"
+
+	"github.com/google/uuid"
 )
 
 type RawBenchmark struct {
 	db *sql.DB
 }
 
+func init() {
+	Register(Adapter{Name: "database/sql", Category: CategoryRaw, New: NewRawBenchmark})
+}
+
 func NewRawBenchmark() Benchmark {
 	return &RawBenchmark{}
 }
@@ -28,6 +37,10 @@ func (r *RawBenchmark) Close() error {
 	return r.db.Close()
 }
 
+func (r *RawBenchmark) Capabilities() Capabilities {
+	return nil
+}
+
 func (r *RawBenchmark) Insert(b *testing.B) {
 	book := model.NewBook()
 
@@ -35,6 +48,10 @@ func (r *RawBenchmark) Insert(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		book.ISBN = model.NewISBN()
+		b.StartTimer()
+
 		_, err := r.db.Exec(utils.InsertQuery,
 			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
 
@@ -53,6 +70,12 @@ func (r *RawBenchmark) InsertBulk(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for _, book := range books {
+			book.ISBN = model.NewISBN()
+		}
+		b.StartTimer()
+
 		err := r.doInsertBulk(books)
 
 		if err != nil {
@@ -89,6 +112,7 @@ func (r *RawBenchmark) Delete(b *testing.B) {
 	bookIDs := make([]int64, 0, n)
 	for i := 0; i < n; i++ {
 		var id int64
+		book.ISBN = model.NewISBN()
 		err := r.db.QueryRow(utils.InsertReturningIDQuery,
 			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
 		if err != nil {
@@ -137,6 +161,8 @@ func (r *RawBenchmark) FindByID(b *testing.B) {
 				&foundBook.Genre,
 				&foundBook.Quantity,
 				&foundBook.PublicizedAt,
+				&foundBook.Version,
+				&foundBook.DeletedAt,
 			)
 
 			// checking the error will count on raw benchmarks
@@ -183,6 +209,8 @@ func (r *RawBenchmark) FindPage(b *testing.B) {
 					&book.Genre,
 					&book.Quantity,
 					&book.PublicizedAt,
+					&book.Version,
+					&book.DeletedAt,
 				); err != nil {
 					b.Error(err)
 				}
@@ -219,3 +247,775 @@ func (r *RawBenchmark) doInsertBulk(books []*model.Book) error {
 
 	return err
 }
+
+func (r *RawBenchmark) FindWithPricePolicies(b *testing.B) {
+	book, err := seedBookWithPricePolicies()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		rows, err := r.db.Query(utils.SelectWithPricePoliciesQuery, book.ID)
+
+		// checking the error will count on raw benchmarks
+		if err != nil {
+			b.Error(err)
+		}
+
+		var foundBook *model.Book
+		for rows.Next() {
+			var current model.Book
+			var policy model.PricePolicy
+			if err = rows.Scan(
+				&current.ID,
+				&current.ISBN,
+				&current.Title,
+				&current.Author,
+				&current.Genre,
+				&current.Quantity,
+				&current.PublicizedAt,
+				&policy.ID,
+				&policy.BookID,
+				&policy.Price,
+				&policy.StartDate,
+				&policy.EndDate,
+			); err != nil {
+				b.Error(err)
+			}
+			if foundBook == nil {
+				foundBook = &current
+			}
+			foundBook.PricePolicies = append(foundBook.PricePolicies, policy)
+		}
+	}
+
+	report()
+}
+
+func (r *RawBenchmark) FindPageWithPricePoliciesNaive(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			// making slices will count on raw benchmarks
+			booksPage = make([]model.Book, 0, utils.PageSize)
+
+			rows, err := r.db.Query(utils.SelectPaginatingQuery, pageCursor(books, s), utils.PageSize)
+
+			// checking the error will count on raw benchmarks
+			if err != nil {
+				b.Error(err)
+			}
+
+			for rows.Next() {
+				var book model.Book
+				if err = rows.Scan(
+					&book.ID,
+					&book.ISBN,
+					&book.Title,
+					&book.Author,
+					&book.Genre,
+					&book.Quantity,
+					&book.PublicizedAt,
+					&book.Version,
+					&book.DeletedAt,
+				); err != nil {
+					b.Error(err)
+				}
+				booksPage = append(booksPage, book)
+			}
+
+			for j := range booksPage {
+				rows, err = r.db.Query(utils.SelectPricePoliciesByBookQuery, booksPage[j].ID)
+				if err != nil {
+					b.Error(err)
+				}
+
+				for rows.Next() {
+					var policy model.PricePolicy
+					if err = rows.Scan(
+						&policy.ID,
+						&policy.BookID,
+						&policy.Price,
+						&policy.StartDate,
+						&policy.EndDate,
+					); err != nil {
+						b.Error(err)
+					}
+					booksPage[j].PricePolicies = append(booksPage[j].PricePolicies, policy)
+				}
+			}
+		}
+	}
+
+	report()
+}
+
+func (r *RawBenchmark) FindPageWithPricePoliciesEager(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			rows, err := r.db.Query(utils.SelectPaginatingWithPricePoliciesQuery, pageCursor(books, s), utils.PageSize)
+
+			// checking the error will count on raw benchmarks
+			if err != nil {
+				b.Error(err)
+			}
+
+			page := make([]*model.Book, 0, utils.PageSize)
+			for rows.Next() {
+				var current model.Book
+				var policy model.PricePolicy
+				if err = rows.Scan(
+					&current.ID,
+					&current.ISBN,
+					&current.Title,
+					&current.Author,
+					&current.Genre,
+					&current.Quantity,
+					&current.PublicizedAt,
+					&policy.ID,
+					&policy.BookID,
+					&policy.Price,
+					&policy.StartDate,
+					&policy.EndDate,
+				); err != nil {
+					b.Error(err)
+				}
+				if len(page) == 0 || page[len(page)-1].ID != current.ID {
+					page = append(page, &current)
+				}
+				book := page[len(page)-1]
+				book.PricePolicies = append(book.PricePolicies, policy)
+			}
+		}
+	}
+
+	report()
+}
+
+func (r *RawBenchmark) TransactionCommit(b *testing.B) {
+	benchmarkTransaction(b, true, r.doTransaction)
+}
+
+func (r *RawBenchmark) TransactionRollback(b *testing.B) {
+	benchmarkTransaction(b, false, r.doTransaction)
+}
+
+func (r *RawBenchmark) doTransaction(book *model.Book, commit bool) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// it is a no-op once the transaction is committed
+		_ = tx.Rollback()
+	}()
+
+	err = tx.QueryRow(utils.InsertReturningIDQuery,
+		book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&book.ID)
+	if err != nil {
+		return err
+	}
+	bookIDs, prices, startDates, endDates := pricePolicyArrays(
+		model.NewPricePolicies(book.ID, utils.TransactionPricePoliciesNumber))
+	_, err = tx.Exec(utils.InsertPricePoliciesQuery, bookIDs, prices, startDates, endDates)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(utils.UpdateQuantityQuery, updatedQuantity(book), book.ID)
+	if err != nil {
+		return err
+	}
+
+	if !commit {
+		return tx.Rollback()
+	}
+	return tx.Commit()
+}
+
+func (r *RawBenchmark) Upsert(b *testing.B) {
+	benchmarkUpsert(b, func(book *model.Book) error {
+		_, err := r.db.Exec(utils.UpsertQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+		return err
+	})
+}
+
+func (r *RawBenchmark) UpsertBulk(b *testing.B) {
+	benchmarkUpsertBulk(b, func(books []*model.Book) error {
+		valueStrings := make([]string, 0, len(books))
+		valueArgs := make([]interface{}, 0, len(books)*6)
+
+		start := 1
+
+		for _, book := range books {
+			placeholders := make([]string, 0, 6)
+			for i := 0; i < 6; i++ {
+				placeholders = append(placeholders, fmt.Sprintf("$%d", start))
+				start++
+			}
+			valueStrings = append(valueStrings, "("+strings.Join(placeholders, ",")+")")
+			valueArgs = append(valueArgs, book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+		}
+
+		query := fmt.Sprintf(utils.UpsertBulkQuery, strings.Join(valueStrings, ","))
+
+		_, err := r.db.Exec(query, valueArgs...)
+		return err
+	})
+}
+
+func (r *RawBenchmark) UpdateBulk(b *testing.B) {
+	benchmarkUpdateBulk(b, func(ids []int64, quantity int) error {
+		_, err := r.db.Exec(utils.UpdateQuantityByIDsQuery, quantity, ids)
+		return err
+	})
+}
+
+func (r *RawBenchmark) UpdateBulkValues(b *testing.B) {
+	benchmarkUpdateBulkValues(b, func(ids []int64, quantities []int) error {
+		valueStrings := make([]string, 0, len(ids))
+		valueArgs := make([]interface{}, 0, len(ids)*2)
+		for i := range ids {
+			valueStrings = append(valueStrings, fmt.Sprintf("($%d::INTEGER, $%d::INTEGER)", i*2+1, i*2+2))
+			valueArgs = append(valueArgs, ids[i], quantities[i])
+		}
+
+		query := fmt.Sprintf(utils.UpdateQuantitiesQuery, strings.Join(valueStrings, ","))
+
+		_, err := r.db.Exec(query, valueArgs...)
+		return err
+	})
+}
+
+func (r *RawBenchmark) DeleteBulk(b *testing.B) {
+	benchmarkDeleteBulk(b, func(ids []int64) error {
+		_, err := r.db.Exec(utils.DeleteByIDsQuery, ids)
+		return err
+	})
+}
+
+func (r *RawBenchmark) DeleteBulkWhere(b *testing.B) {
+	benchmarkDeleteBulkWhere(b, func(genre string) error {
+		_, err := r.db.Exec(utils.DeleteByGenreQuery, genre)
+		return err
+	})
+}
+
+func (r *RawBenchmark) FindPageOffset(b *testing.B) {
+	benchmarkPageDepths(b, func(offset int, _ int64) error {
+		rows, err := r.db.Query(utils.SelectPaginatingOffsetQuery, utils.PageSize, offset)
+		if err != nil {
+			return err
+		}
+		if _, err = scanSQLBooks(rows); err != nil {
+			return err
+		}
+
+		var total int64
+		return r.db.QueryRow(utils.CountBooksQuery).Scan(&total)
+	})
+}
+
+func (r *RawBenchmark) FindPageKeyset(b *testing.B) {
+	benchmarkPageDepths(b, func(_ int, cursor int64) error {
+		rows, err := r.db.Query(utils.SelectPaginatingKeysetQuery, cursor, utils.PageSize)
+		if err != nil {
+			return err
+		}
+		_, err = scanSQLBooks(rows)
+		return err
+	})
+}
+
+// scanSQLBooks reads the rows of a books query.
+func scanSQLBooks(rows *sql.Rows) ([]model.Book, error) {
+	defer rows.Close()
+
+	books := make([]model.Book, 0, utils.PageSize)
+	for rows.Next() {
+		var book model.Book
+		if err := rows.Scan(
+			&book.ID,
+			&book.ISBN,
+			&book.Title,
+			&book.Author,
+			&book.Genre,
+			&book.Quantity,
+			&book.PublicizedAt,
+			&book.Version,
+			&book.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		books = append(books, book)
+	}
+	return books, rows.Err()
+}
+
+func (r *RawBenchmark) SummarizeGenres(b *testing.B) {
+	benchmarkSummarizeGenres(b, func() error {
+		rows, err := r.db.Query(utils.SelectGenreSummariesQuery)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		var summaries []model.GenreSummary
+		for rows.Next() {
+			var summary model.GenreSummary
+			if err = rows.Scan(
+				&summary.Genre,
+				&summary.Books,
+				&summary.Quantity,
+				&summary.FirstPublicizedAt,
+				&summary.LastPublicizedAt,
+			); err != nil {
+				return err
+			}
+			summaries = append(summaries, summary)
+		}
+		return rows.Err()
+	})
+}
+
+func (r *RawBenchmark) Search(b *testing.B) {
+	benchmarkSearch(b, func(search bookSearch) error {
+		query, args := searchSQL(search)
+		rows, err := r.db.Query(query, args...)
+		if err != nil {
+			return err
+		}
+		_, err = scanSQLBooks(rows)
+		return err
+	})
+}
+
+func (r *RawBenchmark) FindTitleByID(b *testing.B) {
+	benchmarkFindTitleByID(b, func(id int64) error {
+		var title model.BookTitle
+		return r.db.QueryRow(utils.SelectTitleByIDQuery, id).Scan(&title.ID, &title.Title)
+	})
+}
+
+func (r *RawBenchmark) FindTitlePage(b *testing.B) {
+	benchmarkFindTitlePage(b, func(cursor int64) error {
+		rows, err := r.db.Query(utils.SelectTitlesPaginatingQuery, cursor, utils.PageSize)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		titles := make([]model.BookTitle, 0, utils.PageSize)
+		for rows.Next() {
+			var title model.BookTitle
+			if err = rows.Scan(&title.ID, &title.Title); err != nil {
+				return err
+			}
+			titles = append(titles, title)
+		}
+		return rows.Err()
+	})
+}
+
+func (r *RawBenchmark) Stream(b *testing.B) {
+	benchmarkStream(b, func(limit int) (int, error) {
+		rows, err := r.db.Query(utils.SelectPaginatingKeysetQuery, 0, limit)
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+
+		read := 0
+		var book model.Book
+		for rows.Next() {
+			if err = rows.Scan(
+				&book.ID,
+				&book.ISBN,
+				&book.Title,
+				&book.Author,
+				&book.Genre,
+				&book.Quantity,
+				&book.PublicizedAt,
+				&book.Version,
+				&book.DeletedAt,
+			); err != nil {
+				return read, err
+			}
+			read++
+		}
+		return read, rows.Err()
+	})
+}
+
+func (r *RawBenchmark) UpdatePartial(b *testing.B) {
+	benchmarkUpdatePartial(b, func(book *model.Book) error {
+		_, err := r.db.Exec(utils.UpdateQuantityQuery, book.Quantity, book.ID)
+		return err
+	})
+}
+
+func (r *RawBenchmark) Decrement(b *testing.B) {
+	benchmarkDecrement(b, r.decrement)
+}
+
+func (r *RawBenchmark) DecrementConcurrent(b *testing.B) {
+	benchmarkDecrementConcurrent(b, r.decrement)
+}
+
+func (r *RawBenchmark) decrement(id int64) (int, error) {
+	var quantity int
+	err := r.db.QueryRow(utils.DecrementQuantityQuery, id).Scan(&quantity)
+	return quantity, err
+}
+
+func (r *RawBenchmark) UpdateOptimistic(b *testing.B) {
+	benchmarkUpdateOptimistic(b, r.findBook, r.saveVersioned)
+}
+
+func (r *RawBenchmark) findBook(id int64) (*model.Book, error) {
+	return scanSQLBook(r.db.QueryRow(utils.SelectByIDQuery, id))
+}
+
+// scanSQLBook reads the row of a query selecting one book.
+func scanSQLBook(row *sql.Row) (*model.Book, error) {
+	book := new(model.Book)
+	err := row.Scan(
+		&book.ID,
+		&book.ISBN,
+		&book.Title,
+		&book.Author,
+		&book.Genre,
+		&book.Quantity,
+		&book.PublicizedAt,
+		&book.Version,
+		&book.DeletedAt,
+	)
+	return book, err
+}
+
+func (r *RawBenchmark) saveVersioned(book *model.Book) error {
+	result, err := r.db.Exec(utils.UpdateVersionedQuery, book.Quantity, book.ID, book.Version)
+	if err != nil {
+		return err
+	}
+	updated, err := result.RowsAffected()
+	if err == nil && updated == 0 {
+		return errVersionConflict
+	}
+	return err
+}
+
+func (r *RawBenchmark) SoftDelete(b *testing.B) {
+	benchmarkSoftDelete(b, func(id int64) error {
+		_, err := r.db.Exec(utils.SoftDeleteQuery, time.Now(), id)
+		return err
+	})
+}
+
+func (r *RawBenchmark) RestoreSoftDeleted(b *testing.B) {
+	benchmarkRestoreSoftDeleted(b, func(id int64) error {
+		_, err := r.db.Exec(utils.RestoreQuery, id)
+		return err
+	})
+}
+
+func (r *RawBenchmark) FindNotDeletedByID(b *testing.B) {
+	benchmarkFindNotDeletedByID(b, r.findNotDeleted)
+}
+
+func (r *RawBenchmark) findNotDeleted(id int64) (bool, error) {
+	_, err := scanSQLBook(r.db.QueryRow(utils.SelectNotDeletedByIDQuery, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *RawBenchmark) Exists(b *testing.B) {
+	benchmarkExists(b, func(isbn string) (bool, error) {
+		var exists bool
+		err := r.db.QueryRow(utils.ExistsByISBNQuery, isbn).Scan(&exists)
+		return exists, err
+	})
+}
+
+func (r *RawBenchmark) Count(b *testing.B) {
+	benchmarkCount(b, func(genre string) (int, error) {
+		var count int
+		err := r.db.QueryRow(utils.CountByGenreQuery, genre).Scan(&count)
+		return count, err
+	})
+}
+
+func (r *RawBenchmark) FindByIDs(b *testing.B) {
+	benchmarkFindByIDs(b, func(ids []int64) (int, error) {
+		rows, err := r.db.Query(utils.SelectByIDsQuery, ids)
+		if err != nil {
+			return 0, err
+		}
+		books, err := scanSQLBooks(rows)
+		return len(books), err
+	})
+}
+
+func (r *RawBenchmark) InsertListing(b *testing.B) {
+	benchmarkInsertListing(b, func(listing *model.Listing) error {
+		attributes, err := json.Marshal(listing.Attributes)
+		if err != nil {
+			return err
+		}
+		return r.db.QueryRow(utils.InsertListingQuery,
+			listing.Reference,
+			attributes,
+			listing.Tags,
+			listing.Price,
+			listing.Cover,
+			listing.Subtitle,
+			listing.DiscontinuedAt,
+			listing.ListedAt,
+		).Scan(&listing.ID)
+	})
+}
+
+func (r *RawBenchmark) FindListingByID(b *testing.B) {
+	benchmarkFindListingByID(b, func(id int64) error {
+		_, err := scanSQLListing(r.db.QueryRow(utils.SelectListingByIDQuery, id))
+		return err
+	})
+}
+
+func (r *RawBenchmark) UpdateListing(b *testing.B) {
+	benchmarkUpdateListing(b, func(listing *model.Listing) error {
+		attributes, err := json.Marshal(listing.Attributes)
+		if err != nil {
+			return err
+		}
+		_, err = r.db.Exec(utils.UpdateListingQuery,
+			listing.Reference,
+			attributes,
+			listing.Tags,
+			listing.Price,
+			listing.Cover,
+			listing.Subtitle,
+			listing.DiscontinuedAt,
+			listing.ListedAt,
+			listing.ID,
+		)
+		return err
+	})
+}
+
+// scanSQLListing reads the row of a query selecting one listing, decoding its attributes from JSON.
+func scanSQLListing(row *sql.Row) (*model.Listing, error) {
+	listing := new(model.Listing)
+	var attributes []byte
+	err := row.Scan(
+		&listing.ID,
+		&listing.Reference,
+		&attributes,
+		&listing.Tags,
+		&listing.Price,
+		&listing.Cover,
+		&listing.Subtitle,
+		&listing.DiscontinuedAt,
+		&listing.ListedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return listing, json.Unmarshal(attributes, &listing.Attributes)
+}
+
+func (r *RawBenchmark) InsertDraft(b *testing.B) {
+	benchmarkInsertDraft(b, func(draft *model.Draft) error {
+		d := newSQLDraft(draft)
+		return r.db.QueryRow(utils.InsertDraftQuery,
+			d.Title,
+			d.Subtitle,
+			d.Author,
+			d.Translator,
+			d.Pages,
+			d.Rating,
+			d.Illustrated,
+			d.SubmittedAt,
+		).Scan(&draft.ID)
+	})
+}
+
+func (r *RawBenchmark) FindDraftByID(b *testing.B) {
+	benchmarkFindDraftByID(b, func(id int64) error {
+		_, err := scanSQLDraft(r.db.QueryRow(utils.SelectDraftByIDQuery, id).Scan)
+		return err
+	})
+}
+
+func (r *RawBenchmark) FindDrafts(b *testing.B) {
+	benchmarkFindDrafts(b, func(limit int) (int, error) {
+		rows, err := r.db.Query(utils.SelectDraftsQuery, limit)
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+
+		drafts := make([]*sqlDraft, 0, limit)
+		for rows.Next() {
+			draft, err := scanSQLDraft(rows.Scan)
+			if err != nil {
+				return len(drafts), err
+			}
+			drafts = append(drafts, draft)
+		}
+		return len(drafts), rows.Err()
+	})
+}
+
+// sqlDraft maps the drafts table with the sql.Null* types of database/sql, the alternative to pointers that
+// does not allocate the values.
+type sqlDraft struct {
+	ID          int64
+	Title       sql.NullString
+	Subtitle    sql.NullString
+	Author      sql.NullString
+	Translator  sql.NullString
+	Pages       sql.NullInt32
+	Rating      sql.NullFloat64
+	Illustrated sql.NullBool
+	SubmittedAt sql.NullTime
+}
+
+func newSQLDraft(draft *model.Draft) *sqlDraft {
+	d := &sqlDraft{ID: draft.ID}
+	if draft.Title != nil {
+		d.Title = sql.NullString{String: *draft.Title, Valid: true}
+	}
+	if draft.Subtitle != nil {
+		d.Subtitle = sql.NullString{String: *draft.Subtitle, Valid: true}
+	}
+	if draft.Author != nil {
+		d.Author = sql.NullString{String: *draft.Author, Valid: true}
+	}
+	if draft.Translator != nil {
+		d.Translator = sql.NullString{String: *draft.Translator, Valid: true}
+	}
+	if draft.Pages != nil {
+		d.Pages = sql.NullInt32{Int32: int32(*draft.Pages), Valid: true}
+	}
+	if draft.Rating != nil {
+		d.Rating = sql.NullFloat64{Float64: *draft.Rating, Valid: true}
+	}
+	if draft.Illustrated != nil {
+		d.Illustrated = sql.NullBool{Bool: *draft.Illustrated, Valid: true}
+	}
+	if draft.SubmittedAt != nil {
+		d.SubmittedAt = sql.NullTime{Time: *draft.SubmittedAt, Valid: true}
+	}
+	return d
+}
+
+// scanSQLDraft reads a draft with scan, the Scan method of either a row or rows.
+func scanSQLDraft(scan func(dest ...any) error) (*sqlDraft, error) {
+	draft := new(sqlDraft)
+	err := scan(
+		&draft.ID,
+		&draft.Title,
+		&draft.Subtitle,
+		&draft.Author,
+		&draft.Translator,
+		&draft.Pages,
+		&draft.Rating,
+		&draft.Illustrated,
+		&draft.SubmittedAt,
+	)
+	return draft, err
+}
+
+func (r *RawBenchmark) InsertWide(b *testing.B) {
+	benchmarkInsertWide(b, func(wide *model.Wide) error {
+		return r.db.QueryRow(utils.InsertWideQuery, wide.Values()...).Scan(&wide.ID)
+	})
+}
+
+func (r *RawBenchmark) FindWideByID(b *testing.B) {
+	benchmarkFindWideByID(b, func(id int64) error {
+		wide := new(model.Wide)
+		return r.db.QueryRow(utils.SelectWideByIDQuery, id).Scan(wide.Fields()...)
+	})
+}
+
+func (r *RawBenchmark) FindWides(b *testing.B) {
+	benchmarkFindWides(b, func(limit int) (int, error) {
+		rows, err := r.db.Query(utils.SelectWidesQuery, limit)
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+
+		wides := make([]*model.Wide, 0, limit)
+		for rows.Next() {
+			wide := new(model.Wide)
+			if err = rows.Scan(wide.Fields()...); err != nil {
+				return len(wides), err
+			}
+			wides = append(wides, wide)
+		}
+		return len(wides), rows.Err()
+	})
+}
+
+func (r *RawBenchmark) InsertUUIDClient(b *testing.B) {
+	benchmarkInsertUUIDClient(b, func(book *model.UUIDBook) error {
+		_, err := r.db.Exec(utils.InsertUUIDBookQuery,
+			book.ID, book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+		return err
+	})
+}
+
+func (r *RawBenchmark) InsertUUIDServer(b *testing.B) {
+	benchmarkInsertUUIDServer(b, func(book *model.UUIDBook) error {
+		return r.db.QueryRow(utils.InsertUUIDBookReturningIDQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&book.ID)
+	})
+}
+
+func (r *RawBenchmark) FindUUIDByID(b *testing.B) {
+	benchmarkFindUUIDByID(b, func(id uuid.UUID) error {
+		var book model.UUIDBook
+		return r.db.QueryRow(utils.SelectUUIDBookByIDQuery, id).Scan(
+			&book.ID,
+			&book.ISBN,
+			&book.Title,
+			&book.Author,
+			&book.Genre,
+			&book.Quantity,
+			&book.PublicizedAt,
+		)
+	})
+}
+
+func (r *RawBenchmark) DeleteUUID(b *testing.B) {
+	benchmarkDeleteUUID(b, func(id uuid.UUID) error {
+		_, err := r.db.Exec(utils.DeleteUUIDBookQuery, id)
+		return err
+	})
+}
@@ -0,0 +1,405 @@
+package benchmark
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// Category groups the adapters by the kind of library being measured.
+type Category string
+
+const (
+	CategoryRaw     Category = "raw"
+	CategoryBuilder Category = "builder"
+	CategoryORM     Category = "orm"
+	CategoryCodegen Category = "codegen"
+)
+
+const (
+	InsertOp     = "insert"
+	InsertBulkOp = "insert-bulk"
+	UpdateOp     = "update"
+	DeleteOp     = "delete"
+	SelectOneOp  = "select-one"
+	SelectPageOp = "select-page"
+
+	UpdatePartialOp             = "update-partial"
+	UpdateDecrementOp           = "update-decrement"
+	UpdateDecrementConcurrentOp = "update-decrement-concurrent"
+	UpdateOptimisticOp          = "update-optimistic"
+
+	DeleteSoftOp          = "delete-soft"
+	UpdateRestoreOp       = "update-restore"
+	SelectOneNotDeletedOp = "select-one-not-deleted"
+
+	SelectByIDsOp = "select-by-ids"
+
+	InsertRichTypesOp    = "insert-rich-types"
+	SelectOneRichTypesOp = "select-one-rich-types"
+	UpdateRichTypesOp    = "update-rich-types"
+
+	InsertNullableOp     = "insert-nullable"
+	SelectOneNullableOp  = "select-one-nullable"
+	SelectPageNullableOp = "select-page-nullable"
+
+	InsertWideOp     = "insert-wide"
+	SelectOneWideOp  = "select-one-wide"
+	SelectPageWideOp = "select-page-wide"
+
+	InsertUUIDClientOp = "insert-uuid-client"
+	InsertUUIDServerOp = "insert-uuid-server"
+	SelectOneUUIDOp    = "select-one-uuid"
+	DeleteUUIDOp       = "delete-uuid"
+
+	SelectOneColumnsOp  = "select-one-columns"
+	SelectPageColumnsOp = "select-page-columns"
+
+	SelectPageOffsetOp = "select-page-offset"
+	SelectPageKeysetOp = "select-page-keyset"
+
+	SelectStreamOp = "select-stream"
+
+	SelectGroupByOp = "select-group-by"
+	SelectSearchOp  = "select-search"
+
+	SelectExistsOp = "select-exists"
+	SelectCountOp  = "select-count"
+
+	SelectRelationsOp          = "select-relations"
+	SelectPageRelationsNaiveOp = "select-page-relations-naive"
+	SelectPageRelationsEagerOp = "select-page-relations-eager"
+
+	UpdateBulkOp       = "update-bulk"
+	UpdateBulkValuesOp = "update-bulk-values"
+	DeleteBulkOp       = "delete-bulk"
+	DeleteBulkWhereOp  = "delete-bulk-where"
+
+	UpsertOp     = "upsert"
+	UpsertBulkOp = "upsert-bulk"
+
+	TransactionCommitOp   = "transaction-commit"
+	TransactionRollbackOp = "transaction-rollback"
+)
+
+// Operation binds an operation name, as accepted on the command line, to the Benchmark method that runs it.
+// Run returns nil when the adapter does not implement the operation, which is reported as unsupported.
+type Operation struct {
+	Name        string
+	Description string
+	Run         func(Benchmark) func(*testing.B)
+}
+
+// Operations lists every operation in the order they are reported.
+var Operations = []Operation{
+	{Name: InsertOp, Description: "insert one book", Run: func(b Benchmark) func(*testing.B) { return b.Insert }},
+	{Name: InsertBulkOp, Description: "insert books in bulk", Run: func(b Benchmark) func(*testing.B) { return b.InsertBulk }},
+	{Name: UpdateOp, Description: "update all columns of one book", Run: func(b Benchmark) func(*testing.B) { return b.Update }},
+	{Name: UpdatePartialOp, Description: "update the quantity of one book only", Run: func(b Benchmark) func(*testing.B) {
+		if p, ok := b.(PartialUpdateBenchmark); ok {
+			return p.UpdatePartial
+		}
+		return nil
+	}},
+	{Name: UpdateDecrementOp, Description: "decrement the quantity of a book in stock and return the new value", Run: func(b Benchmark) func(*testing.B) {
+		if d, ok := b.(DecrementBenchmark); ok {
+			return d.Decrement
+		}
+		return nil
+	}},
+	{Name: UpdateDecrementConcurrentOp, Description: "decrement the quantity of the same book from concurrent goroutines", Run: func(b Benchmark) func(*testing.B) {
+		if d, ok := b.(DecrementBenchmark); ok {
+			return d.DecrementConcurrent
+		}
+		return nil
+	}},
+	{Name: UpdateOptimisticOp, Description: "read a book and update it unless its version changed meanwhile", Run: func(b Benchmark) func(*testing.B) {
+		if o, ok := b.(OptimisticLockBenchmark); ok {
+			return o.UpdateOptimistic
+		}
+		return nil
+	}},
+	{Name: DeleteOp, Description: "delete one book by id", Run: func(b Benchmark) func(*testing.B) { return b.Delete }},
+	{Name: DeleteSoftOp, Description: "soft delete one book by id, setting its deleted_at", Run: func(b Benchmark) func(*testing.B) {
+		if s, ok := b.(SoftDeleteBenchmark); ok {
+			return s.SoftDelete
+		}
+		return nil
+	}},
+	{Name: UpdateRestoreOp, Description: "restore one soft deleted book by id", Run: func(b Benchmark) func(*testing.B) {
+		if s, ok := b.(SoftDeleteBenchmark); ok {
+			return s.RestoreSoftDeleted
+		}
+		return nil
+	}},
+	{Name: SelectOneOp, Description: "select one book by id", Run: func(b Benchmark) func(*testing.B) { return b.FindByID }},
+	{Name: SelectOneNotDeletedOp, Description: "select one book by id unless it is soft deleted", Run: func(b Benchmark) func(*testing.B) {
+		if s, ok := b.(SoftDeleteBenchmark); ok {
+			return s.FindNotDeletedByID
+		}
+		return nil
+	}},
+	{Name: SelectPageOp, Description: "select pages of books using keyset pagination", Run: func(b Benchmark) func(*testing.B) { return b.FindPage }},
+	{Name: SelectByIDsOp, Description: "select the books of lists of 10, 100 and 1000 ids", Run: func(b Benchmark) func(*testing.B) {
+		if l, ok := b.(IDListBenchmark); ok {
+			return l.FindByIDs
+		}
+		return nil
+	}},
+	{Name: InsertRichTypesOp, Description: "insert one listing, a row of UUID, JSONB, TEXT[], NUMERIC, BYTEA and TIMESTAMPTZ columns", Run: func(b Benchmark) func(*testing.B) {
+		if r, ok := b.(RichTypesBenchmark); ok {
+			return r.InsertListing
+		}
+		return nil
+	}},
+	{Name: SelectOneRichTypesOp, Description: "select one listing by id", Run: func(b Benchmark) func(*testing.B) {
+		if r, ok := b.(RichTypesBenchmark); ok {
+			return r.FindListingByID
+		}
+		return nil
+	}},
+	{Name: UpdateRichTypesOp, Description: "update all columns of one listing", Run: func(b Benchmark) func(*testing.B) {
+		if r, ok := b.(RichTypesBenchmark); ok {
+			return r.UpdateListing
+		}
+		return nil
+	}},
+	{Name: InsertNullableOp, Description: "insert one draft, a row of nullable columns half of which are NULL", Run: func(b Benchmark) func(*testing.B) {
+		if n, ok := b.(NullableBenchmark); ok {
+			return n.InsertDraft
+		}
+		return nil
+	}},
+	{Name: SelectOneNullableOp, Description: "select one draft by id", Run: func(b Benchmark) func(*testing.B) {
+		if n, ok := b.(NullableBenchmark); ok {
+			return n.FindDraftByID
+		}
+		return nil
+	}},
+	{Name: SelectPageNullableOp, Description: "select 1000 drafts, half of their values NULL", Run: func(b Benchmark) func(*testing.B) {
+		if n, ok := b.(NullableBenchmark); ok {
+			return n.FindDrafts
+		}
+		return nil
+	}},
+	{Name: InsertWideOp, Description: fmt.Sprintf("insert one row of the wides table, of %d columns", model.WideColumnsNumber), Run: func(b Benchmark) func(*testing.B) {
+		if w, ok := b.(WideBenchmark); ok {
+			return w.InsertWide
+		}
+		return nil
+	}},
+	{Name: SelectOneWideOp, Description: "select one row of the wides table by id", Run: func(b Benchmark) func(*testing.B) {
+		if w, ok := b.(WideBenchmark); ok {
+			return w.FindWideByID
+		}
+		return nil
+	}},
+	{Name: SelectPageWideOp, Description: "select 100 rows of the wides table", Run: func(b Benchmark) func(*testing.B) {
+		if w, ok := b.(WideBenchmark); ok {
+			return w.FindWides
+		}
+		return nil
+	}},
+	{Name: InsertUUIDClientOp, Description: "insert one book keyed by a UUID generated by the application", Run: func(b Benchmark) func(*testing.B) {
+		if u, ok := b.(UUIDKeyBenchmark); ok {
+			return u.InsertUUIDClient
+		}
+		return nil
+	}},
+	{Name: InsertUUIDServerOp, Description: "insert one book keyed by a UUID generated by the database", Run: func(b Benchmark) func(*testing.B) {
+		if u, ok := b.(UUIDGeneratedKeyBenchmark); ok {
+			return u.InsertUUIDServer
+		}
+		return nil
+	}},
+	{Name: SelectOneUUIDOp, Description: "select one book by its UUID", Run: func(b Benchmark) func(*testing.B) {
+		if u, ok := b.(UUIDKeyBenchmark); ok {
+			return u.FindUUIDByID
+		}
+		return nil
+	}},
+	{Name: DeleteUUIDOp, Description: "delete one book by its UUID", Run: func(b Benchmark) func(*testing.B) {
+		if u, ok := b.(UUIDKeyBenchmark); ok {
+			return u.DeleteUUID
+		}
+		return nil
+	}},
+	{Name: SelectOneColumnsOp, Description: "select the id and title of a book by id", Run: func(b Benchmark) func(*testing.B) {
+		if p, ok := b.(ProjectionBenchmark); ok {
+			return p.FindTitleByID
+		}
+		return nil
+	}},
+	{Name: SelectPageColumnsOp, Description: "select pages of book ids and titles", Run: func(b Benchmark) func(*testing.B) {
+		if p, ok := b.(ProjectionBenchmark); ok {
+			return p.FindTitlePage
+		}
+		return nil
+	}},
+	{Name: SelectPageOffsetOp, Description: "select pages at growing depths using LIMIT/OFFSET and count the books", Run: func(b Benchmark) func(*testing.B) {
+		if p, ok := b.(PaginationBenchmark); ok {
+			return p.FindPageOffset
+		}
+		return nil
+	}},
+	{Name: SelectPageKeysetOp, Description: "select pages at growing depths using keyset pagination", Run: func(b Benchmark) func(*testing.B) {
+		if p, ok := b.(PaginationBenchmark); ok {
+			return p.FindPageKeyset
+		}
+		return nil
+	}},
+	{Name: SelectStreamOp, Description: "read 100,000 books from a single query, reporting the throughput and peak heap", Run: func(b Benchmark) func(*testing.B) {
+		if s, ok := b.(StreamBenchmark); ok {
+			return s.Stream
+		}
+		return nil
+	}},
+	{Name: SelectGroupByOp, Description: "select the count, total quantity and publication range of every genre", Run: func(b Benchmark) func(*testing.B) {
+		if a, ok := b.(AggregateBenchmark); ok {
+			return a.SummarizeGenres
+		}
+		return nil
+	}},
+	{Name: SelectSearchOp, Description: "select a page of books matching a random combination of optional filters", Run: func(b Benchmark) func(*testing.B) {
+		if s, ok := b.(SearchBenchmark); ok {
+			return s.Search
+		}
+		return nil
+	}},
+	{Name: SelectExistsOp, Description: "check whether a book with a given isbn exists", Run: func(b Benchmark) func(*testing.B) {
+		if s, ok := b.(ScalarBenchmark); ok {
+			return s.Exists
+		}
+		return nil
+	}},
+	{Name: SelectCountOp, Description: "count the books of a genre", Run: func(b Benchmark) func(*testing.B) {
+		if s, ok := b.(ScalarBenchmark); ok {
+			return s.Count
+		}
+		return nil
+	}},
+	{Name: SelectRelationsOp, Description: "select one book with its price policies", Run: func(b Benchmark) func(*testing.B) {
+		if r, ok := b.(RelationBenchmark); ok {
+			return r.FindWithPricePolicies
+		}
+		return nil
+	}},
+	{Name: SelectPageRelationsNaiveOp, Description: "select pages of books, then the price policies of each book (N+1)", Run: func(b Benchmark) func(*testing.B) {
+		if r, ok := b.(RelationBenchmark); ok {
+			return r.FindPageWithPricePoliciesNaive
+		}
+		return nil
+	}},
+	{Name: SelectPageRelationsEagerOp, Description: "select pages of books with their price policies eagerly loaded", Run: func(b Benchmark) func(*testing.B) {
+		if r, ok := b.(RelationBenchmark); ok {
+			return r.FindPageWithPricePoliciesEager
+		}
+		return nil
+	}},
+	{Name: UpdateBulkOp, Description: "set the same quantity on books selected by a list of ids", Run: func(b Benchmark) func(*testing.B) {
+		if m, ok := b.(BulkMutationBenchmark); ok {
+			return m.UpdateBulk
+		}
+		return nil
+	}},
+	{Name: UpdateBulkValuesOp, Description: "set a different quantity on each book in one statement", Run: func(b Benchmark) func(*testing.B) {
+		if m, ok := b.(BulkMutationBenchmark); ok {
+			return m.UpdateBulkValues
+		}
+		return nil
+	}},
+	{Name: DeleteBulkOp, Description: "delete books by a list of ids", Run: func(b Benchmark) func(*testing.B) {
+		if m, ok := b.(BulkMutationBenchmark); ok {
+			return m.DeleteBulk
+		}
+		return nil
+	}},
+	{Name: DeleteBulkWhereOp, Description: "delete books matching a predicate on genre", Run: func(b Benchmark) func(*testing.B) {
+		if m, ok := b.(BulkMutationBenchmark); ok {
+			return m.DeleteBulkWhere
+		}
+		return nil
+	}},
+	{Name: UpsertOp, Description: "insert one book or update it when its isbn exists", Run: func(b Benchmark) func(*testing.B) {
+		if u, ok := b.(UpsertBenchmark); ok {
+			return u.Upsert
+		}
+		return nil
+	}},
+	{Name: UpsertBulkOp, Description: "insert books in bulk or update the ones whose isbn exists", Run: func(b Benchmark) func(*testing.B) {
+		if u, ok := b.(UpsertBenchmark); ok {
+			return u.UpsertBulk
+		}
+		return nil
+	}},
+	{Name: TransactionCommitOp, Description: "insert a book with its price policies and update it in a committed transaction", Run: func(b Benchmark) func(*testing.B) {
+		if t, ok := b.(TransactionBenchmark); ok {
+			return t.TransactionCommit
+		}
+		return nil
+	}},
+	{Name: TransactionRollbackOp, Description: "insert a book with its price policies and update it in a rolled back transaction", Run: func(b Benchmark) func(*testing.B) {
+		if t, ok := b.(TransactionBenchmark); ok {
+			return t.TransactionRollback
+		}
+		return nil
+	}},
+}
+
+// Support tells how the given adapter runs the operation.
+func (op Operation) Support(b Benchmark) Support {
+	if op.Run(b) == nil {
+		return Unsupported
+	}
+	return b.Capabilities().Of(op.Name)
+}
+
+// LookupOperation returns the operation registered with the given name.
+func LookupOperation(name string) (Operation, bool) {
+	for _, op := range Operations {
+		if op.Name == name {
+			return op, true
+		}
+	}
+	return Operation{}, false
+}
+
+// Adapter describes a benchmarked library. Every adapter registers itself in an init function of its own file.
+type Adapter struct {
+	Name     string
+	Category Category
+	New      func() Benchmark
+}
+
+var adapters = map[string]Adapter{}
+
+// Register adds an adapter to the registry. It panics if the name is empty or already taken.
+func Register(adapter Adapter) {
+	if adapter.Name == "" || adapter.New == nil {
+		panic("benchmark: adapter requires a name and a constructor")
+	}
+	if _, ok := adapters[adapter.Name]; ok {
+		panic(fmt.Sprintf("benchmark: adapter %q registered twice", adapter.Name))
+	}
+	adapters[adapter.Name] = adapter
+}
+
+// Adapters returns the registered adapters sorted by name.
+func Adapters() []Adapter {
+	list := make([]Adapter, 0, len(adapters))
+	for _, adapter := range adapters {
+		list = append(list, adapter)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// LookupAdapter returns the adapter registered with the given name.
+func LookupAdapter(name string) (Adapter, bool) {
+	adapter, ok := adapters[name]
+	return adapter, ok
+}
@@ -0,0 +1,45 @@
+package benchmark
+
+import (
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// RelationBenchmark is implemented by the adapters able to load books together with their price policies.
+// The operations report how many statements they issue besides the usual metrics.
+type RelationBenchmark interface {
+	FindWithPricePolicies(b *testing.B)
+	// FindPageWithPricePoliciesNaive selects a page of books and then the price policies of each book (N+1).
+	FindPageWithPricePoliciesNaive(b *testing.B)
+	// FindPageWithPricePoliciesEager selects a page of books and their price policies using eager loading.
+	FindPageWithPricePoliciesEager(b *testing.B)
+}
+
+// seedBookWithPricePolicies persists one book owning utils.PricePoliciesNumber price policies.
+func seedBookWithPricePolicies() (*model.Book, error) {
+	books, err := seedBooksWithPricePolicies(1)
+	if err != nil {
+		return nil, err
+	}
+	return books[0], nil
+}
+
+// seedBooksWithPricePolicies persists the given quantity of books, each one owning utils.PricePoliciesNumber price policies.
+func seedBooksWithPricePolicies(quantity int) ([]*model.Book, error) {
+	books := model.NewBooks(quantity)
+	if err := utils.SeedBooks(books...); err != nil {
+		return nil, err
+	}
+	policies := make([]*model.PricePolicy, 0, quantity*utils.PricePoliciesNumber)
+	for _, book := range books {
+		policies = append(policies, model.NewPricePolicies(book.ID, utils.PricePoliciesNumber)...)
+	}
+	return books, utils.SeedPricePolicies(policies...)
+}
+
+// pageCursor returns the keyset cursor selecting the page that starts at the seeded book of the given index.
+func pageCursor(books []*model.Book, index int) int64 {
+	return books[index].ID - 1
+}
@@ -0,0 +1,103 @@
+package benchmark
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+
+	"github.com/google/uuid"
+)
+
+// RichTypesBenchmark is implemented by the adapters able to map the columns of the listings table, whose types go
+// beyond the VARCHAR, INTEGER and TIMESTAMP of the books. The operations mirror insert, select-one and update, so
+// the difference shows what converting the types costs each library.
+type RichTypesBenchmark interface {
+	// InsertListing inserts one listing and reads back its id.
+	InsertListing(b *testing.B)
+	// FindListingByID selects one listing by its id.
+	FindListingByID(b *testing.B)
+	// UpdateListing updates all columns of one listing.
+	UpdateListing(b *testing.B)
+}
+
+// benchmarkInsertListing measures fn inserting a listing with a new reference at each iteration.
+func benchmarkInsertListing(b *testing.B, fn func(listing *model.Listing) error) {
+	listing := model.NewListing()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		listing.ID = 0
+		listing.Reference = uuid.New()
+		b.StartTimer()
+
+		err := fn(listing)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// benchmarkFindListingByID measures fn selecting a seeded listing, utils.FindOneLoop times per iteration as
+// select-one does.
+func benchmarkFindListingByID(b *testing.B, fn func(id int64) error) {
+	listing := model.NewListing()
+	if err := utils.SeedListings(listing); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for range utils.FindOneLoop {
+			err := fn(listing.ID)
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+}
+
+// benchmarkUpdateListing measures fn updating a seeded listing, whose price, attributes and nullable
+// discontinuation date change at every iteration.
+func benchmarkUpdateListing(b *testing.B, fn func(listing *model.Listing) error) {
+	listing := model.NewListing()
+	if err := utils.SeedListings(listing); err != nil {
+		b.Error(err)
+	}
+	discontinuedAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		listing.Price = float64(4000+i%1000) / 100
+		listing.Attributes.Pages = 300 + i%100
+		if i%2 == 0 {
+			listing.DiscontinuedAt = &discontinuedAt
+		} else {
+			listing.DiscontinuedAt = nil
+		}
+		b.StartTimer()
+
+		err := fn(listing)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
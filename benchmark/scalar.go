@@ -0,0 +1,89 @@
+package benchmark
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// ScalarBenchmark is implemented by the adapters able to run queries returning a single value, which are served
+// by an index and cost little more than the round trip, so the overhead of the library shows.
+type ScalarBenchmark interface {
+	// Exists checks whether a book with a given isbn exists, through the unique index of the column.
+	Exists(b *testing.B)
+	// Count counts the books of a genre, through the index of the column.
+	Count(b *testing.B)
+}
+
+var errExistingISBNMissing = errors.New("the book of the isbn was not found")
+
+// benchmarkExists measures fn checking the isbn of a seeded book, besides the utils.CatalogBooksNumber books of the
+// catalog. An isbn that no book has is checked once beforehand.
+func benchmarkExists(b *testing.B, fn func(isbn string) (bool, error)) {
+	book := model.NewBook()
+	err := utils.SeedCatalog(utils.CatalogBooksNumber)
+	if err == nil {
+		err = utils.SeedBooks(book)
+	}
+	if err != nil {
+		b.Error(err)
+	}
+	exists, err := fn(model.NewISBN())
+	if err == nil && exists {
+		err = errors.New("a book was found for an unused isbn")
+	}
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		exists, err := fn(book.ISBN)
+
+		b.StopTimer()
+		if err == nil && !exists {
+			err = errExistingISBNMissing
+		}
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// benchmarkCount measures fn counting the books of the largest catalog genre, and checks it returns the same count
+// every time.
+func benchmarkCount(b *testing.B, fn func(genre string) (int, error)) {
+	if err := utils.SeedCatalog(utils.CatalogBooksNumber); err != nil {
+		b.Error(err)
+	}
+	genre := model.CatalogGenres()[0]
+	want, err := fn(genre)
+	if err == nil && want == 0 {
+		err = fmt.Errorf("no book of genre %q was counted", genre)
+	}
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		count, err := fn(genre)
+
+		b.StopTimer()
+		if err == nil && count != want {
+			err = fmt.Errorf("counted %d books of genre %q, want %d", count, genre, want)
+		}
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
@@ -0,0 +1,135 @@
+package benchmark
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// SearchBenchmark is implemented by the adapters able to build a query from optional filters.
+type SearchBenchmark interface {
+	// Search selects a page of books matching a combination of filters, ordered by the latest publication.
+	Search(b *testing.B)
+}
+
+// bookSearch is a combination of the optional filters of a book search. A filter is left out while its field holds
+// the zero value.
+type bookSearch struct {
+	author        string
+	genres        []string
+	minQuantity   *int
+	maxQuantity   *int
+	publishedFrom time.Time
+	publishedTo   time.Time
+	title         string
+}
+
+// titlePattern returns the ILIKE pattern matching the titles containing the searched title.
+func (s bookSearch) titlePattern() string {
+	return "%" + s.title + "%"
+}
+
+// searchTitles are the words searched in the titles of the catalog books.
+var searchTitles = []string{"go", "idiomatic", "programming", "real-world"}
+
+// newBookSearches returns the given number of filter combinations, each filter being picked by chance. The seed is
+// fixed so every adapter runs the same searches.
+func newBookSearches(quantity int) []bookSearch {
+	rng := rand.New(rand.NewSource(1))
+	authors := model.CatalogAuthors()
+	genres := model.CatalogGenres()
+
+	searches := make([]bookSearch, quantity)
+	for i := range searches {
+		search := &searches[i]
+		if rng.Intn(2) == 0 {
+			search.author = authors[rng.Intn(len(authors))]
+		}
+		if rng.Intn(2) == 0 {
+			for _, j := range rng.Perm(len(genres))[:1+rng.Intn(3)] {
+				search.genres = append(search.genres, genres[j])
+			}
+		}
+		if rng.Intn(2) == 0 {
+			minQuantity := rng.Intn(25)
+			maxQuantity := minQuantity + 10 + rng.Intn(15)
+			search.minQuantity, search.maxQuantity = &minQuantity, &maxQuantity
+		}
+		if rng.Intn(2) == 0 {
+			search.publishedFrom = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, rng.Intn(6000))
+			search.publishedTo = search.publishedFrom.AddDate(1+rng.Intn(5), 0, 0)
+		}
+		if rng.Intn(2) == 0 {
+			search.title = searchTitles[rng.Intn(len(searchTitles))]
+		}
+	}
+	return searches
+}
+
+// searchSQL writes the query of a search by hand, for the adapters running plain SQL.
+func searchSQL(search bookSearch) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	where := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if search.author != "" {
+		where("author = $%d", search.author)
+	}
+	if search.genres != nil {
+		where("genre = ANY($%d)", search.genres)
+	}
+	if search.minQuantity != nil {
+		where("quantity >= $%d", *search.minQuantity)
+	}
+	if search.maxQuantity != nil {
+		where("quantity <= $%d", *search.maxQuantity)
+	}
+	if !search.publishedFrom.IsZero() {
+		where("publicized_at >= $%d", search.publishedFrom)
+	}
+	if !search.publishedTo.IsZero() {
+		where("publicized_at < $%d", search.publishedTo)
+	}
+	if search.title != "" {
+		where("title ILIKE $%d", search.titlePattern())
+	}
+
+	var query strings.Builder
+	query.WriteString("SELECT * FROM books")
+	if len(conditions) > 0 {
+		query.WriteString(" WHERE ")
+		query.WriteString(strings.Join(conditions, " AND "))
+	}
+	args = append(args, utils.PageSize)
+	fmt.Fprintf(&query, " ORDER BY publicized_at DESC LIMIT $%d", len(args))
+	return query.String(), args
+}
+
+// benchmarkSearch measures fn running the searches of newBookSearches in turn over the catalog books.
+func benchmarkSearch(b *testing.B, fn func(search bookSearch) error) {
+	if err := utils.SeedCatalog(utils.CatalogBooksNumber); err != nil {
+		b.Error(err)
+	}
+	searches := newBookSearches(utils.SearchesNumber)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err := fn(searches[i%len(searches)])
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
@@ -0,0 +1,118 @@
+package benchmark
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// SoftDeleteBenchmark is implemented by the adapters able to soft delete books, setting their deleted_at column
+// rather than removing them. gorm, bun and go-pg scope their queries to the rows not deleted by themselves, the
+// other adapters filter on deleted_at explicitly.
+type SoftDeleteBenchmark interface {
+	// SoftDelete marks one book as deleted by its id.
+	SoftDelete(b *testing.B)
+	// RestoreSoftDeleted clears the deletion of one book by its id.
+	RestoreSoftDeleted(b *testing.B)
+	// FindNotDeletedByID selects one book by its id, unless it is soft deleted.
+	FindNotDeletedByID(b *testing.B)
+}
+
+// findNotDeletedFunc selects the book with the given id and reports whether it was found, a soft deleted book
+// being not found rather than an error.
+type findNotDeletedFunc func(id int64) (bool, error)
+
+var (
+	errSoftDeletedFound  = errors.New("the soft deleted book was selected")
+	errNotDeletedMissing = errors.New("the book not deleted was not selected")
+)
+
+// newSoftDeletedBooks returns n books already deleted, to be seeded.
+func newSoftDeletedBooks(n int) []*model.Book {
+	deletedAt := time.Now().UTC()
+	books := model.NewBooks(n)
+	for _, book := range books {
+		book.DeletedAt = &deletedAt
+	}
+	return books
+}
+
+// benchmarkSoftDelete measures fn soft deleting a different seeded book at each iteration, as delete does.
+func benchmarkSoftDelete(b *testing.B, fn func(id int64) error) {
+	books := model.NewBooks(b.N)
+	if err := utils.SeedBooks(books...); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err := fn(books[i].ID)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// benchmarkRestoreSoftDeleted measures fn restoring a different soft deleted book at each iteration.
+func benchmarkRestoreSoftDeleted(b *testing.B, fn func(id int64) error) {
+	books := newSoftDeletedBooks(b.N)
+	if err := utils.SeedBooks(books...); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err := fn(books[i].ID)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// benchmarkFindNotDeletedByID measures fn selecting a book that is not deleted, utils.FindOneLoop times per
+// iteration as select-one does. A soft deleted book is looked up once beforehand, to check it is filtered out.
+func benchmarkFindNotDeletedByID(b *testing.B, fn findNotDeletedFunc) {
+	book := model.NewBook()
+	deleted := newSoftDeletedBooks(1)[0]
+	if err := utils.SeedBooks(book, deleted); err != nil {
+		b.Error(err)
+	}
+	found, err := fn(deleted.ID)
+	if err == nil && found {
+		err = errSoftDeletedFound
+	}
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for range utils.FindOneLoop {
+			found, err := fn(book.ID)
+
+			b.StopTimer()
+			if err == nil && !found {
+				err = errNotDeletedMissing
+			}
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+}
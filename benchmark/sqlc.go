@@ -2,12 +2,18 @@ package benchmark
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/sqlc/repository"
 	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
 	"github.com/lauro-santana/golang-orm-benchmarks/model"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -18,6 +24,10 @@ type SqlcBenchmark struct {
 	ctx        context.Context
 }
 
+func init() {
+	Register(Adapter{Name: "sqlc", Category: CategoryCodegen, New: NewSqlcBenchmark})
+}
+
 func NewSqlcBenchmark() Benchmark {
 	return &SqlcBenchmark{ctx: context.Background()}
 }
@@ -37,6 +47,10 @@ func (s *SqlcBenchmark) Close() error {
 	return nil
 }
 
+func (s *SqlcBenchmark) Capabilities() Capabilities {
+	return nil
+}
+
 func (s *SqlcBenchmark) Insert(b *testing.B) {
 	book := model.NewBook()
 
@@ -46,6 +60,7 @@ func (s *SqlcBenchmark) Insert(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
 		book.ID = 0
+		book.ISBN = model.NewISBN()
 		b.StartTimer()
 
 		err := s.repository.Create(s.ctx, repository.CreateParams{
@@ -84,6 +99,12 @@ func (s *SqlcBenchmark) InsertBulk(b *testing.B) {
 	}
 
 	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for j := range batch {
+			batch[j].Isbn = model.NewISBN()
+		}
+		b.StartTimer()
+
 		_, err := s.repository.CreateMany(s.ctx, batch)
 
 		if err != nil {
@@ -133,7 +154,7 @@ func (s *SqlcBenchmark) Delete(b *testing.B) {
 	bookIDs := make([]int32, n)
 	for i := 0; i < n; i++ {
 		id, err := s.repository.CreateReturningID(s.ctx, repository.CreateReturningIDParams{
-			Isbn:         book.ISBN,
+			Isbn:         model.NewISBN(),
 			Title:        book.Title,
 			Author:       book.Author,
 			Genre:        book.Genre,
@@ -197,7 +218,7 @@ func (s *SqlcBenchmark) FindPage(b *testing.B) {
 	bookIDs := make([]int32, utils.BulkInsertPageNumber)
 	for i := 0; i < utils.BulkInsertPageNumber; i++ {
 		id, err := s.repository.CreateReturningID(s.ctx, repository.CreateReturningIDParams{
-			Isbn:         book.ISBN,
+			Isbn:         model.NewISBN(),
 			Title:        book.Title,
 			Author:       book.Author,
 			Genre:        book.Genre,
@@ -228,3 +249,598 @@ func (s *SqlcBenchmark) FindPage(b *testing.B) {
 		}
 	}
 }
+
+func (s *SqlcBenchmark) FindWithPricePolicies(b *testing.B) {
+	book, err := seedBookWithPricePolicies()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := s.repository.GetWithPricePolicies(s.ctx, int32(book.ID))
+
+		if err != nil {
+			b.Error(err)
+		}
+	}
+
+	report()
+}
+
+func (s *SqlcBenchmark) FindPageWithPricePoliciesNaive(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for size := 0; size < utils.BulkInsertPageNumber; size = size + utils.PageSize {
+			page, err := s.repository.ListPaginating(s.ctx, repository.ListPaginatingParams{
+				ID:    int32(pageCursor(books, size)),
+				Limit: utils.PageSize,
+			})
+			if err != nil {
+				b.Error(err)
+			}
+
+			for _, book := range page {
+				_, err = s.repository.ListPricePoliciesByBook(s.ctx, book.ID)
+				if err != nil {
+					b.Error(err)
+				}
+			}
+		}
+	}
+
+	report()
+}
+
+func (s *SqlcBenchmark) FindPageWithPricePoliciesEager(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for size := 0; size < utils.BulkInsertPageNumber; size = size + utils.PageSize {
+			page, err := s.repository.ListPaginating(s.ctx, repository.ListPaginatingParams{
+				ID:    int32(pageCursor(books, size)),
+				Limit: utils.PageSize,
+			})
+			if err != nil {
+				b.Error(err)
+			}
+
+			// sqlc has no relations, the policies of the whole page are loaded by a second query.
+			bookIDs := make([]int32, len(page))
+			for j, book := range page {
+				bookIDs[j] = book.ID
+			}
+			policies, err := s.repository.ListPricePoliciesByBooks(s.ctx, bookIDs)
+			if err != nil {
+				b.Error(err)
+			}
+
+			policiesByBook := make(map[int32][]repository.PricePolicy, len(page))
+			for _, policy := range policies {
+				policiesByBook[policy.BookID] = append(policiesByBook[policy.BookID], policy)
+			}
+		}
+	}
+
+	report()
+}
+
+func (s *SqlcBenchmark) TransactionCommit(b *testing.B) {
+	benchmarkTransaction(b, true, s.doTransaction)
+}
+
+func (s *SqlcBenchmark) TransactionRollback(b *testing.B) {
+	benchmarkTransaction(b, false, s.doTransaction)
+}
+
+func (s *SqlcBenchmark) doTransaction(book *model.Book, commit bool) error {
+	tx, err := s.db.Begin(s.ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// it is a no-op once the transaction is committed
+		_ = tx.Rollback(s.ctx)
+	}()
+	queries := s.repository.WithTx(tx)
+
+	id, err := queries.CreateReturningID(s.ctx, repository.CreateReturningIDParams{
+		Isbn:         book.ISBN,
+		Title:        book.Title,
+		Author:       book.Author,
+		Genre:        book.Genre,
+		Quantity:     int32(book.Quantity),
+		PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	policies := model.NewPricePolicies(int64(id), utils.TransactionPricePoliciesNumber)
+	arg := repository.CreatePricePoliciesParams{
+		BookIds:    make([]int32, len(policies)),
+		Prices:     make([]float64, len(policies)),
+		StartDates: make([]pgtype.Timestamp, len(policies)),
+		EndDates:   make([]pgtype.Timestamp, len(policies)),
+	}
+	for i, policy := range policies {
+		arg.BookIds[i] = id
+		arg.Prices[i] = policy.Price
+		arg.StartDates[i] = pgtype.Timestamp{Time: policy.StartDate, Valid: true}
+		arg.EndDates[i] = pgtype.Timestamp{Time: policy.EndDate, Valid: true}
+	}
+	if err = queries.CreatePricePolicies(s.ctx, arg); err != nil {
+		return err
+	}
+	err = queries.UpdateQuantity(s.ctx, repository.UpdateQuantityParams{
+		Quantity: int32(updatedQuantity(book)),
+		ID:       id,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !commit {
+		return tx.Rollback(s.ctx)
+	}
+	return tx.Commit(s.ctx)
+}
+
+func (s *SqlcBenchmark) Upsert(b *testing.B) {
+	benchmarkUpsert(b, func(book *model.Book) error {
+		return s.repository.Upsert(s.ctx, repository.UpsertParams{
+			Isbn:         book.ISBN,
+			Title:        book.Title,
+			Author:       book.Author,
+			Genre:        book.Genre,
+			Quantity:     int32(book.Quantity),
+			PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+		})
+	})
+}
+
+func (s *SqlcBenchmark) UpsertBulk(b *testing.B) {
+	benchmarkUpsertBulk(b, func(books []*model.Book) error {
+		arg := repository.UpsertManyParams{
+			Isbns:         make([]string, len(books)),
+			Titles:        make([]string, len(books)),
+			Authors:       make([]string, len(books)),
+			Genres:        make([]string, len(books)),
+			Quantities:    make([]int32, len(books)),
+			PublicizedAts: make([]pgtype.Timestamp, len(books)),
+		}
+		for i, book := range books {
+			arg.Isbns[i] = book.ISBN
+			arg.Titles[i] = book.Title
+			arg.Authors[i] = book.Author
+			arg.Genres[i] = book.Genre
+			arg.Quantities[i] = int32(book.Quantity)
+			arg.PublicizedAts[i] = pgtype.Timestamp{Time: book.PublicizedAt, Valid: true}
+		}
+
+		return s.repository.UpsertMany(s.ctx, arg)
+	})
+}
+
+func (s *SqlcBenchmark) UpdateBulk(b *testing.B) {
+	benchmarkUpdateBulk(b, func(ids []int64, quantity int) error {
+		return s.repository.UpdateQuantityByIDs(s.ctx, repository.UpdateQuantityByIDsParams{
+			Quantity: int32(quantity),
+			Ids:      toInt32s(ids),
+		})
+	})
+}
+
+func (s *SqlcBenchmark) UpdateBulkValues(b *testing.B) {
+	benchmarkUpdateBulkValues(b, func(ids []int64, quantities []int) error {
+		arg := repository.UpdateQuantitiesParams{
+			Ids:        toInt32s(ids),
+			Quantities: make([]int32, len(quantities)),
+		}
+		for i, quantity := range quantities {
+			arg.Quantities[i] = int32(quantity)
+		}
+		return s.repository.UpdateQuantities(s.ctx, arg)
+	})
+}
+
+func (s *SqlcBenchmark) DeleteBulk(b *testing.B) {
+	benchmarkDeleteBulk(b, func(ids []int64) error {
+		return s.repository.DeleteByIDs(s.ctx, toInt32s(ids))
+	})
+}
+
+func (s *SqlcBenchmark) DeleteBulkWhere(b *testing.B) {
+	benchmarkDeleteBulkWhere(b, func(genre string) error {
+		return s.repository.DeleteByGenre(s.ctx, genre)
+	})
+}
+
+// toInt32s converts the ids to the type sqlc generates for SERIAL columns.
+func toInt32s(ids []int64) []int32 {
+	converted := make([]int32, len(ids))
+	for i, id := range ids {
+		converted[i] = int32(id)
+	}
+	return converted
+}
+
+func (s *SqlcBenchmark) FindPageOffset(b *testing.B) {
+	benchmarkPageDepths(b, func(offset int, _ int64) error {
+		_, err := s.repository.ListPaginatingOffset(s.ctx, repository.ListPaginatingOffsetParams{
+			Limit:  utils.PageSize,
+			Offset: int32(offset),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = s.repository.CountBooks(s.ctx)
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) FindPageKeyset(b *testing.B) {
+	benchmarkPageDepths(b, func(_ int, cursor int64) error {
+		_, err := s.repository.ListPaginatingKeyset(s.ctx, repository.ListPaginatingKeysetParams{
+			ID:    int32(cursor),
+			Limit: utils.PageSize,
+		})
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) SummarizeGenres(b *testing.B) {
+	benchmarkSummarizeGenres(b, func() error {
+		_, err := s.repository.SummarizeGenres(s.ctx)
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) Search(b *testing.B) {
+	benchmarkSearch(b, func(search bookSearch) error {
+		params := repository.SearchBooksParams{
+			Author:        pgtype.Text{String: search.author, Valid: search.author != ""},
+			Genres:        search.genres,
+			PublishedFrom: pgtype.Timestamp{Time: search.publishedFrom, Valid: !search.publishedFrom.IsZero()},
+			PublishedTo:   pgtype.Timestamp{Time: search.publishedTo, Valid: !search.publishedTo.IsZero()},
+			Title:         pgtype.Text{String: search.titlePattern(), Valid: search.title != ""},
+			MaxRows:       utils.PageSize,
+		}
+		if search.minQuantity != nil {
+			params.MinQuantity = pgtype.Int4{Int32: int32(*search.minQuantity), Valid: true}
+		}
+		if search.maxQuantity != nil {
+			params.MaxQuantity = pgtype.Int4{Int32: int32(*search.maxQuantity), Valid: true}
+		}
+
+		_, err := s.repository.SearchBooks(s.ctx, params)
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) FindTitleByID(b *testing.B) {
+	benchmarkFindTitleByID(b, func(id int64) error {
+		_, err := s.repository.GetTitle(s.ctx, int32(id))
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) FindTitlePage(b *testing.B) {
+	benchmarkFindTitlePage(b, func(cursor int64) error {
+		_, err := s.repository.ListTitlesPaginating(s.ctx, repository.ListTitlesPaginatingParams{
+			ID:    int32(cursor),
+			Limit: utils.PageSize,
+		})
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) Stream(b *testing.B) {
+	benchmarkStream(b, func(limit int) (int, error) {
+		// sqlc generates no iterator, :many queries load the whole result at once.
+		books, err := s.repository.ListPaginatingKeyset(s.ctx, repository.ListPaginatingKeysetParams{
+			ID:    0,
+			Limit: int32(limit),
+		})
+		return len(books), err
+	})
+}
+
+func (s *SqlcBenchmark) UpdatePartial(b *testing.B) {
+	benchmarkUpdatePartial(b, func(book *model.Book) error {
+		return s.repository.UpdateQuantity(s.ctx, repository.UpdateQuantityParams{
+			Quantity: int32(book.Quantity),
+			ID:       int32(book.ID),
+		})
+	})
+}
+
+func (s *SqlcBenchmark) Decrement(b *testing.B) {
+	benchmarkDecrement(b, s.decrement)
+}
+
+func (s *SqlcBenchmark) DecrementConcurrent(b *testing.B) {
+	benchmarkDecrementConcurrent(b, s.decrement)
+}
+
+func (s *SqlcBenchmark) decrement(id int64) (int, error) {
+	quantity, err := s.repository.DecrementQuantity(s.ctx, int32(id))
+	return int(quantity), err
+}
+
+func (s *SqlcBenchmark) UpdateOptimistic(b *testing.B) {
+	benchmarkUpdateOptimistic(b, s.findBook, s.saveVersioned)
+}
+
+func (s *SqlcBenchmark) findBook(id int64) (*model.Book, error) {
+	found, err := s.repository.Get(s.ctx, int32(id))
+	if err != nil {
+		return nil, err
+	}
+	return &model.Book{
+		ID:           int64(found.ID),
+		ISBN:         found.Isbn,
+		Title:        found.Title,
+		Author:       found.Author,
+		Genre:        found.Genre,
+		Quantity:     int(found.Quantity),
+		PublicizedAt: found.PublicizedAt.Time,
+		Version:      int(found.Version),
+	}, nil
+}
+
+func (s *SqlcBenchmark) saveVersioned(book *model.Book) error {
+	updated, err := s.repository.UpdateVersioned(s.ctx, repository.UpdateVersionedParams{
+		Quantity: int32(book.Quantity),
+		ID:       int32(book.ID),
+		Version:  int32(book.Version),
+	})
+	if err == nil && updated == 0 {
+		return errVersionConflict
+	}
+	return err
+}
+
+func (s *SqlcBenchmark) SoftDelete(b *testing.B) {
+	benchmarkSoftDelete(b, func(id int64) error {
+		return s.repository.SoftDelete(s.ctx, repository.SoftDeleteParams{
+			DeletedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+			ID:        int32(id),
+		})
+	})
+}
+
+func (s *SqlcBenchmark) RestoreSoftDeleted(b *testing.B) {
+	benchmarkRestoreSoftDeleted(b, func(id int64) error {
+		return s.repository.Restore(s.ctx, int32(id))
+	})
+}
+
+func (s *SqlcBenchmark) FindNotDeletedByID(b *testing.B) {
+	benchmarkFindNotDeletedByID(b, s.findNotDeleted)
+}
+
+func (s *SqlcBenchmark) findNotDeleted(id int64) (bool, error) {
+	_, err := s.repository.GetNotDeleted(s.ctx, int32(id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *SqlcBenchmark) Exists(b *testing.B) {
+	benchmarkExists(b, func(isbn string) (bool, error) {
+		return s.repository.ExistsByISBN(s.ctx, isbn)
+	})
+}
+
+func (s *SqlcBenchmark) Count(b *testing.B) {
+	benchmarkCount(b, func(genre string) (int, error) {
+		count, err := s.repository.CountByGenre(s.ctx, genre)
+		return int(count), err
+	})
+}
+
+func (s *SqlcBenchmark) FindByIDs(b *testing.B) {
+	benchmarkFindByIDs(b, func(ids []int64) (int, error) {
+		books, err := s.repository.ListByIDs(s.ctx, toInt32s(ids))
+		return len(books), err
+	})
+}
+
+func (s *SqlcBenchmark) InsertListing(b *testing.B) {
+	benchmarkInsertListing(b, func(listing *model.Listing) error {
+		params, err := newCreateListingParams(listing)
+		if err != nil {
+			return err
+		}
+		id, err := s.repository.CreateListing(s.ctx, params)
+		listing.ID = int64(id)
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) FindListingByID(b *testing.B) {
+	benchmarkFindListingByID(b, func(id int64) error {
+		_, err := s.repository.GetListing(s.ctx, int32(id))
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) UpdateListing(b *testing.B) {
+	benchmarkUpdateListing(b, func(listing *model.Listing) error {
+		params, err := newCreateListingParams(listing)
+		if err != nil {
+			return err
+		}
+		return s.repository.UpdateListing(s.ctx, repository.UpdateListingParams{
+			Reference:      params.Reference,
+			Attributes:     params.Attributes,
+			Tags:           params.Tags,
+			Price:          params.Price,
+			Cover:          params.Cover,
+			Subtitle:       params.Subtitle,
+			DiscontinuedAt: params.DiscontinuedAt,
+			ListedAt:       params.ListedAt,
+			ID:             int32(listing.ID),
+		})
+	})
+}
+
+// newCreateListingParams converts a listing to the pgtype values sqlc generates for its columns, JSONB being left
+// as bytes to encode.
+func newCreateListingParams(listing *model.Listing) (repository.CreateListingParams, error) {
+	params := repository.CreateListingParams{
+		Reference: pgtype.UUID{Bytes: listing.Reference, Valid: true},
+		Tags:      listing.Tags,
+		Cover:     listing.Cover,
+		ListedAt:  pgtype.Timestamptz{Time: listing.ListedAt, Valid: true},
+	}
+	if listing.Subtitle != nil {
+		params.Subtitle = pgtype.Text{String: *listing.Subtitle, Valid: true}
+	}
+	if listing.DiscontinuedAt != nil {
+		params.DiscontinuedAt = pgtype.Timestamptz{Time: *listing.DiscontinuedAt, Valid: true}
+	}
+
+	var err error
+	if params.Attributes, err = json.Marshal(listing.Attributes); err != nil {
+		return params, err
+	}
+	err = params.Price.Scan(strconv.FormatFloat(listing.Price, 'f', -1, 64))
+	return params, err
+}
+
+func (s *SqlcBenchmark) InsertDraft(b *testing.B) {
+	benchmarkInsertDraft(b, func(draft *model.Draft) error {
+		id, err := s.repository.CreateDraft(s.ctx, newCreateDraftParams(draft))
+		draft.ID = int64(id)
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) FindDraftByID(b *testing.B) {
+	benchmarkFindDraftByID(b, func(id int64) error {
+		_, err := s.repository.GetDraft(s.ctx, int32(id))
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) FindDrafts(b *testing.B) {
+	benchmarkFindDrafts(b, func(limit int) (int, error) {
+		drafts, err := s.repository.ListDrafts(s.ctx, int32(limit))
+		return len(drafts), err
+	})
+}
+
+// newCreateDraftParams converts the pointers of a draft to the pgtype values sqlc generates for nullable columns.
+func newCreateDraftParams(draft *model.Draft) repository.CreateDraftParams {
+	var params repository.CreateDraftParams
+	if draft.Title != nil {
+		params.Title = pgtype.Text{String: *draft.Title, Valid: true}
+	}
+	if draft.Subtitle != nil {
+		params.Subtitle = pgtype.Text{String: *draft.Subtitle, Valid: true}
+	}
+	if draft.Author != nil {
+		params.Author = pgtype.Text{String: *draft.Author, Valid: true}
+	}
+	if draft.Translator != nil {
+		params.Translator = pgtype.Text{String: *draft.Translator, Valid: true}
+	}
+	if draft.Pages != nil {
+		params.Pages = pgtype.Int4{Int32: int32(*draft.Pages), Valid: true}
+	}
+	if draft.Rating != nil {
+		params.Rating = pgtype.Float8{Float64: *draft.Rating, Valid: true}
+	}
+	if draft.Illustrated != nil {
+		params.Illustrated = pgtype.Bool{Bool: *draft.Illustrated, Valid: true}
+	}
+	if draft.SubmittedAt != nil {
+		params.SubmittedAt = pgtype.Timestamp{Time: *draft.SubmittedAt, Valid: true}
+	}
+	return params
+}
+
+func (s *SqlcBenchmark) InsertWide(b *testing.B) {
+	benchmarkInsertWide(b, func(wide *model.Wide) error {
+		id, err := s.repository.CreateWide(s.ctx, newCreateWideParams(wide))
+		wide.ID = int64(id)
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) FindWideByID(b *testing.B) {
+	benchmarkFindWideByID(b, func(id int64) error {
+		_, err := s.repository.GetWide(s.ctx, int32(id))
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) FindWides(b *testing.B) {
+	benchmarkFindWides(b, func(limit int) (int, error) {
+		wides, err := s.repository.ListWides(s.ctx, int32(limit))
+		return len(wides), err
+	})
+}
+
+func (s *SqlcBenchmark) InsertUUIDClient(b *testing.B) {
+	benchmarkInsertUUIDClient(b, func(book *model.UUIDBook) error {
+		return s.repository.CreateUUIDBook(s.ctx, repository.CreateUUIDBookParams{
+			ID:           pgtype.UUID{Bytes: book.ID, Valid: true},
+			Isbn:         book.ISBN,
+			Title:        book.Title,
+			Author:       book.Author,
+			Genre:        book.Genre,
+			Quantity:     int32(book.Quantity),
+			PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+		})
+	})
+}
+
+func (s *SqlcBenchmark) InsertUUIDServer(b *testing.B) {
+	benchmarkInsertUUIDServer(b, func(book *model.UUIDBook) error {
+		id, err := s.repository.CreateUUIDBookReturningID(s.ctx, repository.CreateUUIDBookReturningIDParams{
+			Isbn:         book.ISBN,
+			Title:        book.Title,
+			Author:       book.Author,
+			Genre:        book.Genre,
+			Quantity:     int32(book.Quantity),
+			PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+		})
+		book.ID = id.Bytes
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) FindUUIDByID(b *testing.B) {
+	benchmarkFindUUIDByID(b, func(id uuid.UUID) error {
+		_, err := s.repository.GetUUIDBook(s.ctx, pgtype.UUID{Bytes: id, Valid: true})
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) DeleteUUID(b *testing.B) {
+	benchmarkDeleteUUID(b, func(id uuid.UUID) error {
+		return s.repository.DeleteUUIDBook(s.ctx, pgtype.UUID{Bytes: id, Valid: true})
+	})
+}
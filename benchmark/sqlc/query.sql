@@ -21,11 +21,193 @@ SET isbn = $1,
     publicized_at = $6
 WHERE id = $7;
 
+-- name: UpdateVersioned :execrows
+UPDATE books
+SET quantity = $1, version = version + 1
+WHERE id = $2 AND version = $3;
+
+-- name: DecrementQuantity :one
+UPDATE books
+SET quantity = quantity - 1
+WHERE id = $1 AND quantity > 0
+RETURNING quantity;
+
+-- name: UpdateQuantity :exec
+UPDATE books
+SET quantity = $1
+WHERE id = $2;
+
 -- name: Delete :exec
 DELETE FROM books WHERE id = $1;
 
+-- name: SoftDelete :exec
+UPDATE books SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL;
+
+-- name: Restore :exec
+UPDATE books SET deleted_at = NULL WHERE id = $1;
+
 -- name: Get :one
 SELECT * FROM books WHERE id = $1 ;
 
+-- name: GetNotDeleted :one
+SELECT * FROM books WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListByIDs :many
+SELECT * FROM books WHERE id = ANY(@ids::int[]);
+
+-- name: ExistsByISBN :one
+SELECT EXISTS (SELECT 1 FROM books WHERE isbn = $1);
+
+-- name: CountByGenre :one
+SELECT count(*) FROM books WHERE genre = $1;
+
 -- name: ListPaginating :many
 SELECT * FROM books WHERE id > $1 LIMIT $2;
+
+-- name: GetTitle :one
+SELECT id, title FROM books WHERE id = $1;
+
+-- name: ListTitlesPaginating :many
+SELECT id, title FROM books WHERE id > $1 LIMIT $2;
+
+-- name: ListPaginatingOffset :many
+SELECT * FROM books ORDER BY id LIMIT $1 OFFSET $2;
+
+-- name: ListPaginatingKeyset :many
+SELECT * FROM books WHERE id > $1 ORDER BY id LIMIT $2;
+
+-- name: CountBooks :one
+SELECT count(*) FROM books;
+
+-- name: SearchBooks :many
+SELECT * FROM books
+WHERE (sqlc.narg(author)::TEXT IS NULL OR author = sqlc.narg(author))
+  AND (sqlc.narg(genres)::TEXT[] IS NULL OR genre = ANY(sqlc.narg(genres)::TEXT[]))
+  AND (sqlc.narg(min_quantity)::INTEGER IS NULL OR quantity >= sqlc.narg(min_quantity))
+  AND (sqlc.narg(max_quantity)::INTEGER IS NULL OR quantity <= sqlc.narg(max_quantity))
+  AND (sqlc.narg(published_from)::TIMESTAMP IS NULL OR publicized_at >= sqlc.narg(published_from))
+  AND (sqlc.narg(published_to)::TIMESTAMP IS NULL OR publicized_at < sqlc.narg(published_to))
+  AND (sqlc.narg(title)::TEXT IS NULL OR title ILIKE sqlc.narg(title))
+ORDER BY publicized_at DESC
+LIMIT sqlc.arg(max_rows);
+
+-- name: SummarizeGenres :many
+SELECT genre,
+       count(*) AS books,
+       sum(quantity)::BIGINT AS quantity,
+       min(publicized_at)::TIMESTAMP AS first_publicized_at,
+       max(publicized_at)::TIMESTAMP AS last_publicized_at
+FROM books
+GROUP BY genre
+ORDER BY genre;
+
+-- name: GetWithPricePolicies :many
+SELECT sqlc.embed(books), sqlc.embed(price_policies)
+FROM books
+JOIN price_policies ON price_policies.book_id = books.id
+WHERE books.id = $1;
+
+-- name: ListPricePoliciesByBook :many
+SELECT * FROM price_policies WHERE book_id = $1;
+
+-- name: ListPricePoliciesByBooks :many
+SELECT * FROM price_policies WHERE book_id = ANY(@book_ids::int[]);
+
+-- name: CreatePricePolicies :exec
+INSERT INTO price_policies (book_id, price, start_date, end_date)
+SELECT * FROM unnest(
+    @book_ids::INTEGER[],
+    @prices::FLOAT[],
+    @start_dates::TIMESTAMP[],
+    @end_dates::TIMESTAMP[]
+);
+
+-- name: Upsert :exec
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (isbn) DO UPDATE
+SET title = EXCLUDED.title,
+    author = EXCLUDED.author,
+    genre = EXCLUDED.genre,
+    quantity = EXCLUDED.quantity,
+    publicized_at = EXCLUDED.publicized_at;
+
+-- name: UpsertMany :exec
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+SELECT * FROM unnest(
+    @isbns::VARCHAR[],
+    @titles::VARCHAR[],
+    @authors::VARCHAR[],
+    @genres::VARCHAR[],
+    @quantities::INTEGER[],
+    @publicized_ats::TIMESTAMP[]
+)
+ON CONFLICT (isbn) DO UPDATE
+SET title = EXCLUDED.title,
+    author = EXCLUDED.author,
+    genre = EXCLUDED.genre,
+    quantity = EXCLUDED.quantity,
+    publicized_at = EXCLUDED.publicized_at;
+
+-- name: UpdateQuantityByIDs :exec
+UPDATE books
+SET quantity = @quantity
+WHERE id = ANY(@ids::int[]);
+
+-- name: UpdateQuantities :exec
+UPDATE books
+SET quantity = v.quantity
+FROM unnest(@ids::int[], @quantities::int[]) AS v(id, quantity)
+WHERE books.id = v.id;
+
+-- name: DeleteByIDs :exec
+DELETE FROM books WHERE id = ANY(@ids::int[]);
+
+-- name: DeleteByGenre :exec
+DELETE FROM books WHERE genre = $1;
+
+-- name: CreateListing :one
+INSERT INTO listings (reference, attributes, tags, price, cover, subtitle, discontinued_at, listed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id;
+
+-- name: GetListing :one
+SELECT * FROM listings WHERE id = $1;
+
+-- name: UpdateListing :exec
+UPDATE listings
+SET reference = $1,
+    attributes = $2,
+    tags = $3,
+    price = $4,
+    cover = $5,
+    subtitle = $6,
+    discontinued_at = $7,
+    listed_at = $8
+WHERE id = $9;
+
+-- name: CreateDraft :one
+INSERT INTO drafts (title, subtitle, author, translator, pages, rating, illustrated, submitted_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id;
+
+-- name: GetDraft :one
+SELECT * FROM drafts WHERE id = $1;
+
+-- name: ListDrafts :many
+SELECT * FROM drafts ORDER BY id LIMIT $1;
+
+-- name: CreateUUIDBook :exec
+INSERT INTO uuid_books (id, isbn, title, author, genre, quantity, publicized_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: CreateUUIDBookReturningID :one
+INSERT INTO uuid_books (isbn, title, author, genre, quantity, publicized_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- name: GetUUIDBook :one
+SELECT * FROM uuid_books WHERE id = $1;
+
+-- name: DeleteUUIDBook :exec
+DELETE FROM uuid_books WHERE id = $1;
@@ -16,4 +16,116 @@ type Book struct {
 	Genre        string
 	Quantity     int32
 	PublicizedAt pgtype.Timestamp
+	Version      int32
+	DeletedAt    pgtype.Timestamp
+}
+
+type Draft struct {
+	ID          int32
+	Title       pgtype.Text
+	Subtitle    pgtype.Text
+	Author      pgtype.Text
+	Translator  pgtype.Text
+	Pages       pgtype.Int4
+	Rating      pgtype.Float8
+	Illustrated pgtype.Bool
+	SubmittedAt pgtype.Timestamp
+}
+
+type Listing struct {
+	ID             int32
+	Reference      pgtype.UUID
+	Attributes     []byte
+	Tags           []string
+	Price          pgtype.Numeric
+	Cover          []byte
+	Subtitle       pgtype.Text
+	DiscontinuedAt pgtype.Timestamptz
+	ListedAt       pgtype.Timestamptz
+}
+
+type PricePolicy struct {
+	ID        int32
+	BookID    int32
+	Price     float64
+	StartDate pgtype.Timestamp
+	EndDate   pgtype.Timestamp
+}
+
+type UuidBook struct {
+	ID           pgtype.UUID
+	Isbn         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int32
+	PublicizedAt pgtype.Timestamp
+}
+
+type Wide struct {
+	ID        int32
+	Column001 string
+	Column002 int32
+	Column003 float64
+	Column004 pgtype.Timestamp
+	Column005 string
+	Column006 int32
+	Column007 float64
+	Column008 pgtype.Timestamp
+	Column009 string
+	Column010 int32
+	Column011 float64
+	Column012 pgtype.Timestamp
+	Column013 string
+	Column014 int32
+	Column015 float64
+	Column016 pgtype.Timestamp
+	Column017 string
+	Column018 int32
+	Column019 float64
+	Column020 pgtype.Timestamp
+	Column021 string
+	Column022 int32
+	Column023 float64
+	Column024 pgtype.Timestamp
+	Column025 string
+	Column026 int32
+	Column027 float64
+	Column028 pgtype.Timestamp
+	Column029 string
+	Column030 int32
+	Column031 float64
+	Column032 pgtype.Timestamp
+	Column033 string
+	Column034 int32
+	Column035 float64
+	Column036 pgtype.Timestamp
+	Column037 string
+	Column038 int32
+	Column039 float64
+	Column040 pgtype.Timestamp
+	Column041 string
+	Column042 int32
+	Column043 float64
+	Column044 pgtype.Timestamp
+	Column045 string
+	Column046 int32
+	Column047 float64
+	Column048 pgtype.Timestamp
+	Column049 string
+	Column050 int32
+	Column051 float64
+	Column052 pgtype.Timestamp
+	Column053 string
+	Column054 int32
+	Column055 float64
+	Column056 pgtype.Timestamp
+	Column057 string
+	Column058 int32
+	Column059 float64
+	Column060 pgtype.Timestamp
+	Column061 string
+	Column062 int32
+	Column063 float64
+	Column064 pgtype.Timestamp
 }
@@ -11,6 +11,28 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countBooks = `-- name: CountBooks :one
+SELECT count(*) FROM books
+`
+
+func (q *Queries) CountBooks(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countBooks)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countByGenre = `-- name: CountByGenre :one
+SELECT count(*) FROM books WHERE genre = $1
+`
+
+func (q *Queries) CountByGenre(ctx context.Context, genre string) (int64, error) {
+	row := q.db.QueryRow(ctx, countByGenre, genre)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const create = `-- name: Create :exec
 INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
 VALUES ($1, $2, $3, $4, $5, $6)
@@ -46,6 +68,99 @@ type CreateManyParams struct {
 	PublicizedAt pgtype.Timestamp
 }
 
+const createDraft = `-- name: CreateDraft :one
+INSERT INTO drafts (title, subtitle, author, translator, pages, rating, illustrated, submitted_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id
+`
+
+type CreateDraftParams struct {
+	Title       pgtype.Text
+	Subtitle    pgtype.Text
+	Author      pgtype.Text
+	Translator  pgtype.Text
+	Pages       pgtype.Int4
+	Rating      pgtype.Float8
+	Illustrated pgtype.Bool
+	SubmittedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateDraft(ctx context.Context, arg CreateDraftParams) (int32, error) {
+	row := q.db.QueryRow(ctx, createDraft,
+		arg.Title,
+		arg.Subtitle,
+		arg.Author,
+		arg.Translator,
+		arg.Pages,
+		arg.Rating,
+		arg.Illustrated,
+		arg.SubmittedAt,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createListing = `-- name: CreateListing :one
+INSERT INTO listings (reference, attributes, tags, price, cover, subtitle, discontinued_at, listed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id
+`
+
+type CreateListingParams struct {
+	Reference      pgtype.UUID
+	Attributes     []byte
+	Tags           []string
+	Price          pgtype.Numeric
+	Cover          []byte
+	Subtitle       pgtype.Text
+	DiscontinuedAt pgtype.Timestamptz
+	ListedAt       pgtype.Timestamptz
+}
+
+func (q *Queries) CreateListing(ctx context.Context, arg CreateListingParams) (int32, error) {
+	row := q.db.QueryRow(ctx, createListing,
+		arg.Reference,
+		arg.Attributes,
+		arg.Tags,
+		arg.Price,
+		arg.Cover,
+		arg.Subtitle,
+		arg.DiscontinuedAt,
+		arg.ListedAt,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createPricePolicies = `-- name: CreatePricePolicies :exec
+INSERT INTO price_policies (book_id, price, start_date, end_date)
+SELECT * FROM unnest(
+    $1::INTEGER[],
+    $2::FLOAT[],
+    $3::TIMESTAMP[],
+    $4::TIMESTAMP[]
+)
+`
+
+type CreatePricePoliciesParams struct {
+	BookIds    []int32
+	Prices     []float64
+	StartDates []pgtype.Timestamp
+	EndDates   []pgtype.Timestamp
+}
+
+func (q *Queries) CreatePricePolicies(ctx context.Context, arg CreatePricePoliciesParams) error {
+	_, err := q.db.Exec(ctx, createPricePolicies,
+		arg.BookIds,
+		arg.Prices,
+		arg.StartDates,
+		arg.EndDates,
+	)
+	return err
+}
+
 const createReturningID = `-- name: CreateReturningID :one
 INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
 VALUES ($1, $2, $3, $4, $5, $6)
@@ -75,6 +190,77 @@ func (q *Queries) CreateReturningID(ctx context.Context, arg CreateReturningIDPa
 	return id, err
 }
 
+const createUUIDBook = `-- name: CreateUUIDBook :exec
+INSERT INTO uuid_books (id, isbn, title, author, genre, quantity, publicized_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateUUIDBookParams struct {
+	ID           pgtype.UUID
+	Isbn         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int32
+	PublicizedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateUUIDBook(ctx context.Context, arg CreateUUIDBookParams) error {
+	_, err := q.db.Exec(ctx, createUUIDBook,
+		arg.ID,
+		arg.Isbn,
+		arg.Title,
+		arg.Author,
+		arg.Genre,
+		arg.Quantity,
+		arg.PublicizedAt,
+	)
+	return err
+}
+
+const createUUIDBookReturningID = `-- name: CreateUUIDBookReturningID :one
+INSERT INTO uuid_books (isbn, title, author, genre, quantity, publicized_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id
+`
+
+type CreateUUIDBookReturningIDParams struct {
+	Isbn         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int32
+	PublicizedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateUUIDBookReturningID(ctx context.Context, arg CreateUUIDBookReturningIDParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, createUUIDBookReturningID,
+		arg.Isbn,
+		arg.Title,
+		arg.Author,
+		arg.Genre,
+		arg.Quantity,
+		arg.PublicizedAt,
+	)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const decrementQuantity = `-- name: DecrementQuantity :one
+UPDATE books
+SET quantity = quantity - 1
+WHERE id = $1 AND quantity > 0
+RETURNING quantity
+`
+
+func (q *Queries) DecrementQuantity(ctx context.Context, id int32) (int32, error) {
+	row := q.db.QueryRow(ctx, decrementQuantity, id)
+	var quantity int32
+	err := row.Scan(&quantity)
+	return quantity, err
+}
+
 const delete = `-- name: Delete :exec
 DELETE FROM books WHERE id = $1
 `
@@ -84,8 +270,46 @@ func (q *Queries) Delete(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteByGenre = `-- name: DeleteByGenre :exec
+DELETE FROM books WHERE genre = $1
+`
+
+func (q *Queries) DeleteByGenre(ctx context.Context, genre string) error {
+	_, err := q.db.Exec(ctx, deleteByGenre, genre)
+	return err
+}
+
+const deleteByIDs = `-- name: DeleteByIDs :exec
+DELETE FROM books WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) DeleteByIDs(ctx context.Context, ids []int32) error {
+	_, err := q.db.Exec(ctx, deleteByIDs, ids)
+	return err
+}
+
+const deleteUUIDBook = `-- name: DeleteUUIDBook :exec
+DELETE FROM uuid_books WHERE id = $1
+`
+
+func (q *Queries) DeleteUUIDBook(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUUIDBook, id)
+	return err
+}
+
+const existsByISBN = `-- name: ExistsByISBN :one
+SELECT EXISTS (SELECT 1 FROM books WHERE isbn = $1)
+`
+
+func (q *Queries) ExistsByISBN(ctx context.Context, isbn string) (bool, error) {
+	row := q.db.QueryRow(ctx, existsByISBN, isbn)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const get = `-- name: Get :one
-SELECT id, isbn, title, author, genre, quantity, publicized_at FROM books WHERE id = $1
+SELECT id, isbn, title, author, genre, quantity, publicized_at, version, deleted_at FROM books WHERE id = $1
 `
 
 func (q *Queries) Get(ctx context.Context, id int32) (Book, error) {
@@ -99,12 +323,227 @@ func (q *Queries) Get(ctx context.Context, id int32) (Book, error) {
 		&i.Genre,
 		&i.Quantity,
 		&i.PublicizedAt,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getDraft = `-- name: GetDraft :one
+SELECT id, title, subtitle, author, translator, pages, rating, illustrated, submitted_at FROM drafts WHERE id = $1
+`
+
+func (q *Queries) GetDraft(ctx context.Context, id int32) (Draft, error) {
+	row := q.db.QueryRow(ctx, getDraft, id)
+	var i Draft
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Subtitle,
+		&i.Author,
+		&i.Translator,
+		&i.Pages,
+		&i.Rating,
+		&i.Illustrated,
+		&i.SubmittedAt,
+	)
+	return i, err
+}
+
+const getListing = `-- name: GetListing :one
+SELECT id, reference, attributes, tags, price, cover, subtitle, discontinued_at, listed_at FROM listings WHERE id = $1
+`
+
+func (q *Queries) GetListing(ctx context.Context, id int32) (Listing, error) {
+	row := q.db.QueryRow(ctx, getListing, id)
+	var i Listing
+	err := row.Scan(
+		&i.ID,
+		&i.Reference,
+		&i.Attributes,
+		&i.Tags,
+		&i.Price,
+		&i.Cover,
+		&i.Subtitle,
+		&i.DiscontinuedAt,
+		&i.ListedAt,
+	)
+	return i, err
+}
+
+const getNotDeleted = `-- name: GetNotDeleted :one
+SELECT id, isbn, title, author, genre, quantity, publicized_at, version, deleted_at FROM books WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetNotDeleted(ctx context.Context, id int32) (Book, error) {
+	row := q.db.QueryRow(ctx, getNotDeleted, id)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.Isbn,
+		&i.Title,
+		&i.Author,
+		&i.Genre,
+		&i.Quantity,
+		&i.PublicizedAt,
+		&i.Version,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
+const getTitle = `-- name: GetTitle :one
+SELECT id, title FROM books WHERE id = $1
+`
+
+type GetTitleRow struct {
+	ID    int32
+	Title string
+}
+
+func (q *Queries) GetTitle(ctx context.Context, id int32) (GetTitleRow, error) {
+	row := q.db.QueryRow(ctx, getTitle, id)
+	var i GetTitleRow
+	err := row.Scan(&i.ID, &i.Title)
+	return i, err
+}
+
+const getUUIDBook = `-- name: GetUUIDBook :one
+SELECT id, isbn, title, author, genre, quantity, publicized_at FROM uuid_books WHERE id = $1
+`
+
+func (q *Queries) GetUUIDBook(ctx context.Context, id pgtype.UUID) (UuidBook, error) {
+	row := q.db.QueryRow(ctx, getUUIDBook, id)
+	var i UuidBook
+	err := row.Scan(
+		&i.ID,
+		&i.Isbn,
+		&i.Title,
+		&i.Author,
+		&i.Genre,
+		&i.Quantity,
+		&i.PublicizedAt,
+	)
+	return i, err
+}
+
+const getWithPricePolicies = `-- name: GetWithPricePolicies :many
+SELECT books.id, books.isbn, books.title, books.author, books.genre, books.quantity, books.publicized_at, books.version, books.deleted_at, price_policies.id, price_policies.book_id, price_policies.price, price_policies.start_date, price_policies.end_date
+FROM books
+JOIN price_policies ON price_policies.book_id = books.id
+WHERE books.id = $1
+`
+
+type GetWithPricePoliciesRow struct {
+	Book        Book
+	PricePolicy PricePolicy
+}
+
+func (q *Queries) GetWithPricePolicies(ctx context.Context, id int32) ([]GetWithPricePoliciesRow, error) {
+	rows, err := q.db.Query(ctx, getWithPricePolicies, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWithPricePoliciesRow
+	for rows.Next() {
+		var i GetWithPricePoliciesRow
+		if err := rows.Scan(
+			&i.Book.ID,
+			&i.Book.Isbn,
+			&i.Book.Title,
+			&i.Book.Author,
+			&i.Book.Genre,
+			&i.Book.Quantity,
+			&i.Book.PublicizedAt,
+			&i.Book.Version,
+			&i.Book.DeletedAt,
+			&i.PricePolicy.ID,
+			&i.PricePolicy.BookID,
+			&i.PricePolicy.Price,
+			&i.PricePolicy.StartDate,
+			&i.PricePolicy.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listByIDs = `-- name: ListByIDs :many
+SELECT id, isbn, title, author, genre, quantity, publicized_at, version, deleted_at FROM books WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) ListByIDs(ctx context.Context, ids []int32) ([]Book, error) {
+	rows, err := q.db.Query(ctx, listByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.Isbn,
+			&i.Title,
+			&i.Author,
+			&i.Genre,
+			&i.Quantity,
+			&i.PublicizedAt,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDrafts = `-- name: ListDrafts :many
+SELECT id, title, subtitle, author, translator, pages, rating, illustrated, submitted_at FROM drafts ORDER BY id LIMIT $1
+`
+
+func (q *Queries) ListDrafts(ctx context.Context, limit int32) ([]Draft, error) {
+	rows, err := q.db.Query(ctx, listDrafts, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Draft
+	for rows.Next() {
+		var i Draft
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Subtitle,
+			&i.Author,
+			&i.Translator,
+			&i.Pages,
+			&i.Rating,
+			&i.Illustrated,
+			&i.SubmittedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPaginating = `-- name: ListPaginating :many
-SELECT id, isbn, title, author, genre, quantity, publicized_at FROM books WHERE id > $1 LIMIT $2
+SELECT id, isbn, title, author, genre, quantity, publicized_at, version, deleted_at FROM books WHERE id > $1 LIMIT $2
 `
 
 type ListPaginatingParams struct {
@@ -129,6 +568,311 @@ func (q *Queries) ListPaginating(ctx context.Context, arg ListPaginatingParams)
 			&i.Genre,
 			&i.Quantity,
 			&i.PublicizedAt,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPaginatingKeyset = `-- name: ListPaginatingKeyset :many
+SELECT id, isbn, title, author, genre, quantity, publicized_at, version, deleted_at FROM books WHERE id > $1 ORDER BY id LIMIT $2
+`
+
+type ListPaginatingKeysetParams struct {
+	ID    int32
+	Limit int32
+}
+
+func (q *Queries) ListPaginatingKeyset(ctx context.Context, arg ListPaginatingKeysetParams) ([]Book, error) {
+	rows, err := q.db.Query(ctx, listPaginatingKeyset, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.Isbn,
+			&i.Title,
+			&i.Author,
+			&i.Genre,
+			&i.Quantity,
+			&i.PublicizedAt,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPaginatingOffset = `-- name: ListPaginatingOffset :many
+SELECT id, isbn, title, author, genre, quantity, publicized_at, version, deleted_at FROM books ORDER BY id LIMIT $1 OFFSET $2
+`
+
+type ListPaginatingOffsetParams struct {
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) ListPaginatingOffset(ctx context.Context, arg ListPaginatingOffsetParams) ([]Book, error) {
+	rows, err := q.db.Query(ctx, listPaginatingOffset, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.Isbn,
+			&i.Title,
+			&i.Author,
+			&i.Genre,
+			&i.Quantity,
+			&i.PublicizedAt,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPricePoliciesByBook = `-- name: ListPricePoliciesByBook :many
+SELECT id, book_id, price, start_date, end_date FROM price_policies WHERE book_id = $1
+`
+
+func (q *Queries) ListPricePoliciesByBook(ctx context.Context, bookID int32) ([]PricePolicy, error) {
+	rows, err := q.db.Query(ctx, listPricePoliciesByBook, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PricePolicy
+	for rows.Next() {
+		var i PricePolicy
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Price,
+			&i.StartDate,
+			&i.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPricePoliciesByBooks = `-- name: ListPricePoliciesByBooks :many
+SELECT id, book_id, price, start_date, end_date FROM price_policies WHERE book_id = ANY($1::int[])
+`
+
+func (q *Queries) ListPricePoliciesByBooks(ctx context.Context, bookIds []int32) ([]PricePolicy, error) {
+	rows, err := q.db.Query(ctx, listPricePoliciesByBooks, bookIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PricePolicy
+	for rows.Next() {
+		var i PricePolicy
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Price,
+			&i.StartDate,
+			&i.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTitlesPaginating = `-- name: ListTitlesPaginating :many
+SELECT id, title FROM books WHERE id > $1 LIMIT $2
+`
+
+type ListTitlesPaginatingParams struct {
+	ID    int32
+	Limit int32
+}
+
+type ListTitlesPaginatingRow struct {
+	ID    int32
+	Title string
+}
+
+func (q *Queries) ListTitlesPaginating(ctx context.Context, arg ListTitlesPaginatingParams) ([]ListTitlesPaginatingRow, error) {
+	rows, err := q.db.Query(ctx, listTitlesPaginating, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTitlesPaginatingRow
+	for rows.Next() {
+		var i ListTitlesPaginatingRow
+		if err := rows.Scan(&i.ID, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restore = `-- name: Restore :exec
+UPDATE books SET deleted_at = NULL WHERE id = $1
+`
+
+func (q *Queries) Restore(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, restore, id)
+	return err
+}
+
+const searchBooks = `-- name: SearchBooks :many
+SELECT id, isbn, title, author, genre, quantity, publicized_at, version, deleted_at FROM books
+WHERE ($1::TEXT IS NULL OR author = $1)
+  AND ($2::TEXT[] IS NULL OR genre = ANY($2::TEXT[]))
+  AND ($3::INTEGER IS NULL OR quantity >= $3)
+  AND ($4::INTEGER IS NULL OR quantity <= $4)
+  AND ($5::TIMESTAMP IS NULL OR publicized_at >= $5)
+  AND ($6::TIMESTAMP IS NULL OR publicized_at < $6)
+  AND ($7::TEXT IS NULL OR title ILIKE $7)
+ORDER BY publicized_at DESC
+LIMIT $8
+`
+
+type SearchBooksParams struct {
+	Author        pgtype.Text
+	Genres        []string
+	MinQuantity   pgtype.Int4
+	MaxQuantity   pgtype.Int4
+	PublishedFrom pgtype.Timestamp
+	PublishedTo   pgtype.Timestamp
+	Title         pgtype.Text
+	MaxRows       int32
+}
+
+func (q *Queries) SearchBooks(ctx context.Context, arg SearchBooksParams) ([]Book, error) {
+	rows, err := q.db.Query(ctx, searchBooks,
+		arg.Author,
+		arg.Genres,
+		arg.MinQuantity,
+		arg.MaxQuantity,
+		arg.PublishedFrom,
+		arg.PublishedTo,
+		arg.Title,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.Isbn,
+			&i.Title,
+			&i.Author,
+			&i.Genre,
+			&i.Quantity,
+			&i.PublicizedAt,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const softDelete = `-- name: SoftDelete :exec
+UPDATE books SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL
+`
+
+type SoftDeleteParams struct {
+	DeletedAt pgtype.Timestamp
+	ID        int32
+}
+
+func (q *Queries) SoftDelete(ctx context.Context, arg SoftDeleteParams) error {
+	_, err := q.db.Exec(ctx, softDelete, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const summarizeGenres = `-- name: SummarizeGenres :many
+SELECT genre,
+       count(*) AS books,
+       sum(quantity)::BIGINT AS quantity,
+       min(publicized_at)::TIMESTAMP AS first_publicized_at,
+       max(publicized_at)::TIMESTAMP AS last_publicized_at
+FROM books
+GROUP BY genre
+ORDER BY genre
+`
+
+type SummarizeGenresRow struct {
+	Genre             string
+	Books             int64
+	Quantity          int64
+	FirstPublicizedAt pgtype.Timestamp
+	LastPublicizedAt  pgtype.Timestamp
+}
+
+func (q *Queries) SummarizeGenres(ctx context.Context) ([]SummarizeGenresRow, error) {
+	rows, err := q.db.Query(ctx, summarizeGenres)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SummarizeGenresRow
+	for rows.Next() {
+		var i SummarizeGenresRow
+		if err := rows.Scan(
+			&i.Genre,
+			&i.Books,
+			&i.Quantity,
+			&i.FirstPublicizedAt,
+			&i.LastPublicizedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -173,3 +917,183 @@ func (q *Queries) Update(ctx context.Context, arg UpdateParams) error {
 	)
 	return err
 }
+
+const updateListing = `-- name: UpdateListing :exec
+UPDATE listings
+SET reference = $1,
+    attributes = $2,
+    tags = $3,
+    price = $4,
+    cover = $5,
+    subtitle = $6,
+    discontinued_at = $7,
+    listed_at = $8
+WHERE id = $9
+`
+
+type UpdateListingParams struct {
+	Reference      pgtype.UUID
+	Attributes     []byte
+	Tags           []string
+	Price          pgtype.Numeric
+	Cover          []byte
+	Subtitle       pgtype.Text
+	DiscontinuedAt pgtype.Timestamptz
+	ListedAt       pgtype.Timestamptz
+	ID             int32
+}
+
+func (q *Queries) UpdateListing(ctx context.Context, arg UpdateListingParams) error {
+	_, err := q.db.Exec(ctx, updateListing,
+		arg.Reference,
+		arg.Attributes,
+		arg.Tags,
+		arg.Price,
+		arg.Cover,
+		arg.Subtitle,
+		arg.DiscontinuedAt,
+		arg.ListedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const updateQuantities = `-- name: UpdateQuantities :exec
+UPDATE books
+SET quantity = v.quantity
+FROM unnest($1::int[], $2::int[]) AS v(id, quantity)
+WHERE books.id = v.id
+`
+
+type UpdateQuantitiesParams struct {
+	Ids        []int32
+	Quantities []int32
+}
+
+func (q *Queries) UpdateQuantities(ctx context.Context, arg UpdateQuantitiesParams) error {
+	_, err := q.db.Exec(ctx, updateQuantities, arg.Ids, arg.Quantities)
+	return err
+}
+
+const updateQuantity = `-- name: UpdateQuantity :exec
+UPDATE books
+SET quantity = $1
+WHERE id = $2
+`
+
+type UpdateQuantityParams struct {
+	Quantity int32
+	ID       int32
+}
+
+func (q *Queries) UpdateQuantity(ctx context.Context, arg UpdateQuantityParams) error {
+	_, err := q.db.Exec(ctx, updateQuantity, arg.Quantity, arg.ID)
+	return err
+}
+
+const updateQuantityByIDs = `-- name: UpdateQuantityByIDs :exec
+UPDATE books
+SET quantity = $1
+WHERE id = ANY($2::int[])
+`
+
+type UpdateQuantityByIDsParams struct {
+	Quantity int32
+	Ids      []int32
+}
+
+func (q *Queries) UpdateQuantityByIDs(ctx context.Context, arg UpdateQuantityByIDsParams) error {
+	_, err := q.db.Exec(ctx, updateQuantityByIDs, arg.Quantity, arg.Ids)
+	return err
+}
+
+const updateVersioned = `-- name: UpdateVersioned :execrows
+UPDATE books
+SET quantity = $1, version = version + 1
+WHERE id = $2 AND version = $3
+`
+
+type UpdateVersionedParams struct {
+	Quantity int32
+	ID       int32
+	Version  int32
+}
+
+func (q *Queries) UpdateVersioned(ctx context.Context, arg UpdateVersionedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateVersioned, arg.Quantity, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsert = `-- name: Upsert :exec
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (isbn) DO UPDATE
+SET title = EXCLUDED.title,
+    author = EXCLUDED.author,
+    genre = EXCLUDED.genre,
+    quantity = EXCLUDED.quantity,
+    publicized_at = EXCLUDED.publicized_at
+`
+
+type UpsertParams struct {
+	Isbn         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int32
+	PublicizedAt pgtype.Timestamp
+}
+
+func (q *Queries) Upsert(ctx context.Context, arg UpsertParams) error {
+	_, err := q.db.Exec(ctx, upsert,
+		arg.Isbn,
+		arg.Title,
+		arg.Author,
+		arg.Genre,
+		arg.Quantity,
+		arg.PublicizedAt,
+	)
+	return err
+}
+
+const upsertMany = `-- name: UpsertMany :exec
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+SELECT * FROM unnest(
+    $1::VARCHAR[],
+    $2::VARCHAR[],
+    $3::VARCHAR[],
+    $4::VARCHAR[],
+    $5::INTEGER[],
+    $6::TIMESTAMP[]
+)
+ON CONFLICT (isbn) DO UPDATE
+SET title = EXCLUDED.title,
+    author = EXCLUDED.author,
+    genre = EXCLUDED.genre,
+    quantity = EXCLUDED.quantity,
+    publicized_at = EXCLUDED.publicized_at
+`
+
+type UpsertManyParams struct {
+	Isbns         []string
+	Titles        []string
+	Authors       []string
+	Genres        []string
+	Quantities    []int32
+	PublicizedAts []pgtype.Timestamp
+}
+
+func (q *Queries) UpsertMany(ctx context.Context, arg UpsertManyParams) error {
+	_, err := q.db.Exec(ctx, upsertMany,
+		arg.Isbns,
+		arg.Titles,
+		arg.Authors,
+		arg.Genres,
+		arg.Quantities,
+		arg.PublicizedAts,
+	)
+	return err
+}
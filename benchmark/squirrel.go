@@ -0,0 +1,1157 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SquirrelBenchmark builds every statement with squirrel at runtime and executes it through pgx,
+// so the difference against PgxBenchmark is the query building overhead.
+type SquirrelBenchmark struct {
+	db      *pgxpool.Pool
+	builder sq.StatementBuilderType
+	ctx     context.Context
+}
+
+func init() {
+	Register(Adapter{Name: "squirrel", Category: CategoryBuilder, New: NewSquirrelBenchmark})
+}
+
+func NewSquirrelBenchmark() Benchmark {
+	return &SquirrelBenchmark{
+		builder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+		ctx:     context.Background(),
+	}
+}
+
+func (s *SquirrelBenchmark) Init() error {
+	var err error
+	s.db, err = pgxpool.New(s.ctx, utils.PostgresDSN)
+	return err
+}
+
+func (s *SquirrelBenchmark) Close() error {
+	s.db.Close()
+	return nil
+}
+
+func (s *SquirrelBenchmark) Capabilities() Capabilities {
+	return nil
+}
+
+func (s *SquirrelBenchmark) Insert(b *testing.B) {
+	book := model.NewBook()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		book.ISBN = model.NewISBN()
+		b.StartTimer()
+
+		query, args, err := s.builder.
+			Insert("books").
+			Columns(columns...).
+			Values(book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).
+			ToSql()
+		if err != nil {
+			b.Error(err)
+		}
+
+		_, err = s.db.Exec(s.ctx, query, args...)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (s *SquirrelBenchmark) InsertBulk(b *testing.B) {
+	books := model.NewBooks(utils.BulkInsertNumber)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for _, book := range books {
+			book.ISBN = model.NewISBN()
+		}
+		b.StartTimer()
+
+		err := s.doInsertBulk(books)
+
+		if err != nil {
+			b.Error(err)
+		}
+	}
+}
+
+func (s *SquirrelBenchmark) Update(b *testing.B) {
+	book := model.NewBook()
+	id, err := s.insertReturningID(book)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		query, args, err := s.builder.
+			Update("books").
+			Set("isbn", book.ISBN).
+			Set("title", book.Title).
+			Set("author", book.Author).
+			Set("genre", book.Genre).
+			Set("quantity", book.Quantity).
+			Set("publicized_at", book.PublicizedAt).
+			Where(sq.Eq{"id": id}).
+			ToSql()
+		if err != nil {
+			b.Error(err)
+		}
+
+		_, err = s.db.Exec(s.ctx, query, args...)
+
+		if err != nil {
+			b.Error(err)
+		}
+	}
+}
+
+func (s *SquirrelBenchmark) Delete(b *testing.B) {
+	book := model.NewBook()
+	savedIDs := make([]int64, b.N)
+	for i := 0; i < b.N; i++ {
+		book.ISBN = model.NewISBN()
+		id, err := s.insertReturningID(book)
+		if err != nil {
+			b.Error(err)
+		}
+		savedIDs[i] = id
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var bookID int64
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		bookID = savedIDs[i]
+		b.StartTimer()
+
+		query, args, err := s.builder.Delete("books").Where(sq.Eq{"id": bookID}).ToSql()
+		if err != nil {
+			b.Error(err)
+		}
+
+		_, err = s.db.Exec(s.ctx, query, args...)
+
+		if err != nil {
+			b.Error(err)
+		}
+	}
+}
+
+func (s *SquirrelBenchmark) FindByID(b *testing.B) {
+	book := model.NewBook()
+	id, err := s.insertReturningID(book)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for range utils.FindOneLoop {
+			query, args, err := s.builder.Select("*").From("books").Where(sq.Eq{"id": id}).ToSql()
+			if err != nil {
+				b.Error(err)
+			}
+
+			var foundBook model.Book
+			err = s.db.QueryRow(s.ctx, query, args...).Scan(
+				&foundBook.ID,
+				&foundBook.ISBN,
+				&foundBook.Title,
+				&foundBook.Author,
+				&foundBook.Genre,
+				&foundBook.Quantity,
+				&foundBook.PublicizedAt,
+				&foundBook.Version,
+				&foundBook.DeletedAt,
+			)
+
+			if err != nil {
+				b.Error(err)
+			}
+		}
+	}
+}
+
+func (s *SquirrelBenchmark) FindPage(b *testing.B) {
+	books := model.NewBooks(utils.BulkInsertPageNumber)
+	if err := s.doInsertBulk(books); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for size := 0; size < utils.BulkInsertPageNumber; size = size + utils.PageSize {
+			booksPage = make([]model.Book, 0, utils.PageSize)
+
+			query, args, err := s.builder.
+				Select("*").
+				From("books").
+				Where(sq.Gt{"id": size}).
+				Limit(utils.PageSize).
+				ToSql()
+			if err != nil {
+				b.Error(err)
+			}
+
+			result, err := s.db.Query(s.ctx, query, args...)
+			if err != nil {
+				b.Error(err)
+			}
+
+			for result.Next() {
+				var book model.Book
+				if err = result.Scan(
+					&book.ID,
+					&book.ISBN,
+					&book.Title,
+					&book.Author,
+					&book.Genre,
+					&book.Quantity,
+					&book.PublicizedAt,
+					&book.Version,
+					&book.DeletedAt,
+				); err != nil {
+					b.Error(err)
+				}
+				booksPage = append(booksPage, book)
+			}
+		}
+	}
+}
+
+func (s *SquirrelBenchmark) insertReturningID(book *model.Book) (int64, error) {
+	query, args, err := s.builder.
+		Insert("books").
+		Columns(columns...).
+		Values(book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = s.db.QueryRow(s.ctx, query, args...).Scan(&id)
+	return id, err
+}
+
+func (s *SquirrelBenchmark) doInsertBulk(books []*model.Book) error {
+	builder := s.builder.Insert("books").Columns(columns...)
+	for _, book := range books {
+		builder = builder.Values(book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(s.ctx, query, args...)
+	return err
+}
+
+func (s *SquirrelBenchmark) FindWithPricePolicies(b *testing.B) {
+	book, err := seedBookWithPricePolicies()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		query, args, err := s.builder.
+			Select(
+				"b.id", "b.isbn", "b.title", "b.author", "b.genre", "b.quantity", "b.publicized_at",
+				"p.id", "p.book_id", "p.price", "p.start_date", "p.end_date",
+			).
+			From("books b").
+			Join("price_policies p ON p.book_id = b.id").
+			Where(sq.Eq{"b.id": book.ID}).
+			ToSql()
+		if err != nil {
+			b.Error(err)
+		}
+
+		result, err := s.db.Query(s.ctx, query, args...)
+		if err != nil {
+			b.Error(err)
+		}
+
+		_, err = scanBooksWithPricePolicies(result)
+		if err != nil {
+			b.Error(err)
+		}
+	}
+
+	report()
+}
+
+func (s *SquirrelBenchmark) FindPageWithPricePoliciesNaive(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for size := 0; size < utils.BulkInsertPageNumber; size = size + utils.PageSize {
+			query, args, err := s.builder.
+				Select("*").
+				From("books").
+				Where(sq.Gt{"id": pageCursor(books, size)}).
+				Limit(utils.PageSize).
+				ToSql()
+			if err != nil {
+				b.Error(err)
+			}
+
+			result, err := s.db.Query(s.ctx, query, args...)
+			if err != nil {
+				b.Error(err)
+			}
+
+			page, err := scanBooks(result)
+			if err != nil {
+				b.Error(err)
+			}
+
+			for j := range page {
+				query, args, err = s.builder.
+					Select("*").
+					From("price_policies").
+					Where(sq.Eq{"book_id": page[j].ID}).
+					ToSql()
+				if err != nil {
+					b.Error(err)
+				}
+
+				result, err = s.db.Query(s.ctx, query, args...)
+				if err != nil {
+					b.Error(err)
+				}
+
+				page[j].PricePolicies, err = scanPricePolicies(result)
+				if err != nil {
+					b.Error(err)
+				}
+			}
+		}
+	}
+
+	report()
+}
+
+func (s *SquirrelBenchmark) FindPageWithPricePoliciesEager(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for size := 0; size < utils.BulkInsertPageNumber; size = size + utils.PageSize {
+			page := s.builder.
+				Select("*").
+				From("books").
+				Where(sq.Gt{"id": pageCursor(books, size)}).
+				OrderBy("id").
+				Limit(utils.PageSize)
+
+			query, args, err := s.builder.
+				Select(
+					"b.id", "b.isbn", "b.title", "b.author", "b.genre", "b.quantity", "b.publicized_at",
+					"p.id", "p.book_id", "p.price", "p.start_date", "p.end_date",
+				).
+				FromSelect(page, "b").
+				Join("price_policies p ON p.book_id = b.id").
+				OrderBy("b.id").
+				ToSql()
+			if err != nil {
+				b.Error(err)
+			}
+
+			result, err := s.db.Query(s.ctx, query, args...)
+			if err != nil {
+				b.Error(err)
+			}
+
+			_, err = scanBooksWithPricePolicies(result)
+			if err != nil {
+				b.Error(err)
+			}
+		}
+	}
+
+	report()
+}
+
+func (s *SquirrelBenchmark) TransactionCommit(b *testing.B) {
+	benchmarkTransaction(b, true, s.doTransaction)
+}
+
+func (s *SquirrelBenchmark) TransactionRollback(b *testing.B) {
+	benchmarkTransaction(b, false, s.doTransaction)
+}
+
+func (s *SquirrelBenchmark) doTransaction(book *model.Book, commit bool) error {
+	tx, err := s.db.Begin(s.ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// it is a no-op once the transaction is committed
+		_ = tx.Rollback(s.ctx)
+	}()
+
+	query, args, err := s.builder.
+		Insert("books").
+		Columns(columns...).
+		Values(book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if err = tx.QueryRow(s.ctx, query, args...).Scan(&book.ID); err != nil {
+		return err
+	}
+
+	insert := s.builder.Insert("price_policies").Columns("book_id", "price", "start_date", "end_date")
+	for _, policy := range model.NewPricePolicies(book.ID, utils.TransactionPricePoliciesNumber) {
+		insert = insert.Values(policy.BookID, policy.Price, policy.StartDate, policy.EndDate)
+	}
+	query, args, err = insert.ToSql()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(s.ctx, query, args...); err != nil {
+		return err
+	}
+
+	query, args, err = s.builder.
+		Update("books").
+		Set("quantity", updatedQuantity(book)).
+		Where(sq.Eq{"id": book.ID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(s.ctx, query, args...); err != nil {
+		return err
+	}
+
+	if !commit {
+		return tx.Rollback(s.ctx)
+	}
+	return tx.Commit(s.ctx)
+}
+
+func (s *SquirrelBenchmark) Upsert(b *testing.B) {
+	benchmarkUpsert(b, func(book *model.Book) error {
+		query, args, err := s.builder.
+			Insert("books").
+			Columns(columns...).
+			Values(book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).
+			Suffix("ON CONFLICT (isbn) DO UPDATE SET " + upsertSet).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = s.db.Exec(s.ctx, query, args...)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) UpsertBulk(b *testing.B) {
+	benchmarkUpsertBulk(b, func(books []*model.Book) error {
+		builder := s.builder.Insert("books").Columns(columns...)
+		for _, book := range books {
+			builder = builder.Values(book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+		}
+
+		query, args, err := builder.Suffix("ON CONFLICT (isbn) DO UPDATE SET " + upsertSet).ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = s.db.Exec(s.ctx, query, args...)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) UpdateBulk(b *testing.B) {
+	benchmarkUpdateBulk(b, func(ids []int64, quantity int) error {
+		query, args, err := s.builder.
+			Update("books").
+			Set("quantity", quantity).
+			Where("id = ANY(?)", ids).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = s.db.Exec(s.ctx, query, args...)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) UpdateBulkValues(b *testing.B) {
+	benchmarkUpdateBulkValues(b, func(ids []int64, quantities []int) error {
+		quantity := sq.Case("id")
+		for i := range ids {
+			quantity = quantity.When(sq.Expr("?::INTEGER", ids[i]), sq.Expr("?::INTEGER", quantities[i]))
+		}
+
+		query, args, err := s.builder.
+			Update("books").
+			Set("quantity", quantity).
+			Where("id = ANY(?)", ids).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = s.db.Exec(s.ctx, query, args...)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) DeleteBulk(b *testing.B) {
+	benchmarkDeleteBulk(b, func(ids []int64) error {
+		query, args, err := s.builder.Delete("books").Where("id = ANY(?)", ids).ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = s.db.Exec(s.ctx, query, args...)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) DeleteBulkWhere(b *testing.B) {
+	benchmarkDeleteBulkWhere(b, func(genre string) error {
+		query, args, err := s.builder.Delete("books").Where(sq.Eq{"genre": genre}).ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = s.db.Exec(s.ctx, query, args...)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) FindPageOffset(b *testing.B) {
+	benchmarkPageDepths(b, func(offset int, _ int64) error {
+		query, args, err := s.builder.
+			Select("*").
+			From("books").
+			OrderBy("id").
+			Limit(utils.PageSize).
+			Offset(uint64(offset)).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		rows, err := s.db.Query(s.ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		if _, err = scanBooks(rows); err != nil {
+			return err
+		}
+
+		query, args, err = s.builder.Select("count(*)").From("books").ToSql()
+		if err != nil {
+			return err
+		}
+		var total int64
+		return s.db.QueryRow(s.ctx, query, args...).Scan(&total)
+	})
+}
+
+func (s *SquirrelBenchmark) FindPageKeyset(b *testing.B) {
+	benchmarkPageDepths(b, func(_ int, cursor int64) error {
+		query, args, err := s.builder.
+			Select("*").
+			From("books").
+			Where(sq.Gt{"id": cursor}).
+			OrderBy("id").
+			Limit(utils.PageSize).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		rows, err := s.db.Query(s.ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		_, err = scanBooks(rows)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) SummarizeGenres(b *testing.B) {
+	benchmarkSummarizeGenres(b, func() error {
+		query, args, err := s.builder.
+			Select(genreSummaryColumns).
+			From("books").
+			GroupBy("genre").
+			OrderBy("genre").
+			ToSql()
+		if err != nil {
+			return err
+		}
+		rows, err := s.db.Query(s.ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		_, err = scanGenreSummaries(rows)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) Search(b *testing.B) {
+	benchmarkSearch(b, func(search bookSearch) error {
+		filters := sq.And{}
+		if search.author != "" {
+			filters = append(filters, sq.Eq{"author": search.author})
+		}
+		if search.genres != nil {
+			filters = append(filters, sq.Eq{"genre": search.genres})
+		}
+		if search.minQuantity != nil {
+			filters = append(filters, sq.GtOrEq{"quantity": *search.minQuantity})
+		}
+		if search.maxQuantity != nil {
+			filters = append(filters, sq.LtOrEq{"quantity": *search.maxQuantity})
+		}
+		if !search.publishedFrom.IsZero() {
+			filters = append(filters, sq.GtOrEq{"publicized_at": search.publishedFrom})
+		}
+		if !search.publishedTo.IsZero() {
+			filters = append(filters, sq.Lt{"publicized_at": search.publishedTo})
+		}
+		if search.title != "" {
+			filters = append(filters, sq.ILike{"title": search.titlePattern()})
+		}
+
+		query, args, err := s.builder.
+			Select("*").
+			From("books").
+			Where(filters).
+			OrderBy("publicized_at DESC").
+			Limit(utils.PageSize).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		rows, err := s.db.Query(s.ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		_, err = scanBooks(rows)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) FindTitleByID(b *testing.B) {
+	benchmarkFindTitleByID(b, func(id int64) error {
+		query, args, err := s.builder.
+			Select("id", "title").
+			From("books").
+			Where(sq.Eq{"id": id}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		var title model.BookTitle
+		return s.db.QueryRow(s.ctx, query, args...).Scan(&title.ID, &title.Title)
+	})
+}
+
+func (s *SquirrelBenchmark) FindTitlePage(b *testing.B) {
+	benchmarkFindTitlePage(b, func(cursor int64) error {
+		query, args, err := s.builder.
+			Select("id", "title").
+			From("books").
+			Where(sq.Gt{"id": cursor}).
+			Limit(utils.PageSize).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		rows, err := s.db.Query(s.ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		_, err = scanBookTitles(rows)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) Stream(b *testing.B) {
+	benchmarkStream(b, func(limit int) (int, error) {
+		query, args, err := s.builder.
+			Select("*").
+			From("books").
+			OrderBy("id").
+			Limit(uint64(limit)).
+			ToSql()
+		if err != nil {
+			return 0, err
+		}
+		rows, err := s.db.Query(s.ctx, query, args...)
+		if err != nil {
+			return 0, err
+		}
+		return streamBooks(rows)
+	})
+}
+
+func (s *SquirrelBenchmark) UpdatePartial(b *testing.B) {
+	benchmarkUpdatePartial(b, func(book *model.Book) error {
+		query, args, err := s.builder.
+			Update("books").
+			Set("quantity", book.Quantity).
+			Where(sq.Eq{"id": book.ID}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		_, err = s.db.Exec(s.ctx, query, args...)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) Decrement(b *testing.B) {
+	benchmarkDecrement(b, s.decrement)
+}
+
+func (s *SquirrelBenchmark) DecrementConcurrent(b *testing.B) {
+	benchmarkDecrementConcurrent(b, s.decrement)
+}
+
+func (s *SquirrelBenchmark) decrement(id int64) (int, error) {
+	query, args, err := s.builder.
+		Update("books").
+		Set("quantity", sq.Expr("quantity - 1")).
+		Where(sq.Eq{"id": id}).
+		Where(sq.Gt{"quantity": 0}).
+		Suffix("RETURNING quantity").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+	var quantity int
+	err = s.db.QueryRow(s.ctx, query, args...).Scan(&quantity)
+	return quantity, err
+}
+
+func (s *SquirrelBenchmark) UpdateOptimistic(b *testing.B) {
+	benchmarkUpdateOptimistic(b, s.findBook, s.saveVersioned)
+}
+
+func (s *SquirrelBenchmark) findBook(id int64) (*model.Book, error) {
+	query, args, err := s.builder.
+		Select("*").
+		From("books").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	return scanBook(s.db.QueryRow(s.ctx, query, args...))
+}
+
+func (s *SquirrelBenchmark) saveVersioned(book *model.Book) error {
+	query, args, err := s.builder.
+		Update("books").
+		Set("quantity", book.Quantity).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": book.ID, "version": book.Version}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	tag, err := s.db.Exec(s.ctx, query, args...)
+	if err == nil && tag.RowsAffected() == 0 {
+		return errVersionConflict
+	}
+	return err
+}
+
+func (s *SquirrelBenchmark) SoftDelete(b *testing.B) {
+	benchmarkSoftDelete(b, func(id int64) error {
+		query, args, err := s.builder.
+			Update("books").
+			Set("deleted_at", time.Now()).
+			Where(sq.Eq{"id": id, "deleted_at": nil}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		_, err = s.db.Exec(s.ctx, query, args...)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) RestoreSoftDeleted(b *testing.B) {
+	benchmarkRestoreSoftDeleted(b, func(id int64) error {
+		query, args, err := s.builder.
+			Update("books").
+			Set("deleted_at", nil).
+			Where(sq.Eq{"id": id}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		_, err = s.db.Exec(s.ctx, query, args...)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) FindNotDeletedByID(b *testing.B) {
+	benchmarkFindNotDeletedByID(b, s.findNotDeleted)
+}
+
+func (s *SquirrelBenchmark) findNotDeleted(id int64) (bool, error) {
+	query, args, err := s.builder.
+		Select("*").
+		From("books").
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+	_, err = scanBook(s.db.QueryRow(s.ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *SquirrelBenchmark) Exists(b *testing.B) {
+	benchmarkExists(b, func(isbn string) (bool, error) {
+		query, args, err := s.builder.
+			Select("1").
+			Prefix("SELECT EXISTS (").
+			From("books").
+			Where(sq.Eq{"isbn": isbn}).
+			Suffix(")").
+			ToSql()
+		if err != nil {
+			return false, err
+		}
+		var exists bool
+		err = s.db.QueryRow(s.ctx, query, args...).Scan(&exists)
+		return exists, err
+	})
+}
+
+func (s *SquirrelBenchmark) Count(b *testing.B) {
+	benchmarkCount(b, func(genre string) (int, error) {
+		query, args, err := s.builder.
+			Select("count(*)").
+			From("books").
+			Where(sq.Eq{"genre": genre}).
+			ToSql()
+		if err != nil {
+			return 0, err
+		}
+		var count int
+		err = s.db.QueryRow(s.ctx, query, args...).Scan(&count)
+		return count, err
+	})
+}
+
+func (s *SquirrelBenchmark) FindByIDs(b *testing.B) {
+	benchmarkFindByIDs(b, func(ids []int64) (int, error) {
+		query, args, err := s.builder.
+			Select("*").
+			From("books").
+			Where(sq.Eq{"id": ids}).
+			ToSql()
+		if err != nil {
+			return 0, err
+		}
+		rows, err := s.db.Query(s.ctx, query, args...)
+		if err != nil {
+			return 0, err
+		}
+		books, err := scanBooks(rows)
+		return len(books), err
+	})
+}
+
+func (s *SquirrelBenchmark) InsertListing(b *testing.B) {
+	benchmarkInsertListing(b, func(listing *model.Listing) error {
+		query, args, err := s.builder.
+			Insert("listings").
+			Columns(listingColumns...).
+			Values(
+				listing.Reference,
+				listing.Attributes,
+				[]string(listing.Tags),
+				listing.Price,
+				listing.Cover,
+				listing.Subtitle,
+				listing.DiscontinuedAt,
+				listing.ListedAt,
+			).
+			Suffix("RETURNING id").
+			ToSql()
+		if err != nil {
+			return err
+		}
+		return s.db.QueryRow(s.ctx, query, args...).Scan(&listing.ID)
+	})
+}
+
+func (s *SquirrelBenchmark) FindListingByID(b *testing.B) {
+	benchmarkFindListingByID(b, func(id int64) error {
+		query, args, err := s.builder.
+			Select("*").
+			From("listings").
+			Where(sq.Eq{"id": id}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		_, err = scanListing(s.db.QueryRow(s.ctx, query, args...))
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) UpdateListing(b *testing.B) {
+	benchmarkUpdateListing(b, func(listing *model.Listing) error {
+		query, args, err := s.builder.
+			Update("listings").
+			SetMap(map[string]interface{}{
+				"reference":       listing.Reference,
+				"attributes":      listing.Attributes,
+				"tags":            []string(listing.Tags),
+				"price":           listing.Price,
+				"cover":           listing.Cover,
+				"subtitle":        listing.Subtitle,
+				"discontinued_at": listing.DiscontinuedAt,
+				"listed_at":       listing.ListedAt,
+			}).
+			Where(sq.Eq{"id": listing.ID}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		_, err = s.db.Exec(s.ctx, query, args...)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) InsertDraft(b *testing.B) {
+	benchmarkInsertDraft(b, func(draft *model.Draft) error {
+		query, args, err := s.builder.
+			Insert("drafts").
+			Columns(draftColumns...).
+			Values(
+				draft.Title,
+				draft.Subtitle,
+				draft.Author,
+				draft.Translator,
+				draft.Pages,
+				draft.Rating,
+				draft.Illustrated,
+				draft.SubmittedAt,
+			).
+			Suffix("RETURNING id").
+			ToSql()
+		if err != nil {
+			return err
+		}
+		return s.db.QueryRow(s.ctx, query, args...).Scan(&draft.ID)
+	})
+}
+
+func (s *SquirrelBenchmark) FindDraftByID(b *testing.B) {
+	benchmarkFindDraftByID(b, func(id int64) error {
+		query, args, err := s.builder.
+			Select("*").
+			From("drafts").
+			Where(sq.Eq{"id": id}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		_, err = scanDraft(s.db.QueryRow(s.ctx, query, args...))
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) FindDrafts(b *testing.B) {
+	benchmarkFindDrafts(b, func(limit int) (int, error) {
+		query, args, err := s.builder.
+			Select("*").
+			From("drafts").
+			OrderBy("id").
+			Limit(uint64(limit)).
+			ToSql()
+		if err != nil {
+			return 0, err
+		}
+		rows, err := s.db.Query(s.ctx, query, args...)
+		if err != nil {
+			return 0, err
+		}
+		return collectDrafts(rows, limit)
+	})
+}
+
+func (s *SquirrelBenchmark) InsertWide(b *testing.B) {
+	benchmarkInsertWide(b, func(wide *model.Wide) error {
+		query, args, err := s.builder.
+			Insert("wides").
+			Columns(model.WideColumns...).
+			Values(wide.Values()...).
+			Suffix("RETURNING id").
+			ToSql()
+		if err != nil {
+			return err
+		}
+		return s.db.QueryRow(s.ctx, query, args...).Scan(&wide.ID)
+	})
+}
+
+func (s *SquirrelBenchmark) FindWideByID(b *testing.B) {
+	benchmarkFindWideByID(b, func(id int64) error {
+		query, args, err := s.builder.
+			Select("*").
+			From("wides").
+			Where(sq.Eq{"id": id}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		wide := new(model.Wide)
+		return s.db.QueryRow(s.ctx, query, args...).Scan(wide.Fields()...)
+	})
+}
+
+func (s *SquirrelBenchmark) FindWides(b *testing.B) {
+	benchmarkFindWides(b, func(limit int) (int, error) {
+		query, args, err := s.builder.
+			Select("*").
+			From("wides").
+			OrderBy("id").
+			Limit(uint64(limit)).
+			ToSql()
+		if err != nil {
+			return 0, err
+		}
+		rows, err := s.db.Query(s.ctx, query, args...)
+		if err != nil {
+			return 0, err
+		}
+		return collectWides(rows, limit)
+	})
+}
+
+func (s *SquirrelBenchmark) InsertUUIDClient(b *testing.B) {
+	benchmarkInsertUUIDClient(b, func(book *model.UUIDBook) error {
+		query, args, err := s.builder.
+			Insert("uuid_books").
+			Columns("id").
+			Columns(columns...).
+			Values(book.ID, book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		_, err = s.db.Exec(s.ctx, query, args...)
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) InsertUUIDServer(b *testing.B) {
+	benchmarkInsertUUIDServer(b, func(book *model.UUIDBook) error {
+		query, args, err := s.builder.
+			Insert("uuid_books").
+			Columns(columns...).
+			Values(book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).
+			Suffix("RETURNING id").
+			ToSql()
+		if err != nil {
+			return err
+		}
+		return s.db.QueryRow(s.ctx, query, args...).Scan(&book.ID)
+	})
+}
+
+func (s *SquirrelBenchmark) FindUUIDByID(b *testing.B) {
+	benchmarkFindUUIDByID(b, func(id uuid.UUID) error {
+		query, args, err := s.builder.
+			Select("*").
+			From("uuid_books").
+			Where(sq.Eq{"id": id}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		_, err = scanUUIDBook(s.db.QueryRow(s.ctx, query, args...))
+		return err
+	})
+}
+
+func (s *SquirrelBenchmark) DeleteUUID(b *testing.B) {
+	benchmarkDeleteUUID(b, func(id uuid.UUID) error {
+		query, args, err := s.builder.
+			Delete("uuid_books").
+			Where(sq.Eq{"id": id}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+		_, err = s.db.Exec(s.ctx, query, args...)
+		return err
+	})
+}
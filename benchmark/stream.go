@@ -0,0 +1,99 @@
+package benchmark
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/metrics"
+	"testing"
+	"time"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+)
+
+// StreamBenchmark is implemented by the adapters able to read a large result set. The books are read by a single
+// query ordered by id (ORDER BY id LIMIT n), through the iterator of the library when it has one. ent reads them in
+// keyset batches of utils.StreamBatchSize books instead, reported as emulated.
+type StreamBenchmark interface {
+	// Stream reads utils.StreamBooksNumber books, keeping as few of them in memory as the library allows.
+	Stream(b *testing.B)
+}
+
+const (
+	// throughputMetric is the unit of the books read per second.
+	throughputMetric = "rows/s"
+	// peakHeapMetric is the unit of the highest heap growth seen while reading.
+	peakHeapMetric = "peak-heap-B"
+)
+
+// heapObjectsMetric is the runtime metric of the bytes held by heap objects, including the unswept ones.
+const heapObjectsMetric = "/memory/classes/heap/objects:bytes"
+
+// heapSampler polls the heap while an operation runs and keeps its peak. runtime/metrics is read instead of
+// runtime.ReadMemStats, which stops the world.
+type heapSampler struct {
+	baseline uint64
+	peak     uint64
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func startHeapSampler() *heapSampler {
+	runtime.GC()
+	s := &heapSampler{stop: make(chan struct{}), done: make(chan struct{})}
+	sample := []metrics.Sample{{Name: heapObjectsMetric}}
+	metrics.Read(sample)
+	s.baseline = sample[0].Value.Uint64()
+	s.peak = s.baseline
+
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(time.Millisecond)
+		defer ticker.Stop()
+		for {
+			metrics.Read(sample)
+			s.peak = max(s.peak, sample[0].Value.Uint64())
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return s
+}
+
+// Stop ends the sampling and returns the peak growth of the heap since the sampler started.
+func (s *heapSampler) Stop() uint64 {
+	close(s.stop)
+	<-s.done
+	return s.peak - s.baseline
+}
+
+// benchmarkStream measures fn reading utils.StreamBooksNumber books. fn receives the number of books to read and
+// returns the number it read, and the throughput and the peak heap growth are reported besides the usual metrics.
+func benchmarkStream(b *testing.B, fn func(limit int) (int, error)) {
+	if _, err := utils.SeedBooksUpTo(utils.StreamBooksNumber); err != nil {
+		b.Error(err)
+	}
+	sampler := startHeapSampler()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		read, err := fn(utils.StreamBooksNumber)
+
+		b.StopTimer()
+		if err == nil && read != utils.StreamBooksNumber {
+			err = fmt.Errorf("read %d books, want %d", read, utils.StreamBooksNumber)
+		}
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+
+	b.StopTimer()
+	b.ReportMetric(float64(sampler.Stop()), peakHeapMetric)
+	b.ReportMetric(float64(b.N*utils.StreamBooksNumber)/b.Elapsed().Seconds(), throughputMetric)
+}
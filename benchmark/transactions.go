@@ -0,0 +1,57 @@
+package benchmark
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// TransactionBenchmark is implemented by the adapters able to run the operations inside a transaction.
+// Every transaction inserts a book with utils.TransactionPricePoliciesNumber price policies and then
+// updates the quantity of the book.
+type TransactionBenchmark interface {
+	// TransactionCommit runs the transaction and commits it.
+	TransactionCommit(b *testing.B)
+	// TransactionRollback runs the transaction and rolls it back.
+	TransactionRollback(b *testing.B)
+}
+
+// errRollback is returned from the callbacks of transaction APIs, such as bun.RunInTx or gorm.Transaction,
+// to roll the transaction back on purpose.
+var errRollback = errors.New("benchmark: rollback")
+
+// transactionFunc runs one transaction for the given book, committing it when commit is true.
+type transactionFunc func(book *model.Book, commit bool) error
+
+// benchmarkTransaction measures the transaction run by fn, it is shared by the commit and rollback operations
+// of every adapter.
+func benchmarkTransaction(b *testing.B, commit bool, fn transactionFunc) {
+	book := model.NewBook()
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		book.ID = 0
+		book.ISBN = model.NewISBN()
+		b.StartTimer()
+
+		err := fn(book, commit)
+
+		b.StopTimer()
+		if err != nil && !errors.Is(err, errRollback) {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+
+	report()
+}
+
+// updatedQuantity returns the quantity set by the update of the transaction benchmarks.
+func updatedQuantity(book *model.Book) int {
+	return book.Quantity - 1
+}
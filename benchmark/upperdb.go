@@ -0,0 +1,800 @@
+//go:build upperdb
+
+package benchmark
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+
+	"github.com/google/uuid"
+	"github.com/upper/db/v4"
+	"github.com/upper/db/v4/adapter/postgresql"
+)
+
+// upperBook maps the books table for upper/db. It needs the omitempty option on the primary key,
+// which gorp rejects as an unknown db tag option, so it cannot share the tags of model.Book.
+type upperBook struct {
+	ID           int64      `db:"id,omitempty"`
+	ISBN         string     `db:"isbn"`
+	Title        string     `db:"title"`
+	Author       string     `db:"author"`
+	Genre        string     `db:"genre"`
+	Quantity     int        `db:"quantity"`
+	PublicizedAt time.Time  `db:"publicized_at"`
+	Version      int        `db:"version"`
+	DeletedAt    *time.Time `db:"deleted_at"`
+}
+
+func newUpperBook(book *model.Book) *upperBook {
+	return &upperBook{
+		ISBN:         book.ISBN,
+		Title:        book.Title,
+		Author:       book.Author,
+		Genre:        book.Genre,
+		Quantity:     book.Quantity,
+		PublicizedAt: book.PublicizedAt,
+		Version:      book.Version,
+	}
+}
+
+// upperListing maps the listings table for upper/db, whose PostgreSQL adapter provides the types converting JSONB
+// and TEXT[] columns.
+type upperListing struct {
+	ID             int64                  `db:"id,omitempty"`
+	Reference      uuid.UUID              `db:"reference"`
+	Attributes     postgresql.JSONB       `db:"attributes"`
+	Tags           postgresql.StringArray `db:"tags"`
+	Price          float64                `db:"price"`
+	Cover          []byte                 `db:"cover"`
+	Subtitle       *string                `db:"subtitle"`
+	DiscontinuedAt *time.Time             `db:"discontinued_at"`
+	ListedAt       time.Time              `db:"listed_at"`
+}
+
+func newUpperListing(listing *model.Listing) *upperListing {
+	return &upperListing{
+		ID:             listing.ID,
+		Reference:      listing.Reference,
+		Attributes:     postgresql.JSONB{V: listing.Attributes},
+		Tags:           postgresql.StringArray(listing.Tags),
+		Price:          listing.Price,
+		Cover:          listing.Cover,
+		Subtitle:       listing.Subtitle,
+		DiscontinuedAt: listing.DiscontinuedAt,
+		ListedAt:       listing.ListedAt,
+	}
+}
+
+// upperDraft maps the drafts table for upper/db, with the omitempty option on the primary key as upperBook.
+type upperDraft struct {
+	ID          int64      `db:"id,omitempty"`
+	Title       *string    `db:"title"`
+	Subtitle    *string    `db:"subtitle"`
+	Author      *string    `db:"author"`
+	Translator  *string    `db:"translator"`
+	Pages       *int       `db:"pages"`
+	Rating      *float64   `db:"rating"`
+	Illustrated *bool      `db:"illustrated"`
+	SubmittedAt *time.Time `db:"submitted_at"`
+}
+
+type UpperDBBenchmark struct {
+	sess db.Session
+}
+
+func init() {
+	Register(Adapter{Name: "upper/db", Category: CategoryORM, New: NewUpperDBBenchmark})
+}
+
+func NewUpperDBBenchmark() Benchmark {
+	return &UpperDBBenchmark{}
+}
+
+func (o *UpperDBBenchmark) Init() error {
+	settings, err := postgresql.ParseURL(utils.PostgresDSN)
+	if err != nil {
+		return err
+	}
+	o.sess, err = postgresql.Open(settings)
+	return err
+}
+
+func (o *UpperDBBenchmark) Close() error {
+	return o.sess.Close()
+}
+
+func (o *UpperDBBenchmark) Capabilities() Capabilities {
+	return Capabilities{
+		SelectRelationsOp:          Emulated,
+		SelectPageRelationsEagerOp: Emulated,
+	}
+}
+
+func (o *UpperDBBenchmark) Insert(b *testing.B) {
+	book := newUpperBook(model.NewBook())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		book.ID = 0
+		book.ISBN = model.NewISBN()
+		b.StartTimer()
+
+		_, err := o.sess.Collection("books").Insert(book)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (o *UpperDBBenchmark) InsertBulk(b *testing.B) {
+	books := make([]*upperBook, utils.BulkInsertNumber)
+	for i, book := range model.NewBooks(utils.BulkInsertNumber) {
+		books[i] = newUpperBook(book)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for _, book := range books {
+			book.ISBN = model.NewISBN()
+		}
+		b.StartTimer()
+
+		err := o.doInsertBulk(books)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (o *UpperDBBenchmark) Update(b *testing.B) {
+	book := newUpperBook(model.NewBook())
+
+	err := o.sess.Collection("books").InsertReturning(book)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err = o.sess.Collection("books").Find(db.Cond{"id": book.ID}).Update(book)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (o *UpperDBBenchmark) Delete(b *testing.B) {
+	n := b.N
+	book := newUpperBook(model.NewBook())
+	bookIDs := make([]int64, n)
+	for i := 0; i < n; i++ {
+		book.ID = 0
+		book.ISBN = model.NewISBN()
+		if err := o.sess.Collection("books").InsertReturning(book); err != nil {
+			b.Error(err)
+		}
+		bookIDs[i] = book.ID
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var bookID int64
+	for i := 0; i < n; i++ {
+		b.StopTimer()
+		bookID = bookIDs[i]
+		b.StartTimer()
+
+		err := o.sess.Collection("books").Find(db.Cond{"id": bookID}).Delete()
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func (o *UpperDBBenchmark) FindByID(b *testing.B) {
+	book := newUpperBook(model.NewBook())
+
+	err := o.sess.Collection("books").InsertReturning(book)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for range utils.FindOneLoop {
+			var foundBook upperBook
+			err = o.sess.Collection("books").Find(db.Cond{"id": book.ID}).One(&foundBook)
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+}
+
+func (o *UpperDBBenchmark) FindPage(b *testing.B) {
+	books := make([]*upperBook, utils.BulkInsertPageNumber)
+	for i, book := range model.NewBooks(utils.BulkInsertPageNumber) {
+		books[i] = newUpperBook(book)
+	}
+	if err := o.doInsertBulk(books); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	booksPage := make([]upperBook, 0, utils.PageSize)
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			// ent, sqlc and goe generates the slice inside, so all makes counts
+			booksPage = make([]upperBook, 0, utils.PageSize)
+
+			err := o.sess.Collection("books").Find(db.Cond{"id >": s}).Limit(utils.PageSize).All(&booksPage)
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+}
+
+func (o *UpperDBBenchmark) FindWithPricePolicies(b *testing.B) {
+	book, err := seedBookWithPricePolicies()
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		// upper/db has no eager loading, the policies are loaded by a second query.
+		var foundBook upperBook
+		var policies []model.PricePolicy
+		err = o.sess.Collection("books").Find(db.Cond{"id": book.ID}).One(&foundBook)
+		if err == nil {
+			err = o.sess.Collection("price_policies").Find(db.Cond{"book_id": book.ID}).All(&policies)
+		}
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+
+	report()
+}
+
+func (o *UpperDBBenchmark) FindPageWithPricePoliciesNaive(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			var booksPage []upperBook
+			err = o.sess.Collection("books").Find(db.Cond{"id >": pageCursor(books, s)}).Limit(utils.PageSize).All(&booksPage)
+			for j := 0; err == nil && j < len(booksPage); j++ {
+				var policies []model.PricePolicy
+				err = o.sess.Collection("price_policies").Find(db.Cond{"book_id": booksPage[j].ID}).All(&policies)
+			}
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *UpperDBBenchmark) FindPageWithPricePoliciesEager(b *testing.B) {
+	books, err := seedBooksWithPricePolicies(utils.BulkInsertPageNumber)
+	if err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	report := countStatements(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for s := 0; s < utils.BulkInsertPageNumber; s = s + utils.PageSize {
+			// upper/db has no eager loading, the policies of the whole page are loaded by a second query.
+			var booksPage []upperBook
+			var policies []model.PricePolicy
+			err = o.sess.Collection("books").Find(db.Cond{"id >": pageCursor(books, s)}).Limit(utils.PageSize).All(&booksPage)
+			if err == nil {
+				bookIDs := make([]int64, len(booksPage))
+				for j := range booksPage {
+					bookIDs[j] = booksPage[j].ID
+				}
+				err = o.sess.Collection("price_policies").Find(db.Cond{"book_id IN": bookIDs}).All(&policies)
+			}
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+
+	report()
+}
+
+func (o *UpperDBBenchmark) TransactionCommit(b *testing.B) {
+	benchmarkTransaction(b, true, o.doTransaction)
+}
+
+func (o *UpperDBBenchmark) TransactionRollback(b *testing.B) {
+	benchmarkTransaction(b, false, o.doTransaction)
+}
+
+func (o *UpperDBBenchmark) doTransaction(book *model.Book, commit bool) error {
+	return o.sess.Tx(func(sess db.Session) error {
+		created := newUpperBook(book)
+		err := sess.Collection("books").InsertReturning(created)
+		if err != nil {
+			return err
+		}
+
+		insert := sess.SQL().InsertInto("price_policies").Columns("book_id", "price", "start_date", "end_date")
+		for _, policy := range model.NewPricePolicies(created.ID, utils.TransactionPricePoliciesNumber) {
+			insert = insert.Values(policy.BookID, policy.Price, policy.StartDate, policy.EndDate)
+		}
+		if _, err = insert.Exec(); err != nil {
+			return err
+		}
+
+		err = sess.Collection("books").Find(db.Cond{"id": created.ID}).Update(map[string]interface{}{"quantity": updatedQuantity(book)})
+		if err != nil {
+			return err
+		}
+
+		if !commit {
+			return errRollback
+		}
+		return nil
+	})
+}
+
+func (o *UpperDBBenchmark) Upsert(b *testing.B) {
+	benchmarkUpsert(b, func(book *model.Book) error {
+		return o.doUpsert([]*model.Book{book})
+	})
+}
+
+func (o *UpperDBBenchmark) UpsertBulk(b *testing.B) {
+	benchmarkUpsertBulk(b, o.doUpsert)
+}
+
+// doUpsert appends the conflict clause to an insert, as upper/db has no upsert API.
+func (o *UpperDBBenchmark) doUpsert(books []*model.Book) error {
+	insert := o.sess.SQL().InsertInto("books").Columns(columns...)
+	for _, book := range books {
+		insert = insert.Values(book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+	}
+	_, err := insert.Amend(func(query string) string {
+		return query + " ON CONFLICT (isbn) DO UPDATE SET " + upsertSet
+	}).Exec()
+	return err
+}
+
+func (o *UpperDBBenchmark) UpdateBulk(b *testing.B) {
+	benchmarkUpdateBulk(b, func(ids []int64, quantity int) error {
+		return o.sess.Collection("books").Find(db.Cond{"id IN": ids}).Update(map[string]interface{}{"quantity": quantity})
+	})
+}
+
+func (o *UpperDBBenchmark) UpdateBulkValues(b *testing.B) {
+	benchmarkUpdateBulkValues(b, func(ids []int64, quantities []int) error {
+		// upper/db has no bulk update of different values, the statement is written by hand.
+		_, err := o.sess.SQL().Exec(utils.UpdateQuantitiesUnnestQuery, ids, quantities)
+		return err
+	})
+}
+
+func (o *UpperDBBenchmark) DeleteBulk(b *testing.B) {
+	benchmarkDeleteBulk(b, func(ids []int64) error {
+		return o.sess.Collection("books").Find(db.Cond{"id IN": ids}).Delete()
+	})
+}
+
+func (o *UpperDBBenchmark) DeleteBulkWhere(b *testing.B) {
+	benchmarkDeleteBulkWhere(b, func(genre string) error {
+		return o.sess.Collection("books").Find(db.Cond{"genre": genre}).Delete()
+	})
+}
+
+func (o *UpperDBBenchmark) doInsertBulk(books []*upperBook) error {
+	batch := o.sess.SQL().InsertInto("books").Batch(len(books))
+
+	go func() {
+		defer batch.Done()
+		for _, book := range books {
+			batch.Values(book)
+		}
+	}()
+
+	return batch.Wait()
+}
+
+func (o *UpperDBBenchmark) FindPageOffset(b *testing.B) {
+	benchmarkPageDepths(b, func(offset int, _ int64) error {
+		var booksPage []upperBook
+		books := o.sess.Collection("books").Find().OrderBy("id")
+		err := books.Limit(utils.PageSize).Offset(offset).All(&booksPage)
+		if err != nil {
+			return err
+		}
+
+		_, err = books.Count()
+		return err
+	})
+}
+
+func (o *UpperDBBenchmark) FindPageKeyset(b *testing.B) {
+	benchmarkPageDepths(b, func(_ int, cursor int64) error {
+		var booksPage []upperBook
+		return o.sess.Collection("books").
+			Find(db.Cond{"id >": cursor}).
+			OrderBy("id").
+			Limit(utils.PageSize).
+			All(&booksPage)
+	})
+}
+
+func (o *UpperDBBenchmark) SummarizeGenres(b *testing.B) {
+	benchmarkSummarizeGenres(b, func() error {
+		var summaries []model.GenreSummary
+		return o.sess.SQL().
+			Select(db.Raw(genreSummaryColumns)).
+			From("books").
+			GroupBy("genre").
+			OrderBy("genre").
+			All(&summaries)
+	})
+}
+
+func (o *UpperDBBenchmark) Search(b *testing.B) {
+	benchmarkSearch(b, func(search bookSearch) error {
+		filters := db.Cond{}
+		if search.author != "" {
+			filters["author"] = search.author
+		}
+		if search.genres != nil {
+			filters["genre IN"] = search.genres
+		}
+		if search.minQuantity != nil {
+			filters["quantity >="] = *search.minQuantity
+		}
+		if search.maxQuantity != nil {
+			filters["quantity <="] = *search.maxQuantity
+		}
+		if !search.publishedFrom.IsZero() {
+			filters["publicized_at >="] = search.publishedFrom
+		}
+		if !search.publishedTo.IsZero() {
+			filters["publicized_at <"] = search.publishedTo
+		}
+		if search.title != "" {
+			filters["title ILIKE"] = search.titlePattern()
+		}
+
+		var books []upperBook
+		return o.sess.Collection("books").
+			Find(filters).
+			OrderBy("-publicized_at").
+			Limit(utils.PageSize).
+			All(&books)
+	})
+}
+
+func (o *UpperDBBenchmark) FindTitleByID(b *testing.B) {
+	benchmarkFindTitleByID(b, func(id int64) error {
+		var title model.BookTitle
+		return o.sess.Collection("books").Find(db.Cond{"id": id}).Select("id", "title").One(&title)
+	})
+}
+
+func (o *UpperDBBenchmark) FindTitlePage(b *testing.B) {
+	benchmarkFindTitlePage(b, func(cursor int64) error {
+		var titles []model.BookTitle
+		return o.sess.Collection("books").
+			Find(db.Cond{"id >": cursor}).
+			Select("id", "title").
+			Limit(utils.PageSize).
+			All(&titles)
+	})
+}
+
+func (o *UpperDBBenchmark) Stream(b *testing.B) {
+	benchmarkStream(b, func(limit int) (int, error) {
+		books := o.sess.Collection("books").Find().OrderBy("id").Limit(limit)
+		defer func() {
+			_ = books.Close()
+		}()
+
+		read := 0
+		var book upperBook
+		for books.Next(&book) {
+			read++
+		}
+		return read, books.Err()
+	})
+}
+
+func (o *UpperDBBenchmark) UpdatePartial(b *testing.B) {
+	benchmarkUpdatePartial(b, func(book *model.Book) error {
+		return o.sess.Collection("books").
+			Find(db.Cond{"id": book.ID}).
+			Update(map[string]interface{}{"quantity": book.Quantity})
+	})
+}
+
+func (o *UpperDBBenchmark) Decrement(b *testing.B) {
+	benchmarkDecrement(b, o.decrement)
+}
+
+func (o *UpperDBBenchmark) DecrementConcurrent(b *testing.B) {
+	benchmarkDecrementConcurrent(b, o.decrement)
+}
+
+func (o *UpperDBBenchmark) decrement(id int64) (int, error) {
+	// upper/db updates return no columns, the statement is written by hand.
+	row, err := o.sess.SQL().QueryRow(utils.DecrementQuantityQuery, id)
+	if err != nil {
+		return 0, err
+	}
+	var quantity int
+	err = row.Scan(&quantity)
+	return quantity, err
+}
+
+func (o *UpperDBBenchmark) UpdateOptimistic(b *testing.B) {
+	benchmarkUpdateOptimistic(b, o.findBook, o.saveVersioned)
+}
+
+func (o *UpperDBBenchmark) findBook(id int64) (*model.Book, error) {
+	var found upperBook
+	if err := o.sess.Collection("books").Find(db.Cond{"id": id}).One(&found); err != nil {
+		return nil, err
+	}
+	return &model.Book{
+		ID:           found.ID,
+		ISBN:         found.ISBN,
+		Title:        found.Title,
+		Author:       found.Author,
+		Genre:        found.Genre,
+		Quantity:     found.Quantity,
+		PublicizedAt: found.PublicizedAt,
+		Version:      found.Version,
+	}, nil
+}
+
+func (o *UpperDBBenchmark) saveVersioned(book *model.Book) error {
+	result, err := o.sess.SQL().
+		Update("books").
+		Set("quantity", book.Quantity, "version", db.Raw("version + 1")).
+		Where("id = ? AND version = ?", book.ID, book.Version).
+		Exec()
+	if err != nil {
+		return err
+	}
+	updated, err := result.RowsAffected()
+	if err == nil && updated == 0 {
+		return errVersionConflict
+	}
+	return err
+}
+
+func (o *UpperDBBenchmark) SoftDelete(b *testing.B) {
+	benchmarkSoftDelete(b, func(id int64) error {
+		_, err := o.sess.SQL().
+			Update("books").
+			Set("deleted_at", time.Now()).
+			Where("id = ? AND deleted_at IS NULL", id).
+			Exec()
+		return err
+	})
+}
+
+func (o *UpperDBBenchmark) RestoreSoftDeleted(b *testing.B) {
+	benchmarkRestoreSoftDeleted(b, func(id int64) error {
+		_, err := o.sess.SQL().
+			Update("books").
+			Set("deleted_at", nil).
+			Where("id = ?", id).
+			Exec()
+		return err
+	})
+}
+
+func (o *UpperDBBenchmark) FindNotDeletedByID(b *testing.B) {
+	benchmarkFindNotDeletedByID(b, o.findNotDeleted)
+}
+
+func (o *UpperDBBenchmark) findNotDeleted(id int64) (bool, error) {
+	var found upperBook
+	err := o.sess.Collection("books").Find(db.Cond{"id": id, "deleted_at IS": nil}).One(&found)
+	if errors.Is(err, db.ErrNoMoreRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (o *UpperDBBenchmark) Exists(b *testing.B) {
+	benchmarkExists(b, func(isbn string) (bool, error) {
+		return o.sess.Collection("books").Find(db.Cond{"isbn": isbn}).Exists()
+	})
+}
+
+func (o *UpperDBBenchmark) Count(b *testing.B) {
+	benchmarkCount(b, func(genre string) (int, error) {
+		count, err := o.sess.Collection("books").Find(db.Cond{"genre": genre}).Count()
+		return int(count), err
+	})
+}
+
+func (o *UpperDBBenchmark) FindByIDs(b *testing.B) {
+	benchmarkFindByIDs(b, func(ids []int64) (int, error) {
+		var books []upperBook
+		err := o.sess.Collection("books").Find(db.Cond{"id IN": ids}).All(&books)
+		return len(books), err
+	})
+}
+
+func (o *UpperDBBenchmark) InsertListing(b *testing.B) {
+	benchmarkInsertListing(b, func(listing *model.Listing) error {
+		found := newUpperListing(listing)
+		err := o.sess.Collection("listings").InsertReturning(found)
+		listing.ID = found.ID
+		return err
+	})
+}
+
+func (o *UpperDBBenchmark) FindListingByID(b *testing.B) {
+	benchmarkFindListingByID(b, func(id int64) error {
+		var attributes model.ListingAttributes
+		found := upperListing{Attributes: postgresql.JSONB{V: &attributes}}
+		return o.sess.Collection("listings").Find(db.Cond{"id": id}).One(&found)
+	})
+}
+
+func (o *UpperDBBenchmark) UpdateListing(b *testing.B) {
+	benchmarkUpdateListing(b, func(listing *model.Listing) error {
+		return o.sess.Collection("listings").Find(db.Cond{"id": listing.ID}).Update(newUpperListing(listing))
+	})
+}
+
+func (o *UpperDBBenchmark) InsertDraft(b *testing.B) {
+	benchmarkInsertDraft(b, func(draft *model.Draft) error {
+		d := upperDraft(*draft)
+		err := o.sess.Collection("drafts").InsertReturning(&d)
+		draft.ID = d.ID
+		return err
+	})
+}
+
+func (o *UpperDBBenchmark) FindDraftByID(b *testing.B) {
+	benchmarkFindDraftByID(b, func(id int64) error {
+		var draft upperDraft
+		return o.sess.Collection("drafts").Find(db.Cond{"id": id}).One(&draft)
+	})
+}
+
+func (o *UpperDBBenchmark) FindDrafts(b *testing.B) {
+	benchmarkFindDrafts(b, func(limit int) (int, error) {
+		var drafts []upperDraft
+		err := o.sess.Collection("drafts").Find().OrderBy("id").Limit(limit).All(&drafts)
+		return len(drafts), err
+	})
+}
+
+func (o *UpperDBBenchmark) InsertWide(b *testing.B) {
+	benchmarkInsertWide(b, func(wide *model.Wide) error {
+		// model.Wide has no omitempty option on its id, which the collection would insert.
+		row, err := o.sess.SQL().
+			InsertInto("wides").
+			Columns(model.WideColumns...).
+			Values(wide.Values()...).
+			Returning("id").
+			QueryRow()
+		if err != nil {
+			return err
+		}
+		return row.Scan(&wide.ID)
+	})
+}
+
+func (o *UpperDBBenchmark) FindWideByID(b *testing.B) {
+	benchmarkFindWideByID(b, func(id int64) error {
+		var wide model.Wide
+		return o.sess.Collection("wides").Find(db.Cond{"id": id}).One(&wide)
+	})
+}
+
+func (o *UpperDBBenchmark) FindWides(b *testing.B) {
+	benchmarkFindWides(b, func(limit int) (int, error) {
+		var wides []model.Wide
+		err := o.sess.Collection("wides").Find().OrderBy("id").Limit(limit).All(&wides)
+		return len(wides), err
+	})
+}
+
+func (o *UpperDBBenchmark) InsertUUIDClient(b *testing.B) {
+	benchmarkInsertUUIDClient(b, func(book *model.UUIDBook) error {
+		_, err := o.sess.Collection("uuid_books").Insert(book)
+		return err
+	})
+}
+
+func (o *UpperDBBenchmark) InsertUUIDServer(b *testing.B) {
+	benchmarkInsertUUIDServer(b, func(book *model.UUIDBook) error {
+		row, err := o.sess.SQL().
+			InsertInto("uuid_books").
+			Columns("isbn", "title", "author", "genre", "quantity", "publicized_at").
+			Values(book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).
+			Returning("id").
+			QueryRow()
+		if err != nil {
+			return err
+		}
+		return row.Scan(&book.ID)
+	})
+}
+
+func (o *UpperDBBenchmark) FindUUIDByID(b *testing.B) {
+	benchmarkFindUUIDByID(b, func(id uuid.UUID) error {
+		var book model.UUIDBook
+		return o.sess.Collection("uuid_books").Find(db.Cond{"id": id}).One(&book)
+	})
+}
+
+func (o *UpperDBBenchmark) DeleteUUID(b *testing.B) {
+	benchmarkDeleteUUID(b, func(id uuid.UUID) error {
+		return o.sess.Collection("uuid_books").Find(db.Cond{"id": id}).Delete()
+	})
+}
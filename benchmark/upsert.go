@@ -0,0 +1,83 @@
+package benchmark
+
+import (
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// UpsertBenchmark is implemented by the adapters able to insert books or update the ones whose ISBN already exists.
+type UpsertBenchmark interface {
+	// Upsert upserts one book whose ISBN exists, so every iteration takes the update path.
+	Upsert(b *testing.B)
+	// UpsertBulk upserts utils.BulkInsertNumber books at once, half of them new and half of them existing.
+	UpsertBulk(b *testing.B)
+}
+
+// upsertSet lists the columns updated when the ISBN of an upserted book already exists.
+const upsertSet = "title = EXCLUDED.title, author = EXCLUDED.author, genre = EXCLUDED.genre, " +
+	"quantity = EXCLUDED.quantity, publicized_at = EXCLUDED.publicized_at"
+
+// upsertColumns are the columns of upsertSet.
+var upsertColumns = []string{"title", "author", "genre", "quantity", "publicized_at"}
+
+// benchmarkUpsert measures fn upserting a book that was seeded beforehand.
+func benchmarkUpsert(b *testing.B, fn func(book *model.Book) error) {
+	book := model.NewBook()
+	if err := utils.SeedBooks(book); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		book.ID = 0
+		book.Quantity++
+		b.StartTimer()
+
+		err := fn(book)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// benchmarkUpsertBulk measures fn upserting books, the first half of them seeded beforehand and the second half
+// renewed with unused ISBNs before every iteration.
+func benchmarkUpsertBulk(b *testing.B, fn func(books []*model.Book) error) {
+	books := model.NewBooks(utils.BulkInsertNumber)
+	existing, inserted := books[:len(books)/2], books[len(books)/2:]
+	if err := utils.SeedBooks(existing...); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for _, book := range existing {
+			book.ID = 0
+			book.Quantity++
+		}
+		for _, book := range inserted {
+			book.ID = 0
+			book.ISBN = model.NewISBN()
+		}
+		b.StartTimer()
+
+		err := fn(books)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
@@ -11,8 +11,31 @@ const (
 	PageSize             = 10
 	BulkInsertPageNumber = 100
 	FindOneLoop          = 1
+	PricePoliciesNumber  = 10
+	// TransactionPricePoliciesNumber is the number of price policies inserted with the book of each transaction.
+	TransactionPricePoliciesNumber = 3
+	// PaginationBooksNumber is the number of books the pagination operations read from.
+	PaginationBooksNumber = 100000
+	// CatalogBooksNumber is the number of books spread across the catalog genres for the aggregate operations.
+	CatalogBooksNumber = 10000
+	// StreamBooksNumber is the number of books the streaming operation reads in a single query.
+	StreamBooksNumber = 100000
+	// StreamBatchSize is the number of books read per query by the adapters unable to stream.
+	StreamBatchSize = 1000
+	// SearchesNumber is the number of filter combinations the search operation cycles through.
+	SearchesNumber = 64
+	// DraftsNumber is the number of drafts, half of their values NULL, the nullable select reads in one query.
+	DraftsNumber = 1000
+	// WidesNumber is the number of rows of the wides table the wide select reads in one query.
+	WidesNumber = 100
 )
 
+// PageDepths are the pages, counted from zero, read by the pagination operations to show how their cost grows with the depth.
+var PageDepths = []int{0, 100, 1000, 9000}
+
+// IDListSizes are the numbers of ids looked up at once by the id list operation, as a batch loader would.
+var IDListSizes = []int{10, 100, 1000}
+
 var PostgresDSN string
 
 func init() {
@@ -21,7 +21,27 @@ func RecreateDatabase() {
 	}()
 
 	_, err = db.Exec(queries.RecreateDatabaseSQL)
+	if err == nil {
+		_, err = db.Exec(queries.RecreateWideTableSQL)
+	}
 	if err != nil {
 		log.Fatal("the benchmark execution was aborted", err)
 	}
 }
+
+// CountStatements returns how many statements were executed on the benchmark database, as tracked by
+// pg_stat_statements. It fails when the extension is not loaded by the server.
+func CountStatements() (int64, error) {
+	db, err := sql.Open("pgx", PostgresDSN)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		_ = db.Close()
+	}()
+
+	var calls int64
+	err = db.QueryRow(CountStatementsQuery).Scan(&calls)
+	return calls, err
+}
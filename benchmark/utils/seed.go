@@ -0,0 +1,254 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var pricePoliciesColumns = []string{"book_id", "price", "start_date", "end_date"}
+
+// SeedBooks persists the books in a single statement and fills their ids.
+// It prepares the data of operations that do not measure inserts, so every adapter starts from the same rows.
+func SeedBooks(books ...*model.Book) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, PostgresDSN)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	isbns := make([]string, len(books))
+	titles := make([]string, len(books))
+	authors := make([]string, len(books))
+	genres := make([]string, len(books))
+	quantities := make([]int, len(books))
+	publicizedAt := make([]time.Time, len(books))
+	deletedAt := make([]*time.Time, len(books))
+	for i, book := range books {
+		isbns[i] = book.ISBN
+		titles[i] = book.Title
+		authors[i] = book.Author
+		genres[i] = book.Genre
+		quantities[i] = book.Quantity
+		publicizedAt[i] = book.PublicizedAt
+		deletedAt[i] = book.DeletedAt
+	}
+
+	rows, err := conn.Query(ctx, SeedBooksQuery, isbns, titles, authors, genres, quantities, publicizedAt, deletedAt)
+	if err != nil {
+		return err
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return err
+	}
+	for i, id := range ids {
+		books[i].ID = id
+	}
+	return nil
+}
+
+// SeedPricePolicies persists the price policies using copy.
+func SeedPricePolicies(policies ...*model.PricePolicy) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, PostgresDSN)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	rows := make([][]interface{}, len(policies))
+	for i, policy := range policies {
+		rows[i] = []interface{}{policy.BookID, policy.Price, policy.StartDate, policy.EndDate}
+	}
+
+	_, err = conn.CopyFrom(ctx, pgx.Identifier{"price_policies"}, pricePoliciesColumns, pgx.CopyFromRows(rows))
+	return err
+}
+
+// SeedBooksUpTo tops the books table up to total rows and returns the ids found at the given offsets in id order.
+// The operations run several times on the same database, so the books are only seeded by the first run.
+func SeedBooksUpTo(total int, offsets ...int) ([]int64, error) {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, PostgresDSN)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	var count int
+	if err = conn.QueryRow(ctx, CountBooksQuery).Scan(&count); err != nil {
+		return nil, err
+	}
+	if count < total {
+		if err = SeedBooks(model.NewBooks(total - count)...); err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]int64, len(offsets))
+	for i, offset := range offsets {
+		if err = conn.QueryRow(ctx, SelectIDAtOffsetQuery, offset).Scan(&ids[i]); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+// SeedCatalog tops the books of the catalog genres up to total rows, spread as model.NewCatalogBooks does.
+// Like SeedBooksUpTo, only the first run of an operation seeds the books.
+func SeedCatalog(total int) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, PostgresDSN)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	var count int
+	if err = conn.QueryRow(ctx, CountCatalogBooksQuery, model.CatalogGenres()).Scan(&count); err != nil {
+		return err
+	}
+	if count >= total {
+		return nil
+	}
+	return SeedBooks(model.NewCatalogBooks(total - count)...)
+}
+
+// SeedListings persists the listings and fills their ids.
+func SeedListings(listings ...*model.Listing) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, PostgresDSN)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	for _, listing := range listings {
+		err = conn.QueryRow(ctx, InsertListingQuery,
+			listing.Reference,
+			listing.Attributes,
+			[]string(listing.Tags),
+			listing.Price,
+			listing.Cover,
+			listing.Subtitle,
+			listing.DiscontinuedAt,
+			listing.ListedAt,
+		).Scan(&listing.ID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SeedDrafts persists the drafts in a single statement and fills their ids.
+func SeedDrafts(drafts ...*model.Draft) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, PostgresDSN)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	titles := make([]*string, len(drafts))
+	subtitles := make([]*string, len(drafts))
+	authors := make([]*string, len(drafts))
+	translators := make([]*string, len(drafts))
+	pages := make([]*int, len(drafts))
+	ratings := make([]*float64, len(drafts))
+	illustrated := make([]*bool, len(drafts))
+	submittedAt := make([]*time.Time, len(drafts))
+	for i, draft := range drafts {
+		titles[i] = draft.Title
+		subtitles[i] = draft.Subtitle
+		authors[i] = draft.Author
+		translators[i] = draft.Translator
+		pages[i] = draft.Pages
+		ratings[i] = draft.Rating
+		illustrated[i] = draft.Illustrated
+		submittedAt[i] = draft.SubmittedAt
+	}
+
+	rows, err := conn.Query(ctx, SeedDraftsQuery,
+		titles, subtitles, authors, translators, pages, ratings, illustrated, submittedAt)
+	if err != nil {
+		return err
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return err
+	}
+	for i, id := range ids {
+		drafts[i].ID = id
+	}
+	return nil
+}
+
+// SeedWides persists the rows of the wides table and fills their ids.
+func SeedWides(wides ...*model.Wide) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, PostgresDSN)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	for _, wide := range wides {
+		if err = conn.QueryRow(ctx, InsertWideQuery, wide.Values()...).Scan(&wide.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SeedUUIDBooks persists the books, keyed by the ids they already have, in a single statement.
+func SeedUUIDBooks(books ...*model.UUIDBook) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, PostgresDSN)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	ids := make([]uuid.UUID, len(books))
+	isbns := make([]string, len(books))
+	titles := make([]string, len(books))
+	authors := make([]string, len(books))
+	genres := make([]string, len(books))
+	quantities := make([]int, len(books))
+	publicizedAt := make([]time.Time, len(books))
+	for i, book := range books {
+		ids[i] = book.ID
+		isbns[i] = book.ISBN
+		titles[i] = book.Title
+		authors[i] = book.Author
+		genres[i] = book.Genre
+		quantities[i] = book.Quantity
+		publicizedAt[i] = book.PublicizedAt
+	}
+
+	_, err = conn.Exec(ctx, SeedUUIDBooksQuery, ids, isbns, titles, authors, genres, quantities, publicizedAt)
+	return err
+}
@@ -9,12 +9,106 @@ var (
 	InsertReturningIDQuery string
 	//go:embed sql/insert_bulk.sql
 	InsertBulkQuery string
+	//go:embed sql/upsert.sql
+	UpsertQuery string
+	//go:embed sql/upsert_bulk.sql
+	UpsertBulkQuery string
+	//go:embed sql/upsert_unnest.sql
+	UpsertUnnestQuery string
 	//go:embed sql/update.sql
 	UpdateQuery string
+	//go:embed sql/update_quantity.sql
+	UpdateQuantityQuery string
+	//go:embed sql/update_versioned.sql
+	UpdateVersionedQuery string
+	//go:embed sql/decrement_quantity.sql
+	DecrementQuantityQuery string
+	//go:embed sql/update_quantity_by_ids.sql
+	UpdateQuantityByIDsQuery string
+	//go:embed sql/update_quantities.sql
+	UpdateQuantitiesQuery string
+	//go:embed sql/update_quantities_unnest.sql
+	UpdateQuantitiesUnnestQuery string
 	//go:embed sql/delete.sql
 	DeleteQuery string
+	//go:embed sql/soft_delete.sql
+	SoftDeleteQuery string
+	//go:embed sql/restore.sql
+	RestoreQuery string
+	//go:embed sql/delete_by_ids.sql
+	DeleteByIDsQuery string
+	//go:embed sql/delete_by_genre.sql
+	DeleteByGenreQuery string
 	//go:embed sql/select_by_id.sql
 	SelectByIDQuery string
+	//go:embed sql/select_not_deleted_by_id.sql
+	SelectNotDeletedByIDQuery string
+	//go:embed sql/select_by_ids.sql
+	SelectByIDsQuery string
 	//go:embed sql/select_paginating.sql
 	SelectPaginatingQuery string
+	//go:embed sql/select_title_by_id.sql
+	SelectTitleByIDQuery string
+	//go:embed sql/select_titles_paginating.sql
+	SelectTitlesPaginatingQuery string
+	//go:embed sql/select_paginating_offset.sql
+	SelectPaginatingOffsetQuery string
+	//go:embed sql/select_paginating_keyset.sql
+	SelectPaginatingKeysetQuery string
+	//go:embed sql/count_books.sql
+	CountBooksQuery string
+	//go:embed sql/count_by_genre.sql
+	CountByGenreQuery string
+	//go:embed sql/exists_by_isbn.sql
+	ExistsByISBNQuery string
+	//go:embed sql/select_genre_summaries.sql
+	SelectGenreSummariesQuery string
+	//go:embed sql/select_with_price_policies.sql
+	SelectWithPricePoliciesQuery string
+	//go:embed sql/select_price_policies_by_book.sql
+	SelectPricePoliciesByBookQuery string
+	//go:embed sql/select_paginating_with_price_policies.sql
+	SelectPaginatingWithPricePoliciesQuery string
+	//go:embed sql/select_price_policies_by_books.sql
+	SelectPricePoliciesByBooksQuery string
+	//go:embed sql/insert_price_policies.sql
+	InsertPricePoliciesQuery string
+	//go:embed sql/count_statements.sql
+	CountStatementsQuery string
+	//go:embed sql/seed_books.sql
+	SeedBooksQuery string
+	//go:embed sql/select_id_at_offset.sql
+	SelectIDAtOffsetQuery string
+	//go:embed sql/count_catalog_books.sql
+	CountCatalogBooksQuery string
+	//go:embed sql/insert_listing.sql
+	InsertListingQuery string
+	//go:embed sql/select_listing_by_id.sql
+	SelectListingByIDQuery string
+	//go:embed sql/update_listing.sql
+	UpdateListingQuery string
+	//go:embed sql/insert_draft.sql
+	InsertDraftQuery string
+	//go:embed sql/select_draft_by_id.sql
+	SelectDraftByIDQuery string
+	//go:embed sql/select_drafts.sql
+	SelectDraftsQuery string
+	//go:embed sql/seed_drafts.sql
+	SeedDraftsQuery string
+	//go:embed sql/insert_wide.sql
+	InsertWideQuery string
+	//go:embed sql/select_wide_by_id.sql
+	SelectWideByIDQuery string
+	//go:embed sql/select_wides.sql
+	SelectWidesQuery string
+	//go:embed sql/insert_uuid_book.sql
+	InsertUUIDBookQuery string
+	//go:embed sql/insert_uuid_book_returning_id.sql
+	InsertUUIDBookReturningIDQuery string
+	//go:embed sql/select_uuid_book_by_id.sql
+	SelectUUIDBookByIDQuery string
+	//go:embed sql/delete_uuid_book.sql
+	DeleteUUIDBookQuery string
+	//go:embed sql/seed_uuid_books.sql
+	SeedUUIDBooksQuery string
 )
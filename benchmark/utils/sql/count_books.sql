@@ -0,0 +1,2 @@
+-- countBooks
+SELECT count(*) FROM books;
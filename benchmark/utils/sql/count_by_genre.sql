@@ -0,0 +1,3 @@
+-- countByGenre
+-- $1 Genre
+SELECT count(*) FROM books WHERE genre = $1;
@@ -0,0 +1,3 @@
+-- countCatalogBooks
+-- $1 Genres
+SELECT count(*) FROM books WHERE genre = ANY($1);
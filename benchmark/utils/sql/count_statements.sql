@@ -0,0 +1,7 @@
+-- countStatements
+-- Statements executed on the current database, except the ones reading pg_stat_statements.
+SELECT COALESCE(SUM(s.calls), 0)::BIGINT
+FROM pg_stat_statements s
+JOIN pg_database d ON d.oid = s.dbid
+WHERE d.datname = current_database()
+  AND s.query NOT LIKE '%pg_stat_statements%';
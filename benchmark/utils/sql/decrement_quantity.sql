@@ -0,0 +1,6 @@
+-- decrementQuantity
+-- $1 ID
+UPDATE books
+SET quantity = quantity - 1
+WHERE id = $1 AND quantity > 0
+RETURNING quantity;
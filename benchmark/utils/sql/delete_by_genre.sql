@@ -0,0 +1,3 @@
+-- deleteBooksByGenre
+-- $1 Genre
+DELETE FROM books WHERE genre = $1;
@@ -0,0 +1,3 @@
+-- deleteBooks
+-- $1 IDs
+DELETE FROM books WHERE id = ANY($1);
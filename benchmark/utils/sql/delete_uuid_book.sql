@@ -0,0 +1,3 @@
+-- deleteUUIDBook
+-- $1 ID
+DELETE FROM uuid_books WHERE id = $1;
@@ -0,0 +1,3 @@
+-- existsByISBN
+-- $1 ISBN
+SELECT EXISTS (SELECT 1 FROM books WHERE isbn = $1);
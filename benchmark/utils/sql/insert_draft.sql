@@ -0,0 +1,12 @@
+-- insertDraft
+-- $1 Title
+-- $2 Subtitle
+-- $3 Author
+-- $4 Translator
+-- $5 Pages
+-- $6 Rating
+-- $7 Illustrated
+-- $8 Submission date
+INSERT INTO drafts (title, subtitle, author, translator, pages, rating, illustrated, submitted_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id;
@@ -0,0 +1,12 @@
+-- insertListing
+-- $1 Reference
+-- $2 Attributes
+-- $3 Tags
+-- $4 Price
+-- $5 Cover
+-- $6 Subtitle
+-- $7 Discontinuation date
+-- $8 Listing date
+INSERT INTO listings (reference, attributes, tags, price, cover, subtitle, discontinued_at, listed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id;
@@ -0,0 +1,7 @@
+-- insertPricePolicies
+-- $1 Book IDs
+-- $2 Prices
+-- $3 Start dates
+-- $4 End dates
+INSERT INTO price_policies (book_id, price, start_date, end_date)
+SELECT * FROM unnest($1::INTEGER[], $2::FLOAT[], $3::TIMESTAMP[], $4::TIMESTAMP[]);
@@ -0,0 +1,10 @@
+-- insertUUIDBook
+-- $1 ID
+-- $2 ISBN
+-- $3 Title
+-- $4 Author
+-- $5 Genre
+-- $6 Quantity
+-- $7 Publishing date
+INSERT INTO uuid_books (id, isbn, title, author, genre, quantity, publicized_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
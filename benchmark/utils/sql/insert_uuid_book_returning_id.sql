@@ -0,0 +1,10 @@
+-- insertUUIDBookReturningID
+-- $1 ISBN
+-- $2 Title
+-- $3 Author
+-- $4 Genre
+-- $5 Quantity
+-- $6 Publishing date
+INSERT INTO uuid_books (isbn, title, author, genre, quantity, publicized_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;
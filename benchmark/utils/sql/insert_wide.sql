@@ -0,0 +1,5 @@
+-- insertWide
+-- $1 to $64 The columns, in order
+INSERT INTO wides (column001, column002, column003, column004, column005, column006, column007, column008, column009, column010, column011, column012, column013, column014, column015, column016, column017, column018, column019, column020, column021, column022, column023, column024, column025, column026, column027, column028, column029, column030, column031, column032, column033, column034, column035, column036, column037, column038, column039, column040, column041, column042, column043, column044, column045, column046, column047, column048, column049, column050, column051, column052, column053, column054, column055, column056, column057, column058, column059, column060, column061, column062, column063, column064)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37, $38, $39, $40, $41, $42, $43, $44, $45, $46, $47, $48, $49, $50, $51, $52, $53, $54, $55, $56, $57, $58, $59, $60, $61, $62, $63, $64)
+RETURNING id;
@@ -0,0 +1,3 @@
+-- restoreBook
+-- $1 ID
+UPDATE books SET deleted_at = NULL WHERE id = $1;
@@ -0,0 +1,11 @@
+-- seedBooks
+-- $1 ISBNs
+-- $2 Titles
+-- $3 Authors
+-- $4 Genres
+-- $5 Quantities
+-- $6 Publishing dates
+-- $7 Deletion dates
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at, deleted_at)
+SELECT * FROM unnest($1::VARCHAR[], $2::VARCHAR[], $3::VARCHAR[], $4::VARCHAR[], $5::INTEGER[], $6::TIMESTAMP[], $7::TIMESTAMP[])
+RETURNING id;
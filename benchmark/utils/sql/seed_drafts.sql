@@ -0,0 +1,12 @@
+-- seedDrafts
+-- $1 Titles
+-- $2 Subtitles
+-- $3 Authors
+-- $4 Translators
+-- $5 Pages
+-- $6 Ratings
+-- $7 Illustrated
+-- $8 Submission dates
+INSERT INTO drafts (title, subtitle, author, translator, pages, rating, illustrated, submitted_at)
+SELECT * FROM unnest($1::VARCHAR[], $2::VARCHAR[], $3::VARCHAR[], $4::VARCHAR[], $5::INTEGER[], $6::FLOAT[], $7::BOOLEAN[], $8::TIMESTAMP[])
+RETURNING id;
@@ -0,0 +1,10 @@
+-- seedUUIDBooks
+-- $1 IDs
+-- $2 ISBNs
+-- $3 Titles
+-- $4 Authors
+-- $5 Genres
+-- $6 Quantities
+-- $7 Publishing dates
+INSERT INTO uuid_books (id, isbn, title, author, genre, quantity, publicized_at)
+SELECT * FROM unnest($1::UUID[], $2::VARCHAR[], $3::VARCHAR[], $4::VARCHAR[], $5::VARCHAR[], $6::INTEGER[], $7::TIMESTAMP[]);
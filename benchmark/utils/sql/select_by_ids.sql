@@ -0,0 +1,3 @@
+-- selectByIDs
+-- $1 IDs
+SELECT * FROM books WHERE id = ANY($1);
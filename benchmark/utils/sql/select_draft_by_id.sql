@@ -0,0 +1,3 @@
+-- selectDraftByID
+-- $1 ID
+SELECT * FROM drafts WHERE id = $1;
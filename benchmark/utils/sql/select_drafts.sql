@@ -0,0 +1,3 @@
+-- selectDrafts
+-- $1 Limit
+SELECT * FROM drafts ORDER BY id LIMIT $1;
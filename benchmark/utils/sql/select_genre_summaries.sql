@@ -0,0 +1,9 @@
+-- selectGenreSummaries
+SELECT genre,
+       count(*) AS books,
+       sum(quantity) AS quantity,
+       min(publicized_at) AS first_publicized_at,
+       max(publicized_at) AS last_publicized_at
+FROM books
+GROUP BY genre
+ORDER BY genre;
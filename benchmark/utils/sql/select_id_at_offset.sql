@@ -0,0 +1,3 @@
+-- selectIDAtOffset
+-- $1 Offset
+SELECT id FROM books ORDER BY id OFFSET $1 LIMIT 1;
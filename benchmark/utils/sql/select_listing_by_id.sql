@@ -0,0 +1,3 @@
+-- selectListingByID
+-- $1 ID
+SELECT * FROM listings WHERE id = $1;
@@ -0,0 +1,3 @@
+-- selectNotDeletedByID
+-- $1 ID
+SELECT * FROM books WHERE id = $1 AND deleted_at IS NULL;
@@ -0,0 +1,4 @@
+-- selectPaginatingKeyset
+-- $1 Cursor
+-- $2 Limit
+SELECT * FROM books WHERE id > $1 ORDER BY id LIMIT $2;
@@ -0,0 +1,4 @@
+-- selectPaginatingOffset
+-- $1 Limit
+-- $2 Offset
+SELECT * FROM books ORDER BY id LIMIT $1 OFFSET $2;
@@ -0,0 +1,8 @@
+-- selectPaginatingWithPricePolicies
+-- $1 Cursor
+-- $2 Limit
+SELECT b.id, b.isbn, b.title, b.author, b.genre, b.quantity, b.publicized_at,
+       p.id, p.book_id, p.price, p.start_date, p.end_date
+FROM (SELECT * FROM books WHERE id > $1 ORDER BY id LIMIT $2) b
+JOIN price_policies p ON p.book_id = b.id
+ORDER BY b.id;
@@ -0,0 +1,3 @@
+-- selectPricePoliciesByBook
+-- $1 Book ID
+SELECT * FROM price_policies WHERE book_id = $1;
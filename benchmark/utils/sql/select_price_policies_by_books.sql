@@ -0,0 +1,3 @@
+-- selectPricePoliciesByBooks
+-- $1 Book IDs
+SELECT * FROM price_policies WHERE book_id = ANY($1);
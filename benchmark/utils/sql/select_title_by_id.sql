@@ -0,0 +1,3 @@
+-- selectTitleByID
+-- $1 ID
+SELECT id, title FROM books WHERE id = $1;
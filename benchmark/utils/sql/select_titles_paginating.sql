@@ -0,0 +1,4 @@
+-- selectTitlesPaginating
+-- $1 ID
+-- $2 Limit
+SELECT id, title FROM books WHERE id > $1 LIMIT $2;
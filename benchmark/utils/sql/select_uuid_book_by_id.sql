@@ -0,0 +1,3 @@
+-- selectUUIDBookByID
+-- $1 ID
+SELECT * FROM uuid_books WHERE id = $1;
@@ -0,0 +1,3 @@
+-- selectWideByID
+-- $1 ID
+SELECT * FROM wides WHERE id = $1;
@@ -0,0 +1,3 @@
+-- selectWides
+-- $1 Limit
+SELECT * FROM wides ORDER BY id LIMIT $1;
@@ -0,0 +1,7 @@
+-- selectWithPricePolicies
+-- $1 Book ID
+SELECT b.id, b.isbn, b.title, b.author, b.genre, b.quantity, b.publicized_at,
+       p.id, p.book_id, p.price, p.start_date, p.end_date
+FROM books b
+JOIN price_policies p ON p.book_id = b.id
+WHERE b.id = $1;
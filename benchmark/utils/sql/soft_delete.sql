@@ -0,0 +1,4 @@
+-- softDeleteBook
+-- $1 Deletion date
+-- $2 ID
+UPDATE books SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL;
@@ -0,0 +1,14 @@
+-- updateListing
+-- $1 Reference
+-- $2 Attributes
+-- $3 Tags
+-- $4 Price
+-- $5 Cover
+-- $6 Subtitle
+-- $7 Discontinuation date
+-- $8 Listing date
+-- $9 ID
+UPDATE listings
+SET reference = $1, attributes = $2, tags = $3, price = $4, cover = $5, subtitle = $6, discontinued_at = $7,
+    listed_at = $8
+WHERE id = $9;
@@ -0,0 +1,6 @@
+-- updateBooksQuantities
+-- %s (ID, Quantity) values
+UPDATE books
+SET quantity = v.quantity
+FROM (VALUES %s) AS v(id, quantity)
+WHERE books.id = v.id;
@@ -0,0 +1,7 @@
+-- updateBooksQuantitiesUnnest
+-- $1 IDs
+-- $2 Quantities
+UPDATE books
+SET quantity = v.quantity
+FROM unnest($1::INTEGER[], $2::INTEGER[]) AS v(id, quantity)
+WHERE books.id = v.id;
@@ -0,0 +1,6 @@
+-- updateBookQuantity
+-- $1 Quantity
+-- $2 ID
+UPDATE books
+SET quantity = $1
+WHERE id = $2;
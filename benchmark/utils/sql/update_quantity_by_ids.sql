@@ -0,0 +1,6 @@
+-- updateBooksQuantity
+-- $1 Quantity
+-- $2 IDs
+UPDATE books
+SET quantity = $1
+WHERE id = ANY($2);
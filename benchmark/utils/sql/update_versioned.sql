@@ -0,0 +1,7 @@
+-- updateVersioned
+-- $1 Quantity
+-- $2 ID
+-- $3 Version
+UPDATE books
+SET quantity = $1, version = version + 1
+WHERE id = $2 AND version = $3;
@@ -0,0 +1,15 @@
+-- upsertBook
+-- $1 ISBN
+-- $2 Title
+-- $3 Author
+-- $4 Genre
+-- $5 Quantity
+-- $6 Publishing date
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (isbn) DO UPDATE
+SET title = EXCLUDED.title,
+    author = EXCLUDED.author,
+    genre = EXCLUDED.genre,
+    quantity = EXCLUDED.quantity,
+    publicized_at = EXCLUDED.publicized_at;
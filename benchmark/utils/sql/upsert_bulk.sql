@@ -0,0 +1,16 @@
+-- upsertBooks
+-- upsertBook
+-- $1 ISBN
+-- $2 Title
+-- $3 Author
+-- $4 Genre
+-- $5 Quantity
+-- $6 Publishing date
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+VALUES %s
+ON CONFLICT (isbn) DO UPDATE
+SET title = EXCLUDED.title,
+    author = EXCLUDED.author,
+    genre = EXCLUDED.genre,
+    quantity = EXCLUDED.quantity,
+    publicized_at = EXCLUDED.publicized_at;
@@ -0,0 +1,15 @@
+-- upsertBooksUnnest
+-- $1 ISBNs
+-- $2 Titles
+-- $3 Authors
+-- $4 Genres
+-- $5 Quantities
+-- $6 Publishing dates
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+SELECT * FROM unnest($1::VARCHAR[], $2::VARCHAR[], $3::VARCHAR[], $4::VARCHAR[], $5::INTEGER[], $6::TIMESTAMP[])
+ON CONFLICT (isbn) DO UPDATE
+SET title = EXCLUDED.title,
+    author = EXCLUDED.author,
+    genre = EXCLUDED.genre,
+    quantity = EXCLUDED.quantity,
+    publicized_at = EXCLUDED.publicized_at;
@@ -0,0 +1,127 @@
+package benchmark
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+
+	"github.com/google/uuid"
+)
+
+// UUIDKeyBenchmark is implemented by the adapters able to map the uuid_books table, keyed by a UUID instead of a
+// serial id. The operations mirror insert, select-one and delete, the key being generated by the application. The
+// table sits beside books rather than replacing it: there is no run mode switching the whole workload to UUID keys.
+type UUIDKeyBenchmark interface {
+	// InsertUUIDClient inserts one book with a key generated by the application.
+	InsertUUIDClient(b *testing.B)
+	// FindUUIDByID selects one book by its key.
+	FindUUIDByID(b *testing.B)
+	// DeleteUUID deletes one book by its key.
+	DeleteUUID(b *testing.B)
+}
+
+// UUIDGeneratedKeyBenchmark is implemented by the adapters able to read back a UUID key generated by the database,
+// which rules out the libraries that only read back integer keys.
+type UUIDGeneratedKeyBenchmark interface {
+	// InsertUUIDServer inserts one book with a key generated by the database, and reads it back.
+	InsertUUIDServer(b *testing.B)
+}
+
+var errGeneratedKeyMissing = errors.New("the key generated by the database was not read back")
+
+// benchmarkInsertUUIDClient measures generating a key and fn inserting a book with it.
+func benchmarkInsertUUIDClient(b *testing.B, fn func(book *model.UUIDBook) error) {
+	book := model.NewUUIDBook()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		book.ISBN = model.NewISBN()
+		b.StartTimer()
+
+		book.ID = uuid.New()
+		err := fn(book)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// benchmarkInsertUUIDServer measures fn inserting a book without a key, and checks it reads back the key the
+// database generated.
+func benchmarkInsertUUIDServer(b *testing.B, fn func(book *model.UUIDBook) error) {
+	book := model.NewUUIDBook()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		book.ID = uuid.Nil
+		book.ISBN = model.NewISBN()
+		b.StartTimer()
+
+		err := fn(book)
+
+		b.StopTimer()
+		if err == nil && book.ID == uuid.Nil {
+			err = errGeneratedKeyMissing
+		}
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+// benchmarkFindUUIDByID measures fn selecting a seeded book by its key, utils.FindOneLoop times per iteration as
+// select-one does.
+func benchmarkFindUUIDByID(b *testing.B, fn func(id uuid.UUID) error) {
+	book := model.NewUUIDBook()
+	if err := utils.SeedUUIDBooks(book); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for range utils.FindOneLoop {
+			err := fn(book.ID)
+
+			b.StopTimer()
+			if err != nil {
+				b.Error(err)
+			}
+			b.StartTimer()
+		}
+	}
+}
+
+// benchmarkDeleteUUID measures fn deleting a seeded book by its key, a different one at each iteration.
+func benchmarkDeleteUUID(b *testing.B, fn func(id uuid.UUID) error) {
+	books := model.NewUUIDBooks(b.N)
+	if err := utils.SeedUUIDBooks(books...); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err := fn(books[i].ID)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
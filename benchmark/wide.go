@@ -0,0 +1,97 @@
+package benchmark
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/utils"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+)
+
+// WideBenchmark is implemented by the adapters able to map the wides table, whose number of columns is set by
+// widegen, so the cost of the reflection-based libraries can be charted against the width of the rows.
+type WideBenchmark interface {
+	// InsertWide inserts one row and reads back its id.
+	InsertWide(b *testing.B)
+	// FindWideByID selects one row by its id.
+	FindWideByID(b *testing.B)
+	// FindWides selects utils.WidesNumber rows in one query.
+	FindWides(b *testing.B)
+}
+
+// wideColumnsMetric is the unit of the metric reporting the number of columns of the wides table, so that the
+// results of runs at different widths can be told apart.
+const wideColumnsMetric = "columns"
+
+// benchmarkInsertWide measures fn inserting a row of the wides table.
+func benchmarkInsertWide(b *testing.B, fn func(wide *model.Wide) error) {
+	wide := model.NewWide()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		wide.ID = 0
+		b.StartTimer()
+
+		err := fn(wide)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+
+	b.ReportMetric(model.WideColumnsNumber, wideColumnsMetric)
+}
+
+// benchmarkFindWideByID measures fn selecting a seeded row of the wides table.
+func benchmarkFindWideByID(b *testing.B, fn func(id int64) error) {
+	wide := model.NewWide()
+	if err := utils.SeedWides(wide); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err := fn(wide.ID)
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+
+	b.ReportMetric(model.WideColumnsNumber, wideColumnsMetric)
+}
+
+// benchmarkFindWides measures fn selecting utils.WidesNumber seeded rows of the wides table, and checks it reads
+// all of them.
+func benchmarkFindWides(b *testing.B, fn func(limit int) (int, error)) {
+	if err := utils.SeedWides(model.NewWides(utils.WidesNumber)...); err != nil {
+		b.Error(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		found, err := fn(utils.WidesNumber)
+
+		b.StopTimer()
+		if err == nil && found != utils.WidesNumber {
+			err = fmt.Errorf("found %d wide rows, want %d", found, utils.WidesNumber)
+		}
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+
+	b.ReportMetric(model.WideColumnsNumber, wideColumnsMetric)
+}
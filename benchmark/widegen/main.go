@@ -0,0 +1,300 @@
+// Command widegen writes the files of the wides table, whose number of columns is configurable: the model, the
+// table definition, the queries of the raw adapters, the ent schema, the sqlc schema and queries, and the
+// conversion of the model to the sqlc parameters. Run it from the root of the repository, then regenerate the ent
+// and sqlc code:
+//
+//	go run ./benchmark/widegen -columns 128
+//	go generate ./benchmark/ent
+//	cd benchmark/sqlc && sqlc generate
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// minColumns is the fewest columns accepted, so every column type is present.
+const minColumns = 4
+
+// kind is a column type, with its spelling in each place the generated files mention it.
+type kind struct {
+	SQL    string
+	Go     string
+	Ent    string
+	PgTag  string
+	Value  func(n int) string
+	ToSqlc func(field string) string
+}
+
+// kinds are the column types, assigned to the columns in turn.
+var kinds = []kind{
+	{
+		SQL:    "VARCHAR(255)",
+		Go:     "string",
+		Ent:    "field.String",
+		Value:  func(n int) string { return fmt.Sprintf("%q", fmt.Sprintf("Value of column %d", n)) },
+		ToSqlc: func(field string) string { return field },
+	},
+	{
+		SQL:    "INTEGER",
+		Go:     "int",
+		Ent:    "field.Int",
+		PgTag:  `pg:",use_zero" `,
+		Value:  func(n int) string { return fmt.Sprintf("%d", n) },
+		ToSqlc: func(field string) string { return "int32(" + field + ")" },
+	},
+	{
+		SQL:    "FLOAT",
+		Go:     "float64",
+		Ent:    "field.Float",
+		PgTag:  `pg:",use_zero" `,
+		Value:  func(n int) string { return fmt.Sprintf("%d.5", n) },
+		ToSqlc: func(field string) string { return field },
+	},
+	{
+		SQL:    "TIMESTAMP",
+		Go:     "time.Time",
+		Ent:    "field.Time",
+		Value:  func(n int) string { return fmt.Sprintf("time.Date(2022, time.January, %d, 0, 0, 0, 0, time.UTC)", n) },
+		ToSqlc: func(field string) string { return "pgtype.Timestamp{Time: " + field + ", Valid: true}" },
+	},
+}
+
+type column struct {
+	kind
+	Number int
+	Name   string
+	Field  string
+}
+
+func main() {
+	count := flag.Int("columns", 64, "Number of columns of the wides table, besides the id")
+	flag.Parse()
+	if *count < minColumns {
+		log.Fatalf("the wides table needs at least %d columns", minColumns)
+	}
+
+	columns := make([]column, *count)
+	for i := range columns {
+		n := i + 1
+		columns[i] = column{
+			kind:   kinds[i%len(kinds)],
+			Number: n,
+			Name:   fmt.Sprintf("column%03d", n),
+			Field:  fmt.Sprintf("Column%03d", n),
+		}
+	}
+
+	for _, file := range files {
+		if err := file.write(columns); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// file is a file written from a template, formatted by gofmt when it is Go code.
+type file struct {
+	path     string
+	template *template.Template
+}
+
+func (f file) write(columns []column) error {
+	var out bytes.Buffer
+	if err := f.template.Execute(&out, columns); err != nil {
+		return fmt.Errorf("%s: %w", f.path, err)
+	}
+	content := out.Bytes()
+	if strings.HasSuffix(f.path, ".go") {
+		formatted, err := format.Source(content)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.path, err)
+		}
+		content = formatted
+	}
+	return os.WriteFile(filepath.FromSlash(f.path), content, 0o644)
+}
+
+var functions = template.FuncMap{
+	"last": func(i int, columns []column) bool { return i == len(columns)-1 },
+	"add":  func(a, b int) int { return a + b },
+}
+
+func parse(text string) *template.Template {
+	return template.Must(template.New("").Funcs(functions).Parse(text))
+}
+
+var files = []file{
+	{path: "model/Wide.go", template: parse(modelTemplate)},
+	{path: "sql/wide.sql", template: parse(tableTemplate)},
+	{path: "benchmark/utils/sql/insert_wide.sql", template: parse(insertTemplate)},
+	{path: "benchmark/utils/sql/select_wide_by_id.sql", template: parse(selectByIDTemplate)},
+	{path: "benchmark/utils/sql/select_wides.sql", template: parse(selectTemplate)},
+	{path: "benchmark/ent/schema/wide.go", template: parse(entTemplate)},
+	{path: "benchmark/sqlc/wide_schema.sql", template: parse(sqlcSchemaTemplate)},
+	{path: "benchmark/sqlc/wide_query.sql", template: parse(sqlcQueryTemplate)},
+	{path: "benchmark/sqlc_wide.go", template: parse(sqlcParamsTemplate)},
+}
+
+const modelTemplate = `// Code generated by widegen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+// WideColumnsNumber is the number of columns of the wides table, besides the id.
+const WideColumnsNumber = {{len .}}
+
+// WideColumns are the columns of the wides table, besides the id, in order.
+var WideColumns = []string{
+{{- range .}}
+	"{{.Name}}",
+{{- end}}
+}
+
+// Wide represents a row of the wides table, whose width is set when generating it.
+type Wide struct {
+	ID int64 ` + "`" + `bun:"id,pk,autoincrement" gorm:"primary_key" pg:"id,pk" db:"id"` + "`" + `
+{{- range .}}
+	{{.Field}} {{.Go}} ` + "`" + `{{.PgTag}}db:"{{.Name}}"` + "`" + `
+{{- end}}
+}
+
+func NewWides(quantity int) []*Wide {
+	wides := make([]*Wide, quantity)
+	for i := 0; i < quantity; i++ {
+		wides[i] = NewWide()
+	}
+	return wides
+}
+
+func NewWide() *Wide {
+	return &Wide{
+{{- range .}}
+		{{.Field}}: {{call .Value .Number}},
+{{- end}}
+	}
+}
+
+// Values returns the values of the columns, besides the id, in order.
+func (w *Wide) Values() []any {
+	return []any{
+{{- range .}}
+		w.{{.Field}},
+{{- end}}
+	}
+}
+
+// Fields returns the addresses of the fields of all columns, in order, to scan a row into.
+func (w *Wide) Fields() []any {
+	return []any{
+		&w.ID,
+{{- range .}}
+		&w.{{.Field}},
+{{- end}}
+	}
+}
+`
+
+// createTable is the definition of the wides table, shared by the database and sqlc.
+const createTable = `CREATE TABLE IF NOT EXISTS wides (
+    id SERIAL PRIMARY KEY,
+{{- range $i, $c := .}}
+    {{$c.Name}} {{$c.SQL}} NOT NULL{{if not (last $i $)}},{{end}}
+{{- end}}
+);
+`
+
+const tableTemplate = `-- Code generated by widegen. DO NOT EDIT.
+
+DROP TABLE IF EXISTS wides;
+
+` + createTable
+
+const insertTemplate = `-- insertWide
+-- $1 to ${{len .}} The columns, in order
+INSERT INTO wides ({{range $i, $c := .}}{{if $i}}, {{end}}{{$c.Name}}{{end}})
+VALUES ({{range $i, $c := .}}{{if $i}}, {{end}}${{add $i 1}}{{end}})
+RETURNING id;
+`
+
+const selectByIDTemplate = `-- selectWideByID
+-- $1 ID
+SELECT * FROM wides WHERE id = $1;
+`
+
+const selectTemplate = `-- selectWides
+-- $1 Limit
+SELECT * FROM wides ORDER BY id LIMIT $1;
+`
+
+const entTemplate = `// Code generated by widegen. DO NOT EDIT.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Wide holds the schema definition for the Wide entity.
+type Wide struct {
+	ent.Schema
+}
+
+// Fields of the Wide.
+func (Wide) Fields() []ent.Field {
+	return []ent.Field{
+{{- range .}}
+		{{.Ent}}("{{.Name}}"),
+{{- end}}
+	}
+}
+`
+
+const sqlcSchemaTemplate = `-- Code generated by widegen. DO NOT EDIT.
+
+` + createTable
+
+const sqlcQueryTemplate = `-- Code generated by widegen. DO NOT EDIT.
+
+-- name: CreateWide :one
+INSERT INTO wides ({{range $i, $c := .}}{{if $i}}, {{end}}{{$c.Name}}{{end}})
+VALUES ({{range $i, $c := .}}{{if $i}}, {{end}}${{add $i 1}}{{end}})
+RETURNING id;
+
+-- name: GetWide :one
+SELECT * FROM wides WHERE id = $1;
+
+-- name: ListWides :many
+SELECT * FROM wides ORDER BY id LIMIT $1;
+`
+
+const sqlcParamsTemplate = `// Code generated by widegen. DO NOT EDIT.
+
+package benchmark
+
+import (
+	"github.com/lauro-santana/golang-orm-benchmarks/benchmark/sqlc/repository"
+	"github.com/lauro-santana/golang-orm-benchmarks/model"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// newCreateWideParams converts a wide row to the parameters sqlc generates for its insert.
+func newCreateWideParams(wide *model.Wide) repository.CreateWideParams {
+	return repository.CreateWideParams{
+{{- range .}}
+		{{.Field}}: {{call .ToSqlc (print "wide." .Field)}},
+{{- end}}
+	}
+}
+`
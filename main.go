@@ -6,7 +6,8 @@ import (
 	"log"
 	"math/rand"
 	"os"
-	"slices"
+	"sort"
+	"strings"
 	"testing"
 	"text/tabwriter"
 	"time"
@@ -17,138 +18,195 @@ import (
 	_ "github.com/joho/godotenv/autoload"
 )
 
-const (
-	all = "all"
-
-	insertOp     = "insert"
-	insertBulkOp = "insert-bulk"
-	updateOp     = "update"
-	deleteOp     = "delete"
-	selectOne    = "select-one"
-	selectPage   = "select-page"
-
-	raw  = "database/sql"
-	pgx  = "pgx"
-	bun  = "bun"
-	gorm = "gorm"
-	ent  = "ent"
-	sqlc = "sqlc"
-	goe  = "goe"
-)
-
-var (
-	benchmarksMap   = map[string]benchmark.Benchmark{}
-	validOperations = []string{insertOp, insertBulkOp, updateOp, deleteOp, selectOne, selectPage}
-)
+const all = "all"
 
 func main() {
-	operation := flag.String("operation", selectOne, "Specify the operation to run")
+	flag.Usage = usage
+	operation := flag.String("operation", benchmark.SelectOneOp, "Specify the operation to run, or \"all\"")
+	orms := flag.String("orm", all, "Comma separated list of adapters to run, or \"all\"")
+	category := flag.String("category", all, "Run only the adapters of a category, or \"all\"")
 	flag.Parse()
 
-	if operation == nil && *operation != all && slices.Contains(validOperations, *operation) {
-		log.Fatal("define a valid orm or operation")
+	operations, err := selectOperations(*operation)
+	if err != nil {
+		log.Fatal(err)
+	}
+	adapters, err := selectAdapters(*orms, benchmark.Category(*category))
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	loadBenchmarks()
-	shuffleBenchmarksMap()
-	results := executeBenchmarks(*operation)
-	printBenchmark(results, *operation)
+	shuffleAdapters(adapters)
+	results := executeBenchmarks(adapters, operations)
+	printBenchmark(results, operations)
+}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	_, _ = fmt.Fprintf(out, "Usage of %s:\n", os.Args[0])
+	flag.PrintDefaults()
+
+	_, _ = fmt.Fprint(out, "\nOperations:\n")
+	table := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	for _, op := range benchmark.Operations {
+		_, _ = fmt.Fprintf(table, "  %s\t%s\n", op.Name, op.Description)
+	}
+	_ = table.Flush()
+
+	_, _ = fmt.Fprint(out, "\nAdapters:\n")
+	for _, adapter := range benchmark.Adapters() {
+		b := adapter.New()
+		var emulated, unsupported []string
+		for _, op := range benchmark.Operations {
+			switch op.Support(b) {
+			case benchmark.Emulated:
+				emulated = append(emulated, op.Name)
+			case benchmark.Unsupported:
+				unsupported = append(unsupported, op.Name)
+			}
+		}
+		_, _ = fmt.Fprintf(table, "  %s\t%s", adapter.Name, adapter.Category)
+		if len(emulated) > 0 {
+			_, _ = fmt.Fprintf(table, "\temulated: %s", strings.Join(emulated, ", "))
+		}
+		if len(unsupported) > 0 {
+			_, _ = fmt.Fprintf(table, "\tn/a: %s", strings.Join(unsupported, ", "))
+		}
+		_, _ = fmt.Fprint(table, "\n")
+	}
+	_ = table.Flush()
 }
 
-func loadBenchmarks() {
-	benchmarksMap[raw] = benchmark.NewRawBenchmark()
-	benchmarksMap[pgx] = benchmark.NewPgxBenchmark()
-	benchmarksMap[bun] = benchmark.NewBunBenchmark()
-	benchmarksMap[gorm] = benchmark.NewGormBenchmark()
-	benchmarksMap[ent] = benchmark.NewEntBenchmark()
-	benchmarksMap[sqlc] = benchmark.NewSqlcBenchmark()
-	benchmarksMap[goe] = benchmark.NewGoeBenchmark()
+func selectOperations(name string) ([]benchmark.Operation, error) {
+	if name == all {
+		return benchmark.Operations, nil
+	}
+	op, ok := benchmark.LookupOperation(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown operation %q, run with -h to list the valid ones", name)
+	}
+	return []benchmark.Operation{op}, nil
 }
 
-func shuffleBenchmarksMap() {
+func selectAdapters(names string, category benchmark.Category) ([]benchmark.Adapter, error) {
+	var adapters []benchmark.Adapter
+	if names == all {
+		adapters = benchmark.Adapters()
+	} else {
+		for _, name := range strings.Split(names, ",") {
+			adapter, ok := benchmark.LookupAdapter(strings.TrimSpace(name))
+			if !ok {
+				return nil, fmt.Errorf("unknown adapter %q, run with -h to list the valid ones", name)
+			}
+			adapters = append(adapters, adapter)
+		}
+	}
+
+	if category == all {
+		return adapters, nil
+	}
+	filtered := adapters[:0]
+	for _, adapter := range adapters {
+		if adapter.Category == category {
+			filtered = append(filtered, adapter)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil, fmt.Errorf("no adapter selected for category %q", category)
+	}
+	return filtered, nil
+}
+
+// shuffleAdapters randomizes the execution order, so no adapter is always favored by a warm or cold database.
+func shuffleAdapters(adapters []benchmark.Adapter) {
 	source := rand.NewSource(time.Now().UnixNano())
 	rng := rand.New(source)
-	keys := make([]string, 0, len(benchmarksMap))
-	for key := range benchmarksMap {
-		keys = append(keys, key)
-	}
-	rng.Shuffle(len(keys), func(i, j int) {
-		keys[i], keys[j] = keys[j], keys[i]
+	rng.Shuffle(len(adapters), func(i, j int) {
+		adapters[i], adapters[j] = adapters[j], adapters[i]
 	})
-	shuffledMap := make(map[string]benchmark.Benchmark)
-	for _, key := range keys {
-		shuffledMap[key] = benchmarksMap[key]
-	}
-	benchmarksMap = shuffledMap
 }
 
-func executeBenchmarks(operation string) []benchmark.ResultWrapper {
+func executeBenchmarks(adapters []benchmark.Adapter, operations []benchmark.Operation) []benchmark.ResultWrapper {
 	var results []benchmark.ResultWrapper
-	for ormName, b := range benchmarksMap {
-		results = append(results, doExecuteBenchmarks(b, ormName, operation))
+	for _, adapter := range adapters {
+		results = append(results, doExecuteBenchmarks(adapter, operations))
 	}
 	return results
 }
 
-func doExecuteBenchmarks(b benchmark.Benchmark, orm, operation string) benchmark.ResultWrapper {
+func doExecuteBenchmarks(adapter benchmark.Adapter, operations []benchmark.Operation) benchmark.ResultWrapper {
 	benchmark.BeforeBenchmark()
 	wrapper := benchmark.ResultWrapper{}
-	wrapper.Orm = orm
+	wrapper.Orm = adapter.Name
+	b := adapter.New()
 	err := b.Init()
 	if err != nil {
 		wrapper.Err = err
-	}
-	resultMap := make(map[string]testing.BenchmarkResult)
-	operations := map[string]func(*testing.B){
-		insertOp:     b.Insert,
-		insertBulkOp: b.InsertBulk,
-		updateOp:     b.Update,
-		deleteOp:     b.Delete,
-		selectOne:    b.FindByID,
-		selectPage:   b.FindPage,
-	}
-	if operation == all {
-		for op, f := range operations {
-			resultMap[op] = testing.Benchmark(f)
-		}
-		wrapper.Benchmarks = resultMap
 		return wrapper
 	}
-	wrapper.Benchmarks = map[string]testing.BenchmarkResult{
-		operation: testing.Benchmark(operations[operation]),
+	defer func() {
+		_ = b.Close()
+	}()
+
+	wrapper.Benchmarks = make(map[string]testing.BenchmarkResult)
+	wrapper.Support = make(benchmark.Capabilities)
+	for _, op := range operations {
+		support := op.Support(b)
+		wrapper.Support[op.Name] = support
+		if support == benchmark.Unsupported {
+			continue
+		}
+		wrapper.Benchmarks[op.Name] = testing.Benchmark(op.Run(b))
 	}
 	return wrapper
 }
 
-func printBenchmark(results []benchmark.ResultWrapper, operation string) {
+func printBenchmark(results []benchmark.ResultWrapper, operations []benchmark.Operation) {
 	table := new(tabwriter.Writer)
 	table.Init(os.Stdout, 0, 8, 2, '\t', tabwriter.AlignRight)
-	if operation == all {
-		doPrintBenchmark(table, results, validOperations...)
-	} else {
-		doPrintBenchmark(table, results, operation)
-	}
-}
-
-func doPrintBenchmark(table *tabwriter.Writer, results []benchmark.ResultWrapper, operations ...string) {
 	for _, op := range operations {
 		_, _ = fmt.Fprint(table, "\n")
-		_, _ = fmt.Fprintf(table, "Operation: %s\n", op)
+		_, _ = fmt.Fprintf(table, "Operation: %s\n", op.Name)
 
 		for _, r := range results {
-			result, ok := r.Benchmarks[op]
+			if r.Err != nil {
+				_, _ = fmt.Fprintf(table, "%s:\t%s\n", r.Orm, r.Err)
+				continue
+			}
+			if r.Support.Of(op.Name) == benchmark.Unsupported {
+				_, _ = fmt.Fprintf(table, "%s:\tn/a\n", r.Orm)
+				continue
+			}
+			result, ok := r.Benchmarks[op.Name]
 			if !ok {
 				continue
 			}
-			_, _ = fmt.Fprintf(table, "%s:\t%d\t%d ns/op\t%d B/op\t%d allocs/op\n",
+			_, _ = fmt.Fprintf(table, "%s:\t%d\t%d ns/op\t%d B/op\t%d allocs/op",
 				r.Orm,
 				result.N,
 				result.NsPerOp(),
 				result.AllocedBytesPerOp(),
 				result.AllocsPerOp(),
 			)
+			for _, unit := range extraUnits(result) {
+				_, _ = fmt.Fprintf(table, "\t%.2f %s", result.Extra[unit], unit)
+			}
+			if r.Support.Of(op.Name) == benchmark.Emulated {
+				_, _ = fmt.Fprint(table, "\temulated")
+			}
+			_, _ = fmt.Fprint(table, "\n")
 		}
 
 		_ = table.Flush()
 	}
 }
+
+// extraUnits returns the units of the custom metrics of a result, such as the statements per operation, sorted by name.
+func extraUnits(result testing.BenchmarkResult) []string {
+	units := make([]string, 0, len(result.Extra))
+	for unit := range result.Extra {
+		units = append(units, unit)
+	}
+	sort.Strings(units)
+	return units
+}
@@ -1,16 +1,24 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
 
 // Book represents a book from a bookstore system.
 type Book struct {
-	ID           int64 `bun:"id,pk,autoincrement" gorm:"primary_key"`
-	ISBN         string
-	Title        string
-	Author       string
-	Genre        string
-	Quantity     int
-	PublicizedAt time.Time
+	ID           int64      `bun:"id,pk,autoincrement" gorm:"primary_key" pg:"id,pk" db:"id"`
+	ISBN         string     `db:"isbn"`
+	Title        string     `db:"title"`
+	Author       string     `db:"author"`
+	Genre        string     `db:"genre"`
+	Quantity     int        `pg:",use_zero" db:"quantity"`
+	PublicizedAt time.Time  `db:"publicized_at"`
+	Version      int        `pg:",use_zero" db:"version"`
+	DeletedAt    *time.Time `db:"deleted_at"`
+
+	PricePolicies []PricePolicy `bun:"rel:has-many,join:id=book_id" gorm:"foreignKey:BookID" pg:"rel:has-many" db:"-"`
 }
 
 func NewBooks(quantity int) []*Book {
@@ -31,26 +39,35 @@ func NewBooksNoPtr(quantity int) []Book {
 
 func NewBookNoPtr() Book {
 	return Book{
-		ISBN:         "978-3-16-148410-1",
+		ISBN:         NewISBN(),
 		Title:        "Learning Go: An Idiomatic Approach to Real-World Go Programming",
 		Author:       "Jon Bodner",
 		Genre:        "Programming",
 		Quantity:     20,
 		PublicizedAt: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Version:      1,
 	}
 }
 
 func NewBook() *Book {
 	return &Book{
-		ISBN:         "978-3-16-148410-1",
+		ISBN:         NewISBN(),
 		Title:        "Learning Go: An Idiomatic Approach to Real-World Go Programming",
 		Author:       "Jon Bodner",
 		Genre:        "Programming",
 		Quantity:     20,
 		PublicizedAt: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Version:      1,
 	}
 }
 
+var isbnSequence atomic.Int64
+
+// NewISBN returns an ISBN never returned before by the process, as books are unique by ISBN.
+func NewISBN() string {
+	return fmt.Sprintf("978-%010d", isbnSequence.Add(1))
+}
+
 func Chunk(input []*Book, batchSize int) [][]*Book {
 	var result [][]*Book
 	for i := 0; i < len(input); i += batchSize {
@@ -62,3 +79,71 @@ func Chunk(input []*Book, batchSize int) [][]*Book {
 	}
 	return result
 }
+
+// catalogGenres are the genres of a bookstore catalog, each with its share of the books in percent.
+var catalogGenres = []struct {
+	name  string
+	share int
+}{
+	{"Fiction", 30},
+	{"Mystery", 18},
+	{"Romance", 15},
+	{"Fantasy", 10},
+	{"Science Fiction", 8},
+	{"Biography", 7},
+	{"History", 6},
+	{"Children", 4},
+	{"Poetry", 2},
+}
+
+// catalogAuthors are the authors of the books created by NewCatalogBooks.
+var catalogAuthors = []string{
+	"Jon Bodner",
+	"Alan Donovan",
+	"Brian Kernighan",
+	"Katherine Cox-Buday",
+	"Mat Ryer",
+	"Teiva Harsanyi",
+	"William Kennedy",
+	"Mihalis Tsoukalos",
+}
+
+// CatalogAuthors returns the authors of the books created by NewCatalogBooks.
+func CatalogAuthors() []string {
+	return append([]string(nil), catalogAuthors...)
+}
+
+// CatalogGenres returns the genres of the books created by NewCatalogBooks.
+func CatalogGenres() []string {
+	genres := make([]string, len(catalogGenres))
+	for i, genre := range catalogGenres {
+		genres[i] = genre.name
+	}
+	return genres
+}
+
+// NewCatalogBooks returns books spread across the catalog genres by their share, with authors, quantities and
+// publication dates varying from book to book, as reporting queries would find them in a real catalog.
+func NewCatalogBooks(quantity int) []*Book {
+	books := make([]*Book, quantity)
+	for i := 0; i < quantity; i++ {
+		book := NewBook()
+		book.Author = catalogAuthors[i%len(catalogAuthors)]
+		book.Genre = catalogGenre(i)
+		book.Quantity = i % 50
+		book.PublicizedAt = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, i%8000)
+		books[i] = book
+	}
+	return books
+}
+
+func catalogGenre(i int) string {
+	share := i % 100
+	for _, genre := range catalogGenres {
+		if share < genre.share {
+			return genre.name
+		}
+		share -= genre.share
+	}
+	return catalogGenres[0].name
+}
@@ -0,0 +1,8 @@
+package model
+
+// BookTitle is the slim projection of a book holding only its id and title. Like GenreSummary, ent reads the sql
+// tags while the other libraries read the db tags or the field names.
+type BookTitle struct {
+	ID    int64  `db:"id" sql:"id"`
+	Title string `db:"title" sql:"title"`
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+)
+
+// Draft represents a book still being written, most of whose details are unknown. Every column but the id is
+// nullable, so the libraries map them to pointers, sql.Null* or their own wrappers.
+type Draft struct {
+	ID          int64      `bun:"id,pk,autoincrement" gorm:"primary_key" pg:"id,pk" db:"id"`
+	Title       *string    `db:"title"`
+	Subtitle    *string    `db:"subtitle"`
+	Author      *string    `db:"author"`
+	Translator  *string    `db:"translator"`
+	Pages       *int       `db:"pages"`
+	Rating      *float64   `db:"rating"`
+	Illustrated *bool      `db:"illustrated"`
+	SubmittedAt *time.Time `db:"submitted_at"`
+}
+
+// NewDrafts returns drafts alternating the columns left NULL, so half of their values are NULL.
+func NewDrafts(quantity int) []*Draft {
+	drafts := make([]*Draft, quantity)
+	for i := 0; i < quantity; i++ {
+		drafts[i] = NewDraft(i%2 == 1)
+	}
+	return drafts
+}
+
+// NewDraft returns a draft whose columns are set every other one, in the order of the table, leaving the rest NULL:
+// from the title, or from the subtitle when shifted is true.
+func NewDraft(shifted bool) *Draft {
+	title := "Learning Go: An Idiomatic Approach to Real-World Go Programming"
+	subtitle := "Second Edition"
+	author := "Jon Bodner"
+	translator := "Mariko Tanaka"
+	pages := 494
+	rating := 4.6
+	illustrated := true
+	submittedAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	if shifted {
+		return &Draft{Subtitle: &subtitle, Translator: &translator, Rating: &rating, SubmittedAt: &submittedAt}
+	}
+	return &Draft{Title: &title, Author: &author, Pages: &pages, Illustrated: &illustrated}
+}
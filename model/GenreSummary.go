@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// GenreSummary is the report of the books of a genre. It maps the columns of an aggregate query rather than a table,
+// so ent reads the sql tags while the other libraries read the db tags or the field names.
+type GenreSummary struct {
+	Genre             string    `db:"genre" sql:"genre"`
+	Books             int64     `db:"books" sql:"books"`
+	Quantity          int64     `db:"quantity" sql:"quantity"`
+	FirstPublicizedAt time.Time `db:"first_publicized_at" sql:"first_publicized_at"`
+	LastPublicizedAt  time.Time `db:"last_publicized_at" sql:"last_publicized_at"`
+}
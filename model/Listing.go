@@ -0,0 +1,56 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Listing represents the offer of a book in the store. Unlike Book, its columns have types that each library
+// converts its own way: UUID, JSONB, TEXT[], NUMERIC, BYTEA, TIMESTAMPTZ and nullable columns.
+type Listing struct {
+	ID             int64             `bun:"id,pk,autoincrement" gorm:"primary_key" pg:"id,pk" db:"id"`
+	Reference      uuid.UUID         `db:"reference"`
+	Attributes     ListingAttributes `gorm:"serializer:json" db:"attributes"`
+	Tags           TextArray         `db:"tags"`
+	Price          float64           `db:"price"`
+	Cover          []byte            `db:"cover"`
+	Subtitle       *string           `db:"subtitle"`
+	DiscontinuedAt *time.Time        `db:"discontinued_at"`
+	ListedAt       time.Time         `db:"listed_at"`
+}
+
+// ListingAttributes are the details of a listing stored as a JSON document.
+type ListingAttributes struct {
+	Format       string   `json:"format"`
+	Language     string   `json:"language"`
+	Pages        int      `json:"pages"`
+	Edition      int      `json:"edition"`
+	Translations []string `json:"translations,omitempty"`
+}
+
+// coverSize is the size of the cover of the listings, a thumbnail.
+const coverSize = 2048
+
+func NewListing() *Listing {
+	subtitle := "An Idiomatic Approach to Real-World Go Programming"
+	cover := make([]byte, coverSize)
+	for i := range cover {
+		cover[i] = byte(i)
+	}
+	return &Listing{
+		Reference: uuid.New(),
+		Attributes: ListingAttributes{
+			Format:       "Paperback",
+			Language:     "English",
+			Pages:        374,
+			Edition:      1,
+			Translations: []string{"Japanese", "Korean"},
+		},
+		Tags:     TextArray{"go", "programming", "idioms"},
+		Price:    45.99,
+		Cover:    cover,
+		Subtitle: &subtitle,
+		ListedAt: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
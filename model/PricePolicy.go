@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// PricePolicy represents the price of a book during a period.
+type PricePolicy struct {
+	ID        int64     `bun:"id,pk,autoincrement" gorm:"primary_key" pg:"id,pk" db:"id"`
+	BookID    int64     `db:"book_id"`
+	Price     float64   `db:"price"`
+	StartDate time.Time `db:"start_date"`
+	EndDate   time.Time `db:"end_date"`
+}
+
+func NewPricePolicies(bookID int64, quantity int) []*PricePolicy {
+	policies := make([]*PricePolicy, quantity)
+	for i := 0; i < quantity; i++ {
+		policies[i] = NewPricePolicy(bookID)
+		policies[i].StartDate = policies[i].StartDate.AddDate(0, i, 0)
+		policies[i].EndDate = policies[i].StartDate.AddDate(0, 1, 0)
+	}
+	return policies
+}
+
+func NewPricePolicy(bookID int64) *PricePolicy {
+	return &PricePolicy{
+		BookID:    bookID,
+		Price:     39.9,
+		StartDate: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
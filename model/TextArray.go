@@ -0,0 +1,83 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// TextArray maps a TEXT[] column. The libraries built on database/sql receive arrays as their text representation,
+// which they cannot scan into a []string, so the type parses it itself. pgx and the libraries built on it read the
+// underlying []string directly.
+type TextArray []string
+
+// Value formats the array as a literal, quoting every element.
+func (a TextArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	var literal strings.Builder
+	literal.WriteByte('{')
+	for i, element := range a {
+		if i > 0 {
+			literal.WriteByte(',')
+		}
+		literal.WriteByte('"')
+		for j := 0; j < len(element); j++ {
+			if element[j] == '"' || element[j] == '\\' {
+				literal.WriteByte('\\')
+			}
+			literal.WriteByte(element[j])
+		}
+		literal.WriteByte('"')
+	}
+	literal.WriteByte('}')
+	return literal.String(), nil
+}
+
+// Scan parses the literal of a one-dimensional array. NULL elements are read as empty strings.
+func (a *TextArray) Scan(src any) error {
+	var literal string
+	switch src := src.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case string:
+		literal = src
+	case []byte:
+		literal = string(src)
+	default:
+		return fmt.Errorf("cannot scan %T into a TextArray", src)
+	}
+	if len(literal) < 2 || literal[0] != '{' || literal[len(literal)-1] != '}' {
+		return fmt.Errorf("invalid array literal %q", literal)
+	}
+
+	elements := make(TextArray, 0)
+	literal = literal[1 : len(literal)-1]
+	for i := 0; i < len(literal); i++ {
+		var element strings.Builder
+		if literal[i] == '"' {
+			for i++; i < len(literal) && literal[i] != '"'; i++ {
+				if literal[i] == '\\' {
+					i++
+				}
+				if i < len(literal) {
+					element.WriteByte(literal[i])
+				}
+			}
+			i++
+		} else {
+			start := i
+			for i < len(literal) && literal[i] != ',' {
+				i++
+			}
+			if unquoted := literal[start:i]; unquoted != "NULL" {
+				element.WriteString(unquoted)
+			}
+		}
+		elements = append(elements, element.String())
+	}
+	*a = elements
+	return nil
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UUIDBook represents a book keyed by a UUID instead of a serial id. The key is either generated by the
+// application, as NewUUIDBook does, or left zero for the database to generate it with gen_random_uuid().
+type UUIDBook struct {
+	ID           uuid.UUID `bun:"id,pk,type:uuid,nullzero,default:gen_random_uuid()" gorm:"primary_key;type:uuid;default:gen_random_uuid()" pg:"id,pk,type:uuid,default:gen_random_uuid()" db:"id"`
+	ISBN         string    `db:"isbn"`
+	Title        string    `db:"title"`
+	Author       string    `db:"author"`
+	Genre        string    `db:"genre"`
+	Quantity     int       `pg:",use_zero" db:"quantity"`
+	PublicizedAt time.Time `db:"publicized_at"`
+}
+
+func NewUUIDBooks(quantity int) []*UUIDBook {
+	books := make([]*UUIDBook, quantity)
+	for i := 0; i < quantity; i++ {
+		books[i] = NewUUIDBook()
+	}
+	return books
+}
+
+// NewUUIDBook returns a book whose key is generated by the application.
+func NewUUIDBook() *UUIDBook {
+	return &UUIDBook{
+		ID:           uuid.New(),
+		ISBN:         NewISBN(),
+		Title:        "Learning Go: An Idiomatic Approach to Real-World Go Programming",
+		Author:       "Jon Bodner",
+		Genre:        "Programming",
+		Quantity:     20,
+		PublicizedAt: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
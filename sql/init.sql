@@ -1,20 +1,65 @@
+CREATE EXTENSION IF NOT EXISTS pg_stat_statements;
+
 DROP TABLE IF EXISTS price_policies;
 DROP TABLE IF EXISTS books;
+DROP TABLE IF EXISTS listings;
+DROP TABLE IF EXISTS drafts;
+DROP TABLE IF EXISTS uuid_books;
 
 CREATE TABLE IF NOT EXISTS books (
     id SERIAL PRIMARY KEY,
-    isbn VARCHAR(255) NOT NULL,
+    isbn VARCHAR(255) NOT NULL UNIQUE,
     title VARCHAR(255) NOT NULL,
     author VARCHAR(255) NOT NULL,
     genre VARCHAR(255) NOT NULL,
     quantity INTEGER NOT NULL,
-    publicized_at TIMESTAMP NOT NULL
+    publicized_at TIMESTAMP NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP
 );
 
 CREATE TABLE IF NOT EXISTS price_policies (
     id SERIAL PRIMARY KEY,
-    book_id INTEGER REFERENCES books(id) ON DELETE CASCADE,
+    book_id INTEGER NOT NULL REFERENCES books(id) ON DELETE CASCADE,
     price FLOAT NOT NULL,
     start_date TIMESTAMP NOT NULL,
     end_date TIMESTAMP NOT NULL
 );
+
+CREATE INDEX IF NOT EXISTS price_policies_book_id_idx ON price_policies (book_id);
+
+CREATE INDEX IF NOT EXISTS books_genre_idx ON books (genre);
+
+CREATE TABLE IF NOT EXISTS listings (
+    id SERIAL PRIMARY KEY,
+    reference UUID NOT NULL UNIQUE,
+    attributes JSONB NOT NULL,
+    tags TEXT[] NOT NULL,
+    price NUMERIC(10, 2) NOT NULL,
+    cover BYTEA NOT NULL,
+    subtitle TEXT,
+    discontinued_at TIMESTAMPTZ,
+    listed_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS drafts (
+    id SERIAL PRIMARY KEY,
+    title VARCHAR(255),
+    subtitle VARCHAR(255),
+    author VARCHAR(255),
+    translator VARCHAR(255),
+    pages INTEGER,
+    rating FLOAT,
+    illustrated BOOLEAN,
+    submitted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS uuid_books (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    isbn VARCHAR(255) NOT NULL UNIQUE,
+    title VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL
+);
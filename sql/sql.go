@@ -5,4 +5,7 @@ import _ "embed"
 var (
 	//go:embed init.sql
 	RecreateDatabaseSQL string
+	// RecreateWideTableSQL is generated apart by widegen, as the width of the table is configurable.
+	//go:embed wide.sql
+	RecreateWideTableSQL string
 )